@@ -7,11 +7,13 @@ import (
 	"github.com/vlad-marlo/godo/internal/config"
 	"github.com/vlad-marlo/godo/internal/controller/grpc"
 	httpctrl "github.com/vlad-marlo/godo/internal/controller/http"
+	"github.com/vlad-marlo/godo/internal/controller/scheduler"
 	"github.com/vlad-marlo/godo/internal/pkg/client/postgres"
 	"github.com/vlad-marlo/godo/internal/pkg/logger"
 	"github.com/vlad-marlo/godo/internal/service"
 	"github.com/vlad-marlo/godo/internal/service/production"
 	"github.com/vlad-marlo/godo/internal/store"
+	"github.com/vlad-marlo/godo/internal/store/fs"
 	"github.com/vlad-marlo/godo/internal/store/pgx"
 	"go.uber.org/fx"
 	"go.uber.org/zap"
@@ -49,24 +51,42 @@ func CreateApp() fx.Option {
 				ServiceFactory,
 				fx.As(new(httpctrl.Service)),
 				fx.As(new(grpc.Service)),
+				fx.As(new(scheduler.Service)),
 			),
 			fx.Annotate(
 				pgx.New,
 				fx.As(new(store.Store)),
 			),
+			fx.Annotate(
+				fs.New,
+				fx.As(new(store.BlobStore)),
+			),
 			pgx.NewGroupRepository,
 			pgx.NewUserRepository,
 			pgx.NewTokenRepository,
 			pgx.NewTaskRepository,
 			pgx.NewInviteRepository,
 			pgx.NewRoleRepository,
+			pgx.NewReviewRepository,
+			pgx.NewCommentRepository,
+			pgx.NewDependencyRepository,
+			pgx.NewLabelRepository,
+			pgx.NewBoardRepository,
+			pgx.NewTemplateRepository,
+			pgx.NewHistoryRepository,
+			pgx.NewAttachmentRepository,
+			pgx.NewChecklistRepository,
+			pgx.NewWorklogRepository,
+			pgx.NewWatcherRepository,
 			httpctrl.New,
+			scheduler.New,
 		),
 		fx.Invoke(
 			CreateLogger,
 			ValidateConfig,
 			StartHTTPServer,
 			StartGRPCServer,
+			StartScheduler,
 			LoggerSyncer,
 		),
 	)
@@ -104,6 +124,14 @@ func StartGRPCServer(lc fx.Lifecycle, h *grpc.Server, cfg *config.Config) {
 	})
 }
 
+// StartScheduler is starting scheduler of recurring tasks.
+func StartScheduler(lc fx.Lifecycle, s *scheduler.Scheduler) {
+	lc.Append(fx.Hook{
+		OnStart: s.Start,
+		OnStop:  s.Stop,
+	})
+}
+
 // ValidateConfig checks if config valid and if not logs recommendations to configure application.
 func ValidateConfig(cfg *config.Config, log *zap.Logger) error {
 	ok, err := cfg.Valid()
@@ -120,11 +148,11 @@ func ValidateConfig(cfg *config.Config, log *zap.Logger) error {
 
 // ServiceFactory return right service for server. If server is running on development mode than factory will return
 // development service instead of production.
-func ServiceFactory(store store.Store, cfg *config.Config, log *zap.Logger) service.Interface {
+func ServiceFactory(store store.Store, blobs store.BlobStore, cfg *config.Config, log *zap.Logger) service.Interface {
 	//if cfg.Server.IsDev {
 	// create development server if necessary.
 	//}
-	return production.New(store, cfg, log)
+	return production.New(store, blobs, cfg, log)
 }
 
 // LoggerSyncer add hook to fx application that syncs logger on server shut down.
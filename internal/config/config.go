@@ -55,6 +55,12 @@ type (
 		Addr               string `env:"BIND_ADDR" toml:"addr"`
 		BaseURL            string `env:"BASE_URL" toml:"base_url"`
 		InviteLinkTemplate string `env:"INVITE_LINK_TEMPLATE"`
+		// SchedulerInterval is period with which recurring tasks are checked.
+		SchedulerInterval time.Duration `env:"SCHEDULER_INTERVAL" toml:"scheduler_interval"`
+		// AttachmentsDir is directory in which files of attachments are kept.
+		AttachmentsDir string `env:"ATTACHMENTS_DIR" toml:"attachments_dir"`
+		// MaxAttachmentSize is max size of uploaded file in bytes.
+		MaxAttachmentSize int64 `env:"MAX_ATTACHMENT_SIZE" toml:"max_attachment_size"`
 	}
 	// Test is a configuration that is using in tests
 	Test struct {
@@ -97,6 +103,9 @@ const (
 	defaultType        = "http"
 	defaultTokenSize   = 20
 	defaultInviteTmp   = "%s/api/v1/groups/%s/apply?invite=%s"
+	defaultSchedulerIn = time.Minute
+	defaultAttachDir   = "attachments"
+	defaultAttachSize  = 10 << 20
 )
 
 // New creates new config once and return singleton object every time when called.
@@ -231,6 +240,15 @@ func (c *Config) setDefaultVars() {
 	if c.Server.InviteLinkTemplate == "" {
 		c.Server.InviteLinkTemplate = defaultInviteTmp
 	}
+	if c.Server.SchedulerInterval <= 0 {
+		c.Server.SchedulerInterval = defaultSchedulerIn
+	}
+	if c.Server.AttachmentsDir == "" {
+		c.Server.AttachmentsDir = defaultAttachDir
+	}
+	if c.Server.MaxAttachmentSize <= 0 {
+		c.Server.MaxAttachmentSize = defaultAttachSize
+	}
 	if c.Server.BaseURL == "" {
 		c.Server.BaseURL = fmt.Sprintf("http://%s:%d", c.Server.Addr, c.Server.Port)
 	}
@@ -31,9 +31,13 @@ type Service interface {
 	// CreateGroup create new group.
 	CreateGroup(ctx context.Context, user uuid.UUID, name, description string) (*model.CreateGroupResponse, error)
 	// CreateInvite creates invite link.
-	CreateInvite(ctx context.Context, user, group uuid.UUID, role *model.Role, limit int) (*model.CreateInviteResponse, error)
+	CreateInvite(ctx context.Context, user, group uuid.UUID, role int32, limit int) (*model.CreateInviteResponse, error)
 	// UseInvite add user to group if invite is ok.
 	UseInvite(ctx context.Context, user, group, invite uuid.UUID) error
+	// UpdateTask changes name, description and deadline of task.
+	UpdateTask(ctx context.Context, user, task uuid.UUID, req model.TaskUpdateRequest) (*model.Task, error)
+	// DeleteTask deletes task.
+	DeleteTask(ctx context.Context, user, task uuid.UUID) error
 }
 
 // Server ...
@@ -3,20 +3,59 @@ package httpctrl
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
 	"github.com/google/uuid"
 	mw "github.com/vlad-marlo/godo/internal/controller/http/middleware"
 	"github.com/vlad-marlo/godo/internal/model"
+	"github.com/vlad-marlo/godo/internal/service"
 	"go.uber.org/zap"
 	"io"
+	"mime"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 )
 
 const (
-	zapRequestIDFieldName = "request_id"
-	groupIDParamName      = "group_id"
-	inviteInQueryKey      = "invite"
+	zapRequestIDFieldName    = "request_id"
+	groupIDParamName         = "group_id"
+	taskIDParamName          = "task_id"
+	reviewIDParamName        = "review_id"
+	commentIDParamName       = "comment_id"
+	blockerIDParamName       = "blocker_id"
+	labelIDParamName         = "label_id"
+	templateIDParamName      = "template_id"
+	attachmentIDParamName    = "attachment_id"
+	checklistItemIDParamName = "item_id"
+	worklogIDParamName       = "worklog_id"
+	userIDParamName          = "user_id"
+	roleIDParamName          = "role_id"
+	inviteInQueryKey         = "invite"
+	overdueInQueryKey        = "overdue"
+	dueBeforeInQueryKey      = "due-before"
+	dueAfterInQueryKey       = "due-after"
+	statusInQueryKey         = "status"
+	groupInQueryKey          = "group"
+	creatorInQueryKey        = "creator"
+	assigneeInQueryKey       = "assignee"
+	sortInQueryKey           = "sort"
+	orderInQueryKey          = "order"
+	cursorInQueryKey         = "cursor"
+	limitInQueryKey          = "limit"
+	searchInQueryKey         = "q"
+	formatInQueryKey         = "format"
+	labelInQueryKey          = "label"
+	commentInQueryKey        = "comment"
+	fromInQueryKey           = "from"
+	toInQueryKey             = "to"
+	byInQueryKey             = "by"
+	afterInQueryKey          = "after"
+	attachmentFormField      = "file"
+	// attachmentFormOverhead is allowed size of multipart form without file.
+	attachmentFormOverhead = 1 << 20
 )
 
 // reqIDField return named zap field with reqID in it.
@@ -162,180 +201,3063 @@ func (s *Server) CreateGroup(w http.ResponseWriter, r *http.Request) {
 	s.respond(w, http.StatusCreated, resp, reqIDField(reqID))
 }
 
+// Groups return groups in which user is member.
+//
+//	@Tags		Groups
+//	@Summary	Get groups of user.
+//	@ID			groups
+//	@Accept		plain
+//	@Produce	json
+//
+//	@Success	200	{object}	model.GetGroupsResponse
+//	@Failure	401	{object}	model.Error
+//	@Failure	500	{object}	model.Error
+//
+//	@Router		/groups/ [get]
+func (s *Server) Groups(w http.ResponseWriter, r *http.Request) {
+	reqID := reqIDField(middleware.GetReqID(r.Context()))
+	_ = r.Body.Close()
+
+	resp, err := s.srv.GetGroups(r.Context(), mw.UserFromCtx(r.Context()))
+	if err != nil {
+		s.handleErr(w, err, reqID)
+		return
+	}
+
+	s.respond(w, http.StatusOK, resp, reqID)
+}
+
+// GetGroup return profile of group.
+//
+//	@Tags			Groups
+//	@Summary		Get profile of group.
+//	@Description	Profile is available only to admins of group.
+//	@ID				get_group
+//	@Accept			plain
+//	@Produce		json
+//	@Param			group_id	path		string	true	"group id"
+//
+//	@Success		200			{object}	model.GroupProfile
+//	@Failure		400			{object}	model.Error
+//	@Failure		401			{object}	model.Error
+//	@Failure		403			{object}	model.Error
+//	@Failure		404			{object}	model.Error
+//	@Failure		500			{object}	model.Error
+//
+//	@Router			/groups/{group_id} [get]
+func (s *Server) GetGroup(w http.ResponseWriter, r *http.Request) {
+	reqID := reqIDField(middleware.GetReqID(r.Context()))
+	_ = r.Body.Close()
+
+	group, err := uuid.Parse(chi.URLParam(r, groupIDParamName))
+	if err != nil {
+		s.respond(w, http.StatusBadRequest, map[string]string{"path": "bad group id"}, zap.Error(err), reqID)
+		return
+	}
+
+	resp, err := s.srv.GetGroup(r.Context(), mw.UserFromCtx(r.Context()), group)
+	if err != nil {
+		s.handleErr(w, err, reqID)
+		return
+	}
+
+	s.respond(w, http.StatusOK, resp, reqID)
+}
+
+// UpdateGroup changes profile of group.
+//
+//	@Tags			Groups
+//	@Summary		Change profile of group.
+//	@Description	Profile could be changed only by admins of group. Custom field with null value is removed.
+//	@ID				update_group
+//	@Accept			json
+//	@Produce		json
+//	@Param			group_id	path		string						true	"group id"
+//	@Param			request		body		model.UpdateGroupRequest	true	"new profile data"
+//
+//	@Success		200			{object}	model.GroupProfile
+//	@Failure		400			{object}	model.Error
+//	@Failure		401			{object}	model.Error
+//	@Failure		403			{object}	model.Error
+//	@Failure		404			{object}	model.Error
+//	@Failure		409			{object}	model.Error
+//	@Failure		500			{object}	model.Error
+//
+//	@Router			/groups/{group_id} [patch]
+func (s *Server) UpdateGroup(w http.ResponseWriter, r *http.Request) {
+	reqID := reqIDField(middleware.GetReqID(r.Context()))
+
+	var buf bytes.Buffer
+	if _, err := io.Copy(&buf, r.Body); err != nil {
+		s.internal(w, zap.Error(err), reqID)
+		return
+	}
+	_ = r.Body.Close()
+
+	group, err := uuid.Parse(chi.URLParam(r, groupIDParamName))
+	if err != nil {
+		s.respond(w, http.StatusBadRequest, map[string]string{"path": "bad group id"}, zap.Error(err), reqID)
+		return
+	}
+
+	var req model.UpdateGroupRequest
+	if err = json.NewDecoder(&buf).Decode(&req); err != nil {
+		s.respond(w, http.StatusBadRequest, nil, zap.Error(err), reqID)
+		return
+	}
+
+	resp, err := s.srv.UpdateGroup(r.Context(), mw.UserFromCtx(r.Context()), group, req)
+	if err != nil {
+		s.handleErr(w, err, reqID)
+		return
+	}
+
+	s.respond(w, http.StatusOK, resp, reqID)
+}
+
+// GroupMembers return members of group with their roles.
+//
+//	@Tags		Groups
+//	@Summary	Get members of group.
+//	@ID			group_members
+//	@Accept		plain
+//	@Produce	json
+//	@Param		group_id	path		string	true	"group id"
+//
+//	@Success	200			{object}	model.GetMembersResponse
+//	@Failure	400			{object}	model.Error
+//	@Failure	401			{object}	model.Error
+//	@Failure	403			{object}	model.Error
+//	@Failure	500			{object}	model.Error
+//
+//	@Router		/groups/{group_id}/members [get]
+func (s *Server) GroupMembers(w http.ResponseWriter, r *http.Request) {
+	reqID := reqIDField(middleware.GetReqID(r.Context()))
+	_ = r.Body.Close()
+
+	group, err := uuid.Parse(chi.URLParam(r, groupIDParamName))
+	if err != nil {
+		s.respond(w, http.StatusBadRequest, map[string]string{"path": "bad group id"}, zap.Error(err), reqID)
+		return
+	}
+
+	resp, err := s.srv.GetGroupMembers(r.Context(), mw.UserFromCtx(r.Context()), group)
+	if err != nil {
+		s.handleErr(w, err, reqID)
+		return
+	}
+
+	s.respond(w, http.StatusOK, resp, reqID)
+}
+
+// RemoveGroupMember removes member from group.
+//
+//	@Tags			Groups
+//	@Summary		Remove member from group.
+//	@Description	Member is unassigned from open tasks of group. Admins could be removed only by other admins.
+//	@ID				remove_group_member
+//	@Accept			plain
+//	@Produce		json
+//	@Param			group_id	path	string	true	"group id"
+//	@Param			user_id		path	string	true	"user id"
+//
+//	@Success		200
+//	@Failure		400	{object}	model.Error
+//	@Failure		401	{object}	model.Error
+//	@Failure		403	{object}	model.Error
+//	@Failure		404	{object}	model.Error
+//	@Failure		409	{object}	model.Error
+//	@Failure		500	{object}	model.Error
+//
+//	@Router			/groups/{group_id}/members/{user_id} [delete]
+func (s *Server) RemoveGroupMember(w http.ResponseWriter, r *http.Request) {
+	reqID := reqIDField(middleware.GetReqID(r.Context()))
+	_ = r.Body.Close()
+
+	group, member, ok := s.parseGroupAndMember(w, r, reqID)
+	if !ok {
+		return
+	}
+
+	if err := s.srv.RemoveGroupMember(r.Context(), mw.UserFromCtx(r.Context()), group, member); err != nil {
+		s.handleErr(w, err, reqID)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// UpdateGroupMember changes role and admin flag of member.
+//
+//	@Tags			Groups
+//	@Summary		Change role of member.
+//	@Description	Role could give member only permissions which user who changes it has. Admin flag is changed only by admins.
+//	@Description	Every change is recorded in audit log of group.
+//	@ID				update_group_member
+//	@Accept			json
+//	@Produce		json
+//	@Param			group_id	path		string						true	"group id"
+//	@Param			user_id		path		string						true	"user id"
+//	@Param			request		body		model.UpdateMemberRequest	true	"new role"
+//
+//	@Success		200			{object}	model.UserInGroup
+//	@Failure		400			{object}	model.Error
+//	@Failure		401			{object}	model.Error
+//	@Failure		403			{object}	model.Error
+//	@Failure		404			{object}	model.Error
+//	@Failure		409			{object}	model.Error
+//	@Failure		500			{object}	model.Error
+//
+//	@Router			/groups/{group_id}/members/{user_id} [patch]
+func (s *Server) UpdateGroupMember(w http.ResponseWriter, r *http.Request) {
+	reqID := reqIDField(middleware.GetReqID(r.Context()))
+
+	var buf bytes.Buffer
+	if _, err := io.Copy(&buf, r.Body); err != nil {
+		s.internal(w, zap.Error(err), reqID)
+		return
+	}
+	_ = r.Body.Close()
+
+	group, member, ok := s.parseGroupAndMember(w, r, reqID)
+	if !ok {
+		return
+	}
+
+	var req model.UpdateMemberRequest
+	if err := json.NewDecoder(&buf).Decode(&req); err != nil {
+		s.respond(w, http.StatusBadRequest, nil, zap.Error(err), reqID)
+		return
+	}
+
+	resp, err := s.srv.UpdateGroupMember(r.Context(), mw.UserFromCtx(r.Context()), group, member, req)
+	if err != nil {
+		s.handleErr(w, err, reqID)
+		return
+	}
+
+	s.respond(w, http.StatusOK, resp, reqID)
+}
+
+// parseGroupAndMember parses group and user ids from url path. If ids are bad then bad request is responded.
+func (s *Server) parseGroupAndMember(w http.ResponseWriter, r *http.Request, reqID zap.Field) (group, member uuid.UUID, ok bool) {
+	group, err := uuid.Parse(chi.URLParam(r, groupIDParamName))
+	if err != nil {
+		s.respond(w, http.StatusBadRequest, map[string]string{"path": "bad group id"}, zap.Error(err), reqID)
+		return group, member, false
+	}
+
+	member, err = uuid.Parse(chi.URLParam(r, userIDParamName))
+	if err != nil {
+		s.respond(w, http.StatusBadRequest, map[string]string{"path": "bad user id"}, zap.Error(err), reqID)
+		return group, member, false
+	}
+	return group, member, true
+}
+
+// GroupAuditLog return changes of group members.
+//
+//	@Tags			Groups
+//	@Summary		Get audit log of group.
+//	@Description	Audit log is available only to admins of group.
+//	@ID				group_audit_log
+//	@Accept			plain
+//	@Produce		json
+//	@Param			group_id	path		string	true	"group id"
+//	@Param			after		query		int		false	"id of last received entry, entries after it are returned"
+//	@Param			limit		query		int		false	"max count of entries, 50 by default and 200 at most"
+//
+//	@Success		200			{object}	model.GetAuditLogResponse
+//	@Failure		400			{object}	model.Error
+//	@Failure		401			{object}	model.Error
+//	@Failure		403			{object}	model.Error
+//	@Failure		500			{object}	model.Error
+//
+//	@Router			/groups/{group_id}/audit [get]
+func (s *Server) GroupAuditLog(w http.ResponseWriter, r *http.Request) {
+	reqID := reqIDField(middleware.GetReqID(r.Context()))
+	_ = r.Body.Close()
+
+	group, err := uuid.Parse(chi.URLParam(r, groupIDParamName))
+	if err != nil {
+		s.respond(w, http.StatusBadRequest, map[string]string{"path": "bad group id"}, zap.Error(err), reqID)
+		return
+	}
+	after, limit, ok := s.parseAfterPage(w, r, reqID)
+	if !ok {
+		return
+	}
+
+	resp, err := s.srv.GetGroupAuditLog(r.Context(), mw.UserFromCtx(r.Context()), group, after, limit)
+	if err != nil {
+		s.handleErr(w, err, reqID)
+		return
+	}
+
+	s.respond(w, http.StatusOK, resp, reqID)
+}
+
+// parseGroupAndRole parses group and role ids from url path. If ids are bad then bad request is responded.
+func (s *Server) parseGroupAndRole(w http.ResponseWriter, r *http.Request, reqID zap.Field) (group uuid.UUID, role int32, ok bool) {
+	group, err := uuid.Parse(chi.URLParam(r, groupIDParamName))
+	if err != nil {
+		s.respond(w, http.StatusBadRequest, map[string]string{"path": "bad group id"}, zap.Error(err), reqID)
+		return group, role, false
+	}
+
+	id, err := strconv.ParseInt(chi.URLParam(r, roleIDParamName), 10, 32)
+	if err != nil {
+		s.respond(w, http.StatusBadRequest, map[string]string{"path": "bad role id"}, zap.Error(err), reqID)
+		return group, role, false
+	}
+	return group, int32(id), true
+}
+
+// GroupRoles return roles of group.
+//
+//	@Tags		Groups
+//	@Summary	Get roles of group.
+//	@ID			group_roles
+//	@Accept		plain
+//	@Produce	json
+//	@Param		group_id	path		string	true	"group id"
+//
+//	@Success	200			{object}	model.GetRolesResponse
+//	@Failure	400			{object}	model.Error
+//	@Failure	401			{object}	model.Error
+//	@Failure	403			{object}	model.Error
+//	@Failure	500			{object}	model.Error
+//
+//	@Router		/groups/{group_id}/roles [get]
+func (s *Server) GroupRoles(w http.ResponseWriter, r *http.Request) {
+	reqID := reqIDField(middleware.GetReqID(r.Context()))
+	_ = r.Body.Close()
+
+	group, err := uuid.Parse(chi.URLParam(r, groupIDParamName))
+	if err != nil {
+		s.respond(w, http.StatusBadRequest, map[string]string{"path": "bad group id"}, zap.Error(err), reqID)
+		return
+	}
+
+	resp, err := s.srv.GetGroupRoles(r.Context(), mw.UserFromCtx(r.Context()), group)
+	if err != nil {
+		s.handleErr(w, err, reqID)
+		return
+	}
+
+	s.respond(w, http.StatusOK, resp, reqID)
+}
+
+// CreateGroupRole creates named role of group.
+//
+//	@Tags			Groups
+//	@Summary		Create role of group.
+//	@Description	Roles are created only by admins of group. Every role of group must have unique name and set of permissions.
+//	@Description	Permissions are tasks.view, tasks.create, tasks.edit-related, tasks.edit, tasks.delete, tasks.review, reviews.view,
+//	@Description	issues.view, issues.create, issues.edit-related, issues.moderate, members.view, invites.create,
+//	@Description	members.remove-related and members.remove.
+//	@ID				create_group_role
+//	@Accept			json
+//	@Produce		json
+//	@Param			group_id	path		string					true	"group id"
+//	@Param			request		body		model.CreateRoleRequest	true	"role"
+//
+//	@Success		201			{object}	model.Role
+//	@Failure		400			{object}	model.Error
+//	@Failure		401			{object}	model.Error
+//	@Failure		403			{object}	model.Error
+//	@Failure		409			{object}	model.Error
+//	@Failure		500			{object}	model.Error
+//
+//	@Router			/groups/{group_id}/roles [post]
+func (s *Server) CreateGroupRole(w http.ResponseWriter, r *http.Request) {
+	reqID := reqIDField(middleware.GetReqID(r.Context()))
+
+	var buf bytes.Buffer
+	if _, err := io.Copy(&buf, r.Body); err != nil {
+		s.internal(w, zap.Error(err), reqID)
+		return
+	}
+	_ = r.Body.Close()
+
+	group, err := uuid.Parse(chi.URLParam(r, groupIDParamName))
+	if err != nil {
+		s.respond(w, http.StatusBadRequest, map[string]string{"path": "bad group id"}, zap.Error(err), reqID)
+		return
+	}
+
+	var req model.CreateRoleRequest
+	if err = json.NewDecoder(&buf).Decode(&req); err != nil {
+		s.respond(w, http.StatusBadRequest, nil, zap.Error(err), reqID)
+		return
+	}
+
+	resp, err := s.srv.CreateGroupRole(r.Context(), mw.UserFromCtx(r.Context()), group, req)
+	if err != nil {
+		s.handleErr(w, err, reqID)
+		return
+	}
+
+	s.respond(w, http.StatusCreated, resp, reqID)
+}
+
+// DeleteGroupRole deletes role of group.
+//
+//	@Tags			Groups
+//	@Summary		Delete role of group.
+//	@Description	Roles are deleted only by admins of group. Role which is given to members or invites could not be deleted.
+//	@ID				delete_group_role
+//	@Accept			plain
+//	@Produce		json
+//	@Param			group_id	path	string	true	"group id"
+//	@Param			role_id		path	int		true	"role id"
+//
+//	@Success		200
+//	@Failure		400	{object}	model.Error
+//	@Failure		401	{object}	model.Error
+//	@Failure		403	{object}	model.Error
+//	@Failure		404	{object}	model.Error
+//	@Failure		409	{object}	model.Error
+//	@Failure		500	{object}	model.Error
+//
+//	@Router			/groups/{group_id}/roles/{role_id} [delete]
+func (s *Server) DeleteGroupRole(w http.ResponseWriter, r *http.Request) {
+	reqID := reqIDField(middleware.GetReqID(r.Context()))
+	_ = r.Body.Close()
+
+	group, role, ok := s.parseGroupAndRole(w, r, reqID)
+	if !ok {
+		return
+	}
+
+	if err := s.srv.DeleteGroupRole(r.Context(), mw.UserFromCtx(r.Context()), group, role); err != nil {
+		s.handleErr(w, err, reqID)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// LeaveGroup removes user who made request from group.
+//
+//	@Tags			Groups
+//	@Summary		Leave group.
+//	@Description	The last admin of group could not leave it.
+//	@ID				leave_group
+//	@Accept			plain
+//	@Produce		json
+//	@Param			group_id	path	string	true	"group id"
+//
+//	@Success		200
+//	@Failure		400	{object}	model.Error
+//	@Failure		401	{object}	model.Error
+//	@Failure		404	{object}	model.Error
+//	@Failure		409	{object}	model.Error
+//	@Failure		500	{object}	model.Error
+//
+//	@Router			/groups/{group_id}/members/me [delete]
+func (s *Server) LeaveGroup(w http.ResponseWriter, r *http.Request) {
+	reqID := reqIDField(middleware.GetReqID(r.Context()))
+	_ = r.Body.Close()
+
+	group, err := uuid.Parse(chi.URLParam(r, groupIDParamName))
+	if err != nil {
+		s.respond(w, http.StatusBadRequest, map[string]string{"path": "bad group id"}, zap.Error(err), reqID)
+		return
+	}
+
+	if err = s.srv.LeaveGroup(r.Context(), mw.UserFromCtx(r.Context()), group); err != nil {
+		s.handleErr(w, err, reqID)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
 // CreateInviteLink create new invite link.
 //
-//	@Tags		Invites,Groups
-//	@Summary	создание приглашения в группу.
-//	@ID			invite_user
-//	@Accept		json
+//	@Tags		Invites,Groups
+//	@Summary	создание приглашения в группу.
+//	@ID			invite_user
+//	@Accept		json
+//	@Produce	json
+//	@Param		request	body		model.CreateInviteRequest	true	"invite data"
+//
+//	@Success	201		{object}	model.CreateInviteResponse
+//	@Failure	400		{object}	model.Error
+//	@Failure	401		{object}	model.Error
+//	@Failure	403		{object}	model.Error
+//	@Failure	409		{object}	model.Error
+//	@Failure	500		{object}	model.Error
+//
+//	@Router		/invites [post]
+func (s *Server) CreateInviteLink(w http.ResponseWriter, r *http.Request) {
+	u := mw.UserFromCtx(r.Context())
+	reqID := middleware.GetReqID(r.Context())
+	var req model.CreateInviteRequest
+	var buf bytes.Buffer
+
+	if _, err := io.Copy(&buf, r.Body); err != nil {
+		s.respond(w, http.StatusInternalServerError, nil, zap.Error(err), reqIDField(reqID))
+		return
+	}
+	_ = r.Body.Close()
+
+	if err := json.NewDecoder(&buf).Decode(&req); err != nil {
+		s.respond(w, http.StatusBadRequest, nil, zap.Error(err), reqIDField(reqID))
+		return
+	}
+
+	resp, err := s.srv.CreateInvite(r.Context(), u, req.Group, req.Role, req.Limit)
+	if err != nil {
+		s.handleErr(w, err, reqIDField(reqID))
+		return
+	}
+
+	s.respond(w, http.StatusCreated, resp)
+}
+
+// CreateInviteViaGroup create new invite link.
+//
+//	@Tags		Invites,Groups
+//	@Summary	создание приглашения в группу.
+//	@ID			invite_user_groups
+//	@Accept		json
+//	@Produce	json
+//	@Param		request		body		model.CreateInviteViaGroupRequest	true	"invite data"
+//	@Param		group_id	path		string								true	"group id"
+//
+//	@Success	201			{object}	model.CreateInviteResponse
+//	@Failure	400			{object}	model.Error
+//	@Failure	401			{object}	model.Error
+//	@Failure	403			{object}	model.Error
+//	@Failure	409			{object}	model.Error
+//	@Failure	500			{object}	model.Error
+//
+//	@Router		/groups/{group_id}/invite [post]
+func (s *Server) CreateInviteViaGroup(w http.ResponseWriter, r *http.Request) {
+	reqID := middleware.GetReqID(r.Context())
+	u := mw.UserFromCtx(r.Context())
+
+	var req model.CreateInviteViaGroupRequest
+	var buf bytes.Buffer
+
+	if _, err := io.Copy(&buf, r.Body); err != nil {
+		s.respond(w, http.StatusInternalServerError, nil, zap.Error(err), reqIDField(reqID))
+		return
+	}
+	_ = r.Body.Close()
+
+	group, err := uuid.Parse(chi.URLParam(r, groupIDParamName))
+	if err != nil {
+		s.respond(w, http.StatusBadRequest, map[string]string{"path": "bad group id"}, zap.Error(err), reqIDField(reqID))
+		return
+	}
+
+	if err = json.NewDecoder(&buf).Decode(&req); err != nil {
+		s.respond(w, http.StatusBadRequest, nil, zap.Error(err), reqIDField(reqID))
+		return
+	}
+
+	var resp *model.CreateInviteResponse
+	resp, err = s.srv.CreateInvite(r.Context(), u, group, req.Role, req.Limit)
+	if err != nil {
+		s.handleErr(w, err, reqIDField(reqID))
+		return
+	}
+
+	s.respond(w, http.StatusCreated, resp, reqIDField(reqID))
+
+}
+
+// UseInvite add user to group if invite is good.
+//
+//	@Tags		Groups
+//	@Summary	Использование приглашения в группу.
+//	@ID			apply_user_to_group
+//	@Accept		plain
+//	@Produce	json
+//	@Param		group_id	path		string	true	"group id"
+//	@Param		invite		query		string	true	"invite id"
+//
+//	@Success	201			{string}	model.CreateInviteResponse
+//	@Failure	400			{object}	model.Error
+//	@Failure	401			{object}	model.Error
+//	@Failure	403			{object}	model.Error
+//	@Failure	409			{object}	model.Error
+//	@Failure	500			{object}	model.Error
+//
+//	@Router		/groups/{group_id}/apply [post]
+func (s *Server) UseInvite(w http.ResponseWriter, r *http.Request) {
+	reqID := reqIDField(middleware.GetReqID(r.Context()))
+	_ = r.Body.Close()
+
+	user := mw.UserFromCtx(r.Context())
+
+	group, err := uuid.Parse(chi.URLParam(r, groupIDParamName))
+	if err != nil {
+		s.respond(w, http.StatusBadRequest, map[string]string{"url": "invite must be valid group id in it"}, reqID)
+		return
+	}
+
+	var invite uuid.UUID
+	invite, err = uuid.Parse(r.URL.Query().Get(inviteInQueryKey))
+	if err != nil {
+		s.respond(w, http.StatusBadRequest, map[string]string{"query": "invite must be valid uuid"}, reqID)
+		return
+	}
+
+	if err = s.srv.UseInvite(r.Context(), user, group, invite); err != nil {
+		s.handleErr(w, err, reqID)
+		return
+	}
+
+	s.respond(w, http.StatusOK, nil, reqID)
+}
+
+// UserMe return user profile.
+//
+//	@Tags		Users
+//	@Summary	Get summary info about user.
+//	@ID			users_me
+//	@Accept		plain
+//	@Produce	json
+//
+//	@Success	200	{object}	model.GetMeResponse
+//	@Failure	401	{object}	model.Error
+//	@Failure	404	{object}	model.Error
+//	@Failure	500	{object}	model.Error
+//
+//	@Router		/users/me [get]
+func (s *Server) UserMe(w http.ResponseWriter, r *http.Request) {
+	reqID := reqIDField(middleware.GetReqID(r.Context()))
+
+	u := mw.UserFromCtx(r.Context())
+	resp, err := s.srv.GetMe(r.Context(), u)
+	if err != nil {
+		s.handleErr(w, err, reqID)
+		return
+	}
+
+	s.respond(w, http.StatusOK, resp, reqID)
+}
+
+// parseTimeQuery parses time from query value which could be unix timestamp or time in RFC 3339 format.
+func parseTimeQuery(val string) (*time.Time, error) {
+	if val == "" {
+		return nil, nil
+	}
+	if ts, err := strconv.ParseInt(val, 10, 64); err == nil {
+		t := time.Unix(ts, 0)
+		return &t, nil
+	}
+	t, err := time.Parse(time.RFC3339, val)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+// parseUUIDQuery parses optional uuid from query value.
+func parseUUIDQuery(val string) (*uuid.UUID, error) {
+	if val == "" {
+		return nil, nil
+	}
+	id, err := uuid.Parse(val)
+	if err != nil {
+		return nil, err
+	}
+	return &id, nil
+}
+
+// parseTaskFilter return filter of tasks from query params of request.
+//
+// If any param is bad then data which must be returned to user is returned as second value.
+func parseTaskFilter(r *http.Request) (filter model.TaskFilter, data map[string]string, err error) {
+	q := r.URL.Query()
+
+	if v := q.Get(overdueInQueryKey); v != "" {
+		if filter.Overdue, err = strconv.ParseBool(v); err != nil {
+			return filter, map[string]string{"query": "overdue must be boolean"}, err
+		}
+	}
+	if filter.DueBefore, err = parseTimeQuery(q.Get(dueBeforeInQueryKey)); err != nil {
+		return filter, map[string]string{"query": "due-before must be unix timestamp or time in RFC 3339 format"}, err
+	}
+	if filter.DueAfter, err = parseTimeQuery(q.Get(dueAfterInQueryKey)); err != nil {
+		return filter, map[string]string{"query": "due-after must be unix timestamp or time in RFC 3339 format"}, err
+	}
+	for _, v := range q[statusInQueryKey] {
+		for _, status := range strings.Split(v, ",") {
+			filter.Status = append(filter.Status, model.TaskStatus(status))
+		}
+	}
+	if filter.Group, err = parseUUIDQuery(q.Get(groupInQueryKey)); err != nil {
+		return filter, map[string]string{"query": "group must be valid uuid"}, err
+	}
+	if filter.CreatedBy, err = parseUUIDQuery(q.Get(creatorInQueryKey)); err != nil {
+		return filter, map[string]string{"query": "creator must be valid uuid"}, err
+	}
+	if filter.Assignee, err = parseUUIDQuery(q.Get(assigneeInQueryKey)); err != nil {
+		return filter, map[string]string{"query": "assignee must be valid uuid"}, err
+	}
+	for _, v := range q[labelInQueryKey] {
+		for _, l := range strings.Split(v, ",") {
+			var label uuid.UUID
+			if label, err = uuid.Parse(l); err != nil {
+				return filter, map[string]string{"query": "label must be valid uuid"}, err
+			}
+			filter.Labels = append(filter.Labels, label)
+		}
+	}
+	if filter.Cursor, err = parseUUIDQuery(q.Get(cursorInQueryKey)); err != nil {
+		return filter, map[string]string{"query": "cursor must be valid uuid"}, err
+	}
+	filter.Sort = model.TaskSort(q.Get(sortInQueryKey))
+	switch q.Get(orderInQueryKey) {
+	case "", "asc":
+	case "desc":
+		filter.Desc = true
+	default:
+		return filter, map[string]string{"query": "order must be asc or desc"}, ErrBadQuery
+	}
+	if v := q.Get(limitInQueryKey); v != "" {
+		if filter.Limit, err = strconv.Atoi(v); err != nil {
+			return filter, map[string]string{"query": "limit must be integer number"}, err
+		}
+	}
+	return filter, nil, nil
+}
+
+// AllTasks godoc.
+//
+//	@Tags		Tasks
+//	@Summary	Get user tasks.
+//	@ID			get_tasks
+//	@Accept		plain
+//	@Produce	json
+//	@Param		overdue		query		bool	false	"only unfinished tasks with passed deadline"
+//	@Param		due-before	query		string	false	"unix timestamp or RFC 3339 time"
+//	@Param		due-after	query		string	false	"unix timestamp or RFC 3339 time"
+//	@Param		status		query		string	false	"comma separated statuses of tasks"
+//	@Param		group		query		string	false	"group id"
+//	@Param		creator		query		string	false	"id of task creator"
+//	@Param		assignee	query		string	false	"id of task assignee"
+//	@Param		label		query		string	false	"comma separated ids of labels which all must be attached to task"
+//	@Param		sort		query		string	false	"created-at (default), deadline or name"
+//	@Param		order		query		string	false	"asc (default) or desc"
+//	@Param		cursor		query		string	false	"next-cursor from previous page"
+//	@Param		limit		query		int		false	"size of page, 50 by default and 200 at most"
+//
+//	@Success	200			{object}	model.GetTasksResponse
+//	@Failure	400			{object}	model.Error
+//	@Failure	401			{object}	model.Error
+//	@Failure	404			{object}	model.Error
+//	@Failure	500			{object}	model.Error
+//
+//	@Router		/tasks [get]
+func (s *Server) AllTasks(w http.ResponseWriter, r *http.Request) {
+	_ = r.Body.Close()
+	reqID := reqIDField(middleware.GetReqID(r.Context()))
+	u := mw.UserFromCtx(r.Context())
+
+	filter, data, err := parseTaskFilter(r)
+	if err != nil {
+		s.respond(w, http.StatusBadRequest, data, zap.Error(err), reqID)
+		return
+	}
+
+	resp, err := s.srv.GetUserTasks(r.Context(), u, filter)
+	if err != nil {
+		s.handleErr(w, err, reqID)
+		return
+	}
+
+	s.respond(w, http.StatusOK, resp, reqID)
+}
+
+// GetTask return summary info about task.
+//
+//	@Tags		Tasks
+//	@Summary	Get task by ID.
+//	@ID			get_task
+//	@Accept		plain
+//	@Produce	json
+//	@Param		task_id	path		string	true	"task id"
+//
+//	@Success	200		{object}	model.Task
+//	@Failure	400		{object}	model.Error
+//	@Failure	401		{object}	model.Error
+//	@Failure	403		{object}	model.Error
+//	@Failure	409		{object}	model.Error
+//	@Failure	500		{object}	model.Error
+//
+//	@Router		/tasks/{task_id} [get]
+func (s *Server) GetTask(w http.ResponseWriter, r *http.Request) {
+	reqID := reqIDField(middleware.GetReqID(r.Context()))
+	u := mw.UserFromCtx(r.Context())
+	g, err := uuid.Parse(chi.URLParam(r, "task_id"))
+	if err != nil {
+		s.respond(w, http.StatusBadRequest, nil, zap.Error(err))
+		return
+	}
+
+	var resp *model.Task
+	resp, err = s.srv.GetTask(r.Context(), u, g)
+	if err != nil {
+		s.handleErr(w, err, reqID)
+		return
+	}
+
+	s.respond(w, http.StatusOK, resp, reqID)
+}
+
+// CreateTask create task.
+//
+// Response contains assignees to whom task was not assigned because of lack of common group with creator.
+//
+//	@Tags		Tasks
+//	@Summary	Create task.
+//	@ID			create_task
+//	@Accept		json
+//	@Produce	json
+//	@Param		request	body		model.TaskCreateRequest	true	"task"
+//
+//	@Success	201		{object}	model.CreateTaskResponse
+//	@Failure	400		{object}	model.Error
+//	@Failure	401		{object}	model.Error
+//	@Failure	403		{object}	model.Error
+//	@Failure	409		{object}	model.Error
+//	@Failure	500		{object}	model.Error
+//
+//	@Router		/tasks/ [post]
+func (s *Server) CreateTask(w http.ResponseWriter, r *http.Request) {
+	reqID := reqIDField(middleware.GetReqID(r.Context()))
+
+	var buf bytes.Buffer
+	if _, err := io.Copy(&buf, r.Body); err != nil {
+		s.internal(w, zap.Error(err), reqID)
+		return
+	}
+
+	_ = r.Body.Close()
+
+	u := mw.UserFromCtx(r.Context())
+
+	var req model.TaskCreateRequest
+	if err := json.NewDecoder(&buf).Decode(&req); err != nil {
+		s.respond(w, http.StatusBadRequest, nil, zap.Error(err), reqID)
+		return
+	}
+
+	resp, err := s.srv.CreateTask(r.Context(), u, req)
+	if err != nil {
+		s.handleErr(w, err, reqID)
+		return
+	}
+
+	s.respond(w, http.StatusCreated, resp, reqID)
+}
+
+// UpdateTask changes task.
+//
+// PUT request replaces all fields of task, PATCH request changes only provided fields.
+//
+//	@Tags		Tasks
+//	@Summary	Change task.
+//	@ID			update_task
+//	@Accept		json
+//	@Produce	json
+//	@Param		task_id	path		string					true	"task id"
+//	@Param		request	body		model.TaskUpdateRequest	true	"new task data"
+//
+//	@Success	200		{object}	model.Task
+//	@Failure	400		{object}	model.Error
+//	@Failure	401		{object}	model.Error
+//	@Failure	403		{object}	model.Error
+//	@Failure	404		{object}	model.Error
+//	@Failure	500		{object}	model.Error
+//
+//	@Router		/tasks/{task_id} [put]
+//	@Router		/tasks/{task_id} [patch]
+func (s *Server) UpdateTask(w http.ResponseWriter, r *http.Request) {
+	reqID := reqIDField(middleware.GetReqID(r.Context()))
+
+	var buf bytes.Buffer
+	if _, err := io.Copy(&buf, r.Body); err != nil {
+		s.internal(w, zap.Error(err), reqID)
+		return
+	}
+	_ = r.Body.Close()
+
+	task, err := uuid.Parse(chi.URLParam(r, taskIDParamName))
+	if err != nil {
+		s.respond(w, http.StatusBadRequest, map[string]string{"path": "bad task id"}, zap.Error(err), reqID)
+		return
+	}
+
+	var req model.TaskUpdateRequest
+	if err = json.NewDecoder(&buf).Decode(&req); err != nil {
+		s.respond(w, http.StatusBadRequest, nil, zap.Error(err), reqID)
+		return
+	}
+	if r.Method == http.MethodPut && (req.Name == nil || req.Description == nil) {
+		s.respond(w, http.StatusBadRequest, map[string]string{"body": "name and description are required"}, reqID)
+		return
+	}
+
+	var resp *model.Task
+	resp, err = s.srv.UpdateTask(r.Context(), mw.UserFromCtx(r.Context()), task, req)
+	if err != nil {
+		s.handleErr(w, err, reqID)
+		return
+	}
+
+	s.respond(w, http.StatusOK, resp, reqID)
+}
+
+// DeleteTask deletes task.
+//
+//	@Tags		Tasks
+//	@Summary	Delete task.
+//	@ID			delete_task
+//	@Accept		plain
+//	@Produce	json
+//	@Param		task_id	path	string	true	"task id"
+//
+//	@Success	200
+//	@Failure	400	{object}	model.Error
+//	@Failure	401	{object}	model.Error
+//	@Failure	403	{object}	model.Error
+//	@Failure	404	{object}	model.Error
+//	@Failure	500	{object}	model.Error
+//
+//	@Router		/tasks/{task_id} [delete]
+func (s *Server) DeleteTask(w http.ResponseWriter, r *http.Request) {
+	reqID := reqIDField(middleware.GetReqID(r.Context()))
+	_ = r.Body.Close()
+
+	task, err := uuid.Parse(chi.URLParam(r, taskIDParamName))
+	if err != nil {
+		s.respond(w, http.StatusBadRequest, map[string]string{"path": "bad task id"}, zap.Error(err), reqID)
+		return
+	}
+
+	if err = s.srv.DeleteTask(r.Context(), mw.UserFromCtx(r.Context()), task); err != nil {
+		s.handleErr(w, err, reqID)
+		return
+	}
+
+	s.respond(w, http.StatusOK, nil, reqID)
+}
+
+// BulkTasks applies one operation to many tasks.
+//
+// Permissions are checked for every task. Operation is applied to all tasks which passed checks in one transaction.
+//
+//	@Tags		Tasks
+//	@Summary	Change many tasks.
+//	@ID			bulk_tasks
+//	@Accept		json
+//	@Produce	json
+//	@Param		request	body		model.BulkTaskRequest	true	"tasks and operation"
+//
+//	@Success	200		{object}	model.BulkTaskResponse
+//	@Failure	400		{object}	model.Error
+//	@Failure	401		{object}	model.Error
+//	@Failure	404		{object}	model.Error
+//	@Failure	500		{object}	model.Error
+//
+//	@Router		/tasks/bulk [post]
+func (s *Server) BulkTasks(w http.ResponseWriter, r *http.Request) {
+	reqID := reqIDField(middleware.GetReqID(r.Context()))
+
+	var buf bytes.Buffer
+	if _, err := io.Copy(&buf, r.Body); err != nil {
+		s.internal(w, zap.Error(err), reqID)
+		return
+	}
+	_ = r.Body.Close()
+
+	var req model.BulkTaskRequest
+	if err := json.NewDecoder(&buf).Decode(&req); err != nil {
+		s.respond(w, http.StatusBadRequest, nil, zap.Error(err), reqID)
+		return
+	}
+
+	resp, err := s.srv.BulkTasks(r.Context(), mw.UserFromCtx(r.Context()), req)
+	if err != nil {
+		s.handleErr(w, err, reqID)
+		return
+	}
+
+	s.respond(w, http.StatusOK, resp, reqID)
+}
+
+// Subtasks return subtasks of task.
+//
+//	@Tags		Tasks
+//	@Summary	Get subtasks of task.
+//	@ID			get_subtasks
+//	@Accept		plain
+//	@Produce	json
+//	@Param		task_id	path		string	true	"task id"
+//
+//	@Success	200		{object}	model.GetTasksResponse
+//	@Failure	400		{object}	model.Error
+//	@Failure	401		{object}	model.Error
+//	@Failure	404		{object}	model.Error
+//	@Failure	500		{object}	model.Error
+//
+//	@Router		/tasks/{task_id}/subtasks [get]
+func (s *Server) Subtasks(w http.ResponseWriter, r *http.Request) {
+	reqID := reqIDField(middleware.GetReqID(r.Context()))
+	_ = r.Body.Close()
+
+	task, err := uuid.Parse(chi.URLParam(r, taskIDParamName))
+	if err != nil {
+		s.respond(w, http.StatusBadRequest, map[string]string{"path": "bad task id"}, zap.Error(err), reqID)
+		return
+	}
+
+	resp, err := s.srv.GetSubtasks(r.Context(), mw.UserFromCtx(r.Context()), task)
+	if err != nil {
+		s.handleErr(w, err, reqID)
+		return
+	}
+
+	s.respond(w, http.StatusOK, resp, reqID)
+}
+
+// TaskHistory return changes of task.
+//
+//	@Tags		Tasks
+//	@Summary	Get history of task changes.
+//	@ID			get_task_history
+//	@Accept		plain
+//	@Produce	json
+//	@Param		task_id	path		string	true	"task id"
+//
+//	@Success	200		{object}	model.GetTaskHistoryResponse
+//	@Failure	400		{object}	model.Error
+//	@Failure	401		{object}	model.Error
+//	@Failure	404		{object}	model.Error
+//	@Failure	500		{object}	model.Error
+//
+//	@Router		/tasks/{task_id}/history [get]
+func (s *Server) TaskHistory(w http.ResponseWriter, r *http.Request) {
+	reqID := reqIDField(middleware.GetReqID(r.Context()))
+	_ = r.Body.Close()
+
+	task, err := uuid.Parse(chi.URLParam(r, taskIDParamName))
+	if err != nil {
+		s.respond(w, http.StatusBadRequest, map[string]string{"path": "bad task id"}, zap.Error(err), reqID)
+		return
+	}
+
+	resp, err := s.srv.GetTaskHistory(r.Context(), mw.UserFromCtx(r.Context()), task)
+	if err != nil {
+		s.handleErr(w, err, reqID)
+		return
+	}
+
+	s.respond(w, http.StatusOK, resp, reqID)
+}
+
+// TaskWatchers return users who watch task.
+//
+//	@Tags		Watchers
+//	@Summary	Get watchers of task.
+//	@ID			get_task_watchers
+//	@Accept		plain
+//	@Produce	json
+//	@Param		task_id	path		string	true	"task id"
+//
+//	@Success	200		{object}	model.GetWatchersResponse
+//	@Failure	400		{object}	model.Error
+//	@Failure	401		{object}	model.Error
+//	@Failure	404		{object}	model.Error
+//	@Failure	500		{object}	model.Error
+//
+//	@Router		/tasks/{task_id}/watchers [get]
+func (s *Server) TaskWatchers(w http.ResponseWriter, r *http.Request) {
+	reqID := reqIDField(middleware.GetReqID(r.Context()))
+	_ = r.Body.Close()
+
+	task, err := uuid.Parse(chi.URLParam(r, taskIDParamName))
+	if err != nil {
+		s.respond(w, http.StatusBadRequest, map[string]string{"path": "bad task id"}, zap.Error(err), reqID)
+		return
+	}
+
+	resp, err := s.srv.GetTaskWatchers(r.Context(), mw.UserFromCtx(r.Context()), task)
+	if err != nil {
+		s.handleErr(w, err, reqID)
+		return
+	}
+
+	s.respond(w, http.StatusOK, resp, reqID)
+}
+
+// WatchTask subscribes user to changes of task.
+//
+//	@Tags		Watchers
+//	@Summary	Watch task.
+//	@ID			watch_task
+//	@Accept		plain
+//	@Produce	json
+//	@Param		task_id	path	string	true	"task id"
+//
+//	@Success	200
+//	@Failure	400	{object}	model.Error
+//	@Failure	401	{object}	model.Error
+//	@Failure	404	{object}	model.Error
+//	@Failure	500	{object}	model.Error
+//
+//	@Router		/tasks/{task_id}/watchers/me [put]
+func (s *Server) WatchTask(w http.ResponseWriter, r *http.Request) {
+	reqID := reqIDField(middleware.GetReqID(r.Context()))
+	_ = r.Body.Close()
+
+	task, err := uuid.Parse(chi.URLParam(r, taskIDParamName))
+	if err != nil {
+		s.respond(w, http.StatusBadRequest, map[string]string{"path": "bad task id"}, zap.Error(err), reqID)
+		return
+	}
+
+	if err = s.srv.WatchTask(r.Context(), mw.UserFromCtx(r.Context()), task); err != nil {
+		s.handleErr(w, err, reqID)
+		return
+	}
+
+	s.respond(w, http.StatusOK, nil, reqID)
+}
+
+// UnwatchTask unsubscribes user from changes of task.
+//
+//	@Tags		Watchers
+//	@Summary	Stop watching task.
+//	@ID			unwatch_task
+//	@Accept		plain
+//	@Produce	json
+//	@Param		task_id	path	string	true	"task id"
+//
+//	@Success	200
+//	@Failure	400	{object}	model.Error
+//	@Failure	401	{object}	model.Error
+//	@Failure	404	{object}	model.Error
+//	@Failure	500	{object}	model.Error
+//
+//	@Router		/tasks/{task_id}/watchers/me [delete]
+func (s *Server) UnwatchTask(w http.ResponseWriter, r *http.Request) {
+	reqID := reqIDField(middleware.GetReqID(r.Context()))
+	_ = r.Body.Close()
+
+	task, err := uuid.Parse(chi.URLParam(r, taskIDParamName))
+	if err != nil {
+		s.respond(w, http.StatusBadRequest, map[string]string{"path": "bad task id"}, zap.Error(err), reqID)
+		return
+	}
+
+	if err = s.srv.UnwatchTask(r.Context(), mw.UserFromCtx(r.Context()), task); err != nil {
+		s.handleErr(w, err, reqID)
+		return
+	}
+
+	s.respond(w, http.StatusOK, nil, reqID)
+}
+
+// Notifications return changes of watched tasks which were made by other users.
+//
+//	@Tags		Watchers
+//	@Summary	Get notifications about changes of watched tasks.
+//	@ID			get_notifications
+//	@Accept		plain
+//	@Produce	json
+//	@Param		after	query		int	false	"id of last received change, changes after it are returned"
+//	@Param		limit	query		int	false	"max count of changes, 50 by default and 200 at most"
+//
+//	@Success	200		{object}	model.GetNotificationsResponse
+//	@Failure	400		{object}	model.Error
+//	@Failure	401		{object}	model.Error
+//	@Failure	500		{object}	model.Error
+//
+//	@Router		/notifications [get]
+func (s *Server) Notifications(w http.ResponseWriter, r *http.Request) {
+	reqID := reqIDField(middleware.GetReqID(r.Context()))
+	_ = r.Body.Close()
+
+	after, limit, ok := s.parseAfterPage(w, r, reqID)
+	if !ok {
+		return
+	}
+
+	resp, err := s.srv.GetNotifications(r.Context(), mw.UserFromCtx(r.Context()), after, limit)
+	if err != nil {
+		s.handleErr(w, err, reqID)
+		return
+	}
+
+	s.respond(w, http.StatusOK, resp, reqID)
+}
+
+// parseAfterPage parses id of last received record and limit of page from query.
+// If they are not integer numbers then bad request is responded.
+func (s *Server) parseAfterPage(w http.ResponseWriter, r *http.Request, reqID zap.Field) (after int64, limit int, ok bool) {
+	q := r.URL.Query()
+	var err error
+	if v := q.Get(afterInQueryKey); v != "" {
+		if after, err = strconv.ParseInt(v, 10, 64); err != nil {
+			s.respond(w, http.StatusBadRequest, map[string]string{"query": "after must be integer number"}, zap.Error(err), reqID)
+			return 0, 0, false
+		}
+	}
+	if v := q.Get(limitInQueryKey); v != "" {
+		if limit, err = strconv.Atoi(v); err != nil {
+			s.respond(w, http.StatusBadRequest, map[string]string{"query": "limit must be integer number"}, zap.Error(err), reqID)
+			return 0, 0, false
+		}
+	}
+	return after, limit, true
+}
+
+// TaskAttachments return attachments of task.
+//
+//	@Tags		Attachments
+//	@Summary	Get attachments of task and its comments.
+//	@ID			get_task_attachments
+//	@Accept		plain
+//	@Produce	json
+//	@Param		task_id	path		string	true	"task id"
+//
+//	@Success	200		{object}	model.GetAttachmentsResponse
+//	@Failure	400		{object}	model.Error
+//	@Failure	401		{object}	model.Error
+//	@Failure	404		{object}	model.Error
+//	@Failure	500		{object}	model.Error
+//
+//	@Router		/tasks/{task_id}/attachments [get]
+func (s *Server) TaskAttachments(w http.ResponseWriter, r *http.Request) {
+	reqID := reqIDField(middleware.GetReqID(r.Context()))
+	_ = r.Body.Close()
+
+	task, err := uuid.Parse(chi.URLParam(r, taskIDParamName))
+	if err != nil {
+		s.respond(w, http.StatusBadRequest, map[string]string{"path": "bad task id"}, zap.Error(err), reqID)
+		return
+	}
+
+	resp, err := s.srv.GetTaskAttachments(r.Context(), mw.UserFromCtx(r.Context()), task)
+	if err != nil {
+		s.handleErr(w, err, reqID)
+		return
+	}
+
+	s.respond(w, http.StatusOK, resp, reqID)
+}
+
+// UploadAttachment attaches file to task or to comment of task.
+//
+//	@Tags		Attachments
+//	@Summary	Upload attachment.
+//	@ID			upload_attachment
+//	@Accept		mpfd
+//	@Produce	json
+//	@Param		task_id	path		string	true	"task id"
+//	@Param		comment	query		string	false	"id of comment to which file is attached"
+//	@Param		file	formData	file	true	"attached file"
+//
+//	@Success	201		{object}	model.Attachment
+//	@Failure	400		{object}	model.Error
+//	@Failure	401		{object}	model.Error
+//	@Failure	403		{object}	model.Error
+//	@Failure	404		{object}	model.Error
+//	@Failure	413		{object}	model.Error
+//	@Failure	500		{object}	model.Error
+//
+//	@Router		/tasks/{task_id}/attachments [post]
+func (s *Server) UploadAttachment(w http.ResponseWriter, r *http.Request) {
+	reqID := reqIDField(middleware.GetReqID(r.Context()))
+	defer func() {
+		_ = r.Body.Close()
+	}()
+
+	task, err := uuid.Parse(chi.URLParam(r, taskIDParamName))
+	if err != nil {
+		s.respond(w, http.StatusBadRequest, map[string]string{"path": "bad task id"}, zap.Error(err), reqID)
+		return
+	}
+
+	var req model.UploadAttachmentRequest
+	if c := r.URL.Query().Get(commentInQueryKey); c != "" {
+		var comment uuid.UUID
+		if comment, err = uuid.Parse(c); err != nil {
+			s.respond(w, http.StatusBadRequest, map[string]string{"query": "bad comment id"}, zap.Error(err), reqID)
+			return
+		}
+		req.Comment = &comment
+	}
+
+	// file is streamed into blob store, so form is read part by part instead of being parsed into memory.
+	r.Body = http.MaxBytesReader(w, r.Body, s.cfg.Server.MaxAttachmentSize+attachmentFormOverhead)
+	mr, err := r.MultipartReader()
+	if err != nil {
+		s.respond(w, http.StatusBadRequest, map[string]string{"body": "multipart form is expected"}, zap.Error(err), reqID)
+		return
+	}
+	for {
+		part, err := mr.NextPart()
+		if err == io.EOF {
+			s.respond(w, http.StatusBadRequest, map[string]string{attachmentFormField: "file is required"}, reqID)
+			return
+		}
+		if errors.As(err, new(*http.MaxBytesError)) {
+			s.handleErr(w, service.ErrAttachmentTooLarge.With(zap.Error(err)), reqID)
+			return
+		}
+		if err != nil {
+			s.respond(w, http.StatusBadRequest, map[string]string{"body": "bad multipart form"}, zap.Error(err), reqID)
+			return
+		}
+		if part.FormName() != attachmentFormField {
+			_ = part.Close()
+			continue
+		}
+
+		req.Name = part.FileName()
+		req.ContentType = part.Header.Get("content-type")
+		req.Body = part
+		break
+	}
+
+	resp, err := s.srv.UploadAttachment(r.Context(), mw.UserFromCtx(r.Context()), task, req)
+	if err != nil {
+		s.handleErr(w, err, reqID)
+		return
+	}
+
+	s.respond(w, http.StatusCreated, resp, reqID)
+}
+
+// DownloadAttachment writes content of attachment.
+//
+//	@Tags		Attachments
+//	@Summary	Download attachment.
+//	@ID			download_attachment
+//	@Accept		plain
+//	@Produce	octet-stream
+//	@Param		task_id			path		string	true	"task id"
+//	@Param		attachment_id	path		string	true	"attachment id"
+//
+//	@Success	200				{file}		file
+//	@Failure	400				{object}	model.Error
+//	@Failure	401				{object}	model.Error
+//	@Failure	404				{object}	model.Error
+//	@Failure	500				{object}	model.Error
+//
+//	@Router		/tasks/{task_id}/attachments/{attachment_id} [get]
+func (s *Server) DownloadAttachment(w http.ResponseWriter, r *http.Request) {
+	reqID := reqIDField(middleware.GetReqID(r.Context()))
+	_ = r.Body.Close()
+
+	task, err := uuid.Parse(chi.URLParam(r, taskIDParamName))
+	if err != nil {
+		s.respond(w, http.StatusBadRequest, map[string]string{"path": "bad task id"}, zap.Error(err), reqID)
+		return
+	}
+	attachment, err := uuid.Parse(chi.URLParam(r, attachmentIDParamName))
+	if err != nil {
+		s.respond(w, http.StatusBadRequest, map[string]string{"path": "bad attachment id"}, zap.Error(err), reqID)
+		return
+	}
+
+	a, content, err := s.srv.GetAttachment(r.Context(), mw.UserFromCtx(r.Context()), task, attachment)
+	if err != nil {
+		s.handleErr(w, err, reqID)
+		return
+	}
+	defer func() {
+		_ = content.Close()
+	}()
+
+	w.Header().Set("content-type", a.ContentType)
+	w.Header().Set("content-length", strconv.FormatInt(a.Size, 10))
+	w.Header().Set("content-disposition", mime.FormatMediaType("attachment", map[string]string{"filename": a.Name}))
+	// content type is set by user, so browser must not guess it.
+	w.Header().Set("x-content-type-options", "nosniff")
+	w.WriteHeader(http.StatusOK)
+	if _, err = io.Copy(w, content); err != nil {
+		s.log.Error("write attachment", zap.Error(err), reqID)
+	}
+}
+
+// DeleteAttachment deletes attachment.
+//
+//	@Tags		Attachments
+//	@Summary	Delete attachment.
+//	@ID			delete_attachment
+//	@Accept		plain
+//	@Produce	json
+//	@Param		task_id			path	string	true	"task id"
+//	@Param		attachment_id	path	string	true	"attachment id"
+//
+//	@Success	200
+//	@Failure	400	{object}	model.Error
+//	@Failure	401	{object}	model.Error
+//	@Failure	403	{object}	model.Error
+//	@Failure	404	{object}	model.Error
+//	@Failure	500	{object}	model.Error
+//
+//	@Router		/tasks/{task_id}/attachments/{attachment_id} [delete]
+func (s *Server) DeleteAttachment(w http.ResponseWriter, r *http.Request) {
+	reqID := reqIDField(middleware.GetReqID(r.Context()))
+	_ = r.Body.Close()
+
+	task, err := uuid.Parse(chi.URLParam(r, taskIDParamName))
+	if err != nil {
+		s.respond(w, http.StatusBadRequest, map[string]string{"path": "bad task id"}, zap.Error(err), reqID)
+		return
+	}
+	attachment, err := uuid.Parse(chi.URLParam(r, attachmentIDParamName))
+	if err != nil {
+		s.respond(w, http.StatusBadRequest, map[string]string{"path": "bad attachment id"}, zap.Error(err), reqID)
+		return
+	}
+
+	if err = s.srv.DeleteAttachment(r.Context(), mw.UserFromCtx(r.Context()), task, attachment); err != nil {
+		s.handleErr(w, err, reqID)
+		return
+	}
+
+	s.respond(w, http.StatusOK, nil, reqID)
+}
+
+// parseTaskAndChecklistItem return task and checklist item ids from path. If ids are bad then responds with error.
+func (s *Server) parseTaskAndChecklistItem(w http.ResponseWriter, r *http.Request, reqID zap.Field) (task, item uuid.UUID, ok bool) {
+	task, err := uuid.Parse(chi.URLParam(r, taskIDParamName))
+	if err != nil {
+		s.respond(w, http.StatusBadRequest, map[string]string{"path": "bad task id"}, zap.Error(err), reqID)
+		return task, item, false
+	}
+
+	item, err = uuid.Parse(chi.URLParam(r, checklistItemIDParamName))
+	if err != nil {
+		s.respond(w, http.StatusBadRequest, map[string]string{"path": "bad checklist item id"}, zap.Error(err), reqID)
+		return task, item, false
+	}
+	return task, item, true
+}
+
+// Checklist return checklist of task.
+//
+//	@Tags		Checklists,Tasks
+//	@Summary	Get checklist of task.
+//	@ID			get_checklist
+//	@Accept		plain
+//	@Produce	json
+//	@Param		task_id	path		string	true	"task id"
+//
+//	@Success	200		{object}	model.GetChecklistResponse
+//	@Failure	400		{object}	model.Error
+//	@Failure	401		{object}	model.Error
+//	@Failure	404		{object}	model.Error
+//	@Failure	500		{object}	model.Error
+//
+//	@Router		/tasks/{task_id}/checklist [get]
+func (s *Server) Checklist(w http.ResponseWriter, r *http.Request) {
+	reqID := reqIDField(middleware.GetReqID(r.Context()))
+	_ = r.Body.Close()
+
+	task, err := uuid.Parse(chi.URLParam(r, taskIDParamName))
+	if err != nil {
+		s.respond(w, http.StatusBadRequest, map[string]string{"path": "bad task id"}, zap.Error(err), reqID)
+		return
+	}
+
+	resp, err := s.srv.GetChecklist(r.Context(), mw.UserFromCtx(r.Context()), task)
+	if err != nil {
+		s.handleErr(w, err, reqID)
+		return
+	}
+
+	s.respond(w, http.StatusOK, resp, reqID)
+}
+
+// CreateChecklistItem adds item to the end of task checklist.
+//
+//	@Tags		Checklists,Tasks
+//	@Summary	Add checklist item.
+//	@ID			create_checklist_item
+//	@Accept		json
+//	@Produce	json
+//	@Param		task_id	path		string								true	"task id"
+//	@Param		request	body		model.CreateChecklistItemRequest	true	"checklist item"
+//
+//	@Success	201		{object}	model.ChecklistItem
+//	@Failure	400		{object}	model.Error
+//	@Failure	401		{object}	model.Error
+//	@Failure	403		{object}	model.Error
+//	@Failure	404		{object}	model.Error
+//	@Failure	500		{object}	model.Error
+//
+//	@Router		/tasks/{task_id}/checklist [post]
+func (s *Server) CreateChecklistItem(w http.ResponseWriter, r *http.Request) {
+	reqID := reqIDField(middleware.GetReqID(r.Context()))
+
+	var buf bytes.Buffer
+	if _, err := io.Copy(&buf, r.Body); err != nil {
+		s.internal(w, zap.Error(err), reqID)
+		return
+	}
+	_ = r.Body.Close()
+
+	task, err := uuid.Parse(chi.URLParam(r, taskIDParamName))
+	if err != nil {
+		s.respond(w, http.StatusBadRequest, map[string]string{"path": "bad task id"}, zap.Error(err), reqID)
+		return
+	}
+
+	var req model.CreateChecklistItemRequest
+	if err = json.NewDecoder(&buf).Decode(&req); err != nil {
+		s.respond(w, http.StatusBadRequest, nil, zap.Error(err), reqID)
+		return
+	}
+
+	var resp *model.ChecklistItem
+	resp, err = s.srv.CreateChecklistItem(r.Context(), mw.UserFromCtx(r.Context()), task, req)
+	if err != nil {
+		s.handleErr(w, err, reqID)
+		return
+	}
+
+	s.respond(w, http.StatusCreated, resp, reqID)
+}
+
+// ToggleChecklistItem marks checklist item as done or not done.
+//
+//	@Tags		Checklists
+//	@Summary	Toggle checklist item.
+//	@ID			toggle_checklist_item
+//	@Accept		json
+//	@Produce	json
+//	@Param		task_id	path		string								true	"task id"
+//	@Param		item_id	path		string								true	"checklist item id"
+//	@Param		request	body		model.ToggleChecklistItemRequest	true	"state of item"
+//
+//	@Success	200		{object}	model.ChecklistItem
+//	@Failure	400		{object}	model.Error
+//	@Failure	401		{object}	model.Error
+//	@Failure	403		{object}	model.Error
+//	@Failure	404		{object}	model.Error
+//	@Failure	500		{object}	model.Error
+//
+//	@Router		/tasks/{task_id}/checklist/{item_id} [patch]
+func (s *Server) ToggleChecklistItem(w http.ResponseWriter, r *http.Request) {
+	reqID := reqIDField(middleware.GetReqID(r.Context()))
+
+	var buf bytes.Buffer
+	if _, err := io.Copy(&buf, r.Body); err != nil {
+		s.internal(w, zap.Error(err), reqID)
+		return
+	}
+	_ = r.Body.Close()
+
+	task, item, ok := s.parseTaskAndChecklistItem(w, r, reqID)
+	if !ok {
+		return
+	}
+
+	var req model.ToggleChecklistItemRequest
+	if err := json.NewDecoder(&buf).Decode(&req); err != nil {
+		s.respond(w, http.StatusBadRequest, nil, zap.Error(err), reqID)
+		return
+	}
+
+	resp, err := s.srv.ToggleChecklistItem(r.Context(), mw.UserFromCtx(r.Context()), task, item, req)
+	if err != nil {
+		s.handleErr(w, err, reqID)
+		return
+	}
+
+	s.respond(w, http.StatusOK, resp, reqID)
+}
+
+// MoveChecklistItem moves checklist item to position.
+//
+//	@Tags		Checklists
+//	@Summary	Reorder checklist.
+//	@ID			move_checklist_item
+//	@Accept		json
+//	@Produce	json
+//	@Param		task_id	path		string							true	"task id"
+//	@Param		item_id	path		string							true	"checklist item id"
+//	@Param		request	body		model.MoveChecklistItemRequest	true	"new position"
+//
+//	@Success	200		{object}	model.GetChecklistResponse
+//	@Failure	400		{object}	model.Error
+//	@Failure	401		{object}	model.Error
+//	@Failure	403		{object}	model.Error
+//	@Failure	404		{object}	model.Error
+//	@Failure	500		{object}	model.Error
+//
+//	@Router		/tasks/{task_id}/checklist/{item_id}/position [put]
+func (s *Server) MoveChecklistItem(w http.ResponseWriter, r *http.Request) {
+	reqID := reqIDField(middleware.GetReqID(r.Context()))
+
+	var buf bytes.Buffer
+	if _, err := io.Copy(&buf, r.Body); err != nil {
+		s.internal(w, zap.Error(err), reqID)
+		return
+	}
+	_ = r.Body.Close()
+
+	task, item, ok := s.parseTaskAndChecklistItem(w, r, reqID)
+	if !ok {
+		return
+	}
+
+	var req model.MoveChecklistItemRequest
+	if err := json.NewDecoder(&buf).Decode(&req); err != nil {
+		s.respond(w, http.StatusBadRequest, nil, zap.Error(err), reqID)
+		return
+	}
+
+	resp, err := s.srv.MoveChecklistItem(r.Context(), mw.UserFromCtx(r.Context()), task, item, req)
+	if err != nil {
+		s.handleErr(w, err, reqID)
+		return
+	}
+
+	s.respond(w, http.StatusOK, resp, reqID)
+}
+
+// DeleteChecklistItem deletes checklist item.
+//
+//	@Tags		Checklists
+//	@Summary	Delete checklist item.
+//	@ID			delete_checklist_item
+//	@Accept		plain
+//	@Produce	json
+//	@Param		task_id	path	string	true	"task id"
+//	@Param		item_id	path	string	true	"checklist item id"
+//
+//	@Success	200
+//	@Failure	400	{object}	model.Error
+//	@Failure	401	{object}	model.Error
+//	@Failure	403	{object}	model.Error
+//	@Failure	404	{object}	model.Error
+//	@Failure	500	{object}	model.Error
+//
+//	@Router		/tasks/{task_id}/checklist/{item_id} [delete]
+func (s *Server) DeleteChecklistItem(w http.ResponseWriter, r *http.Request) {
+	reqID := reqIDField(middleware.GetReqID(r.Context()))
+	_ = r.Body.Close()
+
+	task, item, ok := s.parseTaskAndChecklistItem(w, r, reqID)
+	if !ok {
+		return
+	}
+
+	if err := s.srv.DeleteChecklistItem(r.Context(), mw.UserFromCtx(r.Context()), task, item); err != nil {
+		s.handleErr(w, err, reqID)
+		return
+	}
+
+	s.respond(w, http.StatusOK, nil, reqID)
+}
+
+// StartTimer starts timer of user on task.
+//
+//	@Tags		Worklogs,Tasks
+//	@Summary	Start timer on task.
+//	@ID			start_timer
+//	@Accept		json
+//	@Produce	json
+//	@Param		task_id	path		string					true	"task id"
+//	@Param		request	body		model.StartTimerRequest	false	"description of work"
+//
+//	@Success	201		{object}	model.Worklog
+//	@Failure	400		{object}	model.Error
+//	@Failure	401		{object}	model.Error
+//	@Failure	403		{object}	model.Error
+//	@Failure	404		{object}	model.Error
+//	@Failure	409		{object}	model.Error
+//	@Failure	500		{object}	model.Error
+//
+//	@Router		/tasks/{task_id}/timer [post]
+func (s *Server) StartTimer(w http.ResponseWriter, r *http.Request) {
+	reqID := reqIDField(middleware.GetReqID(r.Context()))
+
+	var buf bytes.Buffer
+	if _, err := io.Copy(&buf, r.Body); err != nil {
+		s.internal(w, zap.Error(err), reqID)
+		return
+	}
+	_ = r.Body.Close()
+
+	task, err := uuid.Parse(chi.URLParam(r, taskIDParamName))
+	if err != nil {
+		s.respond(w, http.StatusBadRequest, map[string]string{"path": "bad task id"}, zap.Error(err), reqID)
+		return
+	}
+
+	var req model.StartTimerRequest
+	if buf.Len() > 0 {
+		if err = json.NewDecoder(&buf).Decode(&req); err != nil {
+			s.respond(w, http.StatusBadRequest, nil, zap.Error(err), reqID)
+			return
+		}
+	}
+
+	var resp *model.Worklog
+	resp, err = s.srv.StartTimer(r.Context(), mw.UserFromCtx(r.Context()), task, req)
+	if err != nil {
+		s.handleErr(w, err, reqID)
+		return
+	}
+
+	s.respond(w, http.StatusCreated, resp, reqID)
+}
+
+// RunningTimer return running timer of user.
+//
+//	@Tags		Worklogs
+//	@Summary	Get running timer.
+//	@ID			get_running_timer
+//	@Accept		plain
+//	@Produce	json
+//
+//	@Success	200	{object}	model.Worklog
+//	@Failure	401	{object}	model.Error
+//	@Failure	404	{object}	model.Error
+//	@Failure	500	{object}	model.Error
+//
+//	@Router		/worklogs/timer [get]
+func (s *Server) RunningTimer(w http.ResponseWriter, r *http.Request) {
+	reqID := reqIDField(middleware.GetReqID(r.Context()))
+	_ = r.Body.Close()
+
+	resp, err := s.srv.GetRunningTimer(r.Context(), mw.UserFromCtx(r.Context()))
+	if err != nil {
+		s.handleErr(w, err, reqID)
+		return
+	}
+
+	s.respond(w, http.StatusOK, resp, reqID)
+}
+
+// StopTimer stops running timer of user.
+//
+//	@Tags		Worklogs
+//	@Summary	Stop running timer.
+//	@ID			stop_timer
+//	@Accept		plain
+//	@Produce	json
+//
+//	@Success	200	{object}	model.Worklog
+//	@Failure	401	{object}	model.Error
+//	@Failure	404	{object}	model.Error
+//	@Failure	500	{object}	model.Error
+//
+//	@Router		/worklogs/timer/stop [post]
+func (s *Server) StopTimer(w http.ResponseWriter, r *http.Request) {
+	reqID := reqIDField(middleware.GetReqID(r.Context()))
+	_ = r.Body.Close()
+
+	resp, err := s.srv.StopTimer(r.Context(), mw.UserFromCtx(r.Context()))
+	if err != nil {
+		s.handleErr(w, err, reqID)
+		return
+	}
+
+	s.respond(w, http.StatusOK, resp, reqID)
+}
+
+// TaskWorklogs return time which was logged on task.
+//
+//	@Tags		Worklogs,Tasks
+//	@Summary	Get worklogs of task.
+//	@ID			get_task_worklogs
+//	@Accept		plain
+//	@Produce	json
+//	@Param		task_id	path		string	true	"task id"
+//
+//	@Success	200		{object}	model.GetWorklogsResponse
+//	@Failure	400		{object}	model.Error
+//	@Failure	401		{object}	model.Error
+//	@Failure	404		{object}	model.Error
+//	@Failure	500		{object}	model.Error
+//
+//	@Router		/tasks/{task_id}/worklogs [get]
+func (s *Server) TaskWorklogs(w http.ResponseWriter, r *http.Request) {
+	reqID := reqIDField(middleware.GetReqID(r.Context()))
+	_ = r.Body.Close()
+
+	task, err := uuid.Parse(chi.URLParam(r, taskIDParamName))
+	if err != nil {
+		s.respond(w, http.StatusBadRequest, map[string]string{"path": "bad task id"}, zap.Error(err), reqID)
+		return
+	}
+
+	resp, err := s.srv.GetTaskWorklogs(r.Context(), mw.UserFromCtx(r.Context()), task)
+	if err != nil {
+		s.handleErr(w, err, reqID)
+		return
+	}
+
+	s.respond(w, http.StatusOK, resp, reqID)
+}
+
+// CreateWorklog logs time which user spent on task manually.
+//
+//	@Tags		Worklogs,Tasks
+//	@Summary	Log time on task.
+//	@ID			create_worklog
+//	@Accept		json
+//	@Produce	json
+//	@Param		task_id	path		string						true	"task id"
+//	@Param		request	body		model.CreateWorklogRequest	true	"logged time"
+//
+//	@Success	201		{object}	model.Worklog
+//	@Failure	400		{object}	model.Error
+//	@Failure	401		{object}	model.Error
+//	@Failure	403		{object}	model.Error
+//	@Failure	404		{object}	model.Error
+//	@Failure	500		{object}	model.Error
+//
+//	@Router		/tasks/{task_id}/worklogs [post]
+func (s *Server) CreateWorklog(w http.ResponseWriter, r *http.Request) {
+	reqID := reqIDField(middleware.GetReqID(r.Context()))
+
+	var buf bytes.Buffer
+	if _, err := io.Copy(&buf, r.Body); err != nil {
+		s.internal(w, zap.Error(err), reqID)
+		return
+	}
+	_ = r.Body.Close()
+
+	task, err := uuid.Parse(chi.URLParam(r, taskIDParamName))
+	if err != nil {
+		s.respond(w, http.StatusBadRequest, map[string]string{"path": "bad task id"}, zap.Error(err), reqID)
+		return
+	}
+
+	var req model.CreateWorklogRequest
+	if err = json.NewDecoder(&buf).Decode(&req); err != nil {
+		s.respond(w, http.StatusBadRequest, nil, zap.Error(err), reqID)
+		return
+	}
+
+	var resp *model.Worklog
+	resp, err = s.srv.CreateWorklog(r.Context(), mw.UserFromCtx(r.Context()), task, req)
+	if err != nil {
+		s.handleErr(w, err, reqID)
+		return
+	}
+
+	s.respond(w, http.StatusCreated, resp, reqID)
+}
+
+// DeleteWorklog deletes worklog of task.
+//
+//	@Tags		Worklogs
+//	@Summary	Delete worklog.
+//	@ID			delete_worklog
+//	@Accept		plain
+//	@Produce	json
+//	@Param		task_id		path	string	true	"task id"
+//	@Param		worklog_id	path	string	true	"worklog id"
+//
+//	@Success	200
+//	@Failure	400	{object}	model.Error
+//	@Failure	401	{object}	model.Error
+//	@Failure	403	{object}	model.Error
+//	@Failure	404	{object}	model.Error
+//	@Failure	500	{object}	model.Error
+//
+//	@Router		/tasks/{task_id}/worklogs/{worklog_id} [delete]
+func (s *Server) DeleteWorklog(w http.ResponseWriter, r *http.Request) {
+	reqID := reqIDField(middleware.GetReqID(r.Context()))
+	_ = r.Body.Close()
+
+	task, err := uuid.Parse(chi.URLParam(r, taskIDParamName))
+	if err != nil {
+		s.respond(w, http.StatusBadRequest, map[string]string{"path": "bad task id"}, zap.Error(err), reqID)
+		return
+	}
+	worklog, err := uuid.Parse(chi.URLParam(r, worklogIDParamName))
+	if err != nil {
+		s.respond(w, http.StatusBadRequest, map[string]string{"path": "bad worklog id"}, zap.Error(err), reqID)
+		return
+	}
+
+	if err = s.srv.DeleteWorklog(r.Context(), mw.UserFromCtx(r.Context()), task, worklog); err != nil {
+		s.handleErr(w, err, reqID)
+		return
+	}
+
+	s.respond(w, http.StatusOK, nil, reqID)
+}
+
+// WorklogReport return logged time over period aggregated by user, task or group.
+//
+//	@Tags			Worklogs
+//	@Summary		Export report of logged time.
+//	@Description	Without group report contains only own time. With group report contains time of all members on tasks of group.
+//	@ID				worklog_report
+//	@Accept			plain
+//	@Produce		json,text/csv
+//	@Param			from	query		string	true	"start of period as unix timestamp or RFC 3339 time"
+//	@Param			to		query		string	true	"end of period as unix timestamp or RFC 3339 time"
+//	@Param			by		query		string	false	"user (default), task or group"
+//	@Param			group	query		string	false	"group id"
+//	@Param			format	query		string	false	"json (default) or csv"
+//
+//	@Success		200		{object}	model.WorklogReport
+//	@Failure		400		{object}	model.Error
+//	@Failure		401		{object}	model.Error
+//	@Failure		403		{object}	model.Error
+//	@Failure		500		{object}	model.Error
+//
+//	@Router			/worklogs/report [get]
+func (s *Server) WorklogReport(w http.ResponseWriter, r *http.Request) {
+	reqID := reqIDField(middleware.GetReqID(r.Context()))
+	_ = r.Body.Close()
+
+	q := r.URL.Query()
+	format := q.Get(formatInQueryKey)
+	if format != "" && format != "json" && format != "csv" {
+		s.respond(w, http.StatusBadRequest, map[string]string{"query": "format must be json or csv"}, reqID)
+		return
+	}
+
+	filter := model.WorklogReportFilter{By: model.ReportGrouping(q.Get(byInQueryKey))}
+	from, err := parseTimeQuery(q.Get(fromInQueryKey))
+	if err != nil {
+		s.respond(w, http.StatusBadRequest, map[string]string{"query": "bad from"}, zap.Error(err), reqID)
+		return
+	}
+	to, err := parseTimeQuery(q.Get(toInQueryKey))
+	if err != nil {
+		s.respond(w, http.StatusBadRequest, map[string]string{"query": "bad to"}, zap.Error(err), reqID)
+		return
+	}
+	if from != nil {
+		filter.From = *from
+	}
+	if to != nil {
+		filter.To = *to
+	}
+	if filter.Group, err = parseUUIDQuery(q.Get(groupInQueryKey)); err != nil {
+		s.respond(w, http.StatusBadRequest, map[string]string{"query": "bad group"}, zap.Error(err), reqID)
+		return
+	}
+
+	resp, err := s.srv.GetWorklogReport(r.Context(), mw.UserFromCtx(r.Context()), filter)
+	if err != nil {
+		s.handleErr(w, err, reqID)
+		return
+	}
+
+	if format == "csv" {
+		w.Header().Set("content-type", "text/csv")
+		w.Header().Set("content-disposition", mime.FormatMediaType("attachment", map[string]string{"filename": "worklog-report.csv"}))
+		w.WriteHeader(http.StatusOK)
+		if err = resp.WriteCSV(w); err != nil {
+			s.log.Error("write worklog report", zap.Error(err), reqID)
+		}
+		return
+	}
+
+	s.respond(w, http.StatusOK, resp, reqID)
+}
+
+// AddDependency links task with task which blocks it.
+//
+//	@Tags		Tasks
+//	@Summary	Add blocker of task.
+//	@ID			add_dependency
+//	@Accept		json
+//	@Produce	json
+//	@Param		task_id	path		string						true	"task id"
+//	@Param		request	body		model.AddDependencyRequest	true	"blocking task"
+//
+//	@Success	201		{object}	model.TaskDependency
+//	@Failure	400		{object}	model.Error
+//	@Failure	401		{object}	model.Error
+//	@Failure	403		{object}	model.Error
+//	@Failure	404		{object}	model.Error
+//	@Failure	409		{object}	model.Error
+//	@Failure	500		{object}	model.Error
+//
+//	@Router		/tasks/{task_id}/dependencies [post]
+func (s *Server) AddDependency(w http.ResponseWriter, r *http.Request) {
+	reqID := reqIDField(middleware.GetReqID(r.Context()))
+
+	var buf bytes.Buffer
+	if _, err := io.Copy(&buf, r.Body); err != nil {
+		s.internal(w, zap.Error(err), reqID)
+		return
+	}
+	_ = r.Body.Close()
+
+	task, err := uuid.Parse(chi.URLParam(r, taskIDParamName))
+	if err != nil {
+		s.respond(w, http.StatusBadRequest, map[string]string{"path": "bad task id"}, zap.Error(err), reqID)
+		return
+	}
+
+	var req model.AddDependencyRequest
+	if err = json.NewDecoder(&buf).Decode(&req); err != nil {
+		s.respond(w, http.StatusBadRequest, nil, zap.Error(err), reqID)
+		return
+	}
+
+	resp, err := s.srv.AddDependency(r.Context(), mw.UserFromCtx(r.Context()), task, req)
+	if err != nil {
+		s.handleErr(w, err, reqID)
+		return
+	}
+
+	s.respond(w, http.StatusCreated, resp, reqID)
+}
+
+// RemoveDependency deletes link between task and its blocker.
+//
+//	@Tags		Tasks
+//	@Summary	Remove blocker of task.
+//	@ID			remove_dependency
+//	@Accept		plain
+//	@Produce	json
+//	@Param		task_id		path	string	true	"task id"
+//	@Param		blocker_id	path	string	true	"blocking task id"
+//
+//	@Success	200
+//	@Failure	400	{object}	model.Error
+//	@Failure	401	{object}	model.Error
+//	@Failure	403	{object}	model.Error
+//	@Failure	404	{object}	model.Error
+//	@Failure	500	{object}	model.Error
+//
+//	@Router		/tasks/{task_id}/dependencies/{blocker_id} [delete]
+func (s *Server) RemoveDependency(w http.ResponseWriter, r *http.Request) {
+	reqID := reqIDField(middleware.GetReqID(r.Context()))
+	_ = r.Body.Close()
+
+	task, err := uuid.Parse(chi.URLParam(r, taskIDParamName))
+	if err != nil {
+		s.respond(w, http.StatusBadRequest, map[string]string{"path": "bad task id"}, zap.Error(err), reqID)
+		return
+	}
+	blocker, err := uuid.Parse(chi.URLParam(r, blockerIDParamName))
+	if err != nil {
+		s.respond(w, http.StatusBadRequest, map[string]string{"path": "bad blocker id"}, zap.Error(err), reqID)
+		return
+	}
+
+	if err = s.srv.RemoveDependency(r.Context(), mw.UserFromCtx(r.Context()), task, blocker); err != nil {
+		s.handleErr(w, err, reqID)
+		return
+	}
+
+	s.respond(w, http.StatusOK, nil, reqID)
+}
+
+// UpdateTaskStatus change status of task.
+//
+//	@Tags		Tasks
+//	@Summary	Change task status.
+//	@ID			update_task_status
+//	@Accept		json
+//	@Produce	json
+//	@Param		task_id	path		string							true	"task id"
+//	@Param		request	body		model.UpdateTaskStatusRequest	true	"new status"
+//
+//	@Success	200		{object}	model.Task
+//	@Failure	400		{object}	model.Error
+//	@Failure	401		{object}	model.Error
+//	@Failure	403		{object}	model.Error
+//	@Failure	404		{object}	model.Error
+//	@Failure	409		{object}	model.Error
+//	@Failure	500		{object}	model.Error
+//
+//	@Router		/tasks/{task_id}/status [patch]
+func (s *Server) UpdateTaskStatus(w http.ResponseWriter, r *http.Request) {
+	reqID := reqIDField(middleware.GetReqID(r.Context()))
+
+	var buf bytes.Buffer
+	if _, err := io.Copy(&buf, r.Body); err != nil {
+		s.internal(w, zap.Error(err), reqID)
+		return
+	}
+	_ = r.Body.Close()
+
+	task, err := uuid.Parse(chi.URLParam(r, taskIDParamName))
+	if err != nil {
+		s.respond(w, http.StatusBadRequest, map[string]string{"path": "bad task id"}, zap.Error(err), reqID)
+		return
+	}
+
+	var req model.UpdateTaskStatusRequest
+	if err = json.NewDecoder(&buf).Decode(&req); err != nil {
+		s.respond(w, http.StatusBadRequest, nil, zap.Error(err), reqID)
+		return
+	}
+
+	var resp *model.Task
+	resp, err = s.srv.UpdateTaskStatus(r.Context(), mw.UserFromCtx(r.Context()), task, req.Status)
+	if err != nil {
+		s.handleErr(w, err, reqID)
+		return
+	}
+
+	s.respond(w, http.StatusOK, resp, reqID)
+}
+
+// SubmitReview sends task to review.
+//
+//	@Tags		Reviews,Tasks
+//	@Summary	Send task to review.
+//	@ID			submit_review
+//	@Accept		json
+//	@Produce	json
+//	@Param		task_id	path		string						true	"task id"
+//	@Param		request	body		model.SubmitReviewRequest	true	"review request"
+//
+//	@Success	201		{object}	model.Review
+//	@Failure	400		{object}	model.Error
+//	@Failure	401		{object}	model.Error
+//	@Failure	403		{object}	model.Error
+//	@Failure	404		{object}	model.Error
+//	@Failure	409		{object}	model.Error
+//	@Failure	500		{object}	model.Error
+//
+//	@Router		/tasks/{task_id}/reviews [post]
+func (s *Server) SubmitReview(w http.ResponseWriter, r *http.Request) {
+	reqID := reqIDField(middleware.GetReqID(r.Context()))
+
+	var buf bytes.Buffer
+	if _, err := io.Copy(&buf, r.Body); err != nil {
+		s.internal(w, zap.Error(err), reqID)
+		return
+	}
+	_ = r.Body.Close()
+
+	task, err := uuid.Parse(chi.URLParam(r, taskIDParamName))
+	if err != nil {
+		s.respond(w, http.StatusBadRequest, map[string]string{"path": "bad task id"}, zap.Error(err), reqID)
+		return
+	}
+
+	var req model.SubmitReviewRequest
+	if err = json.NewDecoder(&buf).Decode(&req); err != nil {
+		s.respond(w, http.StatusBadRequest, nil, zap.Error(err), reqID)
+		return
+	}
+
+	var resp *model.Review
+	resp, err = s.srv.SubmitReview(r.Context(), mw.UserFromCtx(r.Context()), task, req.Message)
+	if err != nil {
+		s.handleErr(w, err, reqID)
+		return
+	}
+
+	s.respond(w, http.StatusCreated, resp, reqID)
+}
+
+// GroupReviews return reviews of group tasks that are waiting for resolution.
+//
+//	@Tags		Reviews,Groups
+//	@Summary	Get pending reviews of group.
+//	@ID			group_reviews
+//	@Accept		plain
+//	@Produce	json
+//	@Param		group_id	path		string	true	"group id"
+//
+//	@Success	200			{object}	model.GetReviewsResponse
+//	@Failure	400			{object}	model.Error
+//	@Failure	401			{object}	model.Error
+//	@Failure	403			{object}	model.Error
+//	@Failure	500			{object}	model.Error
+//
+//	@Router		/groups/{group_id}/reviews [get]
+func (s *Server) GroupReviews(w http.ResponseWriter, r *http.Request) {
+	reqID := reqIDField(middleware.GetReqID(r.Context()))
+	_ = r.Body.Close()
+
+	group, err := uuid.Parse(chi.URLParam(r, groupIDParamName))
+	if err != nil {
+		s.respond(w, http.StatusBadRequest, map[string]string{"path": "bad group id"}, zap.Error(err), reqID)
+		return
+	}
+
+	var resp *model.GetReviewsResponse
+	resp, err = s.srv.GetPendingReviews(r.Context(), mw.UserFromCtx(r.Context()), group)
+	if err != nil {
+		s.handleErr(w, err, reqID)
+		return
+	}
+
+	s.respond(w, http.StatusOK, resp, reqID)
+}
+
+// GroupStats return statistics of group tasks over period.
+//
+//	@Tags			Groups
+//	@Summary		Get statistics of group tasks.
+//	@Description	Period ends now and lasts 12 weeks if its bounds are not provided.
+//	@ID				group_stats
+//	@Accept			plain
+//	@Produce		json
+//	@Param			group_id	path		string	true	"group id"
+//	@Param			from		query		string	false	"start of period as unix timestamp or RFC 3339 time"
+//	@Param			to			query		string	false	"end of period as unix timestamp or RFC 3339 time"
+//
+//	@Success		200			{object}	model.GroupStats
+//	@Failure		400			{object}	model.Error
+//	@Failure		401			{object}	model.Error
+//	@Failure		403			{object}	model.Error
+//	@Failure		500			{object}	model.Error
+//
+//	@Router			/groups/{group_id}/stats [get]
+func (s *Server) GroupStats(w http.ResponseWriter, r *http.Request) {
+	reqID := reqIDField(middleware.GetReqID(r.Context()))
+	_ = r.Body.Close()
+
+	group, err := uuid.Parse(chi.URLParam(r, groupIDParamName))
+	if err != nil {
+		s.respond(w, http.StatusBadRequest, map[string]string{"path": "bad group id"}, zap.Error(err), reqID)
+		return
+	}
+
+	q := r.URL.Query()
+	var from, to time.Time
+	parsed, err := parseTimeQuery(q.Get(fromInQueryKey))
+	if err != nil {
+		s.respond(w, http.StatusBadRequest, map[string]string{"query": "bad from"}, zap.Error(err), reqID)
+		return
+	}
+	if parsed != nil {
+		from = *parsed
+	}
+	if parsed, err = parseTimeQuery(q.Get(toInQueryKey)); err != nil {
+		s.respond(w, http.StatusBadRequest, map[string]string{"query": "bad to"}, zap.Error(err), reqID)
+		return
+	}
+	if parsed != nil {
+		to = *parsed
+	}
+
+	resp, err := s.srv.GetGroupStats(r.Context(), mw.UserFromCtx(r.Context()), group, from, to)
+	if err != nil {
+		s.handleErr(w, err, reqID)
+		return
+	}
+
+	s.respond(w, http.StatusOK, resp, reqID)
+}
+
+// DependencyGraph return tasks of group and links between them.
+//
+//	@Tags		Groups
+//	@Summary	Export dependency graph of group tasks.
+//	@ID			group_dependencies
+//	@Accept		plain
+//	@Produce	json,text/vnd.graphviz
+//	@Param		group_id	path		string	true	"group id"
+//	@Param		format		query		string	false	"json (default) or dot"
+//
+//	@Success	200			{object}	model.DependencyGraph
+//	@Failure	400			{object}	model.Error
+//	@Failure	401			{object}	model.Error
+//	@Failure	403			{object}	model.Error
+//	@Failure	500			{object}	model.Error
+//
+//	@Router		/groups/{group_id}/dependencies [get]
+func (s *Server) DependencyGraph(w http.ResponseWriter, r *http.Request) {
+	reqID := reqIDField(middleware.GetReqID(r.Context()))
+	_ = r.Body.Close()
+
+	group, err := uuid.Parse(chi.URLParam(r, groupIDParamName))
+	if err != nil {
+		s.respond(w, http.StatusBadRequest, map[string]string{"path": "bad group id"}, zap.Error(err), reqID)
+		return
+	}
+	format := r.URL.Query().Get(formatInQueryKey)
+	if format != "" && format != "json" && format != "dot" {
+		s.respond(w, http.StatusBadRequest, map[string]string{"query": "format must be json or dot"}, reqID)
+		return
+	}
+
+	resp, err := s.srv.GetDependencyGraph(r.Context(), mw.UserFromCtx(r.Context()), group)
+	if err != nil {
+		s.handleErr(w, err, reqID)
+		return
+	}
+
+	if format == "dot" {
+		w.Header().Set("content-type", "text/vnd.graphviz")
+		w.WriteHeader(http.StatusOK)
+		if _, err = io.WriteString(w, resp.DOT()); err != nil {
+			s.log.Error("write dependency graph", zap.Error(err), reqID)
+		}
+		return
+	}
+
+	s.respond(w, http.StatusOK, resp, reqID)
+}
+
+// ResolveReview resolves review as changes requested or accepted.
+//
+//	@Tags		Reviews
+//	@Summary	Resolve review.
+//	@ID			resolve_review
+//	@Accept		json
+//	@Produce	json
+//	@Param		review_id	path		string						true	"review id"
+//	@Param		request		body		model.ResolveReviewRequest	true	"resolution"
+//
+//	@Success	200			{object}	model.Review
+//	@Failure	400			{object}	model.Error
+//	@Failure	401			{object}	model.Error
+//	@Failure	403			{object}	model.Error
+//	@Failure	404			{object}	model.Error
+//	@Failure	409			{object}	model.Error
+//	@Failure	500			{object}	model.Error
+//
+//	@Router		/reviews/{review_id} [patch]
+func (s *Server) ResolveReview(w http.ResponseWriter, r *http.Request) {
+	reqID := reqIDField(middleware.GetReqID(r.Context()))
+
+	var buf bytes.Buffer
+	if _, err := io.Copy(&buf, r.Body); err != nil {
+		s.internal(w, zap.Error(err), reqID)
+		return
+	}
+	_ = r.Body.Close()
+
+	review, err := uuid.Parse(chi.URLParam(r, reviewIDParamName))
+	if err != nil {
+		s.respond(w, http.StatusBadRequest, map[string]string{"path": "bad review id"}, zap.Error(err), reqID)
+		return
+	}
+
+	var req model.ResolveReviewRequest
+	if err = json.NewDecoder(&buf).Decode(&req); err != nil {
+		s.respond(w, http.StatusBadRequest, nil, zap.Error(err), reqID)
+		return
+	}
+
+	var resp *model.Review
+	resp, err = s.srv.ResolveReview(r.Context(), mw.UserFromCtx(r.Context()), review, req)
+	if err != nil {
+		s.handleErr(w, err, reqID)
+		return
+	}
+
+	s.respond(w, http.StatusOK, resp, reqID)
+}
+
+// parseTaskAndComment return task and comment ids from path. If ids are bad then responds with error.
+func (s *Server) parseTaskAndComment(w http.ResponseWriter, r *http.Request, reqID zap.Field) (task, comment uuid.UUID, ok bool) {
+	task, err := uuid.Parse(chi.URLParam(r, taskIDParamName))
+	if err != nil {
+		s.respond(w, http.StatusBadRequest, map[string]string{"path": "bad task id"}, zap.Error(err), reqID)
+		return task, comment, false
+	}
+
+	comment, err = uuid.Parse(chi.URLParam(r, commentIDParamName))
+	if err != nil {
+		s.respond(w, http.StatusBadRequest, map[string]string{"path": "bad comment id"}, zap.Error(err), reqID)
+		return task, comment, false
+	}
+	return task, comment, true
+}
+
+// TaskComments return comments of task.
+//
+//	@Tags		Comments,Tasks
+//	@Summary	Get comments of task.
+//	@ID			task_comments
+//	@Accept		plain
+//	@Produce	json
+//	@Param		task_id	path		string	true	"task id"
+//
+//	@Success	200		{object}	model.GetCommentsResponse
+//	@Failure	400		{object}	model.Error
+//	@Failure	401		{object}	model.Error
+//	@Failure	404		{object}	model.Error
+//	@Failure	500		{object}	model.Error
+//
+//	@Router		/tasks/{task_id}/comments [get]
+func (s *Server) TaskComments(w http.ResponseWriter, r *http.Request) {
+	reqID := reqIDField(middleware.GetReqID(r.Context()))
+	_ = r.Body.Close()
+
+	task, err := uuid.Parse(chi.URLParam(r, taskIDParamName))
+	if err != nil {
+		s.respond(w, http.StatusBadRequest, map[string]string{"path": "bad task id"}, zap.Error(err), reqID)
+		return
+	}
+
+	var resp *model.GetCommentsResponse
+	resp, err = s.srv.GetTaskComments(r.Context(), mw.UserFromCtx(r.Context()), task)
+	if err != nil {
+		s.handleErr(w, err, reqID)
+		return
+	}
+
+	s.respond(w, http.StatusOK, resp, reqID)
+}
+
+// CreateComment creates comment to task.
+//
+//	@Tags		Comments,Tasks
+//	@Summary	Create comment to task.
+//	@ID			create_comment
+//	@Accept		json
+//	@Produce	json
+//	@Param		task_id	path		string						true	"task id"
+//	@Param		request	body		model.CreateCommentRequest	true	"comment"
+//
+//	@Success	201		{object}	model.Comment
+//	@Failure	400		{object}	model.Error
+//	@Failure	401		{object}	model.Error
+//	@Failure	403		{object}	model.Error
+//	@Failure	404		{object}	model.Error
+//	@Failure	500		{object}	model.Error
+//
+//	@Router		/tasks/{task_id}/comments [post]
+func (s *Server) CreateComment(w http.ResponseWriter, r *http.Request) {
+	reqID := reqIDField(middleware.GetReqID(r.Context()))
+
+	var buf bytes.Buffer
+	if _, err := io.Copy(&buf, r.Body); err != nil {
+		s.internal(w, zap.Error(err), reqID)
+		return
+	}
+	_ = r.Body.Close()
+
+	task, err := uuid.Parse(chi.URLParam(r, taskIDParamName))
+	if err != nil {
+		s.respond(w, http.StatusBadRequest, map[string]string{"path": "bad task id"}, zap.Error(err), reqID)
+		return
+	}
+
+	var req model.CreateCommentRequest
+	if err = json.NewDecoder(&buf).Decode(&req); err != nil {
+		s.respond(w, http.StatusBadRequest, nil, zap.Error(err), reqID)
+		return
+	}
+
+	var resp *model.Comment
+	resp, err = s.srv.CreateComment(r.Context(), mw.UserFromCtx(r.Context()), task, req)
+	if err != nil {
+		s.handleErr(w, err, reqID)
+		return
+	}
+
+	s.respond(w, http.StatusCreated, resp, reqID)
+}
+
+// UpdateComment changes message of comment.
+//
+//	@Tags		Comments
+//	@Summary	Edit comment.
+//	@ID			update_comment
+//	@Accept		json
+//	@Produce	json
+//	@Param		task_id		path		string						true	"task id"
+//	@Param		comment_id	path		string						true	"comment id"
+//	@Param		request		body		model.UpdateCommentRequest	true	"new message"
+//
+//	@Success	200			{object}	model.Comment
+//	@Failure	400			{object}	model.Error
+//	@Failure	401			{object}	model.Error
+//	@Failure	403			{object}	model.Error
+//	@Failure	404			{object}	model.Error
+//	@Failure	500			{object}	model.Error
+//
+//	@Router		/tasks/{task_id}/comments/{comment_id} [patch]
+func (s *Server) UpdateComment(w http.ResponseWriter, r *http.Request) {
+	reqID := reqIDField(middleware.GetReqID(r.Context()))
+
+	var buf bytes.Buffer
+	if _, err := io.Copy(&buf, r.Body); err != nil {
+		s.internal(w, zap.Error(err), reqID)
+		return
+	}
+	_ = r.Body.Close()
+
+	task, comment, ok := s.parseTaskAndComment(w, r, reqID)
+	if !ok {
+		return
+	}
+
+	var req model.UpdateCommentRequest
+	if err := json.NewDecoder(&buf).Decode(&req); err != nil {
+		s.respond(w, http.StatusBadRequest, nil, zap.Error(err), reqID)
+		return
+	}
+
+	resp, err := s.srv.UpdateComment(r.Context(), mw.UserFromCtx(r.Context()), task, comment, req)
+	if err != nil {
+		s.handleErr(w, err, reqID)
+		return
+	}
+
+	s.respond(w, http.StatusOK, resp, reqID)
+}
+
+// DeleteComment deletes comment.
+//
+//	@Tags		Comments
+//	@Summary	Delete comment.
+//	@ID			delete_comment
+//	@Accept		plain
+//	@Produce	json
+//	@Param		task_id		path	string	true	"task id"
+//	@Param		comment_id	path	string	true	"comment id"
+//
+//	@Success	200
+//	@Failure	400	{object}	model.Error
+//	@Failure	401	{object}	model.Error
+//	@Failure	403	{object}	model.Error
+//	@Failure	404	{object}	model.Error
+//	@Failure	500	{object}	model.Error
+//
+//	@Router		/tasks/{task_id}/comments/{comment_id} [delete]
+func (s *Server) DeleteComment(w http.ResponseWriter, r *http.Request) {
+	reqID := reqIDField(middleware.GetReqID(r.Context()))
+	_ = r.Body.Close()
+
+	task, comment, ok := s.parseTaskAndComment(w, r, reqID)
+	if !ok {
+		return
+	}
+
+	if err := s.srv.DeleteComment(r.Context(), mw.UserFromCtx(r.Context()), task, comment); err != nil {
+		s.handleErr(w, err, reqID)
+		return
+	}
+
+	s.respond(w, http.StatusOK, nil, reqID)
+}
+
+// CommentHistory return previous versions of comment.
+//
+//	@Tags		Comments
+//	@Summary	Get edit history of comment.
+//	@ID			comment_history
+//	@Accept		plain
+//	@Produce	json
+//	@Param		task_id		path		string	true	"task id"
+//	@Param		comment_id	path		string	true	"comment id"
+//
+//	@Success	200			{object}	model.GetCommentHistoryResponse
+//	@Failure	400			{object}	model.Error
+//	@Failure	401			{object}	model.Error
+//	@Failure	403			{object}	model.Error
+//	@Failure	404			{object}	model.Error
+//	@Failure	500			{object}	model.Error
+//
+//	@Router		/tasks/{task_id}/comments/{comment_id}/history [get]
+func (s *Server) CommentHistory(w http.ResponseWriter, r *http.Request) {
+	reqID := reqIDField(middleware.GetReqID(r.Context()))
+	_ = r.Body.Close()
+
+	task, comment, ok := s.parseTaskAndComment(w, r, reqID)
+	if !ok {
+		return
+	}
+
+	resp, err := s.srv.GetCommentHistory(r.Context(), mw.UserFromCtx(r.Context()), task, comment)
+	if err != nil {
+		s.handleErr(w, err, reqID)
+		return
+	}
+
+	s.respond(w, http.StatusOK, resp, reqID)
+}
+
+// Search return tasks which name, description or comments match query.
+//
+//	@Tags		Tasks
+//	@Summary	Full text search of tasks.
+//	@ID			search_tasks
+//	@Accept		plain
+//	@Produce	json
+//	@Param		q		query		string	true	"search query, english and russian words are stemmed"
+//	@Param		limit	query		int		false	"max count of results, 20 by default and 100 at most"
+//
+//	@Success	200		{object}	model.SearchResponse
+//	@Failure	400		{object}	model.Error
+//	@Failure	401		{object}	model.Error
+//	@Failure	500		{object}	model.Error
+//
+//	@Router		/search [get]
+func (s *Server) Search(w http.ResponseWriter, r *http.Request) {
+	reqID := reqIDField(middleware.GetReqID(r.Context()))
+	_ = r.Body.Close()
+
+	q := r.URL.Query()
+	var limit int
+	if v := q.Get(limitInQueryKey); v != "" {
+		var err error
+		if limit, err = strconv.Atoi(v); err != nil {
+			s.respond(w, http.StatusBadRequest, map[string]string{"query": "limit must be integer number"}, zap.Error(err), reqID)
+			return
+		}
+	}
+
+	resp, err := s.srv.Search(r.Context(), mw.UserFromCtx(r.Context()), q.Get(searchInQueryKey), limit)
+	if err != nil {
+		s.handleErr(w, err, reqID)
+		return
+	}
+
+	s.respond(w, http.StatusOK, resp, reqID)
+}
+
+// parseGroupAndLabel parses group and label ids from url path. If ids are bad then bad request is responded.
+func (s *Server) parseGroupAndLabel(w http.ResponseWriter, r *http.Request, reqID zap.Field) (group, label uuid.UUID, ok bool) {
+	group, err := uuid.Parse(chi.URLParam(r, groupIDParamName))
+	if err != nil {
+		s.respond(w, http.StatusBadRequest, map[string]string{"path": "bad group id"}, zap.Error(err), reqID)
+		return group, label, false
+	}
+
+	label, err = uuid.Parse(chi.URLParam(r, labelIDParamName))
+	if err != nil {
+		s.respond(w, http.StatusBadRequest, map[string]string{"path": "bad label id"}, zap.Error(err), reqID)
+		return group, label, false
+	}
+	return group, label, true
+}
+
+// parseTaskAndLabel parses task and label ids from url path. If ids are bad then bad request is responded.
+func (s *Server) parseTaskAndLabel(w http.ResponseWriter, r *http.Request, reqID zap.Field) (task, label uuid.UUID, ok bool) {
+	task, err := uuid.Parse(chi.URLParam(r, taskIDParamName))
+	if err != nil {
+		s.respond(w, http.StatusBadRequest, map[string]string{"path": "bad task id"}, zap.Error(err), reqID)
+		return task, label, false
+	}
+
+	label, err = uuid.Parse(chi.URLParam(r, labelIDParamName))
+	if err != nil {
+		s.respond(w, http.StatusBadRequest, map[string]string{"path": "bad label id"}, zap.Error(err), reqID)
+		return task, label, false
+	}
+	return task, label, true
+}
+
+// GroupLabels return labels of group.
+//
+//	@Tags		Labels,Groups
+//	@Summary	Get labels of group.
+//	@ID			group_labels
+//	@Accept		plain
+//	@Produce	json
+//	@Param		group_id	path		string	true	"group id"
+//
+//	@Success	200			{object}	model.GetLabelsResponse
+//	@Failure	400			{object}	model.Error
+//	@Failure	401			{object}	model.Error
+//	@Failure	403			{object}	model.Error
+//	@Failure	500			{object}	model.Error
+//
+//	@Router		/groups/{group_id}/labels [get]
+func (s *Server) GroupLabels(w http.ResponseWriter, r *http.Request) {
+	reqID := reqIDField(middleware.GetReqID(r.Context()))
+	_ = r.Body.Close()
+
+	group, err := uuid.Parse(chi.URLParam(r, groupIDParamName))
+	if err != nil {
+		s.respond(w, http.StatusBadRequest, map[string]string{"path": "bad group id"}, zap.Error(err), reqID)
+		return
+	}
+
+	resp, err := s.srv.GetGroupLabels(r.Context(), mw.UserFromCtx(r.Context()), group)
+	if err != nil {
+		s.handleErr(w, err, reqID)
+		return
+	}
+
+	s.respond(w, http.StatusOK, resp, reqID)
+}
+
+// CreateLabel creates label in group.
+//
+//	@Tags		Labels,Groups
+//	@Summary	Create label in group.
+//	@ID			create_label
+//	@Accept		json
+//	@Produce	json
+//	@Param		group_id	path		string						true	"group id"
+//	@Param		request		body		model.CreateLabelRequest	true	"label"
+//
+//	@Success	201			{object}	model.Label
+//	@Failure	400			{object}	model.Error
+//	@Failure	401			{object}	model.Error
+//	@Failure	403			{object}	model.Error
+//	@Failure	409			{object}	model.Error
+//	@Failure	500			{object}	model.Error
+//
+//	@Router		/groups/{group_id}/labels [post]
+func (s *Server) CreateLabel(w http.ResponseWriter, r *http.Request) {
+	reqID := reqIDField(middleware.GetReqID(r.Context()))
+
+	var buf bytes.Buffer
+	if _, err := io.Copy(&buf, r.Body); err != nil {
+		s.internal(w, zap.Error(err), reqID)
+		return
+	}
+	_ = r.Body.Close()
+
+	group, err := uuid.Parse(chi.URLParam(r, groupIDParamName))
+	if err != nil {
+		s.respond(w, http.StatusBadRequest, map[string]string{"path": "bad group id"}, zap.Error(err), reqID)
+		return
+	}
+
+	var req model.CreateLabelRequest
+	if err = json.NewDecoder(&buf).Decode(&req); err != nil {
+		s.respond(w, http.StatusBadRequest, nil, zap.Error(err), reqID)
+		return
+	}
+
+	resp, err := s.srv.CreateLabel(r.Context(), mw.UserFromCtx(r.Context()), group, req)
+	if err != nil {
+		s.handleErr(w, err, reqID)
+		return
+	}
+
+	s.respond(w, http.StatusCreated, resp, reqID)
+}
+
+// UpdateLabel changes name or color of label.
+//
+//	@Tags		Labels,Groups
+//	@Summary	Change label.
+//	@ID			update_label
+//	@Accept		json
+//	@Produce	json
+//	@Param		group_id	path		string						true	"group id"
+//	@Param		label_id	path		string						true	"label id"
+//	@Param		request		body		model.UpdateLabelRequest	true	"new label data"
+//
+//	@Success	200			{object}	model.Label
+//	@Failure	400			{object}	model.Error
+//	@Failure	401			{object}	model.Error
+//	@Failure	403			{object}	model.Error
+//	@Failure	404			{object}	model.Error
+//	@Failure	409			{object}	model.Error
+//	@Failure	500			{object}	model.Error
+//
+//	@Router		/groups/{group_id}/labels/{label_id} [patch]
+func (s *Server) UpdateLabel(w http.ResponseWriter, r *http.Request) {
+	reqID := reqIDField(middleware.GetReqID(r.Context()))
+
+	var buf bytes.Buffer
+	if _, err := io.Copy(&buf, r.Body); err != nil {
+		s.internal(w, zap.Error(err), reqID)
+		return
+	}
+	_ = r.Body.Close()
+
+	group, label, ok := s.parseGroupAndLabel(w, r, reqID)
+	if !ok {
+		return
+	}
+
+	var req model.UpdateLabelRequest
+	if err := json.NewDecoder(&buf).Decode(&req); err != nil {
+		s.respond(w, http.StatusBadRequest, nil, zap.Error(err), reqID)
+		return
+	}
+
+	resp, err := s.srv.UpdateLabel(r.Context(), mw.UserFromCtx(r.Context()), group, label, req)
+	if err != nil {
+		s.handleErr(w, err, reqID)
+		return
+	}
+
+	s.respond(w, http.StatusOK, resp, reqID)
+}
+
+// DeleteLabel deletes label of group.
+//
+//	@Tags		Labels,Groups
+//	@Summary	Delete label.
+//	@ID			delete_label
+//	@Accept		plain
+//	@Produce	json
+//	@Param		group_id	path	string	true	"group id"
+//	@Param		label_id	path	string	true	"label id"
+//
+//	@Success	200
+//	@Failure	400	{object}	model.Error
+//	@Failure	401	{object}	model.Error
+//	@Failure	403	{object}	model.Error
+//	@Failure	404	{object}	model.Error
+//	@Failure	500	{object}	model.Error
+//
+//	@Router		/groups/{group_id}/labels/{label_id} [delete]
+func (s *Server) DeleteLabel(w http.ResponseWriter, r *http.Request) {
+	reqID := reqIDField(middleware.GetReqID(r.Context()))
+	_ = r.Body.Close()
+
+	group, label, ok := s.parseGroupAndLabel(w, r, reqID)
+	if !ok {
+		return
+	}
+
+	if err := s.srv.DeleteLabel(r.Context(), mw.UserFromCtx(r.Context()), group, label); err != nil {
+		s.handleErr(w, err, reqID)
+		return
+	}
+
+	s.respond(w, http.StatusOK, nil, reqID)
+}
+
+// AttachLabel attaches label to task.
+//
+//	@Tags		Labels,Tasks
+//	@Summary	Attach label to task.
+//	@ID			attach_label
+//	@Accept		plain
+//	@Produce	json
+//	@Param		task_id		path	string	true	"task id"
+//	@Param		label_id	path	string	true	"label id"
+//
+//	@Success	200
+//	@Failure	400	{object}	model.Error
+//	@Failure	401	{object}	model.Error
+//	@Failure	403	{object}	model.Error
+//	@Failure	404	{object}	model.Error
+//	@Failure	409	{object}	model.Error
+//	@Failure	500	{object}	model.Error
+//
+//	@Router		/tasks/{task_id}/labels/{label_id} [put]
+func (s *Server) AttachLabel(w http.ResponseWriter, r *http.Request) {
+	reqID := reqIDField(middleware.GetReqID(r.Context()))
+	_ = r.Body.Close()
+
+	task, label, ok := s.parseTaskAndLabel(w, r, reqID)
+	if !ok {
+		return
+	}
+
+	if err := s.srv.AttachLabel(r.Context(), mw.UserFromCtx(r.Context()), task, label); err != nil {
+		s.handleErr(w, err, reqID)
+		return
+	}
+
+	s.respond(w, http.StatusOK, nil, reqID)
+}
+
+// DetachLabel detaches label from task.
+//
+//	@Tags		Labels,Tasks
+//	@Summary	Detach label from task.
+//	@ID			detach_label
+//	@Accept		plain
 //	@Produce	json
-//	@Param		request	body		model.CreateInviteRequest	true	"invite data"
+//	@Param		task_id		path	string	true	"task id"
+//	@Param		label_id	path	string	true	"label id"
 //
-//	@Success	201		{object}	model.CreateInviteResponse
-//	@Failure	400		{object}	model.Error
-//	@Failure	401		{object}	model.Error
-//	@Failure	403		{object}	model.Error
-//	@Failure	409		{object}	model.Error
-//	@Failure	500		{object}	model.Error
+//	@Success	200
+//	@Failure	400	{object}	model.Error
+//	@Failure	401	{object}	model.Error
+//	@Failure	403	{object}	model.Error
+//	@Failure	404	{object}	model.Error
+//	@Failure	500	{object}	model.Error
 //
-//	@Router		/invites [post]
-func (s *Server) CreateInviteLink(w http.ResponseWriter, r *http.Request) {
-	u := mw.UserFromCtx(r.Context())
-	reqID := middleware.GetReqID(r.Context())
-	var req model.CreateInviteRequest
-	var buf bytes.Buffer
+//	@Router		/tasks/{task_id}/labels/{label_id} [delete]
+func (s *Server) DetachLabel(w http.ResponseWriter, r *http.Request) {
+	reqID := reqIDField(middleware.GetReqID(r.Context()))
+	_ = r.Body.Close()
 
-	if _, err := io.Copy(&buf, r.Body); err != nil {
-		s.respond(w, http.StatusInternalServerError, nil, zap.Error(err), reqIDField(reqID))
+	task, label, ok := s.parseTaskAndLabel(w, r, reqID)
+	if !ok {
 		return
 	}
-	_ = r.Body.Close()
 
-	if err := json.NewDecoder(&buf).Decode(&req); err != nil {
-		s.respond(w, http.StatusBadRequest, nil, zap.Error(err), reqIDField(reqID))
+	if err := s.srv.DetachLabel(r.Context(), mw.UserFromCtx(r.Context()), task, label); err != nil {
+		s.handleErr(w, err, reqID)
 		return
 	}
 
-	role := &model.Role{
-		Members:  req.Member,
-		Tasks:    req.Task,
-		Reviews:  req.Review,
-		Comments: req.Comment,
+	s.respond(w, http.StatusOK, nil, reqID)
+}
+
+// Board return tasks of group bucketed by status in manual order.
+//
+//	@Tags		Board,Groups
+//	@Summary	Get board of group.
+//	@ID			group_board
+//	@Accept		plain
+//	@Produce	json
+//	@Param		group_id	path		string	true	"group id"
+//
+//	@Success	200			{object}	model.Board
+//	@Failure	400			{object}	model.Error
+//	@Failure	401			{object}	model.Error
+//	@Failure	403			{object}	model.Error
+//	@Failure	500			{object}	model.Error
+//
+//	@Router		/groups/{group_id}/board [get]
+func (s *Server) Board(w http.ResponseWriter, r *http.Request) {
+	reqID := reqIDField(middleware.GetReqID(r.Context()))
+	_ = r.Body.Close()
+
+	group, err := uuid.Parse(chi.URLParam(r, groupIDParamName))
+	if err != nil {
+		s.respond(w, http.StatusBadRequest, map[string]string{"path": "bad group id"}, zap.Error(err), reqID)
+		return
 	}
 
-	resp, err := s.srv.CreateInvite(r.Context(), u, req.Group, role, req.Limit)
+	resp, err := s.srv.GetBoard(r.Context(), mw.UserFromCtx(r.Context()), group)
 	if err != nil {
-		s.handleErr(w, err, reqIDField(reqID))
+		s.handleErr(w, err, reqID)
 		return
 	}
 
-	s.respond(w, http.StatusCreated, resp)
+	s.respond(w, http.StatusOK, resp, reqID)
 }
 
-// CreateInviteViaGroup create new invite link.
+// MoveTask changes status of task and its position in column of board.
 //
-//	@Tags		Invites,Groups
-//	@Summary	создание приглашения в группу.
-//	@ID			invite_user_groups
+//	@Tags		Board,Groups,Tasks
+//	@Summary	Move task on board of group.
+//	@ID			move_task
 //	@Accept		json
 //	@Produce	json
-//	@Param		request		body		model.CreateInviteViaGroupRequest	true	"invite data"
-//	@Param		group_id	path		string								true	"group id"
+//	@Param		group_id	path		string					true	"group id"
+//	@Param		task_id		path		string					true	"task id"
+//	@Param		request		body		model.MoveTaskRequest	true	"column and position of task"
 //
-//	@Success	201			{object}	model.CreateInviteResponse
+//	@Success	200			{object}	model.Board
 //	@Failure	400			{object}	model.Error
 //	@Failure	401			{object}	model.Error
 //	@Failure	403			{object}	model.Error
+//	@Failure	404			{object}	model.Error
 //	@Failure	409			{object}	model.Error
 //	@Failure	500			{object}	model.Error
 //
-//	@Router		/groups/{group_id}/invite [post]
-func (s *Server) CreateInviteViaGroup(w http.ResponseWriter, r *http.Request) {
-	reqID := middleware.GetReqID(r.Context())
-	u := mw.UserFromCtx(r.Context())
+//	@Router		/groups/{group_id}/board/tasks/{task_id} [put]
+func (s *Server) MoveTask(w http.ResponseWriter, r *http.Request) {
+	reqID := reqIDField(middleware.GetReqID(r.Context()))
 
-	var req model.CreateInviteViaGroupRequest
 	var buf bytes.Buffer
-
 	if _, err := io.Copy(&buf, r.Body); err != nil {
-		s.respond(w, http.StatusInternalServerError, nil, zap.Error(err), reqIDField(reqID))
+		s.internal(w, zap.Error(err), reqID)
 		return
 	}
 	_ = r.Body.Close()
 
 	group, err := uuid.Parse(chi.URLParam(r, groupIDParamName))
 	if err != nil {
-		s.respond(w, http.StatusBadRequest, map[string]string{"path": "bad group id"}, zap.Error(err), reqIDField(reqID))
+		s.respond(w, http.StatusBadRequest, map[string]string{"path": "bad group id"}, zap.Error(err), reqID)
 		return
 	}
 
-	if err = json.NewDecoder(&buf).Decode(&req); err != nil {
-		s.respond(w, http.StatusBadRequest, nil, zap.Error(err), reqIDField(reqID))
+	task, err := uuid.Parse(chi.URLParam(r, taskIDParamName))
+	if err != nil {
+		s.respond(w, http.StatusBadRequest, map[string]string{"path": "bad task id"}, zap.Error(err), reqID)
 		return
 	}
 
-	role := &model.Role{
-		Members:  req.Member,
-		Tasks:    req.Task,
-		Reviews:  req.Review,
-		Comments: req.Comment,
+	var req model.MoveTaskRequest
+	if err = json.NewDecoder(&buf).Decode(&req); err != nil {
+		s.respond(w, http.StatusBadRequest, nil, zap.Error(err), reqID)
+		return
 	}
 
-	var resp *model.CreateInviteResponse
-	resp, err = s.srv.CreateInvite(r.Context(), u, group, role, req.Limit)
+	resp, err := s.srv.MoveTask(r.Context(), mw.UserFromCtx(r.Context()), group, task, req)
 	if err != nil {
-		s.handleErr(w, err, reqIDField(reqID))
+		s.handleErr(w, err, reqID)
 		return
 	}
 
-	s.respond(w, http.StatusCreated, resp, reqIDField(reqID))
-
+	s.respond(w, http.StatusOK, resp, reqID)
 }
 
-// UseInvite add user to group if invite is good.
+// SetBoardLimits changes work-in-progress limits of board columns.
 //
-//	@Tags		Groups
-//	@Summary	Использование приглашения в группу.
-//	@ID			apply_user_to_group
-//	@Accept		plain
+//	@Tags		Board,Groups
+//	@Summary	Change limits of board columns.
+//	@ID			set_board_limits
+//	@Accept		json
 //	@Produce	json
-//	@Param		group_id	path		string	true	"group id"
-//	@Param		invite		query		string	true	"invite id"
+//	@Param		group_id	path		string						true	"group id"
+//	@Param		request		body		model.BoardLimitsRequest	true	"limits of columns, null or zero removes limit"
 //
-//	@Success	201			{string}	model.CreateInviteResponse
+//	@Success	200			{object}	model.Board
 //	@Failure	400			{object}	model.Error
 //	@Failure	401			{object}	model.Error
 //	@Failure	403			{object}	model.Error
-//	@Failure	409			{object}	model.Error
 //	@Failure	500			{object}	model.Error
 //
-//	@Router		/groups/{group_id}/apply [post]
-func (s *Server) UseInvite(w http.ResponseWriter, r *http.Request) {
+//	@Router		/groups/{group_id}/board/limits [put]
+func (s *Server) SetBoardLimits(w http.ResponseWriter, r *http.Request) {
 	reqID := reqIDField(middleware.GetReqID(r.Context()))
-	_ = r.Body.Close()
 
-	user := mw.UserFromCtx(r.Context())
+	var buf bytes.Buffer
+	if _, err := io.Copy(&buf, r.Body); err != nil {
+		s.internal(w, zap.Error(err), reqID)
+		return
+	}
+	_ = r.Body.Close()
 
 	group, err := uuid.Parse(chi.URLParam(r, groupIDParamName))
 	if err != nil {
-		s.respond(w, http.StatusBadRequest, map[string]string{"url": "invite must be valid group id in it"}, reqID)
+		s.respond(w, http.StatusBadRequest, map[string]string{"path": "bad group id"}, zap.Error(err), reqID)
 		return
 	}
 
-	var invite uuid.UUID
-	invite, err = uuid.Parse(r.URL.Query().Get(inviteInQueryKey))
-	if err != nil {
-		s.respond(w, http.StatusBadRequest, map[string]string{"query": "invite must be valid uuid"}, reqID)
+	var req model.BoardLimitsRequest
+	if err = json.NewDecoder(&buf).Decode(&req); err != nil {
+		s.respond(w, http.StatusBadRequest, nil, zap.Error(err), reqID)
 		return
 	}
 
-	if err = s.srv.UseInvite(r.Context(), user, group, invite); err != nil {
+	resp, err := s.srv.SetBoardLimits(r.Context(), mw.UserFromCtx(r.Context()), group, req)
+	if err != nil {
 		s.handleErr(w, err, reqID)
 		return
 	}
 
-	s.respond(w, http.StatusOK, nil, reqID)
+	s.respond(w, http.StatusOK, resp, reqID)
 }
 
-// UserMe return user profile.
+// GroupTemplates return task templates of group.
 //
-//	@Tags		Users
-//	@Summary	Get summary info about user.
-//	@ID			users_me
+//	@Tags		Templates,Groups
+//	@Summary	Get task templates of group.
+//	@ID			group_templates
 //	@Accept		plain
 //	@Produce	json
+//	@Param		group_id	path		string	true	"group id"
 //
-//	@Success	200	{object}	model.GetMeResponse
-//	@Failure	401	{object}	model.Error
-//	@Failure	404	{object}	model.Error
-//	@Failure	500	{object}	model.Error
+//	@Success	200			{object}	model.GetTemplatesResponse
+//	@Failure	400			{object}	model.Error
+//	@Failure	401			{object}	model.Error
+//	@Failure	403			{object}	model.Error
+//	@Failure	500			{object}	model.Error
 //
-//	@Router		/users/me [get]
-func (s *Server) UserMe(w http.ResponseWriter, r *http.Request) {
+//	@Router		/groups/{group_id}/templates [get]
+func (s *Server) GroupTemplates(w http.ResponseWriter, r *http.Request) {
 	reqID := reqIDField(middleware.GetReqID(r.Context()))
+	_ = r.Body.Close()
 
-	u := mw.UserFromCtx(r.Context())
-	resp, err := s.srv.GetMe(r.Context(), u)
+	group, err := uuid.Parse(chi.URLParam(r, groupIDParamName))
+	if err != nil {
+		s.respond(w, http.StatusBadRequest, map[string]string{"path": "bad group id"}, zap.Error(err), reqID)
+		return
+	}
+
+	resp, err := s.srv.GetGroupTemplates(r.Context(), mw.UserFromCtx(r.Context()), group)
 	if err != nil {
 		s.handleErr(w, err, reqID)
 		return
@@ -344,62 +3266,100 @@ func (s *Server) UserMe(w http.ResponseWriter, r *http.Request) {
 	s.respond(w, http.StatusOK, resp, reqID)
 }
 
-// AllTasks godoc.
+// CreateTemplate creates task template in group.
 //
-//	@Tags		Tasks
-//	@Summary	Get user tasks.
-//	@ID			get_tasks
-//	@Accept		plain
+// If recurrence is provided then tasks are created from template by schedule.
+//
+//	@Tags		Templates,Groups
+//	@Summary	Create task template in group.
+//	@ID			create_template
+//	@Accept		json
 //	@Produce	json
+//	@Param		group_id	path		string						true	"group id"
+//	@Param		request		body		model.CreateTemplateRequest	true	"template"
 //
-//	@Success	200	{object}	model.GetTasksResponse
-//	@Failure	401	{object}	model.Error
-//	@Failure	404	{object}	model.Error
-//	@Failure	500	{object}	model.Error
+//	@Success	201			{object}	model.TaskTemplate
+//	@Failure	400			{object}	model.Error
+//	@Failure	401			{object}	model.Error
+//	@Failure	403			{object}	model.Error
+//	@Failure	500			{object}	model.Error
 //
-//	@Router		/tasks [get]
-func (s *Server) AllTasks(w http.ResponseWriter, r *http.Request) {
-	_ = r.Body.Close()
+//	@Router		/groups/{group_id}/templates [post]
+func (s *Server) CreateTemplate(w http.ResponseWriter, r *http.Request) {
 	reqID := reqIDField(middleware.GetReqID(r.Context()))
-	u := mw.UserFromCtx(r.Context())
 
-	resp, err := s.srv.GetUserTasks(r.Context(), u)
+	var buf bytes.Buffer
+	if _, err := io.Copy(&buf, r.Body); err != nil {
+		s.internal(w, zap.Error(err), reqID)
+		return
+	}
+	_ = r.Body.Close()
+
+	group, err := uuid.Parse(chi.URLParam(r, groupIDParamName))
+	if err != nil {
+		s.respond(w, http.StatusBadRequest, map[string]string{"path": "bad group id"}, zap.Error(err), reqID)
+		return
+	}
+
+	var req model.CreateTemplateRequest
+	if err = json.NewDecoder(&buf).Decode(&req); err != nil {
+		s.respond(w, http.StatusBadRequest, nil, zap.Error(err), reqID)
+		return
+	}
+
+	resp, err := s.srv.CreateTemplate(r.Context(), mw.UserFromCtx(r.Context()), group, req)
 	if err != nil {
 		s.handleErr(w, err, reqID)
 		return
 	}
 
-	s.respond(w, http.StatusOK, resp, reqID)
+	s.respond(w, http.StatusCreated, resp, reqID)
 }
 
-// GetTask return summary info about task.
+// parseGroupAndTemplate return group and template ids from path. If ids are bad then responds with error.
+func (s *Server) parseGroupAndTemplate(w http.ResponseWriter, r *http.Request, reqID zap.Field) (group, template uuid.UUID, ok bool) {
+	group, err := uuid.Parse(chi.URLParam(r, groupIDParamName))
+	if err != nil {
+		s.respond(w, http.StatusBadRequest, map[string]string{"path": "bad group id"}, zap.Error(err), reqID)
+		return group, template, false
+	}
+
+	template, err = uuid.Parse(chi.URLParam(r, templateIDParamName))
+	if err != nil {
+		s.respond(w, http.StatusBadRequest, map[string]string{"path": "bad template id"}, zap.Error(err), reqID)
+		return group, template, false
+	}
+	return group, template, true
+}
+
+// GetTemplate return task template of group.
 //
-//	@Tags		Tasks
-//	@Summary	Get task by ID.
-//	@ID			get_task
+//	@Tags		Templates,Groups
+//	@Summary	Get task template.
+//	@ID			get_template
 //	@Accept		plain
 //	@Produce	json
-//	@Param		task_id	path		string	true	"task id"
+//	@Param		group_id	path		string	true	"group id"
+//	@Param		template_id	path		string	true	"template id"
 //
-//	@Success	200		{object}	model.Task
-//	@Failure	400		{object}	model.Error
-//	@Failure	401		{object}	model.Error
-//	@Failure	403		{object}	model.Error
-//	@Failure	409		{object}	model.Error
-//	@Failure	500		{object}	model.Error
+//	@Success	200			{object}	model.TaskTemplate
+//	@Failure	400			{object}	model.Error
+//	@Failure	401			{object}	model.Error
+//	@Failure	403			{object}	model.Error
+//	@Failure	404			{object}	model.Error
+//	@Failure	500			{object}	model.Error
 //
-//	@Router		/tasks/{task_id} [get]
-func (s *Server) GetTask(w http.ResponseWriter, r *http.Request) {
+//	@Router		/groups/{group_id}/templates/{template_id} [get]
+func (s *Server) GetTemplate(w http.ResponseWriter, r *http.Request) {
 	reqID := reqIDField(middleware.GetReqID(r.Context()))
-	u := mw.UserFromCtx(r.Context())
-	g, err := uuid.Parse(chi.URLParam(r, "task_id"))
-	if err != nil {
-		s.respond(w, http.StatusBadRequest, nil, zap.Error(err))
+	_ = r.Body.Close()
+
+	group, template, ok := s.parseGroupAndTemplate(w, r, reqID)
+	if !ok {
 		return
 	}
 
-	var resp *model.Task
-	resp, err = s.srv.GetTask(r.Context(), u, g)
+	resp, err := s.srv.GetTemplate(r.Context(), mw.UserFromCtx(r.Context()), group, template)
 	if err != nil {
 		s.handleErr(w, err, reqID)
 		return
@@ -408,24 +3368,26 @@ func (s *Server) GetTask(w http.ResponseWriter, r *http.Request) {
 	s.respond(w, http.StatusOK, resp, reqID)
 }
 
-// CreateTask create task.
+// UpdateTemplate changes task template of group.
 //
-//	@Tags		Tasks
-//	@Summary	Create task.
-//	@ID			create_task
+//	@Tags		Templates,Groups
+//	@Summary	Update task template.
+//	@ID			update_template
 //	@Accept		json
 //	@Produce	json
-//	@Param		request	body		model.TaskCreateRequest	true	"task"
+//	@Param		group_id	path		string						true	"group id"
+//	@Param		template_id	path		string						true	"template id"
+//	@Param		request		body		model.UpdateTemplateRequest	true	"changed fields"
 //
-//	@Success	200		{object}	model.Task
-//	@Failure	400		{object}	model.Error
-//	@Failure	401		{object}	model.Error
-//	@Failure	403		{object}	model.Error
-//	@Failure	409		{object}	model.Error
-//	@Failure	500		{object}	model.Error
+//	@Success	200			{object}	model.TaskTemplate
+//	@Failure	400			{object}	model.Error
+//	@Failure	401			{object}	model.Error
+//	@Failure	403			{object}	model.Error
+//	@Failure	404			{object}	model.Error
+//	@Failure	500			{object}	model.Error
 //
-//	@Router		/tasks/ [post]
-func (s *Server) CreateTask(w http.ResponseWriter, r *http.Request) {
+//	@Router		/groups/{group_id}/templates/{template_id} [patch]
+func (s *Server) UpdateTemplate(w http.ResponseWriter, r *http.Request) {
 	reqID := reqIDField(middleware.GetReqID(r.Context()))
 
 	var buf bytes.Buffer
@@ -433,23 +3395,59 @@ func (s *Server) CreateTask(w http.ResponseWriter, r *http.Request) {
 		s.internal(w, zap.Error(err), reqID)
 		return
 	}
-
 	_ = r.Body.Close()
 
-	u := mw.UserFromCtx(r.Context())
+	group, template, ok := s.parseGroupAndTemplate(w, r, reqID)
+	if !ok {
+		return
+	}
 
-	var req model.TaskCreateRequest
+	var req model.UpdateTemplateRequest
 	if err := json.NewDecoder(&buf).Decode(&req); err != nil {
 		s.respond(w, http.StatusBadRequest, nil, zap.Error(err), reqID)
 		return
 	}
 
-	resp, err := s.srv.CreateTask(r.Context(), u, req)
+	resp, err := s.srv.UpdateTemplate(r.Context(), mw.UserFromCtx(r.Context()), group, template, req)
 	if err != nil {
+		s.handleErr(w, err, reqID)
+		return
+	}
+
+	s.respond(w, http.StatusOK, resp, reqID)
+}
 
+// DeleteTemplate deletes task template of group.
+//
+//	@Tags		Templates,Groups
+//	@Summary	Delete task template.
+//	@ID			delete_template
+//	@Accept		plain
+//	@Produce	json
+//	@Param		group_id	path	string	true	"group id"
+//	@Param		template_id	path	string	true	"template id"
+//
+//	@Success	200
+//	@Failure	400	{object}	model.Error
+//	@Failure	401	{object}	model.Error
+//	@Failure	403	{object}	model.Error
+//	@Failure	404	{object}	model.Error
+//	@Failure	500	{object}	model.Error
+//
+//	@Router		/groups/{group_id}/templates/{template_id} [delete]
+func (s *Server) DeleteTemplate(w http.ResponseWriter, r *http.Request) {
+	reqID := reqIDField(middleware.GetReqID(r.Context()))
+	_ = r.Body.Close()
+
+	group, template, ok := s.parseGroupAndTemplate(w, r, reqID)
+	if !ok {
+		return
+	}
+
+	if err := s.srv.DeleteTemplate(r.Context(), mw.UserFromCtx(r.Context()), group, template); err != nil {
 		s.handleErr(w, err, reqID)
 		return
 	}
 
-	s.respond(w, http.StatusCreated, resp, reqID)
+	s.respond(w, http.StatusOK, nil, reqID)
 }
@@ -16,6 +16,9 @@ import (
 	"github.com/vlad-marlo/godo/internal/pkg/fielderr"
 	"github.com/vlad-marlo/godo/internal/service"
 	"github.com/vlad-marlo/godo/internal/service/mocks"
+	"io"
+	"mime"
+	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -398,19 +401,9 @@ func TestServer_CreateInviteLink_MainPositive(t *testing.T) {
 	srv := mocks.NewMockInterface(ctrl)
 
 	req := &model.CreateInviteRequest{
-		Group:   uuid.New(),
-		Limit:   2,
-		Member:  3,
-		Task:    4,
-		Review:  5,
-		Comment: 6,
-	}
-
-	role := &model.Role{
-		Members:  req.Member,
-		Tasks:    req.Task,
-		Reviews:  req.Review,
-		Comments: req.Comment,
+		Group: uuid.New(),
+		Limit: 2,
+		Role:  3,
 	}
 
 	resp := &model.CreateInviteResponse{
@@ -418,7 +411,7 @@ func TestServer_CreateInviteLink_MainPositive(t *testing.T) {
 		Limit: req.Limit,
 	}
 
-	srv.EXPECT().CreateInvite(context.Background(), uuid.Nil, req.Group, gomock.Eq(role), 2).Return(resp, nil)
+	srv.EXPECT().CreateInvite(context.Background(), uuid.Nil, req.Group, req.Role, 2).Return(resp, nil)
 
 	s := TestServer(t, srv)
 
@@ -467,22 +460,12 @@ func TestServer_CreateInviteLink_DifferentErrors(t *testing.T) {
 			srv := mocks.NewMockInterface(ctrl)
 
 			req := &model.CreateInviteRequest{
-				Group:   uuid.New(),
-				Limit:   2,
-				Member:  3,
-				Task:    4,
-				Review:  5,
-				Comment: 6,
-			}
-
-			role := &model.Role{
-				Members:  req.Member,
-				Tasks:    req.Task,
-				Reviews:  req.Review,
-				Comments: req.Comment,
+				Group: uuid.New(),
+				Limit: 2,
+				Role:  3,
 			}
 
-			srv.EXPECT().CreateInvite(context.Background(), uuid.Nil, req.Group, gomock.Eq(role), 2).Return(nil, tc.err)
+			srv.EXPECT().CreateInvite(context.Background(), uuid.Nil, req.Group, req.Role, 2).Return(nil, tc.err)
 
 			s := TestServer(t, srv)
 
@@ -513,11 +496,8 @@ func TestServer_CreateInviteLink_DifferentErrors(t *testing.T) {
 func TestServer_CreateInviteViaGroup_MainPositive(t *testing.T) {
 	id := uuid.New()
 	req := &model.CreateInviteViaGroupRequest{
-		Limit:   2,
-		Member:  2,
-		Task:    0,
-		Review:  2,
-		Comment: 2,
+		Limit: 2,
+		Role:  2,
 	}
 
 	b, err := json.Marshal(req)
@@ -541,13 +521,7 @@ func TestServer_CreateInviteViaGroup_MainPositive(t *testing.T) {
 			gomock.Any(),
 			id,
 			id,
-			gomock.Eq(&model.Role{
-				ID:       0,
-				Members:  req.Member,
-				Tasks:    req.Task,
-				Reviews:  req.Review,
-				Comments: req.Comment,
-			}),
+			req.Role,
 			req.Limit,
 		).
 		Return(resp, nil)
@@ -570,11 +544,8 @@ func TestServer_CreateInviteViaGroup_MainPositive(t *testing.T) {
 func TestServer_CreateInviteViaGroup_BadGroup(t *testing.T) {
 	id := uuid.New()
 	req := &model.CreateInviteViaGroupRequest{
-		Limit:   2,
-		Member:  2,
-		Task:    0,
-		Review:  2,
-		Comment: 2,
+		Limit: 2,
+		Role:  2,
 	}
 
 	b, err := json.Marshal(req)
@@ -618,11 +589,8 @@ func TestServer_CreateInviteViaGroup_Errors(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			id := uuid.New()
 			req := &model.CreateInviteViaGroupRequest{
-				Limit:   2,
-				Member:  2,
-				Task:    0,
-				Review:  2,
-				Comment: 2,
+				Limit: 2,
+				Role:  2,
 			}
 
 			b, err := json.Marshal(req)
@@ -642,13 +610,7 @@ func TestServer_CreateInviteViaGroup_Errors(t *testing.T) {
 					gomock.Any(),
 					id,
 					id,
-					gomock.Eq(&model.Role{
-						ID:       0,
-						Members:  req.Member,
-						Tasks:    req.Task,
-						Reviews:  req.Review,
-						Comments: req.Comment,
-					}),
+					req.Role,
 					req.Limit,
 				).
 				Return(nil, tc.err)
@@ -803,8 +765,8 @@ func TestServer_UserMe_Positive(t *testing.T) {
 				"group #1",
 				"description",
 				[]*model.Task{
-					{uuid.New(), "task", "description", time.Now(), u, "NEW"},
-					{uuid.New(), "other task", "other description", time.Now(), u, "NEW"},
+					{ID: uuid.New(), Name: "task", Description: "description", CreatedAt: time.Now(), CreatedBy: u, Status: model.StatusNew},
+					{ID: uuid.New(), Name: "other task", Description: "other description", CreatedAt: time.Now(), CreatedBy: u, Status: model.StatusNew},
 				},
 			},
 			{uuid.New(), "group #2", "other desc", nil},
@@ -961,14 +923,14 @@ func TestServer_AllTasks_Positive(t *testing.T) {
 	resp := &model.GetTasksResponse{
 		Count: 5,
 		Tasks: []*model.Task{
-			{uuid.New(), uuid.NewString(), uuid.NewString(), time.Now(), uuid.New(), uuid.NewString()},
-			{uuid.New(), uuid.NewString(), uuid.NewString(), time.Now(), uuid.New(), uuid.NewString()},
-			{uuid.New(), uuid.NewString(), uuid.NewString(), time.Now(), uuid.New(), uuid.NewString()},
-			{uuid.New(), uuid.NewString(), uuid.NewString(), time.Now(), uuid.New(), uuid.NewString()},
-			{uuid.New(), uuid.NewString(), uuid.NewString(), time.Now(), uuid.New(), uuid.NewString()},
+			{ID: uuid.New(), Name: uuid.NewString(), Description: uuid.NewString(), CreatedAt: time.Now(), CreatedBy: uuid.New(), Status: model.StatusInProgress},
+			{ID: uuid.New(), Name: uuid.NewString(), Description: uuid.NewString(), CreatedAt: time.Now(), CreatedBy: uuid.New(), Status: model.StatusInProgress},
+			{ID: uuid.New(), Name: uuid.NewString(), Description: uuid.NewString(), CreatedAt: time.Now(), CreatedBy: uuid.New(), Status: model.StatusInProgress},
+			{ID: uuid.New(), Name: uuid.NewString(), Description: uuid.NewString(), CreatedAt: time.Now(), CreatedBy: uuid.New(), Status: model.StatusInProgress},
+			{ID: uuid.New(), Name: uuid.NewString(), Description: uuid.NewString(), CreatedAt: time.Now(), CreatedBy: uuid.New(), Status: model.StatusInProgress},
 		},
 	}
-	srv.EXPECT().GetUserTasks(gomock.Any(), uuid.Nil).Return(resp, nil)
+	srv.EXPECT().GetUserTasks(gomock.Any(), uuid.Nil, model.TaskFilter{}).Return(resp, nil)
 
 	s := TestServer(t, srv)
 
@@ -1004,7 +966,7 @@ func TestServer_AllTasks_Errors(t *testing.T) {
 
 			srv.
 				EXPECT().
-				GetUserTasks(gomock.Any(), gomock.Any()).
+				GetUserTasks(gomock.Any(), gomock.Any(), gomock.Any()).
 				Return(nil, tc.err)
 
 			s := TestServer(t, srv)
@@ -1049,7 +1011,12 @@ func TestServer_CreateTask_Positive(t *testing.T) {
 		Status:      "NEW",
 	}
 
-	srv.EXPECT().CreateTask(gomock.Any(), uuid.Nil, req).Return(task, nil)
+	resp := &model.CreateTaskResponse{
+		Task:              task,
+		RejectedAssignees: req.Users[:1],
+	}
+
+	srv.EXPECT().CreateTask(gomock.Any(), uuid.Nil, req).Return(resp, nil)
 
 	s := TestServer(t, srv)
 
@@ -1064,7 +1031,7 @@ func TestServer_CreateTask_Positive(t *testing.T) {
 	defer assert.NoError(t, res.Body.Close())
 
 	var exp []byte
-	exp, err = json.Marshal(task)
+	exp, err = json.Marshal(resp)
 	require.NoError(t, err)
 
 	assert.Equal(t, http.StatusCreated, w.Code)
@@ -1139,3 +1106,1899 @@ func TestServer_CreateTask_BadErr(t *testing.T) {
 		})
 	}
 }
+
+func TestServer_UpdateTaskStatus_Positive(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	srv := mocks.NewMockInterface(ctrl)
+
+	task := &model.Task{
+		ID:          uuid.New(),
+		Name:        "task name",
+		Description: "task desc",
+		CreatedAt:   time.Now(),
+		Status:      model.StatusInProgress,
+	}
+	srv.EXPECT().UpdateTaskStatus(gomock.Any(), uuid.Nil, task.ID, model.StatusInProgress).Return(task, nil)
+
+	s := TestServer(t, srv)
+
+	body, err := json.Marshal(model.UpdateTaskStatusRequest{Status: model.StatusInProgress})
+	require.NoError(t, err)
+	r := reqWithTask(t, httptest.NewRequest(http.MethodPatch, "/", bytes.NewReader(body)), task.ID.String())
+	w := httptest.NewRecorder()
+
+	s.UpdateTaskStatus(w, r)
+
+	res := w.Result()
+	defer assert.NoError(t, res.Body.Close())
+
+	want, err := json.Marshal(task)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, string(want), w.Body.String())
+}
+
+func TestServer_UpdateTaskStatus_BadRequest(t *testing.T) {
+	tt := []struct {
+		name string
+		task string
+		body string
+	}{
+		{"bad task id", "bad id", `{"status":"NEW"}`},
+		{"bad body", uuid.NewString(), `{"status":`},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			s := TestServer(t, nil)
+
+			r := reqWithTask(t, httptest.NewRequest(http.MethodPatch, "/", strings.NewReader(tc.body)), tc.task)
+			w := httptest.NewRecorder()
+
+			s.UpdateTaskStatus(w, r)
+
+			res := w.Result()
+			defer assert.NoError(t, res.Body.Close())
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+		})
+	}
+}
+
+func TestServer_UpdateTaskStatus_Errors(t *testing.T) {
+	tt := []struct {
+		name string
+		err  error
+	}{
+		{"unknown error", errors.New("")},
+		{"field error: conflict", service.ErrStatusTransition.WithData(map[string]any{"allowed": []string{"NEW"}})},
+		{"field error: forbidden", service.ErrForbidden},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			srv := mocks.NewMockInterface(ctrl)
+			srv.EXPECT().UpdateTaskStatus(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, tc.err)
+
+			s := TestServer(t, srv)
+
+			r := reqWithTask(t, httptest.NewRequest(http.MethodPatch, "/", strings.NewReader(`{"status":"NEW"}`)), uuid.NewString())
+			w := httptest.NewRecorder()
+
+			s.UpdateTaskStatus(w, r)
+
+			res := w.Result()
+			defer assert.NoError(t, res.Body.Close())
+
+			fErr, ok := tc.err.(*fielderr.Error)
+			if !ok {
+				assert.Equal(t, http.StatusInternalServerError, w.Code)
+				return
+			}
+			data, err := json.Marshal(fErr.Data())
+			require.NoError(t, err)
+			assert.JSONEq(t, string(data), w.Body.String())
+			assert.Equal(t, fErr.CodeHTTP(), w.Code)
+		})
+	}
+}
+
+func TestServer_SubmitReview(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	srv := mocks.NewMockInterface(ctrl)
+
+	review := &model.Review{ID: uuid.New(), Task: uuid.New(), Message: "done", Status: model.ReviewPending}
+	srv.EXPECT().SubmitReview(gomock.Any(), uuid.Nil, review.Task, review.Message).Return(review, nil)
+	s := TestServer(t, srv)
+
+	r := reqWithTask(t, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"message":"done"}`)), review.Task.String())
+	w := httptest.NewRecorder()
+
+	s.SubmitReview(w, r)
+	res := w.Result()
+	defer assert.NoError(t, res.Body.Close())
+
+	want, err := json.Marshal(review)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.JSONEq(t, string(want), w.Body.String())
+
+	w = httptest.NewRecorder()
+	s.SubmitReview(w, reqWithTask(t, httptest.NewRequest(http.MethodPost, "/", nil), "bad"))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestServer_GroupReviews(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	srv := mocks.NewMockInterface(ctrl)
+
+	group := uuid.New()
+	resp := &model.GetReviewsResponse{Count: 1, Reviews: []*model.Review{{ID: uuid.New()}}}
+	srv.EXPECT().GetPendingReviews(gomock.Any(), uuid.Nil, group).Return(resp, nil)
+	srv.EXPECT().GetPendingReviews(gomock.Any(), uuid.Nil, gomock.Any()).Return(nil, service.ErrForbidden)
+	s := TestServer(t, srv)
+
+	w := httptest.NewRecorder()
+	s.GroupReviews(w, reqWithGroup(t, httptest.NewRequest(http.MethodGet, "/", nil), group.String()))
+	want, err := json.Marshal(resp)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, string(want), w.Body.String())
+
+	w = httptest.NewRecorder()
+	s.GroupReviews(w, reqWithGroup(t, httptest.NewRequest(http.MethodGet, "/", nil), uuid.NewString()))
+	assert.Equal(t, http.StatusForbidden, w.Code)
+
+	w = httptest.NewRecorder()
+	s.GroupReviews(w, reqWithGroup(t, httptest.NewRequest(http.MethodGet, "/", nil), "bad"))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestServer_ResolveReview(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	srv := mocks.NewMockInterface(ctrl)
+
+	review := &model.Review{ID: uuid.New(), Status: model.ReviewAccepted, Answer: "ok"}
+	req := model.ResolveReviewRequest{Status: model.ReviewAccepted, Message: "ok"}
+	srv.EXPECT().ResolveReview(gomock.Any(), uuid.Nil, review.ID, req).Return(review, nil)
+	s := TestServer(t, srv)
+
+	body, err := json.Marshal(req)
+	require.NoError(t, err)
+	w := httptest.NewRecorder()
+	s.ResolveReview(w, reqWithData(t, httptest.NewRequest(http.MethodPatch, "/", bytes.NewReader(body)), reviewIDParamName, review.ID.String()))
+
+	want, err := json.Marshal(review)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, string(want), w.Body.String())
+
+	w = httptest.NewRecorder()
+	s.ResolveReview(w, reqWithData(t, httptest.NewRequest(http.MethodPatch, "/", bytes.NewReader(body)), reviewIDParamName, "bad"))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestServer_TaskComments(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	srv := mocks.NewMockInterface(ctrl)
+
+	task := uuid.New()
+	resp := &model.GetCommentsResponse{Count: 1, Comments: []*model.Comment{{ID: uuid.New(), Task: task}}}
+	srv.EXPECT().GetTaskComments(gomock.Any(), uuid.Nil, task).Return(resp, nil)
+	srv.EXPECT().GetTaskComments(gomock.Any(), uuid.Nil, gomock.Any()).Return(nil, service.ErrNotFound)
+	s := TestServer(t, srv)
+
+	w := httptest.NewRecorder()
+	s.TaskComments(w, reqWithTask(t, httptest.NewRequest(http.MethodGet, "/", nil), task.String()))
+	want, err := json.Marshal(resp)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, string(want), w.Body.String())
+
+	w = httptest.NewRecorder()
+	s.TaskComments(w, reqWithTask(t, httptest.NewRequest(http.MethodGet, "/", nil), uuid.NewString()))
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	w = httptest.NewRecorder()
+	s.TaskComments(w, reqWithTask(t, httptest.NewRequest(http.MethodGet, "/", nil), "bad"))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestServer_CreateComment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	srv := mocks.NewMockInterface(ctrl)
+
+	task := uuid.New()
+	req := model.CreateCommentRequest{Message: "msg"}
+	comment := &model.Comment{ID: uuid.New(), Task: task, Message: req.Message}
+	srv.EXPECT().CreateComment(gomock.Any(), uuid.Nil, task, req).Return(comment, nil)
+	s := TestServer(t, srv)
+
+	body, err := json.Marshal(req)
+	require.NoError(t, err)
+	w := httptest.NewRecorder()
+	s.CreateComment(w, reqWithTask(t, httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(body)), task.String()))
+
+	want, err := json.Marshal(comment)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.JSONEq(t, string(want), w.Body.String())
+
+	w = httptest.NewRecorder()
+	s.CreateComment(w, reqWithTask(t, httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(body)), "bad"))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	w = httptest.NewRecorder()
+	s.CreateComment(w, reqWithTask(t, httptest.NewRequest(http.MethodPost, "/", strings.NewReader("{")), task.String()))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestServer_UpdateComment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	srv := mocks.NewMockInterface(ctrl)
+
+	task, id := uuid.New(), uuid.New()
+	req := model.UpdateCommentRequest{Message: "new"}
+	comment := &model.Comment{ID: id, Task: task, Message: req.Message}
+	srv.EXPECT().UpdateComment(gomock.Any(), uuid.Nil, task, id, req).Return(comment, nil)
+	s := TestServer(t, srv)
+
+	body, err := json.Marshal(req)
+	require.NoError(t, err)
+	w := httptest.NewRecorder()
+	s.UpdateComment(w, reqWithComment(t, httptest.NewRequest(http.MethodPatch, "/", bytes.NewReader(body)), task.String(), id.String()))
+
+	want, err := json.Marshal(comment)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, string(want), w.Body.String())
+
+	w = httptest.NewRecorder()
+	s.UpdateComment(w, reqWithComment(t, httptest.NewRequest(http.MethodPatch, "/", bytes.NewReader(body)), task.String(), "bad"))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestServer_DeleteComment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	srv := mocks.NewMockInterface(ctrl)
+
+	task, id := uuid.New(), uuid.New()
+	srv.EXPECT().DeleteComment(gomock.Any(), uuid.Nil, task, id).Return(nil)
+	srv.EXPECT().DeleteComment(gomock.Any(), uuid.Nil, task, gomock.Any()).Return(service.ErrForbidden)
+	s := TestServer(t, srv)
+
+	w := httptest.NewRecorder()
+	s.DeleteComment(w, reqWithComment(t, httptest.NewRequest(http.MethodDelete, "/", nil), task.String(), id.String()))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	w = httptest.NewRecorder()
+	s.DeleteComment(w, reqWithComment(t, httptest.NewRequest(http.MethodDelete, "/", nil), task.String(), uuid.NewString()))
+	assert.Equal(t, http.StatusForbidden, w.Code)
+
+	w = httptest.NewRecorder()
+	s.DeleteComment(w, reqWithComment(t, httptest.NewRequest(http.MethodDelete, "/", nil), "bad", id.String()))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestServer_CommentHistory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	srv := mocks.NewMockInterface(ctrl)
+
+	task, id := uuid.New(), uuid.New()
+	resp := &model.GetCommentHistoryResponse{Count: 1, Edits: []*model.CommentEdit{{Message: "old"}}}
+	srv.EXPECT().GetCommentHistory(gomock.Any(), uuid.Nil, task, id).Return(resp, nil)
+	s := TestServer(t, srv)
+
+	w := httptest.NewRecorder()
+	s.CommentHistory(w, reqWithComment(t, httptest.NewRequest(http.MethodGet, "/", nil), task.String(), id.String()))
+
+	want, err := json.Marshal(resp)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, string(want), w.Body.String())
+}
+
+func TestServer_UpdateTask(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	srv := mocks.NewMockInterface(ctrl)
+
+	name, description := "name", "description"
+	task := &model.Task{ID: uuid.New(), Name: name, Description: description, Status: model.StatusNew}
+	full := model.TaskUpdateRequest{Name: &name, Description: &description}
+	partial := model.TaskUpdateRequest{Name: &name}
+	srv.EXPECT().UpdateTask(gomock.Any(), uuid.Nil, task.ID, full).Return(task, nil)
+	srv.EXPECT().UpdateTask(gomock.Any(), uuid.Nil, task.ID, partial).Return(nil, service.ErrForbidden)
+	s := TestServer(t, srv)
+
+	body, err := json.Marshal(full)
+	require.NoError(t, err)
+	w := httptest.NewRecorder()
+	s.UpdateTask(w, reqWithTask(t, httptest.NewRequest(http.MethodPut, "/", bytes.NewReader(body)), task.ID.String()))
+
+	want, err := json.Marshal(task)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, string(want), w.Body.String())
+
+	body, err = json.Marshal(partial)
+	require.NoError(t, err)
+	w = httptest.NewRecorder()
+	s.UpdateTask(w, reqWithTask(t, httptest.NewRequest(http.MethodPut, "/", bytes.NewReader(body)), task.ID.String()))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	w = httptest.NewRecorder()
+	s.UpdateTask(w, reqWithTask(t, httptest.NewRequest(http.MethodPatch, "/", bytes.NewReader(body)), task.ID.String()))
+	assert.Equal(t, http.StatusForbidden, w.Code)
+
+	w = httptest.NewRecorder()
+	s.UpdateTask(w, reqWithTask(t, httptest.NewRequest(http.MethodPatch, "/", bytes.NewReader(body)), "bad"))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	w = httptest.NewRecorder()
+	s.UpdateTask(w, reqWithTask(t, httptest.NewRequest(http.MethodPatch, "/", strings.NewReader("{")), task.ID.String()))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestServer_DeleteTask(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	srv := mocks.NewMockInterface(ctrl)
+
+	task := uuid.New()
+	srv.EXPECT().DeleteTask(gomock.Any(), uuid.Nil, task).Return(nil)
+	srv.EXPECT().DeleteTask(gomock.Any(), uuid.Nil, gomock.Any()).Return(service.ErrNotFound)
+	s := TestServer(t, srv)
+
+	w := httptest.NewRecorder()
+	s.DeleteTask(w, reqWithTask(t, httptest.NewRequest(http.MethodDelete, "/", nil), task.String()))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	w = httptest.NewRecorder()
+	s.DeleteTask(w, reqWithTask(t, httptest.NewRequest(http.MethodDelete, "/", nil), uuid.NewString()))
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	w = httptest.NewRecorder()
+	s.DeleteTask(w, reqWithTask(t, httptest.NewRequest(http.MethodDelete, "/", nil), "bad"))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestServer_AllTasks_Filter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	srv := mocks.NewMockInterface(ctrl)
+
+	before := time.Unix(1682953200, 0)
+	after, err := time.Parse(time.RFC3339, "2023-04-01T10:00:00+03:00")
+	require.NoError(t, err)
+	srv.EXPECT().GetUserTasks(gomock.Any(), uuid.Nil, model.TaskFilter{
+		Overdue:   true,
+		DueBefore: &before,
+		DueAfter:  &after,
+	}).Return(&model.GetTasksResponse{}, nil)
+	s := TestServer(t, srv)
+
+	w := httptest.NewRecorder()
+	s.AllTasks(w, httptest.NewRequest(http.MethodGet, "/?overdue=true&due-before=1682953200&due-after=2023-04-01T10:00:00%2B03:00", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	for _, q := range []string{"overdue=maybe", "due-before=tomorrow", "due-after=2023-04-01"} {
+		w = httptest.NewRecorder()
+		s.AllTasks(w, httptest.NewRequest(http.MethodGet, "/?"+q, nil))
+		assert.Equal(t, http.StatusBadRequest, w.Code, q)
+	}
+}
+
+func TestServer_AllTasks_Pagination(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	srv := mocks.NewMockInterface(ctrl)
+
+	group, creator, assignee, cursor := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	srv.EXPECT().GetUserTasks(gomock.Any(), uuid.Nil, model.TaskFilter{
+		Status:    []model.TaskStatus{model.StatusNew, model.StatusInProgress, model.StatusClosed},
+		Group:     &group,
+		CreatedBy: &creator,
+		Assignee:  &assignee,
+		Sort:      model.SortDeadline,
+		Desc:      true,
+		Cursor:    &cursor,
+		Limit:     10,
+	}).Return(&model.GetTasksResponse{NextCursor: &cursor}, nil)
+	s := TestServer(t, srv)
+
+	w := httptest.NewRecorder()
+	s.AllTasks(w, httptest.NewRequest(http.MethodGet, fmt.Sprintf(
+		"/?status=NEW,IN_PROGRESS&status=CLOSED&group=%s&creator=%s&assignee=%s&sort=deadline&order=desc&cursor=%s&limit=10",
+		group, creator, assignee, cursor,
+	), nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), cursor.String())
+
+	for _, q := range []string{"group=1", "creator=1", "assignee=1", "cursor=1", "order=up", "limit=ten"} {
+		w = httptest.NewRecorder()
+		s.AllTasks(w, httptest.NewRequest(http.MethodGet, "/?"+q, nil))
+		assert.Equal(t, http.StatusBadRequest, w.Code, q)
+	}
+}
+
+func TestServer_Search(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	srv := mocks.NewMockInterface(ctrl)
+
+	resp := &model.SearchResponse{
+		Count:   1,
+		Results: []*model.SearchResult{{Task: &model.Task{ID: uuid.New(), Name: "задача"}, Rank: 0.1, Snippet: "<b>задача</b>"}},
+	}
+	srv.EXPECT().Search(gomock.Any(), uuid.Nil, "задачи", 10).Return(resp, nil)
+	srv.EXPECT().Search(gomock.Any(), uuid.Nil, "", 0).Return(nil, service.ErrEmptySearchQuery)
+	s := TestServer(t, srv)
+
+	w := httptest.NewRecorder()
+	s.Search(w, httptest.NewRequest(http.MethodGet, "/?q=%D0%B7%D0%B0%D0%B4%D0%B0%D1%87%D0%B8&limit=10", nil))
+	want, err := json.Marshal(resp)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, string(want), w.Body.String())
+
+	w = httptest.NewRecorder()
+	s.Search(w, httptest.NewRequest(http.MethodGet, "/", nil))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	w = httptest.NewRecorder()
+	s.Search(w, httptest.NewRequest(http.MethodGet, "/?q=task&limit=ten", nil))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestServer_Subtasks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	srv := mocks.NewMockInterface(ctrl)
+
+	task := uuid.New()
+	resp := &model.GetTasksResponse{Count: 1, Tasks: []*model.Task{{ID: uuid.New(), Parent: &task}}}
+	srv.EXPECT().GetSubtasks(gomock.Any(), uuid.Nil, task).Return(resp, nil)
+	srv.EXPECT().GetSubtasks(gomock.Any(), uuid.Nil, gomock.Any()).Return(nil, service.ErrNotFound)
+	s := TestServer(t, srv)
+
+	w := httptest.NewRecorder()
+	s.Subtasks(w, reqWithTask(t, httptest.NewRequest(http.MethodGet, "/", nil), task.String()))
+	want, err := json.Marshal(resp)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, string(want), w.Body.String())
+
+	w = httptest.NewRecorder()
+	s.Subtasks(w, reqWithTask(t, httptest.NewRequest(http.MethodGet, "/", nil), uuid.NewString()))
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	w = httptest.NewRecorder()
+	s.Subtasks(w, reqWithTask(t, httptest.NewRequest(http.MethodGet, "/", nil), "bad"))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestServer_TaskHistory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	srv := mocks.NewMockInterface(ctrl)
+
+	task := uuid.New()
+	name := "task"
+	resp := &model.GetTaskHistoryResponse{Count: 1, Changes: []*model.TaskChange{
+		model.NewTaskChange(task, uuid.New(), model.TaskFieldCreated, nil, &name),
+	}}
+	srv.EXPECT().GetTaskHistory(gomock.Any(), uuid.Nil, task).Return(resp, nil)
+	srv.EXPECT().GetTaskHistory(gomock.Any(), uuid.Nil, gomock.Any()).Return(nil, service.ErrNotFound)
+	s := TestServer(t, srv)
+
+	w := httptest.NewRecorder()
+	s.TaskHistory(w, reqWithTask(t, httptest.NewRequest(http.MethodGet, "/", nil), task.String()))
+	want, err := json.Marshal(resp)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, string(want), w.Body.String())
+
+	w = httptest.NewRecorder()
+	s.TaskHistory(w, reqWithTask(t, httptest.NewRequest(http.MethodGet, "/", nil), uuid.NewString()))
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	w = httptest.NewRecorder()
+	s.TaskHistory(w, reqWithTask(t, httptest.NewRequest(http.MethodGet, "/", nil), "bad"))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestServer_AddDependency(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	srv := mocks.NewMockInterface(ctrl)
+
+	task := uuid.New()
+	req := model.AddDependencyRequest{BlockedBy: uuid.New()}
+	dep := &model.TaskDependency{Blocker: req.BlockedBy, Blocked: task}
+	srv.EXPECT().AddDependency(gomock.Any(), uuid.Nil, task, req).Return(dep, nil)
+	srv.EXPECT().AddDependency(gomock.Any(), uuid.Nil, gomock.Any(), req).Return(nil, service.ErrDependencyCycle)
+	s := TestServer(t, srv)
+
+	body, err := json.Marshal(req)
+	require.NoError(t, err)
+	w := httptest.NewRecorder()
+	s.AddDependency(w, reqWithTask(t, httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(body)), task.String()))
+	want, err := json.Marshal(dep)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.JSONEq(t, string(want), w.Body.String())
+
+	w = httptest.NewRecorder()
+	s.AddDependency(w, reqWithTask(t, httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(body)), uuid.NewString()))
+	assert.Equal(t, http.StatusConflict, w.Code)
+
+	w = httptest.NewRecorder()
+	s.AddDependency(w, reqWithTask(t, httptest.NewRequest(http.MethodPost, "/", strings.NewReader("{")), task.String()))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	w = httptest.NewRecorder()
+	s.AddDependency(w, reqWithTask(t, httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(body)), "bad"))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestServer_RemoveDependency(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	srv := mocks.NewMockInterface(ctrl)
+
+	task, blocker := uuid.New(), uuid.New()
+	srv.EXPECT().RemoveDependency(gomock.Any(), uuid.Nil, task, blocker).Return(nil)
+	s := TestServer(t, srv)
+
+	req := func(task, blocker string) *http.Request {
+		return reqWithDependency(t, httptest.NewRequest(http.MethodDelete, "/", nil), task, blocker)
+	}
+
+	w := httptest.NewRecorder()
+	s.RemoveDependency(w, req(task.String(), blocker.String()))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	w = httptest.NewRecorder()
+	s.RemoveDependency(w, req(task.String(), "bad"))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	w = httptest.NewRecorder()
+	s.RemoveDependency(w, req("bad", blocker.String()))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestServer_DependencyGraph(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	srv := mocks.NewMockInterface(ctrl)
+
+	group := uuid.New()
+	graph := &model.DependencyGraph{
+		Nodes: []*model.DependencyNode{{ID: uuid.New(), Name: "task", Status: model.StatusNew}},
+		Edges: []*model.TaskDependency{},
+	}
+	srv.EXPECT().GetDependencyGraph(gomock.Any(), uuid.Nil, group).Return(graph, nil).Times(2)
+	s := TestServer(t, srv)
+
+	w := httptest.NewRecorder()
+	s.DependencyGraph(w, reqWithGroup(t, httptest.NewRequest(http.MethodGet, "/", nil), group.String()))
+	want, err := json.Marshal(graph)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, string(want), w.Body.String())
+
+	w = httptest.NewRecorder()
+	s.DependencyGraph(w, reqWithGroup(t, httptest.NewRequest(http.MethodGet, "/?format=dot", nil), group.String()))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "text/vnd.graphviz", w.Header().Get("content-type"))
+	assert.Equal(t, graph.DOT(), w.Body.String())
+
+	w = httptest.NewRecorder()
+	s.DependencyGraph(w, reqWithGroup(t, httptest.NewRequest(http.MethodGet, "/?format=png", nil), group.String()))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	w = httptest.NewRecorder()
+	s.DependencyGraph(w, reqWithGroup(t, httptest.NewRequest(http.MethodGet, "/", nil), "bad"))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestServer_GroupLabels(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	srv := mocks.NewMockInterface(ctrl)
+
+	group := uuid.New()
+	resp := &model.GetLabelsResponse{Count: 1, Labels: []*model.Label{{ID: uuid.New(), Group: group, Name: "bug", Color: "#d73a4a"}}}
+	srv.EXPECT().GetGroupLabels(gomock.Any(), uuid.Nil, group).Return(resp, nil)
+	s := TestServer(t, srv)
+
+	w := httptest.NewRecorder()
+	s.GroupLabels(w, reqWithGroup(t, httptest.NewRequest(http.MethodGet, "/", nil), group.String()))
+	want, err := json.Marshal(resp)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, string(want), w.Body.String())
+
+	w = httptest.NewRecorder()
+	s.GroupLabels(w, reqWithGroup(t, httptest.NewRequest(http.MethodGet, "/", nil), "bad"))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestServer_CreateLabel(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	srv := mocks.NewMockInterface(ctrl)
+
+	group := uuid.New()
+	req := model.CreateLabelRequest{Name: "bug", Color: "#d73a4a"}
+	label := &model.Label{ID: uuid.New(), Group: group, Name: req.Name, Color: req.Color}
+	srv.EXPECT().CreateLabel(gomock.Any(), uuid.Nil, group, req).Return(label, nil)
+	srv.EXPECT().CreateLabel(gomock.Any(), uuid.Nil, gomock.Any(), req).Return(nil, service.ErrLabelAlreadyExists)
+	s := TestServer(t, srv)
+
+	body, err := json.Marshal(req)
+	require.NoError(t, err)
+	w := httptest.NewRecorder()
+	s.CreateLabel(w, reqWithGroup(t, httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(body)), group.String()))
+	want, err := json.Marshal(label)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.JSONEq(t, string(want), w.Body.String())
+
+	w = httptest.NewRecorder()
+	s.CreateLabel(w, reqWithGroup(t, httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(body)), uuid.NewString()))
+	assert.Equal(t, http.StatusConflict, w.Code)
+
+	w = httptest.NewRecorder()
+	s.CreateLabel(w, reqWithGroup(t, httptest.NewRequest(http.MethodPost, "/", strings.NewReader("{")), group.String()))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestServer_UpdateLabel(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	srv := mocks.NewMockInterface(ctrl)
+
+	group, id := uuid.New(), uuid.New()
+	color := "#1f883d"
+	req := model.UpdateLabelRequest{Color: &color}
+	label := &model.Label{ID: id, Group: group, Name: "feature", Color: color}
+	srv.EXPECT().UpdateLabel(gomock.Any(), uuid.Nil, group, id, req).Return(label, nil)
+	s := TestServer(t, srv)
+
+	body, err := json.Marshal(req)
+	require.NoError(t, err)
+	w := httptest.NewRecorder()
+	s.UpdateLabel(w, reqWithLabel(t, httptest.NewRequest(http.MethodPatch, "/", bytes.NewReader(body)), groupIDParamName, group.String(), id.String()))
+	want, err := json.Marshal(label)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, string(want), w.Body.String())
+
+	w = httptest.NewRecorder()
+	s.UpdateLabel(w, reqWithLabel(t, httptest.NewRequest(http.MethodPatch, "/", bytes.NewReader(body)), groupIDParamName, group.String(), "bad"))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestServer_DeleteLabel(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	srv := mocks.NewMockInterface(ctrl)
+
+	group, id := uuid.New(), uuid.New()
+	srv.EXPECT().DeleteLabel(gomock.Any(), uuid.Nil, group, id).Return(nil)
+	srv.EXPECT().DeleteLabel(gomock.Any(), uuid.Nil, group, gomock.Any()).Return(service.ErrNotFound)
+	s := TestServer(t, srv)
+
+	w := httptest.NewRecorder()
+	s.DeleteLabel(w, reqWithLabel(t, httptest.NewRequest(http.MethodDelete, "/", nil), groupIDParamName, group.String(), id.String()))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	w = httptest.NewRecorder()
+	s.DeleteLabel(w, reqWithLabel(t, httptest.NewRequest(http.MethodDelete, "/", nil), groupIDParamName, group.String(), uuid.NewString()))
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	w = httptest.NewRecorder()
+	s.DeleteLabel(w, reqWithLabel(t, httptest.NewRequest(http.MethodDelete, "/", nil), groupIDParamName, "bad", id.String()))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestServer_AttachLabel(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	srv := mocks.NewMockInterface(ctrl)
+
+	task, id := uuid.New(), uuid.New()
+	srv.EXPECT().AttachLabel(gomock.Any(), uuid.Nil, task, id).Return(nil)
+	srv.EXPECT().AttachLabel(gomock.Any(), uuid.Nil, gomock.Any(), id).Return(service.ErrBadLabel)
+	srv.EXPECT().DetachLabel(gomock.Any(), uuid.Nil, task, id).Return(nil)
+	s := TestServer(t, srv)
+
+	w := httptest.NewRecorder()
+	s.AttachLabel(w, reqWithLabel(t, httptest.NewRequest(http.MethodPut, "/", nil), taskIDParamName, task.String(), id.String()))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	w = httptest.NewRecorder()
+	s.AttachLabel(w, reqWithLabel(t, httptest.NewRequest(http.MethodPut, "/", nil), taskIDParamName, uuid.NewString(), id.String()))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	w = httptest.NewRecorder()
+	s.DetachLabel(w, reqWithLabel(t, httptest.NewRequest(http.MethodDelete, "/", nil), taskIDParamName, task.String(), id.String()))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	w = httptest.NewRecorder()
+	s.DetachLabel(w, reqWithLabel(t, httptest.NewRequest(http.MethodDelete, "/", nil), taskIDParamName, "bad", id.String()))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestServer_AllTasks_Labels(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	srv := mocks.NewMockInterface(ctrl)
+
+	a, b, c := uuid.New(), uuid.New(), uuid.New()
+	srv.EXPECT().GetUserTasks(gomock.Any(), uuid.Nil, model.TaskFilter{Labels: []uuid.UUID{a, b, c}}).Return(&model.GetTasksResponse{}, nil)
+	s := TestServer(t, srv)
+
+	w := httptest.NewRecorder()
+	s.AllTasks(w, httptest.NewRequest(http.MethodGet, fmt.Sprintf("/?label=%s,%s&label=%s", a, b, c), nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	w = httptest.NewRecorder()
+	s.AllTasks(w, httptest.NewRequest(http.MethodGet, "/?label=bad", nil))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestServer_Board(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	srv := mocks.NewMockInterface(ctrl)
+
+	group := uuid.New()
+	board := model.NewBoard(group, []*model.Task{{ID: uuid.New(), Status: model.StatusNew}}, nil)
+	srv.EXPECT().GetBoard(gomock.Any(), uuid.Nil, group).Return(board, nil)
+	srv.EXPECT().GetBoard(gomock.Any(), uuid.Nil, gomock.Any()).Return(nil, service.ErrForbidden)
+	s := TestServer(t, srv)
+
+	w := httptest.NewRecorder()
+	s.Board(w, reqWithGroup(t, httptest.NewRequest(http.MethodGet, "/", nil), group.String()))
+	want, err := json.Marshal(board)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, string(want), w.Body.String())
+
+	w = httptest.NewRecorder()
+	s.Board(w, reqWithGroup(t, httptest.NewRequest(http.MethodGet, "/", nil), uuid.NewString()))
+	assert.Equal(t, http.StatusForbidden, w.Code)
+
+	w = httptest.NewRecorder()
+	s.Board(w, reqWithGroup(t, httptest.NewRequest(http.MethodGet, "/", nil), "bad"))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestServer_MoveTask(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	srv := mocks.NewMockInterface(ctrl)
+
+	group, task := uuid.New(), uuid.New()
+	req := model.MoveTaskRequest{Status: model.StatusInProgress, Position: 1}
+	board := model.NewBoard(group, nil, nil)
+	srv.EXPECT().MoveTask(gomock.Any(), uuid.Nil, group, task, req).Return(board, nil)
+	srv.EXPECT().MoveTask(gomock.Any(), uuid.Nil, group, gomock.Any(), req).Return(nil, service.ErrWIPLimitExceeded)
+	s := TestServer(t, srv)
+
+	body, err := json.Marshal(req)
+	require.NoError(t, err)
+	w := httptest.NewRecorder()
+	s.MoveTask(w, reqWithGroupTask(t, httptest.NewRequest(http.MethodPut, "/", bytes.NewReader(body)), group.String(), task.String()))
+	want, err := json.Marshal(board)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, string(want), w.Body.String())
+
+	w = httptest.NewRecorder()
+	s.MoveTask(w, reqWithGroupTask(t, httptest.NewRequest(http.MethodPut, "/", bytes.NewReader(body)), group.String(), uuid.NewString()))
+	assert.Equal(t, http.StatusConflict, w.Code)
+
+	w = httptest.NewRecorder()
+	s.MoveTask(w, reqWithGroupTask(t, httptest.NewRequest(http.MethodPut, "/", bytes.NewReader(body)), group.String(), "bad"))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	w = httptest.NewRecorder()
+	s.MoveTask(w, reqWithGroupTask(t, httptest.NewRequest(http.MethodPut, "/", strings.NewReader("{")), group.String(), task.String()))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestServer_SetBoardLimits(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	srv := mocks.NewMockInterface(ctrl)
+
+	group := uuid.New()
+	limit := 3
+	req := model.BoardLimitsRequest{Limits: map[model.TaskStatus]*int{model.StatusInProgress: &limit}}
+	board := model.NewBoard(group, nil, map[model.TaskStatus]int{model.StatusInProgress: limit})
+	srv.EXPECT().SetBoardLimits(gomock.Any(), uuid.Nil, group, req).Return(board, nil)
+	s := TestServer(t, srv)
+
+	body, err := json.Marshal(req)
+	require.NoError(t, err)
+	w := httptest.NewRecorder()
+	s.SetBoardLimits(w, reqWithGroup(t, httptest.NewRequest(http.MethodPut, "/", bytes.NewReader(body)), group.String()))
+	want, err := json.Marshal(board)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, string(want), w.Body.String())
+
+	w = httptest.NewRecorder()
+	s.SetBoardLimits(w, reqWithGroup(t, httptest.NewRequest(http.MethodPut, "/", strings.NewReader("{")), group.String()))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestServer_GroupTemplates(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	srv := mocks.NewMockInterface(ctrl)
+
+	group := uuid.New()
+	resp := &model.GetTemplatesResponse{
+		Count:     1,
+		Templates: []*model.TaskTemplate{{ID: uuid.New(), Group: group, Name: "release notes", Assignees: []uuid.UUID{}}},
+	}
+	srv.EXPECT().GetGroupTemplates(gomock.Any(), uuid.Nil, group).Return(resp, nil)
+	srv.EXPECT().GetGroupTemplates(gomock.Any(), uuid.Nil, gomock.Any()).Return(nil, service.ErrForbidden)
+	s := TestServer(t, srv)
+
+	w := httptest.NewRecorder()
+	s.GroupTemplates(w, reqWithGroup(t, httptest.NewRequest(http.MethodGet, "/", nil), group.String()))
+	want, err := json.Marshal(resp)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, string(want), w.Body.String())
+
+	w = httptest.NewRecorder()
+	s.GroupTemplates(w, reqWithGroup(t, httptest.NewRequest(http.MethodGet, "/", nil), uuid.NewString()))
+	assert.Equal(t, http.StatusForbidden, w.Code)
+
+	w = httptest.NewRecorder()
+	s.GroupTemplates(w, reqWithGroup(t, httptest.NewRequest(http.MethodGet, "/", nil), "bad"))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestServer_CreateTemplate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	srv := mocks.NewMockInterface(ctrl)
+
+	group := uuid.New()
+	req := model.CreateTemplateRequest{Name: "release notes", Recurrence: "0 9 * * MON"}
+	next := time.Now()
+	tpl := &model.TaskTemplate{ID: uuid.New(), Group: group, Name: req.Name, Recurrence: req.Recurrence, NextRunAt: &next}
+	srv.EXPECT().CreateTemplate(gomock.Any(), uuid.Nil, group, req).Return(tpl, nil)
+	srv.EXPECT().CreateTemplate(gomock.Any(), uuid.Nil, gomock.Any(), req).Return(nil, service.ErrBadRecurrence)
+	s := TestServer(t, srv)
+
+	body, err := json.Marshal(req)
+	require.NoError(t, err)
+	w := httptest.NewRecorder()
+	s.CreateTemplate(w, reqWithGroup(t, httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(body)), group.String()))
+	want, err := json.Marshal(tpl)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.JSONEq(t, string(want), w.Body.String())
+
+	w = httptest.NewRecorder()
+	s.CreateTemplate(w, reqWithGroup(t, httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(body)), uuid.NewString()))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	w = httptest.NewRecorder()
+	s.CreateTemplate(w, reqWithGroup(t, httptest.NewRequest(http.MethodPost, "/", strings.NewReader("{")), group.String()))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestServer_DeleteTemplate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	srv := mocks.NewMockInterface(ctrl)
+
+	group, id := uuid.New(), uuid.New()
+	srv.EXPECT().DeleteTemplate(gomock.Any(), uuid.Nil, group, id).Return(nil)
+	srv.EXPECT().DeleteTemplate(gomock.Any(), uuid.Nil, group, gomock.Any()).Return(service.ErrNotFound)
+	s := TestServer(t, srv)
+
+	w := httptest.NewRecorder()
+	s.DeleteTemplate(w, reqWithGroupAnd(t, httptest.NewRequest(http.MethodDelete, "/", nil), group.String(), templateIDParamName, id.String()))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	w = httptest.NewRecorder()
+	s.DeleteTemplate(w, reqWithGroupAnd(t, httptest.NewRequest(http.MethodDelete, "/", nil), group.String(), templateIDParamName, uuid.NewString()))
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	w = httptest.NewRecorder()
+	s.DeleteTemplate(w, reqWithGroupAnd(t, httptest.NewRequest(http.MethodDelete, "/", nil), group.String(), templateIDParamName, "bad"))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestServer_GetTemplate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	srv := mocks.NewMockInterface(ctrl)
+
+	group := uuid.New()
+	tpl := &model.TaskTemplate{ID: uuid.New(), Group: group, Name: "release notes", Assignees: []uuid.UUID{}, Labels: []uuid.UUID{}, Checklist: []string{}}
+	srv.EXPECT().GetTemplate(gomock.Any(), uuid.Nil, group, tpl.ID).Return(tpl, nil)
+	srv.EXPECT().GetTemplate(gomock.Any(), uuid.Nil, group, gomock.Any()).Return(nil, service.ErrNotFound)
+	s := TestServer(t, srv)
+
+	w := httptest.NewRecorder()
+	s.GetTemplate(w, reqWithGroupAnd(t, httptest.NewRequest(http.MethodGet, "/", nil), group.String(), templateIDParamName, tpl.ID.String()))
+	want, err := json.Marshal(tpl)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, string(want), w.Body.String())
+
+	w = httptest.NewRecorder()
+	s.GetTemplate(w, reqWithGroupAnd(t, httptest.NewRequest(http.MethodGet, "/", nil), group.String(), templateIDParamName, uuid.NewString()))
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	w = httptest.NewRecorder()
+	s.GetTemplate(w, reqWithGroupAnd(t, httptest.NewRequest(http.MethodGet, "/", nil), "bad", templateIDParamName, tpl.ID.String()))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestServer_UpdateTemplate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	srv := mocks.NewMockInterface(ctrl)
+
+	group := uuid.New()
+	name := "release notes {week}"
+	req := model.UpdateTemplateRequest{Name: &name, Checklist: []string{"write changelog"}}
+	tpl := &model.TaskTemplate{ID: uuid.New(), Group: group, Name: name, Assignees: []uuid.UUID{}, Labels: []uuid.UUID{}, Checklist: req.Checklist}
+	srv.EXPECT().UpdateTemplate(gomock.Any(), uuid.Nil, group, tpl.ID, req).Return(tpl, nil)
+	srv.EXPECT().UpdateTemplate(gomock.Any(), uuid.Nil, group, gomock.Any(), req).Return(nil, service.ErrBadTemplateLabels)
+	s := TestServer(t, srv)
+
+	body, err := json.Marshal(req)
+	require.NoError(t, err)
+	w := httptest.NewRecorder()
+	s.UpdateTemplate(w, reqWithGroupAnd(t, httptest.NewRequest(http.MethodPatch, "/", bytes.NewReader(body)), group.String(), templateIDParamName, tpl.ID.String()))
+	want, err := json.Marshal(tpl)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, string(want), w.Body.String())
+
+	w = httptest.NewRecorder()
+	s.UpdateTemplate(w, reqWithGroupAnd(t, httptest.NewRequest(http.MethodPatch, "/", bytes.NewReader(body)), group.String(), templateIDParamName, uuid.NewString()))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	w = httptest.NewRecorder()
+	s.UpdateTemplate(w, reqWithGroupAnd(t, httptest.NewRequest(http.MethodPatch, "/", strings.NewReader("{")), group.String(), templateIDParamName, tpl.ID.String()))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestServer_BulkTasks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	srv := mocks.NewMockInterface(ctrl)
+
+	req := model.BulkTaskRequest{Tasks: []uuid.UUID{uuid.New(), uuid.New()}, Operation: model.BulkSetStatus, Status: model.StatusClosed}
+	resp := model.NewBulkTaskResponse([]*model.BulkTaskResult{
+		{Task: req.Tasks[0], OK: true},
+		{Task: req.Tasks[1], Error: "user has no permission", Reason: map[string]string{"error": "you have no permission to do"}},
+	})
+	srv.EXPECT().BulkTasks(gomock.Any(), uuid.Nil, req).Return(resp, nil)
+	srv.EXPECT().BulkTasks(gomock.Any(), uuid.Nil, gomock.Any()).Return(nil, service.ErrBadBulkOperation)
+	s := TestServer(t, srv)
+
+	body, err := json.Marshal(req)
+	require.NoError(t, err)
+	w := httptest.NewRecorder()
+	s.BulkTasks(w, httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(body)))
+	want, err := json.Marshal(resp)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, string(want), w.Body.String())
+
+	w = httptest.NewRecorder()
+	s.BulkTasks(w, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"tasks":[],"operation":"rename"}`)))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	w = httptest.NewRecorder()
+	s.BulkTasks(w, httptest.NewRequest(http.MethodPost, "/", strings.NewReader("{")))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestServer_TaskWatchers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	srv := mocks.NewMockInterface(ctrl)
+
+	task := uuid.New()
+	resp := &model.GetWatchersResponse{Count: 1, Watching: true, Watchers: []*model.Watcher{{User: uuid.Nil, Email: "user@example.com"}}}
+	srv.EXPECT().GetTaskWatchers(gomock.Any(), uuid.Nil, task).Return(resp, nil)
+	srv.EXPECT().GetTaskWatchers(gomock.Any(), uuid.Nil, gomock.Any()).Return(nil, service.ErrNotFound)
+	s := TestServer(t, srv)
+
+	w := httptest.NewRecorder()
+	s.TaskWatchers(w, reqWithTask(t, httptest.NewRequest(http.MethodGet, "/", nil), task.String()))
+	want, err := json.Marshal(resp)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, string(want), w.Body.String())
+
+	w = httptest.NewRecorder()
+	s.TaskWatchers(w, reqWithTask(t, httptest.NewRequest(http.MethodGet, "/", nil), uuid.NewString()))
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	w = httptest.NewRecorder()
+	s.TaskWatchers(w, reqWithTask(t, httptest.NewRequest(http.MethodGet, "/", nil), "bad"))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestServer_WatchTask(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	srv := mocks.NewMockInterface(ctrl)
+
+	task := uuid.New()
+	srv.EXPECT().WatchTask(gomock.Any(), uuid.Nil, task).Return(nil)
+	srv.EXPECT().WatchTask(gomock.Any(), uuid.Nil, gomock.Any()).Return(service.ErrNotFound)
+	srv.EXPECT().UnwatchTask(gomock.Any(), uuid.Nil, task).Return(nil)
+	srv.EXPECT().UnwatchTask(gomock.Any(), uuid.Nil, gomock.Any()).Return(service.ErrNotFound)
+	s := TestServer(t, srv)
+
+	for _, h := range []http.HandlerFunc{s.WatchTask, s.UnwatchTask} {
+		w := httptest.NewRecorder()
+		h(w, reqWithTask(t, httptest.NewRequest(http.MethodPut, "/", nil), task.String()))
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		w = httptest.NewRecorder()
+		h(w, reqWithTask(t, httptest.NewRequest(http.MethodPut, "/", nil), uuid.NewString()))
+		assert.Equal(t, http.StatusNotFound, w.Code)
+
+		w = httptest.NewRecorder()
+		h(w, reqWithTask(t, httptest.NewRequest(http.MethodPut, "/", nil), "bad"))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	}
+}
+
+func TestServer_Notifications(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	srv := mocks.NewMockInterface(ctrl)
+
+	resp := &model.GetNotificationsResponse{Count: 0, Changes: []*model.TaskChange{}}
+	srv.EXPECT().GetNotifications(gomock.Any(), uuid.Nil, int64(12), 5).Return(resp, nil)
+	srv.EXPECT().GetNotifications(gomock.Any(), uuid.Nil, int64(0), -1).Return(nil, service.ErrBadPageLimit)
+	s := TestServer(t, srv)
+
+	w := httptest.NewRecorder()
+	s.Notifications(w, httptest.NewRequest(http.MethodGet, "/?after=12&limit=5", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"count":0,"changes":[]}`, w.Body.String())
+
+	w = httptest.NewRecorder()
+	s.Notifications(w, httptest.NewRequest(http.MethodGet, "/?limit=-1", nil))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	for _, q := range []string{"after=x", "limit=x"} {
+		w = httptest.NewRecorder()
+		s.Notifications(w, httptest.NewRequest(http.MethodGet, "/?"+q, nil))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	}
+}
+
+// multipartFile return multipart body with file in field and its content type.
+func multipartFile(t testing.TB, field, name, content string) (*bytes.Buffer, string) {
+	t.Helper()
+	body := new(bytes.Buffer)
+	mpw := multipart.NewWriter(body)
+	require.NoError(t, mpw.WriteField("other", "value"))
+	fw, err := mpw.CreateFormFile(field, name)
+	require.NoError(t, err)
+	_, err = io.WriteString(fw, content)
+	require.NoError(t, err)
+	require.NoError(t, mpw.Close())
+	return body, mpw.FormDataContentType()
+}
+
+func TestServer_UploadAttachment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	srv := mocks.NewMockInterface(ctrl)
+
+	task, comment := uuid.New(), uuid.New()
+	a := &model.Attachment{ID: uuid.New(), Task: task, Comment: &comment, Name: "notes.txt", Size: 5}
+	srv.EXPECT().UploadAttachment(gomock.Any(), uuid.Nil, task, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _, _ uuid.UUID, req model.UploadAttachmentRequest) (*model.Attachment, error) {
+			assert.Equal(t, &comment, req.Comment)
+			assert.Equal(t, "notes.txt", req.Name)
+			assert.Equal(t, "application/octet-stream", req.ContentType)
+			data, err := io.ReadAll(req.Body)
+			require.NoError(t, err)
+			assert.Equal(t, "notes", string(data))
+			return a, nil
+		},
+	)
+	srv.EXPECT().UploadAttachment(gomock.Any(), uuid.Nil, gomock.Any(), gomock.Any()).Return(nil, service.ErrAttachmentTooLarge)
+	s := TestServer(t, srv)
+
+	body, contentType := multipartFile(t, attachmentFormField, "notes.txt", "notes")
+	r := httptest.NewRequest(http.MethodPost, "/?comment="+comment.String(), body)
+	r.Header.Set("content-type", contentType)
+	w := httptest.NewRecorder()
+	s.UploadAttachment(w, reqWithTask(t, r, task.String()))
+	want, err := json.Marshal(a)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.JSONEq(t, string(want), w.Body.String())
+
+	body, contentType = multipartFile(t, attachmentFormField, "large.txt", "large")
+	r = httptest.NewRequest(http.MethodPost, "/", body)
+	r.Header.Set("content-type", contentType)
+	w = httptest.NewRecorder()
+	s.UploadAttachment(w, reqWithTask(t, r, uuid.NewString()))
+	assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+}
+
+func TestServer_UploadAttachment_BadRequest(t *testing.T) {
+	s := TestServer(t, nil)
+	noFile, noFileType := multipartFile(t, "not-file", "notes.txt", "notes")
+
+	tt := []struct {
+		name        string
+		task        string
+		query       string
+		body        io.Reader
+		contentType string
+	}{
+		{"bad task", "bad", "", strings.NewReader(""), ""},
+		{"bad comment", uuid.NewString(), "?comment=bad", strings.NewReader(""), ""},
+		{"not multipart", uuid.NewString(), "", strings.NewReader("{}"), "application/json"},
+		{"no file", uuid.NewString(), "", noFile, noFileType},
+		{"broken form", uuid.NewString(), "", strings.NewReader("--x\r\n"), "multipart/form-data; boundary=x"},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPost, "/"+tc.query, tc.body)
+			r.Header.Set("content-type", tc.contentType)
+			w := httptest.NewRecorder()
+			s.UploadAttachment(w, reqWithTask(t, r, tc.task))
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+		})
+	}
+}
+
+func TestServer_UploadAttachment_TooLarge(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	srv := mocks.NewMockInterface(ctrl)
+	srv.EXPECT().UploadAttachment(gomock.Any(), uuid.Nil, gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _, _ uuid.UUID, req model.UploadAttachmentRequest) (*model.Attachment, error) {
+			_, err := io.ReadAll(req.Body)
+			require.ErrorAs(t, err, new(*http.MaxBytesError))
+			return nil, service.ErrAttachmentTooLarge
+		},
+	)
+	s := TestServer(t, srv)
+	s.cfg.Server.MaxAttachmentSize = 1
+	large := strings.Repeat("a", attachmentFormOverhead+2)
+
+	tt := []struct {
+		name  string
+		field string
+	}{
+		{"large file", attachmentFormField},
+		{"large field before file", "other-file"},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			body, contentType := multipartFile(t, tc.field, "large.txt", large)
+			r := httptest.NewRequest(http.MethodPost, "/", body)
+			r.Header.Set("content-type", contentType)
+			w := httptest.NewRecorder()
+			s.UploadAttachment(w, reqWithTask(t, r, uuid.NewString()))
+			assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+		})
+	}
+}
+
+func TestServer_TaskAttachments(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	srv := mocks.NewMockInterface(ctrl)
+
+	task := uuid.New()
+	resp := &model.GetAttachmentsResponse{Count: 1, Attachments: []*model.Attachment{{ID: uuid.New(), Task: task}}}
+	srv.EXPECT().GetTaskAttachments(gomock.Any(), uuid.Nil, task).Return(resp, nil)
+	srv.EXPECT().GetTaskAttachments(gomock.Any(), uuid.Nil, gomock.Any()).Return(nil, service.ErrNotFound)
+	s := TestServer(t, srv)
+
+	w := httptest.NewRecorder()
+	s.TaskAttachments(w, reqWithTask(t, httptest.NewRequest(http.MethodGet, "/", nil), task.String()))
+	want, err := json.Marshal(resp)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, string(want), w.Body.String())
+
+	w = httptest.NewRecorder()
+	s.TaskAttachments(w, reqWithTask(t, httptest.NewRequest(http.MethodGet, "/", nil), uuid.NewString()))
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	w = httptest.NewRecorder()
+	s.TaskAttachments(w, reqWithTask(t, httptest.NewRequest(http.MethodGet, "/", nil), "bad"))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestServer_DownloadAttachment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	srv := mocks.NewMockInterface(ctrl)
+
+	task := uuid.New()
+	a := &model.Attachment{ID: uuid.New(), Task: task, Name: "отчёт.txt", ContentType: "text/plain", Size: 7}
+	srv.EXPECT().GetAttachment(gomock.Any(), uuid.Nil, task, a.ID).Return(a, io.NopCloser(strings.NewReader("content")), nil)
+	srv.EXPECT().GetAttachment(gomock.Any(), uuid.Nil, task, gomock.Any()).Return(nil, nil, service.ErrNotFound)
+	s := TestServer(t, srv)
+
+	w := httptest.NewRecorder()
+	s.DownloadAttachment(w, reqWithAttachment(t, httptest.NewRequest(http.MethodGet, "/", nil), task.String(), a.ID.String()))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "content", w.Body.String())
+	assert.Equal(t, "text/plain", w.Header().Get("content-type"))
+	assert.Equal(t, "7", w.Header().Get("content-length"))
+	assert.Equal(t, "nosniff", w.Header().Get("x-content-type-options"))
+	_, params, err := mime.ParseMediaType(w.Header().Get("content-disposition"))
+	require.NoError(t, err)
+	assert.Equal(t, a.Name, params["filename"])
+
+	w = httptest.NewRecorder()
+	s.DownloadAttachment(w, reqWithAttachment(t, httptest.NewRequest(http.MethodGet, "/", nil), task.String(), uuid.NewString()))
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	w = httptest.NewRecorder()
+	s.DownloadAttachment(w, reqWithAttachment(t, httptest.NewRequest(http.MethodGet, "/", nil), "bad", a.ID.String()))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	w = httptest.NewRecorder()
+	s.DownloadAttachment(w, reqWithAttachment(t, httptest.NewRequest(http.MethodGet, "/", nil), task.String(), "bad"))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestServer_DeleteAttachment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	srv := mocks.NewMockInterface(ctrl)
+
+	task, attachment := uuid.New(), uuid.New()
+	srv.EXPECT().DeleteAttachment(gomock.Any(), uuid.Nil, task, attachment).Return(nil)
+	srv.EXPECT().DeleteAttachment(gomock.Any(), uuid.Nil, task, gomock.Any()).Return(service.ErrForbidden)
+	s := TestServer(t, srv)
+
+	w := httptest.NewRecorder()
+	s.DeleteAttachment(w, reqWithAttachment(t, httptest.NewRequest(http.MethodDelete, "/", nil), task.String(), attachment.String()))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	w = httptest.NewRecorder()
+	s.DeleteAttachment(w, reqWithAttachment(t, httptest.NewRequest(http.MethodDelete, "/", nil), task.String(), uuid.NewString()))
+	assert.Equal(t, http.StatusForbidden, w.Code)
+
+	w = httptest.NewRecorder()
+	s.DeleteAttachment(w, reqWithAttachment(t, httptest.NewRequest(http.MethodDelete, "/", nil), "bad", attachment.String()))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	w = httptest.NewRecorder()
+	s.DeleteAttachment(w, reqWithAttachment(t, httptest.NewRequest(http.MethodDelete, "/", nil), task.String(), "bad"))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestServer_Checklist(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	srv := mocks.NewMockInterface(ctrl)
+
+	task := uuid.New()
+	resp := model.NewGetChecklistResponse([]*model.ChecklistItem{{ID: uuid.New(), Task: task, Text: "a", Done: true}})
+	srv.EXPECT().GetChecklist(gomock.Any(), uuid.Nil, task).Return(resp, nil)
+	s := TestServer(t, srv)
+
+	w := httptest.NewRecorder()
+	s.Checklist(w, reqWithTask(t, httptest.NewRequest(http.MethodGet, "/", nil), task.String()))
+
+	want, err := json.Marshal(resp)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, string(want), w.Body.String())
+
+	w = httptest.NewRecorder()
+	s.Checklist(w, reqWithTask(t, httptest.NewRequest(http.MethodGet, "/", nil), "bad"))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestServer_CreateChecklistItem(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	srv := mocks.NewMockInterface(ctrl)
+
+	task := uuid.New()
+	req := model.CreateChecklistItemRequest{Text: "write tests"}
+	item := &model.ChecklistItem{ID: uuid.New(), Task: task, Text: req.Text}
+	srv.EXPECT().CreateChecklistItem(gomock.Any(), uuid.Nil, task, req).Return(item, nil)
+	s := TestServer(t, srv)
+
+	body, err := json.Marshal(req)
+	require.NoError(t, err)
+	w := httptest.NewRecorder()
+	s.CreateChecklistItem(w, reqWithTask(t, httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(body)), task.String()))
+
+	want, err := json.Marshal(item)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.JSONEq(t, string(want), w.Body.String())
+
+	w = httptest.NewRecorder()
+	s.CreateChecklistItem(w, reqWithTask(t, httptest.NewRequest(http.MethodPost, "/", strings.NewReader("{")), task.String()))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestServer_ToggleChecklistItem(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	srv := mocks.NewMockInterface(ctrl)
+
+	task, id := uuid.New(), uuid.New()
+	req := model.ToggleChecklistItemRequest{Done: true}
+	item := &model.ChecklistItem{ID: id, Task: task, Text: "a", Done: true}
+	srv.EXPECT().ToggleChecklistItem(gomock.Any(), uuid.Nil, task, id, req).Return(item, nil)
+	srv.EXPECT().ToggleChecklistItem(gomock.Any(), uuid.Nil, task, gomock.Any(), req).Return(nil, service.ErrForbidden)
+	s := TestServer(t, srv)
+
+	body, err := json.Marshal(req)
+	require.NoError(t, err)
+	w := httptest.NewRecorder()
+	s.ToggleChecklistItem(w, reqWithChecklistItem(t, httptest.NewRequest(http.MethodPatch, "/", bytes.NewReader(body)), task.String(), id.String()))
+
+	want, err := json.Marshal(item)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, string(want), w.Body.String())
+
+	w = httptest.NewRecorder()
+	s.ToggleChecklistItem(w, reqWithChecklistItem(t, httptest.NewRequest(http.MethodPatch, "/", bytes.NewReader(body)), task.String(), uuid.NewString()))
+	assert.Equal(t, http.StatusForbidden, w.Code)
+
+	w = httptest.NewRecorder()
+	s.ToggleChecklistItem(w, reqWithChecklistItem(t, httptest.NewRequest(http.MethodPatch, "/", bytes.NewReader(body)), task.String(), "bad"))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestServer_MoveChecklistItem(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	srv := mocks.NewMockInterface(ctrl)
+
+	task, id := uuid.New(), uuid.New()
+	req := model.MoveChecklistItemRequest{Position: 2}
+	resp := model.NewGetChecklistResponse([]*model.ChecklistItem{{ID: id, Task: task, Text: "a"}})
+	srv.EXPECT().MoveChecklistItem(gomock.Any(), uuid.Nil, task, id, req).Return(resp, nil)
+	s := TestServer(t, srv)
+
+	body, err := json.Marshal(req)
+	require.NoError(t, err)
+	w := httptest.NewRecorder()
+	s.MoveChecklistItem(w, reqWithChecklistItem(t, httptest.NewRequest(http.MethodPut, "/", bytes.NewReader(body)), task.String(), id.String()))
+
+	want, err := json.Marshal(resp)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, string(want), w.Body.String())
+
+	w = httptest.NewRecorder()
+	s.MoveChecklistItem(w, reqWithChecklistItem(t, httptest.NewRequest(http.MethodPut, "/", bytes.NewReader(body)), "bad", id.String()))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestServer_DeleteChecklistItem(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	srv := mocks.NewMockInterface(ctrl)
+
+	task, id := uuid.New(), uuid.New()
+	srv.EXPECT().DeleteChecklistItem(gomock.Any(), uuid.Nil, task, id).Return(nil)
+	srv.EXPECT().DeleteChecklistItem(gomock.Any(), uuid.Nil, task, gomock.Any()).Return(service.ErrNotFound)
+	s := TestServer(t, srv)
+
+	w := httptest.NewRecorder()
+	s.DeleteChecklistItem(w, reqWithChecklistItem(t, httptest.NewRequest(http.MethodDelete, "/", nil), task.String(), id.String()))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	w = httptest.NewRecorder()
+	s.DeleteChecklistItem(w, reqWithChecklistItem(t, httptest.NewRequest(http.MethodDelete, "/", nil), task.String(), uuid.NewString()))
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	w = httptest.NewRecorder()
+	s.DeleteChecklistItem(w, reqWithChecklistItem(t, httptest.NewRequest(http.MethodDelete, "/", nil), task.String(), "bad"))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestServer_StartTimer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	srv := mocks.NewMockInterface(ctrl)
+
+	task := uuid.New()
+	worklog := &model.Worklog{ID: uuid.New(), Task: task, StartedAt: time.Unix(100, 0)}
+	srv.EXPECT().StartTimer(gomock.Any(), uuid.Nil, task, model.StartTimerRequest{}).Return(worklog, nil)
+	srv.EXPECT().StartTimer(gomock.Any(), uuid.Nil, task, model.StartTimerRequest{Description: "x"}).Return(nil, service.ErrTimerAlreadyRunning)
+	s := TestServer(t, srv)
+
+	w := httptest.NewRecorder()
+	s.StartTimer(w, reqWithTask(t, httptest.NewRequest(http.MethodPost, "/", nil), task.String()))
+	want, err := json.Marshal(worklog)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.JSONEq(t, string(want), w.Body.String())
+
+	w = httptest.NewRecorder()
+	s.StartTimer(w, reqWithTask(t, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"description":"x"}`)), task.String()))
+	assert.Equal(t, http.StatusConflict, w.Code)
+
+	w = httptest.NewRecorder()
+	s.StartTimer(w, reqWithTask(t, httptest.NewRequest(http.MethodPost, "/", nil), "bad"))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestServer_RunningTimer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	srv := mocks.NewMockInterface(ctrl)
+
+	worklog := &model.Worklog{ID: uuid.New(), StartedAt: time.Now()}
+	gomock.InOrder(
+		srv.EXPECT().GetRunningTimer(gomock.Any(), uuid.Nil).Return(worklog, nil),
+		srv.EXPECT().GetRunningTimer(gomock.Any(), uuid.Nil).Return(nil, service.ErrNoRunningTimer),
+	)
+	s := TestServer(t, srv)
+
+	w := httptest.NewRecorder()
+	s.RunningTimer(w, httptest.NewRequest(http.MethodGet, "/", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	w = httptest.NewRecorder()
+	s.RunningTimer(w, httptest.NewRequest(http.MethodGet, "/", nil))
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestServer_StopTimer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	srv := mocks.NewMockInterface(ctrl)
+
+	stopped := time.Unix(200, 0)
+	worklog := &model.Worklog{ID: uuid.New(), StartedAt: time.Unix(100, 0), StoppedAt: &stopped}
+	gomock.InOrder(
+		srv.EXPECT().StopTimer(gomock.Any(), uuid.Nil).Return(worklog, nil),
+		srv.EXPECT().StopTimer(gomock.Any(), uuid.Nil).Return(nil, service.ErrNoRunningTimer),
+	)
+	s := TestServer(t, srv)
+
+	w := httptest.NewRecorder()
+	s.StopTimer(w, httptest.NewRequest(http.MethodPost, "/", nil))
+	want, err := json.Marshal(worklog)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, string(want), w.Body.String())
+
+	w = httptest.NewRecorder()
+	s.StopTimer(w, httptest.NewRequest(http.MethodPost, "/", nil))
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestServer_TaskWorklogs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	srv := mocks.NewMockInterface(ctrl)
+
+	task := uuid.New()
+	resp := &model.GetWorklogsResponse{Count: 0, Worklogs: []*model.Worklog{}}
+	srv.EXPECT().GetTaskWorklogs(gomock.Any(), uuid.Nil, task).Return(resp, nil)
+	s := TestServer(t, srv)
+
+	w := httptest.NewRecorder()
+	s.TaskWorklogs(w, reqWithTask(t, httptest.NewRequest(http.MethodGet, "/", nil), task.String()))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"count":0,"worklogs":[]}`, w.Body.String())
+
+	w = httptest.NewRecorder()
+	s.TaskWorklogs(w, reqWithTask(t, httptest.NewRequest(http.MethodGet, "/", nil), "bad"))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestServer_CreateWorklog(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	srv := mocks.NewMockInterface(ctrl)
+
+	task := uuid.New()
+	req := model.CreateWorklogRequest{StartedAt: time.Unix(100, 0).UTC(), StoppedAt: time.Unix(200, 0).UTC(), Description: "x"}
+	worklog := &model.Worklog{ID: uuid.New(), Task: task, StartedAt: req.StartedAt, StoppedAt: &req.StoppedAt}
+	srv.EXPECT().CreateWorklog(gomock.Any(), uuid.Nil, task, req).Return(worklog, nil)
+	s := TestServer(t, srv)
+
+	body, err := json.Marshal(req)
+	require.NoError(t, err)
+	w := httptest.NewRecorder()
+	s.CreateWorklog(w, reqWithTask(t, httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(body)), task.String()))
+	want, err := json.Marshal(worklog)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.JSONEq(t, string(want), w.Body.String())
+
+	w = httptest.NewRecorder()
+	s.CreateWorklog(w, reqWithTask(t, httptest.NewRequest(http.MethodPost, "/", strings.NewReader("{")), task.String()))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestServer_DeleteWorklog(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	srv := mocks.NewMockInterface(ctrl)
+
+	task, worklog := uuid.New(), uuid.New()
+	srv.EXPECT().DeleteWorklog(gomock.Any(), uuid.Nil, task, worklog).Return(nil)
+	srv.EXPECT().DeleteWorklog(gomock.Any(), uuid.Nil, task, gomock.Any()).Return(service.ErrForbidden)
+	s := TestServer(t, srv)
+
+	w := httptest.NewRecorder()
+	s.DeleteWorklog(w, reqWithWorklog(t, httptest.NewRequest(http.MethodDelete, "/", nil), task.String(), worklog.String()))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	w = httptest.NewRecorder()
+	s.DeleteWorklog(w, reqWithWorklog(t, httptest.NewRequest(http.MethodDelete, "/", nil), task.String(), uuid.NewString()))
+	assert.Equal(t, http.StatusForbidden, w.Code)
+
+	w = httptest.NewRecorder()
+	s.DeleteWorklog(w, reqWithWorklog(t, httptest.NewRequest(http.MethodDelete, "/", nil), task.String(), "bad"))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestServer_WorklogReport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	srv := mocks.NewMockInterface(ctrl)
+
+	group, row := uuid.New(), uuid.New()
+	filter := model.WorklogReportFilter{From: time.Unix(100, 0), To: time.Unix(200, 0), By: model.ReportByTask, Group: &group}
+	report := model.NewWorklogReport(filter, []*model.WorklogReportRow{{ID: row, Name: "task", Entries: 1, Seconds: 3600}})
+	srv.EXPECT().GetWorklogReport(gomock.Any(), uuid.Nil, filter).Return(report, nil).Times(2)
+	srv.EXPECT().GetWorklogReport(gomock.Any(), uuid.Nil, model.WorklogReportFilter{}).Return(nil, service.ErrBadReportPeriod)
+	s := TestServer(t, srv)
+
+	target := "/?from=100&to=200&by=task&group=" + group.String()
+	w := httptest.NewRecorder()
+	s.WorklogReport(w, httptest.NewRequest(http.MethodGet, target, nil))
+	want, err := json.Marshal(report)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, string(want), w.Body.String())
+
+	w = httptest.NewRecorder()
+	s.WorklogReport(w, httptest.NewRequest(http.MethodGet, target+"&format=csv", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "text/csv", w.Header().Get("content-type"))
+	assert.Equal(t, "task,name,entries,seconds,hours\n"+row.String()+",task,1,3600,1.00\n", w.Body.String())
+
+	w = httptest.NewRecorder()
+	s.WorklogReport(w, httptest.NewRequest(http.MethodGet, "/", nil))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	for _, q := range []string{"format=xml", "from=bad", "to=bad", "group=bad"} {
+		w = httptest.NewRecorder()
+		s.WorklogReport(w, httptest.NewRequest(http.MethodGet, "/?"+q, nil))
+		assert.Equal(t, http.StatusBadRequest, w.Code, q)
+	}
+}
+
+func TestServer_GroupStats(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	srv := mocks.NewMockInterface(ctrl)
+
+	group := uuid.New()
+	from := time.Unix(1682899200, 0)
+	stats := &model.GroupStats{Group: group, From: from, To: from.AddDate(0, 0, 7)}
+	stats.FillGaps()
+	srv.EXPECT().GetGroupStats(gomock.Any(), uuid.Nil, group, from, time.Time{}).Return(stats, nil)
+	srv.EXPECT().GetGroupStats(gomock.Any(), uuid.Nil, group, time.Time{}, time.Time{}).Return(nil, service.ErrForbidden)
+	s := TestServer(t, srv)
+
+	w := httptest.NewRecorder()
+	s.GroupStats(w, reqWithGroup(t, httptest.NewRequest(http.MethodGet, "/?from=1682899200", nil), group.String()))
+	assert.Equal(t, http.StatusOK, w.Code)
+	data, err := json.Marshal(stats)
+	require.NoError(t, err)
+	assert.JSONEq(t, string(data), w.Body.String())
+
+	w = httptest.NewRecorder()
+	s.GroupStats(w, reqWithGroup(t, httptest.NewRequest(http.MethodGet, "/", nil), group.String()))
+	assert.Equal(t, http.StatusForbidden, w.Code)
+
+	for _, tc := range []struct {
+		query, group string
+	}{
+		{"from=bad", group.String()},
+		{"to=bad", group.String()},
+		{"", "bad_id"},
+	} {
+		w = httptest.NewRecorder()
+		s.GroupStats(w, reqWithGroup(t, httptest.NewRequest(http.MethodGet, "/?"+tc.query, nil), tc.group))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	}
+}
+
+func TestServer_Groups(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	srv := mocks.NewMockInterface(ctrl)
+
+	resp := &model.GetGroupsResponse{Count: 1, Groups: []*model.GroupInUser{{ID: uuid.New(), Name: "name"}}}
+	srv.EXPECT().GetGroups(gomock.Any(), uuid.Nil).Return(resp, nil)
+	srv.EXPECT().GetGroups(gomock.Any(), uuid.Nil).Return(nil, service.ErrInternal)
+	s := TestServer(t, srv)
+
+	w := httptest.NewRecorder()
+	s.Groups(w, httptest.NewRequest(http.MethodGet, "/", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	data, err := json.Marshal(resp)
+	require.NoError(t, err)
+	assert.JSONEq(t, string(data), w.Body.String())
+
+	w = httptest.NewRecorder()
+	s.Groups(w, httptest.NewRequest(http.MethodGet, "/", nil))
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+}
+
+func TestServer_GetGroup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	srv := mocks.NewMockInterface(ctrl)
+
+	group, foreign := uuid.New(), uuid.New()
+	resp := (&model.Group{ID: group, Name: "name", TelegramChat: "@godo_team"}).Profile()
+	srv.EXPECT().GetGroup(gomock.Any(), uuid.Nil, group).Return(resp, nil)
+	srv.EXPECT().GetGroup(gomock.Any(), uuid.Nil, foreign).Return(nil, service.ErrForbidden)
+	s := TestServer(t, srv)
+
+	w := httptest.NewRecorder()
+	s.GetGroup(w, reqWithGroup(t, httptest.NewRequest(http.MethodGet, "/", nil), group.String()))
+	assert.Equal(t, http.StatusOK, w.Code)
+	data, err := json.Marshal(resp)
+	require.NoError(t, err)
+	assert.JSONEq(t, string(data), w.Body.String())
+
+	w = httptest.NewRecorder()
+	s.GetGroup(w, reqWithGroup(t, httptest.NewRequest(http.MethodGet, "/", nil), foreign.String()))
+	assert.Equal(t, http.StatusForbidden, w.Code)
+
+	w = httptest.NewRecorder()
+	s.GetGroup(w, reqWithGroup(t, httptest.NewRequest(http.MethodGet, "/", nil), "bad_id"))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestServer_UpdateGroup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	srv := mocks.NewMockInterface(ctrl)
+
+	group := uuid.New()
+	chat, site := "@godo_team", "example.com"
+	req := model.UpdateGroupRequest{TelegramChat: &chat, Fields: map[string]*string{"site": &site, "old": nil}}
+	resp := (&model.Group{ID: group, Name: "name", TelegramChat: chat, Fields: map[string]string{"site": site}}).Profile()
+	srv.EXPECT().UpdateGroup(gomock.Any(), uuid.Nil, group, req).Return(resp, nil)
+	srv.EXPECT().UpdateGroup(gomock.Any(), uuid.Nil, group, model.UpdateGroupRequest{}).Return(nil, service.ErrBadGroupProfile)
+	s := TestServer(t, srv)
+
+	w := httptest.NewRecorder()
+	body := `{"telegram-chat":"@godo_team","fields":{"site":"example.com","old":null}}`
+	s.UpdateGroup(w, reqWithGroup(t, httptest.NewRequest(http.MethodPatch, "/", strings.NewReader(body)), group.String()))
+	assert.Equal(t, http.StatusOK, w.Code)
+	data, err := json.Marshal(resp)
+	require.NoError(t, err)
+	assert.JSONEq(t, string(data), w.Body.String())
+
+	w = httptest.NewRecorder()
+	s.UpdateGroup(w, reqWithGroup(t, httptest.NewRequest(http.MethodPatch, "/", strings.NewReader(`{}`)), group.String()))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	for _, tc := range []struct {
+		body, group string
+	}{
+		{"{", group.String()},
+		{"{}", "bad_id"},
+	} {
+		w = httptest.NewRecorder()
+		s.UpdateGroup(w, reqWithGroup(t, httptest.NewRequest(http.MethodPatch, "/", strings.NewReader(tc.body)), tc.group))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	}
+}
+
+func TestServer_GroupMembers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	srv := mocks.NewMockInterface(ctrl)
+
+	group, foreign := uuid.New(), uuid.New()
+	inviter := uuid.New()
+	resp := &model.GetMembersResponse{Count: 1, Members: []*model.UserInGroup{{UserID: uuid.New(), Email: "user@example.com", InvitedBy: &inviter}}}
+	srv.EXPECT().GetGroupMembers(gomock.Any(), uuid.Nil, group).Return(resp, nil)
+	srv.EXPECT().GetGroupMembers(gomock.Any(), uuid.Nil, foreign).Return(nil, service.ErrForbidden)
+	s := TestServer(t, srv)
+
+	w := httptest.NewRecorder()
+	s.GroupMembers(w, reqWithGroup(t, httptest.NewRequest(http.MethodGet, "/", nil), group.String()))
+	assert.Equal(t, http.StatusOK, w.Code)
+	data, err := json.Marshal(resp)
+	require.NoError(t, err)
+	assert.JSONEq(t, string(data), w.Body.String())
+
+	w = httptest.NewRecorder()
+	s.GroupMembers(w, reqWithGroup(t, httptest.NewRequest(http.MethodGet, "/", nil), foreign.String()))
+	assert.Equal(t, http.StatusForbidden, w.Code)
+
+	w = httptest.NewRecorder()
+	s.GroupMembers(w, reqWithGroup(t, httptest.NewRequest(http.MethodGet, "/", nil), "bad_id"))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestServer_RemoveGroupMember(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	srv := mocks.NewMockInterface(ctrl)
+
+	group, member, admin := uuid.New(), uuid.New(), uuid.New()
+	srv.EXPECT().RemoveGroupMember(gomock.Any(), uuid.Nil, group, member).Return(nil)
+	srv.EXPECT().RemoveGroupMember(gomock.Any(), uuid.Nil, group, admin).Return(service.ErrForbidden)
+	s := TestServer(t, srv)
+
+	for _, tc := range []struct {
+		group, member string
+		want          int
+	}{
+		{group.String(), member.String(), http.StatusOK},
+		{group.String(), admin.String(), http.StatusForbidden},
+		{"bad_id", member.String(), http.StatusBadRequest},
+		{group.String(), "bad_id", http.StatusBadRequest},
+	} {
+		w := httptest.NewRecorder()
+		r := reqWithGroupAnd(t, httptest.NewRequest(http.MethodDelete, "/", nil), tc.group, userIDParamName, tc.member)
+		s.RemoveGroupMember(w, r)
+		assert.Equal(t, tc.want, w.Code)
+	}
+}
+
+func TestServer_LeaveGroup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	srv := mocks.NewMockInterface(ctrl)
+
+	group, lonely := uuid.New(), uuid.New()
+	srv.EXPECT().LeaveGroup(gomock.Any(), uuid.Nil, group).Return(nil)
+	srv.EXPECT().LeaveGroup(gomock.Any(), uuid.Nil, lonely).Return(service.ErrLastAdmin)
+	s := TestServer(t, srv)
+
+	for _, tc := range []struct {
+		group string
+		want  int
+	}{
+		{group.String(), http.StatusOK},
+		{lonely.String(), http.StatusConflict},
+		{"bad_id", http.StatusBadRequest},
+	} {
+		w := httptest.NewRecorder()
+		s.LeaveGroup(w, reqWithGroup(t, httptest.NewRequest(http.MethodDelete, "/", nil), tc.group))
+		assert.Equal(t, tc.want, w.Code)
+	}
+}
+
+func TestServer_UpdateGroupMember(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	srv := mocks.NewMockInterface(ctrl)
+
+	group, member, admin := uuid.New(), uuid.New(), uuid.New()
+	role := int32(3)
+	resp := &model.UserInGroup{UserID: member, Email: "user@example.com", Role: role, RoleName: "editor", Permissions: model.PermEditTasks}
+	srv.EXPECT().UpdateGroupMember(gomock.Any(), uuid.Nil, group, member, model.UpdateMemberRequest{Role: &role}).Return(resp, nil)
+	srv.EXPECT().UpdateGroupMember(gomock.Any(), uuid.Nil, group, admin, model.UpdateMemberRequest{}).Return(nil, service.ErrRoleEscalation)
+	s := TestServer(t, srv)
+
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodPatch, "/", strings.NewReader(`{"role":3}`))
+	s.UpdateGroupMember(w, reqWithGroupAnd(t, r, group.String(), userIDParamName, member.String()))
+	assert.Equal(t, http.StatusOK, w.Code)
+	data, err := json.Marshal(resp)
+	require.NoError(t, err)
+	assert.JSONEq(t, string(data), w.Body.String())
+
+	for _, tc := range []struct {
+		body, group, member string
+		want                int
+	}{
+		{`{}`, group.String(), admin.String(), http.StatusForbidden},
+		{`{`, group.String(), member.String(), http.StatusBadRequest},
+		{`{}`, "bad_id", member.String(), http.StatusBadRequest},
+		{`{}`, group.String(), "bad_id", http.StatusBadRequest},
+	} {
+		w = httptest.NewRecorder()
+		r = httptest.NewRequest(http.MethodPatch, "/", strings.NewReader(tc.body))
+		s.UpdateGroupMember(w, reqWithGroupAnd(t, r, tc.group, userIDParamName, tc.member))
+		assert.Equal(t, tc.want, w.Code)
+	}
+}
+
+func TestServer_GroupAuditLog(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	srv := mocks.NewMockInterface(ctrl)
+
+	group := uuid.New()
+	resp := &model.GetAuditLogResponse{Count: 0, Entries: []*model.AuditEntry{}}
+	srv.EXPECT().GetGroupAuditLog(gomock.Any(), uuid.Nil, group, int64(4), 10).Return(resp, nil)
+	srv.EXPECT().GetGroupAuditLog(gomock.Any(), uuid.Nil, group, int64(0), 0).Return(nil, service.ErrForbidden)
+	s := TestServer(t, srv)
+
+	w := httptest.NewRecorder()
+	s.GroupAuditLog(w, reqWithGroup(t, httptest.NewRequest(http.MethodGet, "/?after=4&limit=10", nil), group.String()))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"count":0,"entries":[]}`, w.Body.String())
+
+	w = httptest.NewRecorder()
+	s.GroupAuditLog(w, reqWithGroup(t, httptest.NewRequest(http.MethodGet, "/", nil), group.String()))
+	assert.Equal(t, http.StatusForbidden, w.Code)
+
+	for _, tc := range []struct {
+		query, group string
+	}{
+		{"after=x", group.String()},
+		{"limit=x", group.String()},
+		{"", "bad_id"},
+	} {
+		w = httptest.NewRecorder()
+		s.GroupAuditLog(w, reqWithGroup(t, httptest.NewRequest(http.MethodGet, "/?"+tc.query, nil), tc.group))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	}
+}
+
+func TestServer_GroupRoles(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	srv := mocks.NewMockInterface(ctrl)
+
+	group, stranger := uuid.New(), uuid.New()
+	resp := &model.GetRolesResponse{Count: 1, Roles: []*model.Role{{ID: 1, Group: group, Name: "reviewer", Permissions: model.PermReviewTasks}}}
+	srv.EXPECT().GetGroupRoles(gomock.Any(), uuid.Nil, group).Return(resp, nil)
+	srv.EXPECT().GetGroupRoles(gomock.Any(), uuid.Nil, stranger).Return(nil, service.ErrForbidden)
+	s := TestServer(t, srv)
+
+	w := httptest.NewRecorder()
+	s.GroupRoles(w, reqWithGroup(t, httptest.NewRequest(http.MethodGet, "/", nil), group.String()))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"count":1,"roles":[{"id":1,"group":"`+group.String()+`","name":"reviewer","permissions":["tasks.review"]}]}`, w.Body.String())
+
+	for _, tc := range []struct {
+		group string
+		want  int
+	}{
+		{stranger.String(), http.StatusForbidden},
+		{"bad_id", http.StatusBadRequest},
+	} {
+		w = httptest.NewRecorder()
+		s.GroupRoles(w, reqWithGroup(t, httptest.NewRequest(http.MethodGet, "/", nil), tc.group))
+		assert.Equal(t, tc.want, w.Code)
+	}
+}
+
+func TestServer_CreateGroupRole(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	srv := mocks.NewMockInterface(ctrl)
+
+	group, other := uuid.New(), uuid.New()
+	req := model.CreateRoleRequest{Name: "reviewer", Permissions: model.PermReviewTasks | model.PermViewReviews}
+	role := &model.Role{ID: 2, Group: group, Name: req.Name, Permissions: req.Permissions}
+	srv.EXPECT().CreateGroupRole(gomock.Any(), uuid.Nil, group, req).Return(role, nil)
+	srv.EXPECT().CreateGroupRole(gomock.Any(), uuid.Nil, other, req).Return(nil, service.ErrRoleAlreadyExists)
+	s := TestServer(t, srv)
+
+	body := `{"name":"reviewer","permissions":["tasks.review","reviews.view"]}`
+	w := httptest.NewRecorder()
+	s.CreateGroupRole(w, reqWithGroup(t, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body)), group.String()))
+	assert.Equal(t, http.StatusCreated, w.Code)
+	data, err := json.Marshal(role)
+	require.NoError(t, err)
+	assert.JSONEq(t, string(data), w.Body.String())
+
+	for _, tc := range []struct {
+		body, group string
+		want        int
+	}{
+		{body, other.String(), http.StatusConflict},
+		{`{"name":"reviewer","permissions":["tasks.fly"]}`, group.String(), http.StatusBadRequest},
+		{`{`, group.String(), http.StatusBadRequest},
+		{body, "bad_id", http.StatusBadRequest},
+	} {
+		w = httptest.NewRecorder()
+		s.CreateGroupRole(w, reqWithGroup(t, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(tc.body)), tc.group))
+		assert.Equal(t, tc.want, w.Code)
+	}
+}
+
+func TestServer_DeleteGroupRole(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	srv := mocks.NewMockInterface(ctrl)
+
+	group := uuid.New()
+	srv.EXPECT().DeleteGroupRole(gomock.Any(), uuid.Nil, group, int32(2)).Return(nil)
+	srv.EXPECT().DeleteGroupRole(gomock.Any(), uuid.Nil, group, int32(3)).Return(service.ErrRoleInUse)
+	s := TestServer(t, srv)
+
+	for _, tc := range []struct {
+		group, role string
+		want        int
+	}{
+		{group.String(), "2", http.StatusOK},
+		{group.String(), "3", http.StatusConflict},
+		{group.String(), "bad_id", http.StatusBadRequest},
+		{"bad_id", "2", http.StatusBadRequest},
+	} {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodDelete, "/", nil)
+		s.DeleteGroupRole(w, reqWithGroupAnd(t, r, tc.group, roleIDParamName, tc.role))
+		assert.Equal(t, tc.want, w.Code)
+	}
+}
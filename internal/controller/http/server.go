@@ -9,8 +9,10 @@ import (
 	httpSwagger "github.com/swaggo/http-swagger"
 	"github.com/vlad-marlo/godo/internal/pkg/fielderr"
 	"go.uber.org/zap/zapcore"
+	"io"
 	"net"
 	"net/http"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
@@ -25,6 +27,7 @@ import (
 
 var (
 	ErrNilPointer = errors.New("nil pointer reference")
+	ErrBadQuery   = errors.New("bad query")
 )
 
 type Service interface {
@@ -39,17 +42,146 @@ type Service interface {
 	GetUserFromToken(ctx context.Context, t string) (uuid.UUID, error)
 	// CreateGroup create new group.
 	CreateGroup(ctx context.Context, user uuid.UUID, name, description string) (*model.CreateGroupResponse, error)
+	// GetGroups return groups in which user is member.
+	GetGroups(ctx context.Context, user uuid.UUID) (*model.GetGroupsResponse, error)
+	// GetGroup return profile of group.
+	GetGroup(ctx context.Context, user, group uuid.UUID) (*model.GroupProfile, error)
+	// UpdateGroup changes profile of group.
+	UpdateGroup(ctx context.Context, user, group uuid.UUID, req model.UpdateGroupRequest) (*model.GroupProfile, error)
+	// GetGroupMembers return members of group.
+	GetGroupMembers(ctx context.Context, user, group uuid.UUID) (*model.GetMembersResponse, error)
+	// RemoveGroupMember removes member from group.
+	RemoveGroupMember(ctx context.Context, user, group, member uuid.UUID) error
+	// LeaveGroup removes user from group.
+	LeaveGroup(ctx context.Context, user, group uuid.UUID) error
+	// UpdateGroupMember changes role and admin flag of member.
+	UpdateGroupMember(ctx context.Context, user, group, member uuid.UUID, req model.UpdateMemberRequest) (*model.UserInGroup, error)
+	// GetGroupAuditLog return audit log of group.
+	GetGroupAuditLog(ctx context.Context, user, group uuid.UUID, after int64, limit int) (*model.GetAuditLogResponse, error)
+	// GetGroupRoles return roles of group.
+	GetGroupRoles(ctx context.Context, user, group uuid.UUID) (*model.GetRolesResponse, error)
+	// CreateGroupRole creates named role of group.
+	CreateGroupRole(ctx context.Context, user, group uuid.UUID, req model.CreateRoleRequest) (*model.Role, error)
+	// DeleteGroupRole deletes role of group.
+	DeleteGroupRole(ctx context.Context, user, group uuid.UUID, role int32) error
 	// CreateInvite creates invite link.
-	CreateInvite(ctx context.Context, user, group uuid.UUID, role *model.Role, limit int) (*model.CreateInviteResponse, error)
+	CreateInvite(ctx context.Context, user, group uuid.UUID, role int32, limit int) (*model.CreateInviteResponse, error)
 	// UseInvite add user to group if invite is ok.
 	UseInvite(ctx context.Context, user, group, invite uuid.UUID) error
 	// GetMe return user's info
 	GetMe(ctx context.Context, user uuid.UUID) (*model.GetMeResponse, error)
-	// GetUserTasks return all tasks that are related to user.
-	GetUserTasks(ctx context.Context, user uuid.UUID) (*model.GetTasksResponse, error)
+	// GetUserTasks return all tasks that are related to user and satisfy filter.
+	GetUserTasks(ctx context.Context, user uuid.UUID, filter model.TaskFilter) (*model.GetTasksResponse, error)
 	// GetTask return task if user related to task and task exists.
 	GetTask(ctx context.Context, user, task uuid.UUID) (*model.Task, error)
-	CreateTask(ctx context.Context, user uuid.UUID, task model.TaskCreateRequest) (*model.Task, error)
+	// CreateTask creates task and relates it to group and assignees.
+	CreateTask(ctx context.Context, user uuid.UUID, task model.TaskCreateRequest) (*model.CreateTaskResponse, error)
+	// UpdateTask changes name, description and deadline of task.
+	UpdateTask(ctx context.Context, user, task uuid.UUID, req model.TaskUpdateRequest) (*model.Task, error)
+	// DeleteTask deletes task.
+	DeleteTask(ctx context.Context, user, task uuid.UUID) error
+	// BulkTasks applies one operation to many tasks.
+	BulkTasks(ctx context.Context, user uuid.UUID, req model.BulkTaskRequest) (*model.BulkTaskResponse, error)
+	// GetTaskWatchers return users who watch task.
+	GetTaskWatchers(ctx context.Context, user, task uuid.UUID) (*model.GetWatchersResponse, error)
+	// WatchTask subscribes user to changes of task.
+	WatchTask(ctx context.Context, user, task uuid.UUID) error
+	// UnwatchTask unsubscribes user from changes of task.
+	UnwatchTask(ctx context.Context, user, task uuid.UUID) error
+	// GetNotifications return changes of watched tasks which were made by other users.
+	GetNotifications(ctx context.Context, user uuid.UUID, after int64, limit int) (*model.GetNotificationsResponse, error)
+	// GetTaskHistory return changes of task in order in which they were made.
+	GetTaskHistory(ctx context.Context, user, task uuid.UUID) (*model.GetTaskHistoryResponse, error)
+	// GetTaskAttachments return attachments of task which are visible to user.
+	GetTaskAttachments(ctx context.Context, user, task uuid.UUID) (*model.GetAttachmentsResponse, error)
+	// UploadAttachment stores file and attaches it to task or to comment of task.
+	UploadAttachment(ctx context.Context, user, task uuid.UUID, req model.UploadAttachmentRequest) (*model.Attachment, error)
+	// GetAttachment return attachment of task with reader of its content.
+	GetAttachment(ctx context.Context, user, task, attachment uuid.UUID) (*model.Attachment, io.ReadCloser, error)
+	// DeleteAttachment deletes attachment of task.
+	DeleteAttachment(ctx context.Context, user, task, attachment uuid.UUID) error
+	// GetChecklist return checklist of task ordered by position.
+	GetChecklist(ctx context.Context, user, task uuid.UUID) (*model.GetChecklistResponse, error)
+	// CreateChecklistItem adds item to the end of task checklist.
+	CreateChecklistItem(ctx context.Context, user, task uuid.UUID, req model.CreateChecklistItemRequest) (*model.ChecklistItem, error)
+	// ToggleChecklistItem marks checklist item as done or not done.
+	ToggleChecklistItem(ctx context.Context, user, task, item uuid.UUID, req model.ToggleChecklistItemRequest) (*model.ChecklistItem, error)
+	// MoveChecklistItem moves item to position in task checklist.
+	MoveChecklistItem(ctx context.Context, user, task, item uuid.UUID, req model.MoveChecklistItemRequest) (*model.GetChecklistResponse, error)
+	// DeleteChecklistItem deletes item from task checklist.
+	DeleteChecklistItem(ctx context.Context, user, task, item uuid.UUID) error
+	// StartTimer starts timer of user on task.
+	StartTimer(ctx context.Context, user, task uuid.UUID, req model.StartTimerRequest) (*model.Worklog, error)
+	// StopTimer stops running timer of user.
+	StopTimer(ctx context.Context, user uuid.UUID) (*model.Worklog, error)
+	// GetRunningTimer return running timer of user.
+	GetRunningTimer(ctx context.Context, user uuid.UUID) (*model.Worklog, error)
+	// CreateWorklog logs time which user spent on task manually.
+	CreateWorklog(ctx context.Context, user, task uuid.UUID, req model.CreateWorklogRequest) (*model.Worklog, error)
+	// GetTaskWorklogs return time which was logged on task.
+	GetTaskWorklogs(ctx context.Context, user, task uuid.UUID) (*model.GetWorklogsResponse, error)
+	// DeleteWorklog deletes worklog of task.
+	DeleteWorklog(ctx context.Context, user, task, worklog uuid.UUID) error
+	// GetGroupStats return statistics of group tasks over period.
+	GetGroupStats(ctx context.Context, user, group uuid.UUID, from, to time.Time) (*model.GroupStats, error)
+	// GetWorklogReport return logged time over period aggregated by user, task or group.
+	GetWorklogReport(ctx context.Context, user uuid.UUID, filter model.WorklogReportFilter) (*model.WorklogReport, error)
+	// GetSubtasks return subtasks of task which are visible to user.
+	GetSubtasks(ctx context.Context, user, task uuid.UUID) (*model.GetTasksResponse, error)
+	// AddDependency links task with task which blocks it.
+	AddDependency(ctx context.Context, user, task uuid.UUID, req model.AddDependencyRequest) (*model.TaskDependency, error)
+	// RemoveDependency deletes link between task and its blocker.
+	RemoveDependency(ctx context.Context, user, task, blocker uuid.UUID) error
+	// GetDependencyGraph return tasks of group and links between them.
+	GetDependencyGraph(ctx context.Context, user, group uuid.UUID) (*model.DependencyGraph, error)
+	// GetGroupLabels return labels of group.
+	GetGroupLabels(ctx context.Context, user, group uuid.UUID) (*model.GetLabelsResponse, error)
+	// CreateLabel creates label in group.
+	CreateLabel(ctx context.Context, user, group uuid.UUID, req model.CreateLabelRequest) (*model.Label, error)
+	// UpdateLabel changes name and color of label.
+	UpdateLabel(ctx context.Context, user, group, label uuid.UUID, req model.UpdateLabelRequest) (*model.Label, error)
+	// DeleteLabel deletes label of group.
+	DeleteLabel(ctx context.Context, user, group, label uuid.UUID) error
+	// AttachLabel attaches label to task.
+	AttachLabel(ctx context.Context, user, task, label uuid.UUID) error
+	// DetachLabel detaches label from task.
+	DetachLabel(ctx context.Context, user, task, label uuid.UUID) error
+	// GetBoard return tasks of group bucketed by status in manual order.
+	GetBoard(ctx context.Context, user, group uuid.UUID) (*model.Board, error)
+	// MoveTask changes status of task and its position on board of group.
+	MoveTask(ctx context.Context, user, group, task uuid.UUID, req model.MoveTaskRequest) (*model.Board, error)
+	// SetBoardLimits changes work-in-progress limits of board columns of group.
+	SetBoardLimits(ctx context.Context, user, group uuid.UUID, req model.BoardLimitsRequest) (*model.Board, error)
+	// GetGroupTemplates return task templates of group.
+	GetGroupTemplates(ctx context.Context, user, group uuid.UUID) (*model.GetTemplatesResponse, error)
+	// CreateTemplate creates task template in group.
+	CreateTemplate(ctx context.Context, user, group uuid.UUID, req model.CreateTemplateRequest) (*model.TaskTemplate, error)
+	// GetTemplate return task template of group.
+	GetTemplate(ctx context.Context, user, group, template uuid.UUID) (*model.TaskTemplate, error)
+	// UpdateTemplate changes task template of group.
+	UpdateTemplate(ctx context.Context, user, group, template uuid.UUID, req model.UpdateTemplateRequest) (*model.TaskTemplate, error)
+	// DeleteTemplate deletes task template of group.
+	DeleteTemplate(ctx context.Context, user, group, template uuid.UUID) error
+	// UpdateTaskStatus moves task into provided status if user has permission to do it.
+	UpdateTaskStatus(ctx context.Context, user, task uuid.UUID, status model.TaskStatus) (*model.Task, error)
+	// SubmitReview sends task to review.
+	SubmitReview(ctx context.Context, user, task uuid.UUID, msg string) (*model.Review, error)
+	// GetPendingReviews return reviews of group tasks that are waiting for resolution.
+	GetPendingReviews(ctx context.Context, user, group uuid.UUID) (*model.GetReviewsResponse, error)
+	// ResolveReview resolves review as changes requested or accepted.
+	ResolveReview(ctx context.Context, user, review uuid.UUID, req model.ResolveReviewRequest) (*model.Review, error)
+	// GetTaskComments return comments of task which are available to user.
+	GetTaskComments(ctx context.Context, user, task uuid.UUID) (*model.GetCommentsResponse, error)
+	// CreateComment creates comment to task.
+	CreateComment(ctx context.Context, user, task uuid.UUID, req model.CreateCommentRequest) (*model.Comment, error)
+	// UpdateComment changes message of comment.
+	UpdateComment(ctx context.Context, user, task, comment uuid.UUID, req model.UpdateCommentRequest) (*model.Comment, error)
+	// DeleteComment deletes comment.
+	DeleteComment(ctx context.Context, user, task, comment uuid.UUID) error
+	// GetCommentHistory return previous versions of comment.
+	GetCommentHistory(ctx context.Context, user, task, comment uuid.UUID) (*model.GetCommentHistoryResponse, error)
+	// Search return tasks visible to user which name, description or comments match query.
+	Search(ctx context.Context, user uuid.UUID, query string, limit int) (*model.SearchResponse, error)
 }
 
 // Server ...
@@ -176,13 +308,103 @@ func (s *Server) configureRoutes() {
 		})
 		r.With(authChecker).Group(func(r chi.Router) {
 			r.Route("/groups", func(r chi.Router) {
+				r.Get("/", s.Groups)
 				r.Post("/", s.CreateGroup)
+				r.Get("/{group_id}", s.GetGroup)
+				r.Patch("/{group_id}", s.UpdateGroup)
 				r.Post("/{group_id}/invite", s.CreateInviteViaGroup)
 				r.Get("/{group_id}/apply", s.UseInvite)
+				r.Route("/{group_id}/members", func(r chi.Router) {
+					r.Get("/", s.GroupMembers)
+					r.Delete("/me", s.LeaveGroup)
+					r.Patch("/{user_id}", s.UpdateGroupMember)
+					r.Delete("/{user_id}", s.RemoveGroupMember)
+				})
+				r.Get("/{group_id}/audit", s.GroupAuditLog)
+				r.Route("/{group_id}/roles", func(r chi.Router) {
+					r.Get("/", s.GroupRoles)
+					r.Post("/", s.CreateGroupRole)
+					r.Delete("/{role_id}", s.DeleteGroupRole)
+				})
+				r.Get("/{group_id}/reviews", s.GroupReviews)
+				r.Get("/{group_id}/stats", s.GroupStats)
+				r.Get("/{group_id}/dependencies", s.DependencyGraph)
+				r.Route("/{group_id}/labels", func(r chi.Router) {
+					r.Get("/", s.GroupLabels)
+					r.Post("/", s.CreateLabel)
+					r.Patch("/{label_id}", s.UpdateLabel)
+					r.Delete("/{label_id}", s.DeleteLabel)
+				})
+				r.Route("/{group_id}/board", func(r chi.Router) {
+					r.Get("/", s.Board)
+					r.Put("/limits", s.SetBoardLimits)
+					r.Put("/tasks/{task_id}", s.MoveTask)
+				})
+				r.Route("/{group_id}/templates", func(r chi.Router) {
+					r.Get("/", s.GroupTemplates)
+					r.Post("/", s.CreateTemplate)
+					r.Get("/{template_id}", s.GetTemplate)
+					r.Patch("/{template_id}", s.UpdateTemplate)
+					r.Delete("/{template_id}", s.DeleteTemplate)
+				})
+			})
+			r.Route("/worklogs", func(r chi.Router) {
+				r.Get("/timer", s.RunningTimer)
+				r.Post("/timer/stop", s.StopTimer)
+				r.Get("/report", s.WorklogReport)
 			})
 			r.Route("/tasks", func(r chi.Router) {
 				r.Get("/", s.AllTasks)
+				r.Post("/", s.CreateTask)
+				r.Post("/bulk", s.BulkTasks)
 				r.Get("/{task_id}", s.GetTask)
+				r.Put("/{task_id}", s.UpdateTask)
+				r.Patch("/{task_id}", s.UpdateTask)
+				r.Delete("/{task_id}", s.DeleteTask)
+				r.Get("/{task_id}/subtasks", s.Subtasks)
+				r.Get("/{task_id}/history", s.TaskHistory)
+				r.Route("/{task_id}/watchers", func(r chi.Router) {
+					r.Get("/", s.TaskWatchers)
+					r.Put("/me", s.WatchTask)
+					r.Delete("/me", s.UnwatchTask)
+				})
+				r.Route("/{task_id}/attachments", func(r chi.Router) {
+					r.Get("/", s.TaskAttachments)
+					r.Post("/", s.UploadAttachment)
+					r.Get("/{attachment_id}", s.DownloadAttachment)
+					r.Delete("/{attachment_id}", s.DeleteAttachment)
+				})
+				r.Route("/{task_id}/checklist", func(r chi.Router) {
+					r.Get("/", s.Checklist)
+					r.Post("/", s.CreateChecklistItem)
+					r.Patch("/{item_id}", s.ToggleChecklistItem)
+					r.Put("/{item_id}/position", s.MoveChecklistItem)
+					r.Delete("/{item_id}", s.DeleteChecklistItem)
+				})
+				r.Post("/{task_id}/timer", s.StartTimer)
+				r.Route("/{task_id}/worklogs", func(r chi.Router) {
+					r.Get("/", s.TaskWorklogs)
+					r.Post("/", s.CreateWorklog)
+					r.Delete("/{worklog_id}", s.DeleteWorklog)
+				})
+				r.Post("/{task_id}/dependencies", s.AddDependency)
+				r.Delete("/{task_id}/dependencies/{blocker_id}", s.RemoveDependency)
+				r.Put("/{task_id}/labels/{label_id}", s.AttachLabel)
+				r.Delete("/{task_id}/labels/{label_id}", s.DetachLabel)
+				r.Patch("/{task_id}/status", s.UpdateTaskStatus)
+				r.Post("/{task_id}/reviews", s.SubmitReview)
+				r.Route("/{task_id}/comments", func(r chi.Router) {
+					r.Get("/", s.TaskComments)
+					r.Post("/", s.CreateComment)
+					r.Patch("/{comment_id}", s.UpdateComment)
+					r.Delete("/{comment_id}", s.DeleteComment)
+					r.Get("/{comment_id}/history", s.CommentHistory)
+				})
+			})
+			r.Get("/search", s.Search)
+			r.Get("/notifications", s.Notifications)
+			r.Route("/reviews", func(r chi.Router) {
+				r.Patch("/{review_id}", s.ResolveReview)
 			})
 			r.Route("/invites", func(r chi.Router) {
 				r.Post("/", s.CreateInviteLink)
@@ -63,3 +63,71 @@ func reqWithGroup(t testing.TB, r *http.Request, val string) *http.Request {
 func reqWithTask(t testing.TB, r *http.Request, val string) *http.Request {
 	return reqWithData(t, r, "task_id", val)
 }
+
+// reqWithComment is helper func to add task_id and comment_id fields to chi url params.
+func reqWithComment(t testing.TB, r *http.Request, task, comment string) *http.Request {
+	t.Helper()
+	rCtx := chi.NewRouteContext()
+	rCtx.URLParams.Add(taskIDParamName, task)
+	rCtx.URLParams.Add(commentIDParamName, comment)
+	return r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rCtx))
+}
+
+// reqWithDependency is helper func to add task_id and blocker_id fields to chi url params.
+func reqWithDependency(t testing.TB, r *http.Request, task, blocker string) *http.Request {
+	t.Helper()
+	rCtx := chi.NewRouteContext()
+	rCtx.URLParams.Add(taskIDParamName, task)
+	rCtx.URLParams.Add(blockerIDParamName, blocker)
+	return r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rCtx))
+}
+
+// reqWithLabel is helper func to add label_id and id of label owner (task or group) to chi url params.
+func reqWithLabel(t testing.TB, r *http.Request, ownerKey, owner, label string) *http.Request {
+	t.Helper()
+	rCtx := chi.NewRouteContext()
+	rCtx.URLParams.Add(ownerKey, owner)
+	rCtx.URLParams.Add(labelIDParamName, label)
+	return r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rCtx))
+}
+
+// reqWithAttachment is helper func to add task_id and attachment_id fields to chi url params.
+func reqWithAttachment(t testing.TB, r *http.Request, task, attachment string) *http.Request {
+	t.Helper()
+	rCtx := chi.NewRouteContext()
+	rCtx.URLParams.Add(taskIDParamName, task)
+	rCtx.URLParams.Add(attachmentIDParamName, attachment)
+	return r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rCtx))
+}
+
+// reqWithChecklistItem is helper func to add task_id and item_id fields to chi url params.
+func reqWithChecklistItem(t testing.TB, r *http.Request, task, item string) *http.Request {
+	t.Helper()
+	rCtx := chi.NewRouteContext()
+	rCtx.URLParams.Add(taskIDParamName, task)
+	rCtx.URLParams.Add(checklistItemIDParamName, item)
+	return r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rCtx))
+}
+
+// reqWithWorklog is helper func to add task_id and worklog_id fields to chi url params.
+func reqWithWorklog(t testing.TB, r *http.Request, task, worklog string) *http.Request {
+	t.Helper()
+	rCtx := chi.NewRouteContext()
+	rCtx.URLParams.Add(taskIDParamName, task)
+	rCtx.URLParams.Add(worklogIDParamName, worklog)
+	return r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rCtx))
+}
+
+// reqWithGroupTask is helper func to add group_id and task_id fields to chi url params.
+func reqWithGroupTask(t testing.TB, r *http.Request, group, task string) *http.Request {
+	return reqWithGroupAnd(t, r, group, taskIDParamName, task)
+}
+
+// reqWithGroupAnd is helper func to add group_id and one more field of group entity to chi url params.
+func reqWithGroupAnd(t testing.TB, r *http.Request, group, key, val string) *http.Request {
+	t.Helper()
+	rCtx := chi.NewRouteContext()
+	rCtx.URLParams.Add(groupIDParamName, group)
+	rCtx.URLParams.Add(key, val)
+	return r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rCtx))
+}
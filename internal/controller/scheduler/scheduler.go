@@ -0,0 +1,119 @@
+// Package scheduler runs periodic jobs of service in background.
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/vlad-marlo/godo/internal/config"
+	"go.uber.org/zap"
+)
+
+var ErrNilPointer = errors.New("nil pointer reference")
+
+// Service is jobs which are run by scheduler.
+type Service interface {
+	// RunRecurringTasks creates tasks from recurring templates which runs are due at now.
+	RunRecurringTasks(ctx context.Context, now time.Time) (int, error)
+	// PurgeOrphanedBlobs deletes contents of deleted attachments.
+	PurgeOrphanedBlobs(ctx context.Context) (int, error)
+}
+
+// Scheduler periodically creates recurring tasks and deletes contents of deleted attachments.
+type Scheduler struct {
+	srv      Service
+	log      *zap.Logger
+	interval time.Duration
+	now      func() time.Time
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// New return scheduler which runs jobs with interval from config.
+func New(srv Service, cfg *config.Config, log *zap.Logger) *Scheduler {
+	return &Scheduler{
+		srv:      srv,
+		log:      log,
+		interval: cfg.Server.SchedulerInterval,
+		now:      time.Now,
+	}
+}
+
+// Start starts background runs. First run is done immediately to catch up runs which were missed while server was down.
+func (s *Scheduler) Start(context.Context) error {
+	if s == nil {
+		return ErrNilPointer
+	}
+	if s.interval <= 0 {
+		return errors.New("scheduler interval must be positive")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+	s.log.Info("starting scheduler", zap.Duration("interval", s.interval))
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+		for {
+			s.run(ctx)
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	return nil
+}
+
+// Stop stops background runs and waits until current run is finished or ctx is done.
+func (s *Scheduler) Stop(ctx context.Context) error {
+	if s == nil {
+		return ErrNilPointer
+	}
+	if s.cancel == nil {
+		return nil
+	}
+	s.cancel()
+
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// run runs jobs once. Jobs are independent, so error of one job does not stop others.
+func (s *Scheduler) run(ctx context.Context) {
+	if created, err := s.srv.RunRecurringTasks(ctx, s.now()); err != nil {
+		s.logErr("run recurring tasks", err)
+	} else if created > 0 {
+		s.log.Info("recurring tasks were created", zap.Int("count", created))
+	}
+
+	if purged, err := s.srv.PurgeOrphanedBlobs(ctx); err != nil {
+		s.logErr("purge orphaned blobs", err)
+	} else if purged > 0 {
+		s.log.Info("orphaned blobs were deleted", zap.Int("count", purged))
+	}
+}
+
+// logErr logs error of job if it was not caused by stop of scheduler.
+func (s *Scheduler) logErr(msg string, err error) {
+	if !errors.Is(err, context.Canceled) {
+		s.log.Error(msg, zap.Error(err))
+	}
+}
@@ -0,0 +1,64 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vlad-marlo/godo/internal/config"
+	"github.com/vlad-marlo/godo/internal/service/mocks"
+	"go.uber.org/zap"
+)
+
+func TestScheduler_NilPointer(t *testing.T) {
+	var s *Scheduler
+	assert.ErrorIs(t, s.Start(context.Background()), ErrNilPointer)
+	assert.ErrorIs(t, s.Stop(context.Background()), ErrNilPointer)
+}
+
+func TestScheduler_BadInterval(t *testing.T) {
+	s := New(nil, &config.Config{}, zap.L())
+	assert.Error(t, s.Start(context.Background()))
+	assert.NoError(t, s.Stop(context.Background()))
+}
+
+func TestScheduler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	srv := mocks.NewMockInterface(ctrl)
+
+	now := time.Now()
+	runs := make(chan struct{}, 3)
+	var calls int
+	srv.EXPECT().RunRecurringTasks(gomock.Any(), now).DoAndReturn(func(context.Context, time.Time) (int, error) {
+		calls++
+		select {
+		case runs <- struct{}{}:
+		default:
+		}
+		// errors of runs must not stop scheduler.
+		if calls%2 == 0 {
+			return 0, errors.New("")
+		}
+		return 1, nil
+	}).MinTimes(2)
+	srv.EXPECT().PurgeOrphanedBlobs(gomock.Any()).Return(0, errors.New("")).MinTimes(2)
+
+	cfg := &config.Config{}
+	cfg.Server.SchedulerInterval = time.Millisecond
+	s := New(srv, cfg, zap.L())
+	s.now = func() time.Time { return now }
+
+	require.NoError(t, s.Start(context.Background()))
+	for i := 0; i < 2; i++ {
+		select {
+		case <-runs:
+		case <-time.After(time.Second):
+			t.Fatal("scheduler did not run jobs")
+		}
+	}
+	require.NoError(t, s.Stop(context.Background()))
+}
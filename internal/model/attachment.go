@@ -0,0 +1,52 @@
+package model
+
+import (
+	"encoding/json"
+	"io"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type (
+	// Attachment is metadata of file attached to task or to comment of task.
+	//
+	// Content of file is kept in blob store with key BlobKey.
+	Attachment struct {
+		ID          uuid.UUID  `json:"id"`
+		Task        uuid.UUID  `json:"task"`
+		Comment     *uuid.UUID `json:"comment,omitempty"`
+		UploadedBy  uuid.UUID  `json:"uploaded-by"`
+		Name        string     `json:"name" example:"report.pdf"`
+		ContentType string     `json:"content-type" example:"application/pdf"`
+		Size        int64      `json:"size" example:"1024"`
+		BlobKey     string     `json:"-"`
+		CreatedAt   time.Time  `json:"-"`
+	}
+	// UploadAttachmentRequest is file which is uploaded as attachment.
+	UploadAttachmentRequest struct {
+		// Comment is optional id of comment of task to which file is attached.
+		Comment     *uuid.UUID
+		Name        string
+		ContentType string
+		Body        io.Reader
+	}
+	// GetAttachmentsResponse ...
+	GetAttachmentsResponse struct {
+		Count       int           `json:"count"`
+		Attachments []*Attachment `json:"attachments"`
+	}
+)
+
+// MarshalJSON implements json.Marshaler. Upload time is passed to user as unix timestamp like in Task.
+func (a *Attachment) MarshalJSON() ([]byte, error) {
+	type alias Attachment
+
+	return json.Marshal(&struct {
+		*alias
+		Created int64 `json:"created-at"`
+	}{
+		alias:   (*alias)(a),
+		Created: a.CreatedAt.Unix(),
+	})
+}
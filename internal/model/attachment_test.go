@@ -0,0 +1,35 @@
+package model
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAttachment_MarshalJSON(t *testing.T) {
+	a := &Attachment{
+		ID:          uuid.New(),
+		Task:        uuid.New(),
+		UploadedBy:  uuid.New(),
+		Name:        "report.pdf",
+		ContentType: "application/pdf",
+		Size:        1024,
+		BlobKey:     "secret",
+		CreatedAt:   time.Unix(1682935200, 0),
+	}
+	data, err := json.Marshal(a)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+	"id": "`+a.ID.String()+`",
+	"task": "`+a.Task.String()+`",
+	"uploaded-by": "`+a.UploadedBy.String()+`",
+	"name": "report.pdf",
+	"content-type": "application/pdf",
+	"size": 1024,
+	"created-at": 1682935200
+}`, string(data))
+}
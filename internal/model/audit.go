@@ -0,0 +1,119 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AuditAction is kind of change of group which is recorded in audit log.
+type AuditAction string
+
+// Actions which are recorded in audit log of group.
+const (
+	// AuditMemberRole is recorded when role or admin flag of member are changed. Values are MemberRole.
+	AuditMemberRole AuditAction = "member-role"
+	// AuditMemberRemoved is recorded when member is removed from group by other user. Old value is MemberRole.
+	AuditMemberRemoved AuditAction = "member-removed"
+	// AuditMemberLeft is recorded when member leaves group. Old value is MemberRole.
+	AuditMemberLeft AuditAction = "member-left"
+)
+
+type (
+	// MemberRole is role of member in group. Name and permissions of role are kept to make audit log readable
+	// after role is deleted.
+	MemberRole struct {
+		IsAdmin     bool       `json:"is-admin"`
+		Role        int32      `json:"role"`
+		Name        string     `json:"role-name"`
+		Permissions Permission `json:"permissions"`
+	}
+	// UpdateMemberRequest is request to change role of member. Fields which are not provided will not be changed.
+	UpdateMemberRequest struct {
+		IsAdmin *bool `json:"is-admin"`
+		// Role is id of group role.
+		Role *int32 `json:"role" example:"1"`
+	}
+	// AuditEntry is record of audit log of group.
+	AuditEntry struct {
+		ID    int64     `json:"id"`
+		Group uuid.UUID `json:"group"`
+		// Actor is user who made change.
+		Actor uuid.UUID `json:"actor"`
+		// Member is user who was affected by change.
+		Member    uuid.UUID       `json:"member"`
+		Action    AuditAction     `json:"action" example:"member-role"`
+		OldValue  json.RawMessage `json:"old-value" swaggertype:"object"`
+		NewValue  json.RawMessage `json:"new-value" swaggertype:"object"`
+		CreatedAt time.Time       `json:"-"`
+	}
+	// GetAuditLogResponse ...
+	GetAuditLogResponse struct {
+		Count   int           `json:"count"`
+		Entries []*AuditEntry `json:"entries"`
+	}
+)
+
+// MemberRole return role of member in group.
+func (m *UserInGroup) MemberRole() MemberRole {
+	return MemberRole{
+		IsAdmin:     m.IsAdmin,
+		Role:        m.Role,
+		Name:        m.RoleName,
+		Permissions: m.Permissions,
+	}
+}
+
+// WithRole return role of member with provided group role.
+func (r MemberRole) WithRole(role *Role) MemberRole {
+	r.Role, r.Name, r.Permissions = role.ID, role.Name, role.Permissions
+	return r
+}
+
+// NewMemberRoleEntry return audit entry about change of member role made by actor now.
+func NewMemberRoleEntry(group, actor, member uuid.UUID, old, new MemberRole) *AuditEntry {
+	// MemberRole has only plain fields, so marshaling could not fail.
+	oldValue, _ := json.Marshal(old)
+	newValue, _ := json.Marshal(new)
+	return &AuditEntry{
+		Group:     group,
+		Actor:     actor,
+		Member:    member,
+		Action:    AuditMemberRole,
+		OldValue:  oldValue,
+		NewValue:  newValue,
+		CreatedAt: time.Now(),
+	}
+}
+
+// NewMemberRemovalEntry return audit entry about removal of member from group made by actor now.
+//
+// If actor is member then entry is about leaving of group. Role of member before removal is set as old value
+// by storage in transaction in which member is removed.
+func NewMemberRemovalEntry(group, actor, member uuid.UUID) *AuditEntry {
+	action := AuditMemberRemoved
+	if actor == member {
+		action = AuditMemberLeft
+	}
+	return &AuditEntry{
+		Group:     group,
+		Actor:     actor,
+		Member:    member,
+		Action:    action,
+		CreatedAt: time.Now(),
+	}
+}
+
+// MarshalJSON implements json.Marshaler. Time of change is passed to user as unix timestamp like in Task.
+func (e *AuditEntry) MarshalJSON() ([]byte, error) {
+	type alias AuditEntry
+
+	return json.Marshal(&struct {
+		*alias
+		Created int64 `json:"created-at"`
+	}{
+		alias:   (*alias)(e),
+		Created: e.CreatedAt.Unix(),
+	})
+}
@@ -0,0 +1,62 @@
+package model
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemberRole_WithRole(t *testing.T) {
+	role := MemberRole{IsAdmin: true, Role: 1, Name: "old", Permissions: PermViewMembers}
+
+	assert.Equal(t,
+		MemberRole{IsAdmin: true, Role: 2, Name: "reviewer", Permissions: PermReviewTasks | PermViewReviews},
+		role.WithRole(&Role{ID: 2, Name: "reviewer", Permissions: PermReviewTasks | PermViewReviews}),
+	)
+}
+
+func TestUserInGroup_MemberRole(t *testing.T) {
+	m := &UserInGroup{IsAdmin: true, Role: 2, RoleName: "reviewer", Permissions: PermReviewTasks}
+	assert.Equal(t, MemberRole{IsAdmin: true, Role: 2, Name: "reviewer", Permissions: PermReviewTasks}, m.MemberRole())
+}
+
+func TestNewMemberRemovalEntry(t *testing.T) {
+	group, actor, member := uuid.New(), uuid.New(), uuid.New()
+
+	e := NewMemberRemovalEntry(group, actor, member)
+	assert.Equal(t, AuditMemberRemoved, e.Action)
+	assert.Equal(t, actor, e.Actor)
+	assert.Equal(t, member, e.Member)
+	assert.Nil(t, e.OldValue)
+
+	assert.Equal(t, AuditMemberLeft, NewMemberRemovalEntry(group, member, member).Action)
+}
+
+func TestAuditEntry_MarshalJSON(t *testing.T) {
+	group, actor, member := uuid.New(), uuid.New(), uuid.New()
+	e := NewMemberRoleEntry(
+		group,
+		actor,
+		member,
+		MemberRole{Role: 1, Name: "read only"},
+		MemberRole{IsAdmin: true, Role: 2, Name: "editor", Permissions: PermCreateTasks | PermEditTasks},
+	)
+	e.CreatedAt = time.Unix(1682899200, 0)
+
+	data, err := json.Marshal(e)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"id": 0,
+		"group": "`+group.String()+`",
+		"actor": "`+actor.String()+`",
+		"member": "`+member.String()+`",
+		"action": "member-role",
+		"old-value": {"is-admin": false, "role": 1, "role-name": "read only", "permissions": []},
+		"new-value": {"is-admin": true, "role": 2, "role-name": "editor", "permissions": ["tasks.create", "tasks.edit"]},
+		"created-at": 1682899200
+	}`, string(data))
+}
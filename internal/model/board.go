@@ -0,0 +1,73 @@
+package model
+
+import (
+	"github.com/google/uuid"
+)
+
+// BoardStatuses is statuses of tasks in order in which columns of board are shown.
+var BoardStatuses = []TaskStatus{
+	StatusNew,
+	StatusInProgress,
+	StatusOnReview,
+	StatusChangesRequested,
+	StatusAccepted,
+	StatusClosed,
+}
+
+type (
+	// Board is tasks of group bucketed by status.
+	Board struct {
+		Group   uuid.UUID      `json:"group"`
+		Columns []*BoardColumn `json:"columns"`
+	}
+	// BoardColumn is tasks with one status in manual order.
+	BoardColumn struct {
+		Status TaskStatus `json:"status" example:"NEW"`
+		// Limit is maximum count of tasks in column. It is not provided if column has no limit.
+		Limit *int    `json:"limit,omitempty"`
+		Count int     `json:"count"`
+		Tasks []*Task `json:"tasks"`
+	}
+	// MoveTaskRequest is request to move task into column of board.
+	MoveTaskRequest struct {
+		// Status is status of column into which task is moved.
+		Status TaskStatus `json:"status" example:"IN_PROGRESS"`
+		// Position is zero-based index of task in column. Task is placed at the end if position is out of column.
+		Position int `json:"position"`
+	}
+	// BoardLimitsRequest is request to change work-in-progress limits of board columns.
+	//
+	// Limit of column is removed if it is null or zero.
+	BoardLimitsRequest struct {
+		Limits map[TaskStatus]*int `json:"limits"`
+	}
+)
+
+// NewBoard return board of group with tasks bucketed by status.
+//
+// Order of tasks is kept inside of each column. Tasks with unknown statuses are skipped.
+func NewBoard(group uuid.UUID, tasks []*Task, limits map[TaskStatus]int) *Board {
+	b := &Board{
+		Group:   group,
+		Columns: make([]*BoardColumn, 0, len(BoardStatuses)),
+	}
+	columns := make(map[TaskStatus]*BoardColumn, len(BoardStatuses))
+	for _, status := range BoardStatuses {
+		c := &BoardColumn{
+			Status: status,
+			Tasks:  []*Task{},
+		}
+		if limit, ok := limits[status]; ok {
+			c.Limit = &limit
+		}
+		columns[status] = c
+		b.Columns = append(b.Columns, c)
+	}
+	for _, t := range tasks {
+		if c, ok := columns[t.Status]; ok {
+			c.Tasks = append(c.Tasks, t)
+			c.Count++
+		}
+	}
+	return b
+}
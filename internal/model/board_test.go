@@ -0,0 +1,33 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBoard(t *testing.T) {
+	group := uuid.New()
+	a := &Task{ID: uuid.New(), Status: StatusInProgress}
+	b := &Task{ID: uuid.New(), Status: StatusNew}
+	c := &Task{ID: uuid.New(), Status: StatusInProgress}
+	unknown := &Task{ID: uuid.New(), Status: "UNKNOWN"}
+
+	board := NewBoard(group, []*Task{a, b, c, unknown}, map[TaskStatus]int{StatusInProgress: 3})
+	assert.Equal(t, group, board.Group)
+	require.Len(t, board.Columns, len(BoardStatuses))
+	for i, status := range BoardStatuses {
+		assert.Equal(t, status, board.Columns[i].Status)
+	}
+
+	assert.Equal(t, []*Task{b}, board.Columns[0].Tasks)
+	assert.Nil(t, board.Columns[0].Limit)
+	assert.Equal(t, []*Task{a, c}, board.Columns[1].Tasks)
+	assert.Equal(t, 2, board.Columns[1].Count)
+	require.NotNil(t, board.Columns[1].Limit)
+	assert.Equal(t, 3, *board.Columns[1].Limit)
+	assert.Empty(t, board.Columns[2].Tasks)
+	assert.NotNil(t, board.Columns[2].Tasks)
+}
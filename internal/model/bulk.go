@@ -0,0 +1,79 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// BulkOperation is change which is applied to every task of bulk request.
+type BulkOperation string
+
+const (
+	BulkSetStatus   BulkOperation = "set-status"
+	BulkAssign      BulkOperation = "assign"
+	BulkUnassign    BulkOperation = "unassign"
+	BulkAddLabel    BulkOperation = "add-label"
+	BulkSetDeadline BulkOperation = "set-deadline"
+	BulkDelete      BulkOperation = "delete"
+)
+
+// Valid return true if operation is known.
+func (o BulkOperation) Valid() bool {
+	switch o {
+	case BulkSetStatus, BulkAssign, BulkUnassign, BulkAddLabel, BulkSetDeadline, BulkDelete:
+		return true
+	}
+	return false
+}
+
+type (
+	// BulkTaskRequest is request to apply one operation to many tasks.
+	BulkTaskRequest struct {
+		// Tasks is ids of tasks to which operation is applied.
+		Tasks     []uuid.UUID   `json:"tasks"`
+		Operation BulkOperation `json:"operation" example:"set-status"`
+		// Status is new status of tasks. Required by set-status.
+		Status TaskStatus `json:"status,omitempty" example:"CLOSED"`
+		// User is user who is assigned to or unassigned from tasks. Required by assign and unassign.
+		User *uuid.UUID `json:"user,omitempty"`
+		// Label is label which is attached to tasks. Required by add-label.
+		Label *uuid.UUID `json:"label,omitempty"`
+		// Deadline is new deadline of tasks in RFC 3339 format with timezone. Deadline is removed by set-deadline if it is not provided.
+		Deadline *time.Time `json:"deadline,omitempty" example:"2023-05-01T18:00:00+03:00"`
+	}
+	// BulkTaskResult is result of operation on one task.
+	BulkTaskResult struct {
+		Task uuid.UUID `json:"task"`
+		OK   bool      `json:"ok"`
+		// Error is message of error due to which operation was not applied to task.
+		Error string `json:"error,omitempty" example:"user has no permission"`
+		// Reason is field errors due to which operation was not applied to task.
+		Reason any `json:"reason,omitempty"`
+	}
+	// BulkTaskResponse ...
+	BulkTaskResponse struct {
+		// Applied is count of tasks to which operation was applied.
+		Applied int `json:"applied"`
+		// Failed is count of tasks to which operation was not applied.
+		Failed int `json:"failed"`
+		// Results is results of operation in order in which tasks were provided.
+		Results []*BulkTaskResult `json:"results"`
+	}
+)
+
+// NewBulkTaskResponse return response with counts of applied and failed results.
+func NewBulkTaskResponse(results []*BulkTaskResult) *BulkTaskResponse {
+	resp := &BulkTaskResponse{Results: results}
+	for _, r := range results {
+		if r.OK {
+			resp.Applied++
+		} else {
+			resp.Failed++
+		}
+	}
+	if resp.Results == nil {
+		resp.Results = []*BulkTaskResult{}
+	}
+	return resp
+}
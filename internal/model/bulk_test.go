@@ -0,0 +1,26 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBulkOperation_Valid(t *testing.T) {
+	assert.True(t, BulkSetStatus.Valid())
+	assert.True(t, BulkDelete.Valid())
+	assert.False(t, BulkOperation("rename").Valid())
+}
+
+func TestNewBulkTaskResponse(t *testing.T) {
+	resp := NewBulkTaskResponse(nil)
+	assert.NotNil(t, resp.Results)
+	assert.Zero(t, resp.Applied)
+
+	results := []*BulkTaskResult{{Task: uuid.New(), OK: true}, {Task: uuid.New(), Error: "not found"}, {Task: uuid.New(), OK: true}}
+	resp = NewBulkTaskResponse(results)
+	assert.Equal(t, 2, resp.Applied)
+	assert.Equal(t, 1, resp.Failed)
+	assert.Equal(t, results, resp.Results)
+}
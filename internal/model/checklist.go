@@ -0,0 +1,75 @@
+package model
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+type (
+	// ChecklistItem is step of task which could be marked as done.
+	ChecklistItem struct {
+		ID   uuid.UUID `json:"id"`
+		Task uuid.UUID `json:"task"`
+		Text string    `json:"text" example:"write tests"`
+		Done bool      `json:"done"`
+		// Position is index of item in checklist of task starting from zero.
+		Position int `json:"position"`
+	}
+	// ChecklistSummary is count of done checklist items of task.
+	ChecklistSummary struct {
+		Done  int `json:"done"`
+		Total int `json:"total"`
+		// Summary is human-readable summary like "3/5 done".
+		Summary string `json:"summary" example:"3/5 done"`
+	}
+	// CreateChecklistItemRequest is request to add item to the end of checklist.
+	CreateChecklistItemRequest struct {
+		Text string `json:"text" example:"write tests"`
+	}
+	// ToggleChecklistItemRequest is request to mark checklist item as done or not done.
+	ToggleChecklistItemRequest struct {
+		Done bool `json:"done"`
+	}
+	// MoveChecklistItemRequest is request to move checklist item to position.
+	MoveChecklistItemRequest struct {
+		// Position is new index of item in checklist. Positions beyond the end move item to the end.
+		Position int `json:"position"`
+	}
+	// GetChecklistResponse ...
+	GetChecklistResponse struct {
+		Checklist *ChecklistSummary `json:"checklist"`
+		Items     []*ChecklistItem  `json:"items"`
+	}
+)
+
+// NewChecklistSummary return summary of checklist with total items of which done are done.
+//
+// Nil is returned if task has no checklist.
+func NewChecklistSummary(done, total int) *ChecklistSummary {
+	if total <= 0 {
+		return nil
+	}
+	return &ChecklistSummary{
+		Done:    done,
+		Total:   total,
+		Summary: fmt.Sprintf("%d/%d done", done, total),
+	}
+}
+
+// NewGetChecklistResponse return checklist with summary of items.
+func NewGetChecklistResponse(items []*ChecklistItem) *GetChecklistResponse {
+	var done int
+	for _, item := range items {
+		if item.Done {
+			done++
+		}
+	}
+	if items == nil {
+		items = []*ChecklistItem{}
+	}
+	return &GetChecklistResponse{
+		Checklist: NewChecklistSummary(done, len(items)),
+		Items:     items,
+	}
+}
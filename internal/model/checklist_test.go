@@ -0,0 +1,23 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewChecklistSummary(t *testing.T) {
+	assert.Nil(t, NewChecklistSummary(0, 0))
+	assert.Equal(t, &ChecklistSummary{Done: 3, Total: 5, Summary: "3/5 done"}, NewChecklistSummary(3, 5))
+}
+
+func TestNewGetChecklistResponse(t *testing.T) {
+	resp := NewGetChecklistResponse(nil)
+	assert.Nil(t, resp.Checklist)
+	assert.NotNil(t, resp.Items)
+
+	items := []*ChecklistItem{{Done: true}, {}, {Done: true}}
+	resp = NewGetChecklistResponse(items)
+	assert.Equal(t, NewChecklistSummary(2, 3), resp.Checklist)
+	assert.Equal(t, items, resp.Items)
+}
@@ -0,0 +1,98 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type (
+	// Comment is issue or discussion message related to task.
+	Comment struct {
+		// ID is primary key of comment.
+		ID uuid.UUID `json:"id"`
+		// Task is id of task to which comment is related.
+		Task uuid.UUID `json:"task"`
+		// ReplyTo is id of comment to which this comment answers.
+		ReplyTo *uuid.UUID `json:"reply-to,omitempty"`
+		// Message is text of comment.
+		Message string `json:"message"`
+		// CreatedBy is id of comment author.
+		CreatedBy uuid.UUID `json:"created-by"`
+		// CreatedAt is creation time of comment.
+		CreatedAt time.Time `json:"-"`
+		// UpdatedAt is time of last edit of comment.
+		UpdatedAt *time.Time `json:"-"`
+	}
+	// CommentEdit is previous version of comment.
+	CommentEdit struct {
+		// Message is text of comment before edit.
+		Message string `json:"message"`
+		// EditedBy is id of user who edited comment.
+		EditedBy uuid.UUID `json:"edited-by"`
+		// EditedAt is time of edit.
+		EditedAt time.Time `json:"-"`
+	}
+	// CreateCommentRequest is request to create comment.
+	CreateCommentRequest struct {
+		// Message is text of comment.
+		Message string `json:"message" example:"there is a bug"`
+		// ReplyTo is optional id of comment in same task to which new comment answers.
+		ReplyTo *uuid.UUID `json:"reply-to" example:"00000000-0000-0000-0000-000000000000"`
+	}
+	// UpdateCommentRequest is request to edit comment.
+	UpdateCommentRequest struct {
+		// Message is new text of comment.
+		Message string `json:"message" example:"there is no bug"`
+	}
+	// GetCommentsResponse ...
+	GetCommentsResponse struct {
+		Count    int        `json:"count"`
+		Comments []*Comment `json:"comments"`
+	}
+	// GetCommentHistoryResponse ...
+	GetCommentHistoryResponse struct {
+		Count int            `json:"count"`
+		Edits []*CommentEdit `json:"edits"`
+	}
+)
+
+// MarshalJSON implements json.Marshaler.
+func (c *Comment) MarshalJSON() ([]byte, error) {
+	if c == nil {
+		return nil, nil
+	}
+	type CommentAlias Comment
+
+	alias := &struct {
+		*CommentAlias
+		Created int64  `json:"created-at"`
+		Updated *int64 `json:"updated-at,omitempty"`
+	}{
+		CommentAlias: (*CommentAlias)(c),
+		Created:      c.CreatedAt.Unix(),
+	}
+	if c.UpdatedAt != nil {
+		updated := c.UpdatedAt.Unix()
+		alias.Updated = &updated
+	}
+
+	return json.Marshal(alias)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (e *CommentEdit) MarshalJSON() ([]byte, error) {
+	if e == nil {
+		return nil, nil
+	}
+	type CommentEditAlias CommentEdit
+
+	return json.Marshal(&struct {
+		*CommentEditAlias
+		Edited int64 `json:"edited-at"`
+	}{
+		CommentEditAlias: (*CommentEditAlias)(e),
+		Edited:           e.EditedAt.Unix(),
+	})
+}
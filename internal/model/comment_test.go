@@ -0,0 +1,54 @@
+package model
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestComment_MarshalJSON(t *testing.T) {
+	b, err := (*Comment)(nil).MarshalJSON()
+	require.NoError(t, err)
+	assert.Nil(t, b)
+
+	now := time.Now()
+	comment := &Comment{ID: uuid.New(), Task: uuid.New(), Message: "msg", CreatedAt: now}
+	b, err = comment.MarshalJSON()
+	require.NoError(t, err)
+
+	got := make(map[string]any)
+	require.NoError(t, json.Unmarshal(b, &got))
+	assert.Equal(t, float64(now.Unix()), got["created-at"])
+	assert.NotContains(t, got, "updated-at")
+	assert.NotContains(t, got, "reply-to")
+
+	updated := now.Add(time.Hour)
+	comment.UpdatedAt = &updated
+	comment.ReplyTo = &comment.ID
+	b, err = comment.MarshalJSON()
+	require.NoError(t, err)
+
+	got = make(map[string]any)
+	require.NoError(t, json.Unmarshal(b, &got))
+	assert.Equal(t, float64(updated.Unix()), got["updated-at"])
+	assert.Equal(t, comment.ID.String(), got["reply-to"])
+}
+
+func TestCommentEdit_MarshalJSON(t *testing.T) {
+	b, err := (*CommentEdit)(nil).MarshalJSON()
+	require.NoError(t, err)
+	assert.Nil(t, b)
+
+	now := time.Now()
+	b, err = (&CommentEdit{Message: "msg", EditedAt: now}).MarshalJSON()
+	require.NoError(t, err)
+
+	got := make(map[string]any)
+	require.NoError(t, json.Unmarshal(b, &got))
+	assert.Equal(t, float64(now.Unix()), got["edited-at"])
+	assert.Equal(t, "msg", got["message"])
+}
@@ -0,0 +1,55 @@
+package model
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type (
+	// TaskDependency is link between tasks. Work on blocked task could not be started until blocker is done.
+	TaskDependency struct {
+		// Blocker is id of task which must be done first.
+		Blocker uuid.UUID `json:"blocker"`
+		// Blocked is id of task which waits for blocker.
+		Blocked uuid.UUID `json:"blocked"`
+		// CreatedBy is id of user who linked tasks.
+		CreatedBy uuid.UUID `json:"created-by"`
+		// CreatedAt is time when tasks were linked.
+		CreatedAt time.Time `json:"-"`
+	}
+	// AddDependencyRequest is request to link task with its blocker.
+	AddDependencyRequest struct {
+		// BlockedBy is id of task which blocks task from path.
+		BlockedBy uuid.UUID `json:"blocked-by" example:"00000000-0000-0000-0000-000000000000"`
+	}
+	// DependencyNode is task in graph of dependencies.
+	DependencyNode struct {
+		ID     uuid.UUID  `json:"id"`
+		Name   string     `json:"name"`
+		Status TaskStatus `json:"status" example:"NEW"`
+	}
+	// DependencyGraph is tasks of group and links between them.
+	DependencyGraph struct {
+		Nodes []*DependencyNode `json:"nodes"`
+		Edges []*TaskDependency `json:"edges"`
+	}
+)
+
+// DOT return graph in graphviz DOT format. Edges are directed from blocker to blocked task.
+func (g *DependencyGraph) DOT() string {
+	var b strings.Builder
+	b.WriteString("digraph dependencies {\n")
+	if g != nil {
+		for _, n := range g.Nodes {
+			b.WriteString("\t" + strconv.Quote(n.ID.String()) + " [label=" + strconv.Quote(n.Name+"\n"+string(n.Status)) + "];\n")
+		}
+		for _, e := range g.Edges {
+			b.WriteString("\t" + strconv.Quote(e.Blocker.String()) + " -> " + strconv.Quote(e.Blocked.String()) + ";\n")
+		}
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
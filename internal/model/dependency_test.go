@@ -0,0 +1,26 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDependencyGraph_DOT(t *testing.T) {
+	assert.Equal(t, "digraph dependencies {\n}\n", (*DependencyGraph)(nil).DOT())
+
+	a, b := uuid.New(), uuid.New()
+	g := &DependencyGraph{
+		Nodes: []*DependencyNode{
+			{ID: a, Name: `fix "login"`, Status: StatusNew},
+			{ID: b, Name: "релиз", Status: StatusClosed},
+		},
+		Edges: []*TaskDependency{{Blocker: b, Blocked: a}},
+	}
+	assert.Equal(t, "digraph dependencies {\n"+
+		"\t\""+a.String()+"\" [label=\"fix \\\"login\\\"\\nNEW\"];\n"+
+		"\t\""+b.String()+"\" [label=\"релиз\\nCLOSED\"];\n"+
+		"\t\""+b.String()+"\" -> \""+a.String()+"\";\n"+
+		"}\n", g.DOT())
+}
@@ -1,11 +1,20 @@
 package model
 
 import (
+	"regexp"
 	"time"
 
 	"github.com/google/uuid"
 )
 
+// telegramChatRe is format of telegram chat: public username or invite link.
+var telegramChatRe = regexp.MustCompile(`^(@[a-zA-Z][a-zA-Z0-9_]{4,31}|https://t\.me/[a-zA-Z0-9_+/-]+)$`)
+
+// ValidTelegramChat return true if chat is telegram username like @godo_team or link like https://t.me/+AbCd.
+func ValidTelegramChat(chat string) bool {
+	return telegramChatRe.MatchString(chat)
+}
+
 type (
 	// Group is abstract view of group.
 	Group struct {
@@ -14,6 +23,12 @@ type (
 		Description string
 		CreatedAt   time.Time
 		Owner       uuid.UUID
+		// TelegramChat is username or invite link of telegram chat of group.
+		TelegramChat string
+		CompanyName  string
+		CompanyInfo  string
+		// Fields is custom fields of group profile.
+		Fields map[string]string
 	}
 
 	// CreateGroupRequest ...
@@ -43,4 +58,54 @@ type (
 		Description string    `json:"description"`
 		Tasks       []*Task   `json:"tasks,omitempty"`
 	}
+	// GroupProfile is full info about group which is available to admins of group.
+	GroupProfile struct {
+		ID          uuid.UUID `json:"id"`
+		Name        string    `json:"name"`
+		Description string    `json:"description"`
+		// Owner is id of user who created group.
+		Owner uuid.UUID `json:"owner"`
+		// CreatedAt is creation time in UNIX format
+		CreatedAt    int64  `json:"created-at"`
+		TelegramChat string `json:"telegram-chat" example:"@godo_team"`
+		CompanyName  string `json:"company-name" example:"Godo LLC"`
+		CompanyInfo  string `json:"company-info"`
+		// Fields is custom fields of group profile.
+		Fields map[string]string `json:"fields"`
+	}
+	// UpdateGroupRequest is request to change profile of group. Fields which are not provided will not be changed.
+	UpdateGroupRequest struct {
+		Name        *string `json:"name"`
+		Description *string `json:"description"`
+		// TelegramChat is username or invite link of telegram chat. Empty string removes chat from profile.
+		TelegramChat *string `json:"telegram-chat" example:"https://t.me/godo_team"`
+		CompanyName  *string `json:"company-name"`
+		CompanyInfo  *string `json:"company-info"`
+		// Fields is custom fields to set. Field with null value is removed from profile.
+		Fields map[string]*string `json:"fields"`
+	}
+	// GetGroupsResponse ...
+	GetGroupsResponse struct {
+		Count  int            `json:"count"`
+		Groups []*GroupInUser `json:"groups"`
+	}
 )
+
+// Profile return full info about group.
+func (g *Group) Profile() *GroupProfile {
+	fields := g.Fields
+	if fields == nil {
+		fields = map[string]string{}
+	}
+	return &GroupProfile{
+		ID:           g.ID,
+		Name:         g.Name,
+		Description:  g.Description,
+		Owner:        g.Owner,
+		CreatedAt:    g.CreatedAt.Unix(),
+		TelegramChat: g.TelegramChat,
+		CompanyName:  g.CompanyName,
+		CompanyInfo:  g.CompanyInfo,
+		Fields:       fields,
+	}
+}
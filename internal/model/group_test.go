@@ -0,0 +1,35 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidTelegramChat(t *testing.T) {
+	assert.True(t, ValidTelegramChat("@godo_team"))
+	assert.True(t, ValidTelegramChat("https://t.me/godo_team"))
+	assert.True(t, ValidTelegramChat("https://t.me/+AbCd-123"))
+	assert.False(t, ValidTelegramChat("@abc"))
+	assert.False(t, ValidTelegramChat("@1godo_team"))
+	assert.False(t, ValidTelegramChat("godo_team"))
+	assert.False(t, ValidTelegramChat("http://t.me/godo_team"))
+	assert.False(t, ValidTelegramChat(""))
+}
+
+func TestGroup_Profile(t *testing.T) {
+	g := &Group{ID: uuid.New(), Name: "name", Owner: uuid.New(), CreatedAt: time.Unix(1682899200, 0), CompanyName: "company"}
+	assert.Equal(t, &GroupProfile{
+		ID:          g.ID,
+		Name:        "name",
+		Owner:       g.Owner,
+		CreatedAt:   1682899200,
+		CompanyName: "company",
+		Fields:      map[string]string{},
+	}, g.Profile())
+
+	g.Fields = map[string]string{"site": "example.com"}
+	assert.Equal(t, g.Fields, g.Profile().Fields)
+}
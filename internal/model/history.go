@@ -0,0 +1,94 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TaskField is field of task which changes are recorded in history.
+type TaskField string
+
+// Fields of task which changes are recorded in history.
+const (
+	// TaskFieldCreated is recorded once when task is created. New value is name of task.
+	TaskFieldCreated     TaskField = "created"
+	TaskFieldStatus      TaskField = "status"
+	TaskFieldName        TaskField = "name"
+	TaskFieldDescription TaskField = "description"
+	TaskFieldDeadline    TaskField = "deadline"
+	// TaskFieldAssignees is recorded for every added or removed assignee. Value is id of user.
+	TaskFieldAssignees TaskField = "assignees"
+	// TaskFieldLabels is recorded for every attached or detached label. Value is name of label.
+	TaskFieldLabels TaskField = "labels"
+)
+
+type (
+	// TaskChange is record of task history. Old value is not provided if value was added,
+	// new value is not provided if value was removed.
+	TaskChange struct {
+		ID        int64     `json:"id"`
+		Task      uuid.UUID `json:"task"`
+		Actor     uuid.UUID `json:"actor"`
+		Field     TaskField `json:"field" example:"status"`
+		OldValue  *string   `json:"old-value" example:"NEW"`
+		NewValue  *string   `json:"new-value" example:"IN_PROGRESS"`
+		CreatedAt time.Time `json:"-"`
+	}
+	// GetTaskHistoryResponse ...
+	GetTaskHistoryResponse struct {
+		Count   int           `json:"count"`
+		Changes []*TaskChange `json:"changes"`
+	}
+)
+
+// NewTaskChange return change of task field made by actor now.
+func NewTaskChange(task, actor uuid.UUID, field TaskField, oldValue, newValue *string) *TaskChange {
+	return &TaskChange{
+		Task:      task,
+		Actor:     actor,
+		Field:     field,
+		OldValue:  oldValue,
+		NewValue:  newValue,
+		CreatedAt: time.Now(),
+	}
+}
+
+// DiffTask return changes of name, description and deadline between old and new versions of task.
+func DiffTask(actor uuid.UUID, old, new *Task) []*TaskChange {
+	var changes []*TaskChange
+	if old.Name != new.Name {
+		changes = append(changes, NewTaskChange(old.ID, actor, TaskFieldName, &old.Name, &new.Name))
+	}
+	if old.Description != new.Description {
+		changes = append(changes, NewTaskChange(old.ID, actor, TaskFieldDescription, &old.Description, &new.Description))
+	}
+	oldDeadline, newDeadline := formatDeadline(old.Deadline), formatDeadline(new.Deadline)
+	if (oldDeadline == nil) != (newDeadline == nil) || (oldDeadline != nil && *oldDeadline != *newDeadline) {
+		changes = append(changes, NewTaskChange(old.ID, actor, TaskFieldDeadline, oldDeadline, newDeadline))
+	}
+	return changes
+}
+
+// formatDeadline return deadline in RFC 3339 format in UTC.
+func formatDeadline(deadline *time.Time) *string {
+	if deadline == nil {
+		return nil
+	}
+	s := deadline.UTC().Format(time.RFC3339)
+	return &s
+}
+
+// MarshalJSON implements json.Marshaler. Time of change is passed to user as unix timestamp like in Task.
+func (c *TaskChange) MarshalJSON() ([]byte, error) {
+	type alias TaskChange
+
+	return json.Marshal(&struct {
+		*alias
+		Created int64 `json:"created-at"`
+	}{
+		alias:   (*alias)(c),
+		Created: c.CreatedAt.Unix(),
+	})
+}
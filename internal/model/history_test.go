@@ -0,0 +1,62 @@
+package model
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffTask(t *testing.T) {
+	actor := uuid.New()
+	deadline := time.Date(2023, time.May, 1, 18, 0, 0, 0, time.FixedZone("MSK", 3*60*60))
+	sameDeadline := deadline.UTC()
+	old := &Task{ID: uuid.New(), Name: "a", Description: "b", Deadline: &deadline}
+
+	assert.Empty(t, DiffTask(actor, old, &Task{ID: old.ID, Name: "a", Description: "b", Deadline: &sameDeadline}))
+
+	changes := DiffTask(actor, old, &Task{ID: old.ID, Name: "c", Description: "", Deadline: nil})
+	require.Len(t, changes, 3)
+	for _, c := range changes {
+		assert.Equal(t, old.ID, c.Task)
+		assert.Equal(t, actor, c.Actor)
+	}
+	assert.Equal(t, TaskFieldName, changes[0].Field)
+	assert.Equal(t, "a", *changes[0].OldValue)
+	assert.Equal(t, "c", *changes[0].NewValue)
+	assert.Equal(t, TaskFieldDescription, changes[1].Field)
+	assert.Equal(t, "", *changes[1].NewValue)
+	assert.Equal(t, TaskFieldDeadline, changes[2].Field)
+	assert.Equal(t, "2023-05-01T15:00:00Z", *changes[2].OldValue)
+	assert.Nil(t, changes[2].NewValue)
+
+	changes = DiffTask(actor, &Task{}, &Task{Deadline: &deadline})
+	require.Len(t, changes, 1)
+	assert.Nil(t, changes[0].OldValue)
+}
+
+func TestTaskChange_MarshalJSON(t *testing.T) {
+	status := "NEW"
+	c := &TaskChange{
+		ID:        1,
+		Task:      uuid.New(),
+		Actor:     uuid.New(),
+		Field:     TaskFieldStatus,
+		NewValue:  &status,
+		CreatedAt: time.Unix(1682935200, 0),
+	}
+	data, err := json.Marshal(c)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+	"id": 1,
+	"task": "`+c.Task.String()+`",
+	"actor": "`+c.Actor.String()+`",
+	"field": "status",
+	"old-value": null,
+	"new-value": "NEW",
+	"created-at": 1682935200
+}`, string(data))
+}
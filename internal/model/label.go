@@ -0,0 +1,48 @@
+package model
+
+import (
+	"regexp"
+
+	"github.com/google/uuid"
+)
+
+// labelColorRe is format of label color: hex RGB with leading #.
+var labelColorRe = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+// ValidLabelColor return true if color is hex RGB color like #1f883d.
+func ValidLabelColor(color string) bool {
+	return labelColorRe.MatchString(color)
+}
+
+type (
+	// Label is mark of group which could be attached to tasks of group.
+	Label struct {
+		// ID is primary key of label.
+		ID uuid.UUID `json:"id"`
+		// Group is id of group to which label belongs.
+		Group uuid.UUID `json:"group"`
+		// Name is unique in group name of label.
+		Name string `json:"name"`
+		// Color is hex RGB color of label.
+		Color string `json:"color" example:"#1f883d"`
+	}
+	// CreateLabelRequest is request to create label in group.
+	CreateLabelRequest struct {
+		// Name is unique in group name of label.
+		Name string `json:"name" example:"bug"`
+		// Color is hex RGB color of label.
+		Color string `json:"color" example:"#d73a4a"`
+	}
+	// UpdateLabelRequest is request to change label. Fields which are not provided will not be changed.
+	UpdateLabelRequest struct {
+		// Name is new name of label.
+		Name *string `json:"name" example:"bug"`
+		// Color is new color of label.
+		Color *string `json:"color" example:"#d73a4a"`
+	}
+	// GetLabelsResponse ...
+	GetLabelsResponse struct {
+		Count  int      `json:"count"`
+		Labels []*Label `json:"labels"`
+	}
+)
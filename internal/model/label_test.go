@@ -0,0 +1,16 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidLabelColor(t *testing.T) {
+	assert.True(t, ValidLabelColor("#1f883d"))
+	assert.True(t, ValidLabelColor("#D73A4A"))
+	assert.False(t, ValidLabelColor("1f883d"))
+	assert.False(t, ValidLabelColor("#fff"))
+	assert.False(t, ValidLabelColor("red"))
+	assert.False(t, ValidLabelColor(""))
+}
@@ -0,0 +1,106 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ReviewStatus is status of review request.
+type ReviewStatus string
+
+// Statuses of review.
+const (
+	ReviewPending          ReviewStatus = "PENDING"
+	ReviewChangesRequested ReviewStatus = "CHANGES_REQUESTED"
+	ReviewAccepted         ReviewStatus = "ACCEPTED"
+)
+
+// TaskStatus return status into which task must be moved after review is resolved with this status.
+//
+// ok is false if review could not be resolved with status.
+func (s ReviewStatus) TaskStatus() (status TaskStatus, ok bool) {
+	switch s {
+	case ReviewChangesRequested:
+		return StatusChangesRequested, true
+	case ReviewAccepted:
+		return StatusAccepted, true
+	}
+	return "", false
+}
+
+type (
+	// Review is request to check task that was done by user.
+	Review struct {
+		// ID is primary key of review request.
+		ID uuid.UUID `json:"id"`
+		// Task is id of task which is reviewed.
+		Task uuid.UUID `json:"task"`
+		// User is id of user who sent task to review.
+		User uuid.UUID `json:"user"`
+		// Message is additional info passed by user.
+		Message string `json:"message"`
+		// Status is status of review.
+		Status ReviewStatus `json:"status" example:"PENDING"`
+		// CreatedAt is time when task was submitted to review.
+		CreatedAt time.Time `json:"-"`
+		// ResolvedBy is id of user who resolved review.
+		ResolvedBy *uuid.UUID `json:"resolved-by,omitempty"`
+		// Answer is message of reviewer.
+		Answer string `json:"answer,omitempty"`
+	}
+	// SubmitReviewRequest is request to send task to review.
+	SubmitReviewRequest struct {
+		// Message is additional info about done work.
+		Message string `json:"message" example:"done, check please"`
+	}
+	// ResolveReviewRequest is request to resolve review.
+	ResolveReviewRequest struct {
+		// Status must be CHANGES_REQUESTED or ACCEPTED.
+		Status ReviewStatus `json:"status" example:"ACCEPTED"`
+		// Message is optional comment of reviewer.
+		Message string `json:"message" example:"good job"`
+	}
+	// GetReviewsResponse ...
+	GetReviewsResponse struct {
+		Count   int       `json:"count"`
+		Reviews []*Review `json:"reviews"`
+	}
+)
+
+// MarshalJSON implements json.Marshaler.
+func (r *Review) MarshalJSON() ([]byte, error) {
+	if r == nil {
+		return nil, nil
+	}
+	type ReviewAlias Review
+
+	return json.Marshal(&struct {
+		*ReviewAlias
+		Submitted int64 `json:"submitted-at"`
+	}{
+		ReviewAlias: (*ReviewAlias)(r),
+		Submitted:   r.CreatedAt.Unix(),
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (r *Review) UnmarshalJSON(data []byte) (err error) {
+	if r == nil {
+		return nil
+	}
+	type ReviewAlias Review
+	alias := &struct {
+		*ReviewAlias
+		Submitted int64 `json:"submitted-at"`
+	}{
+		ReviewAlias: (*ReviewAlias)(r),
+	}
+	if err = json.Unmarshal(data, alias); err != nil {
+		return err
+	}
+
+	r.CreatedAt = time.Unix(alias.Submitted, 0)
+	return nil
+}
@@ -0,0 +1,53 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReview_MarshalJSON(t *testing.T) {
+	b, err := (*Review)(nil).MarshalJSON()
+	require.NoError(t, err)
+	assert.Nil(t, b)
+	require.NoError(t, (*Review)(nil).UnmarshalJSON(nil))
+
+	by := uuid.New()
+	review := &Review{
+		ID:         uuid.New(),
+		Task:       uuid.New(),
+		User:       uuid.New(),
+		Message:    "message",
+		Status:     ReviewAccepted,
+		CreatedAt:  time.Now().Round(time.Second),
+		ResolvedBy: &by,
+		Answer:     "answer",
+	}
+	b, err = review.MarshalJSON()
+	require.NoError(t, err)
+
+	got := new(Review)
+	require.NoError(t, got.UnmarshalJSON(b))
+	assert.Equal(t, review, got)
+}
+
+func TestReviewStatus_TaskStatus(t *testing.T) {
+	tt := []struct {
+		status ReviewStatus
+		want   TaskStatus
+		ok     bool
+	}{
+		{ReviewAccepted, StatusAccepted, true},
+		{ReviewChangesRequested, StatusChangesRequested, true},
+		{ReviewPending, "", false},
+		{"", "", false},
+	}
+	for _, tc := range tt {
+		got, ok := tc.status.TaskStatus()
+		assert.Equal(t, tc.want, got)
+		assert.Equal(t, tc.ok, ok)
+	}
+}
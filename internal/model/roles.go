@@ -1,20 +1,145 @@
 package model
 
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// Permission is right of member in group. Permissions are combined into set by bitwise OR.
+type Permission int32
+
+// Rights which could be granted to member of group by role. Bits of permissions are stored in database, so new
+// permissions are only appended.
 const (
-	PermReadRelated = iota
-	PermReadAll
-	PermCreate
-	PermChangeRelated
-	PermChangeAll
+	PermCreateTasks Permission = 1 << iota
+	// PermEditTasks allows to change content of any task of group: name, description, deadline, dependencies and
+	// checklist.
+	PermEditTasks
+	PermDeleteTasks
+	// PermReviewTasks allows to accept tasks or request changes on them.
+	PermReviewTasks
+	// PermViewReviews allows to view queue of tasks which are waiting for review.
+	PermViewReviews
+	PermCreateIssues
+	// PermViewIssues allows to read all issues of tasks of group.
+	PermViewIssues
+	PermViewMembers
+	PermCreateInvites
+	// PermRemoveMembers allows to remove and change roles of any member except admins.
+	PermRemoveMembers
+	// PermViewTasks allows to see all tasks of group with their statistics.
+	PermViewTasks
+	// PermEditRelatedTasks allows to change what is related to progress of tasks of group: status, position on
+	// board, attachments and logged time. Content of tasks is changed with PermEditTasks.
+	PermEditRelatedTasks
+	// PermEditRelatedIssues allows to edit own issues.
+	PermEditRelatedIssues
+	// PermModerateIssues allows to edit issues of other users.
+	PermModerateIssues
+	// PermRemoveRelatedMembers allows to remove and change roles of members who joined group by own invites.
+	PermRemoveRelatedMembers
+
+	// PermAll is set of all permissions. Admins of group always have it.
+	PermAll = PermViewTasks | PermCreateTasks | PermEditRelatedTasks | PermEditTasks | PermDeleteTasks |
+		PermReviewTasks | PermViewReviews | PermViewIssues | PermCreateIssues | PermEditRelatedIssues |
+		PermModerateIssues | PermViewMembers | PermCreateInvites | PermRemoveRelatedMembers | PermRemoveMembers
+	// PermManageTasks is set of permissions all of which are required to manage labels, board and templates
+	// of group.
+	PermManageTasks = PermEditTasks | PermDeleteTasks
 )
 
+// permissionNames is names of permissions in order in which they are shown to user.
+var permissionNames = []struct {
+	perm Permission
+	name string
+}{
+	{PermViewTasks, "tasks.view"},
+	{PermCreateTasks, "tasks.create"},
+	{PermEditRelatedTasks, "tasks.edit-related"},
+	{PermEditTasks, "tasks.edit"},
+	{PermDeleteTasks, "tasks.delete"},
+	{PermReviewTasks, "tasks.review"},
+	{PermViewReviews, "reviews.view"},
+	{PermViewIssues, "issues.view"},
+	{PermCreateIssues, "issues.create"},
+	{PermEditRelatedIssues, "issues.edit-related"},
+	{PermModerateIssues, "issues.moderate"},
+	{PermViewMembers, "members.view"},
+	{PermCreateInvites, "invites.create"},
+	{PermRemoveRelatedMembers, "members.remove-related"},
+	{PermRemoveMembers, "members.remove"},
+}
+
+// Has return true if set contains all permissions of p.
+func (s Permission) Has(p Permission) bool {
+	return s&p == p
+}
+
+// Any return true if set contains at least one permission of p.
+func (s Permission) Any(p Permission) bool {
+	return s&p != 0
+}
+
+// Valid return true if set contains only known permissions.
+func (s Permission) Valid() bool {
+	return s >= 0 && s&^PermAll == 0
+}
+
+// Names return names of permissions of set.
+func (s Permission) Names() []string {
+	names := make([]string, 0, len(permissionNames))
+	for _, p := range permissionNames {
+		if s.Has(p.perm) {
+			names = append(names, p.name)
+		}
+	}
+	return names
+}
+
+// MarshalJSON implements json.Marshaler. Set is passed to user as list of permission names.
+func (s Permission) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.Names())
+}
+
+// UnmarshalJSON implements json.Unmarshaler. Unknown permission names are rejected.
+func (s *Permission) UnmarshalJSON(data []byte) error {
+	var names []string
+	if err := json.Unmarshal(data, &names); err != nil {
+		return err
+	}
+	var set Permission
+names:
+	for _, name := range names {
+		for _, p := range permissionNames {
+			if p.name == name {
+				set |= p.perm
+				continue names
+			}
+		}
+		return fmt.Errorf("unknown permission %q", name)
+	}
+	*s = set
+	return nil
+}
+
 type (
-	// Role is helper struct to store role.
+	// Role is named set of permissions of members in group.
 	Role struct {
-		ID       int32
-		Members  int
-		Tasks    int
-		Reviews  int
-		Comments int
+		ID          int32      `json:"id" example:"1"`
+		Group       uuid.UUID  `json:"group"`
+		Name        string     `json:"name" example:"reviewer"`
+		Permissions Permission `json:"permissions" swaggertype:"array,string" example:"tasks.review,reviews.view"`
+	}
+	// CreateRoleRequest is request to create role in group.
+	CreateRoleRequest struct {
+		Name        string     `json:"name" example:"reviewer"`
+		Permissions Permission `json:"permissions" swaggertype:"array,string" example:"tasks.review,reviews.view"`
+	}
+	// GetRolesResponse ...
+	GetRolesResponse struct {
+		Count int     `json:"count"`
+		Roles []*Role `json:"roles"`
 	}
 )
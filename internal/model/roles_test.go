@@ -0,0 +1,51 @@
+package model
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPermission_Has(t *testing.T) {
+	set := PermCreateTasks | PermEditTasks
+
+	assert.True(t, set.Has(PermCreateTasks))
+	assert.True(t, set.Has(PermCreateTasks|PermEditTasks))
+	assert.False(t, set.Has(PermManageTasks))
+	assert.True(t, set.Has(0))
+	assert.True(t, set.Any(PermManageTasks))
+	assert.False(t, set.Any(PermViewMembers|PermViewIssues))
+	assert.True(t, PermAll.Has(set))
+}
+
+func TestPermission_Valid(t *testing.T) {
+	assert.True(t, Permission(0).Valid())
+	assert.True(t, PermAll.Valid())
+	assert.False(t, (PermAll + 1).Valid())
+	assert.False(t, Permission(-1).Valid())
+}
+
+func TestPermission_JSON(t *testing.T) {
+	data, err := json.Marshal(PermViewReviews | PermCreateTasks)
+	require.NoError(t, err)
+	assert.JSONEq(t, `["tasks.create", "reviews.view"]`, string(data))
+
+	data, err = json.Marshal(Permission(0))
+	require.NoError(t, err)
+	assert.JSONEq(t, `[]`, string(data))
+
+	var set Permission
+	require.NoError(t, json.Unmarshal([]byte(`["members.remove", "tasks.edit", "tasks.edit"]`), &set))
+	assert.Equal(t, PermRemoveMembers|PermEditTasks, set)
+
+	assert.Error(t, json.Unmarshal([]byte(`["tasks.fly"]`), &set))
+	assert.Error(t, json.Unmarshal([]byte(`4`), &set))
+	assert.Equal(t, PermRemoveMembers|PermEditTasks, set)
+}
+
+func TestPermission_Names(t *testing.T) {
+	assert.Len(t, PermAll.Names(), 15)
+	assert.Equal(t, []string{"issues.view", "members.view"}, (PermViewMembers | PermViewIssues).Names())
+}
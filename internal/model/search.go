@@ -0,0 +1,19 @@
+package model
+
+type (
+	// SearchResult is task found by search query.
+	SearchResult struct {
+		Task *Task `json:"task"`
+		// Rank is relevance of task to query. Results with greater rank are more relevant.
+		Rank float32 `json:"rank"`
+		// Snippet is fragment of task name and description with matched words wrapped into <b> tag.
+		Snippet string `json:"snippet,omitempty"`
+		// CommentSnippet is fragment of the most relevant comment of task with matched words wrapped into <b> tag.
+		CommentSnippet string `json:"comment-snippet,omitempty"`
+	}
+	// SearchResponse ...
+	SearchResponse struct {
+		Count   int             `json:"count"`
+		Results []*SearchResult `json:"results"`
+	}
+)
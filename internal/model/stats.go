@@ -0,0 +1,134 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// week is duration of one week.
+const week = 7 * 24 * time.Hour
+
+// WeekStart return start of ISO week of t, that is Monday 00:00 UTC.
+func WeekStart(t time.Time) time.Time {
+	t = t.UTC()
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	return day.AddDate(0, 0, -(int(day.Weekday())+6)%7)
+}
+
+type (
+	// GroupStatsFilter is parameters of group statistics.
+	GroupStatsFilter struct {
+		Group uuid.UUID
+		// From is start of period. Events which happened before it are not counted.
+		From time.Time
+		// To is end of period. Events which happened at or after it are not counted.
+		To time.Time
+	}
+	// WeeklyFlow is count of tasks which were created and closed during week.
+	WeeklyFlow struct {
+		// Week is start of week, Monday 00:00 UTC.
+		Week    time.Time `json:"-"`
+		Created int       `json:"created"`
+		Closed  int       `json:"closed"`
+	}
+	// CycleTime is time which tasks spent from first move into IN_PROGRESS until they were accepted.
+	CycleTime struct {
+		// Tasks is count of acceptances of tasks during period.
+		Tasks int `json:"tasks"`
+		// MedianSeconds is median cycle time of accepted tasks.
+		MedianSeconds int64 `json:"median-seconds"`
+	}
+	// ReviewStats is count of reviews which were resolved during period.
+	ReviewStats struct {
+		Resolved int `json:"resolved"`
+		// Rejected is count of reviews which were resolved with changes requested.
+		Rejected int `json:"rejected"`
+		// RejectionRate is share of rejected reviews from 0 to 1.
+		RejectionRate float64 `json:"rejection-rate" example:"0.25"`
+	}
+	// GroupStats is statistics of group tasks over period.
+	GroupStats struct {
+		Group uuid.UUID `json:"group"`
+		From  time.Time `json:"-"`
+		To    time.Time `json:"-"`
+		// Statuses is current count of group tasks in every status.
+		Statuses map[TaskStatus]int `json:"statuses"`
+		// Weeks is created and closed tasks for every week of period, earliest first.
+		Weeks     []*WeeklyFlow `json:"weeks"`
+		CycleTime CycleTime     `json:"cycle-time"`
+		Reviews   ReviewStats   `json:"reviews"`
+	}
+)
+
+// MarshalJSON implements json.Marshaler. Start of week is passed to user as unix timestamp like in Task.
+func (f *WeeklyFlow) MarshalJSON() ([]byte, error) {
+	if f == nil {
+		return nil, nil
+	}
+	type alias WeeklyFlow
+
+	return json.Marshal(&struct {
+		*alias
+		Week int64 `json:"week"`
+	}{
+		alias: (*alias)(f),
+		Week:  f.Week.Unix(),
+	})
+}
+
+// FillGaps adds zero counts of statuses and weeks of period in which nothing happened and calculates rejection rate.
+func (s *GroupStats) FillGaps() {
+	if s.Statuses == nil {
+		s.Statuses = make(map[TaskStatus]int)
+	}
+	for _, status := range []TaskStatus{
+		StatusNew,
+		StatusInProgress,
+		StatusOnReview,
+		StatusChangesRequested,
+		StatusClosed,
+		StatusAccepted,
+	} {
+		s.Statuses[status] += 0
+	}
+
+	known := make(map[int64]*WeeklyFlow, len(s.Weeks))
+	for _, w := range s.Weeks {
+		known[WeekStart(w.Week).Unix()] = w
+	}
+	weeks := make([]*WeeklyFlow, 0, len(s.Weeks))
+	for start := WeekStart(s.From); start.Before(s.To); start = start.Add(week) {
+		w, ok := known[start.Unix()]
+		if !ok {
+			w = &WeeklyFlow{}
+		}
+		w.Week = start
+		weeks = append(weeks, w)
+	}
+	s.Weeks = weeks
+
+	s.Reviews.RejectionRate = 0
+	if s.Reviews.Resolved > 0 {
+		s.Reviews.RejectionRate = float64(s.Reviews.Rejected) / float64(s.Reviews.Resolved)
+	}
+}
+
+// MarshalJSON implements json.Marshaler. Times are passed to user as unix timestamps like in Task.
+func (s *GroupStats) MarshalJSON() ([]byte, error) {
+	if s == nil {
+		return nil, nil
+	}
+	type alias GroupStats
+
+	return json.Marshal(&struct {
+		*alias
+		From int64 `json:"from"`
+		To   int64 `json:"to"`
+	}{
+		alias: (*alias)(s),
+		From:  s.From.Unix(),
+		To:    s.To.Unix(),
+	})
+}
@@ -0,0 +1,49 @@
+package model
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWeekStart(t *testing.T) {
+	// Sunday evening in UTC-3 is already Monday in UTC.
+	sunday := time.Date(2023, time.May, 7, 22, 0, 0, 0, time.FixedZone("", -3*60*60))
+	assert.Equal(t, time.Date(2023, time.May, 8, 0, 0, 0, 0, time.UTC), WeekStart(sunday))
+	assert.Equal(t, time.Date(2023, time.May, 1, 0, 0, 0, 0, time.UTC), WeekStart(time.Date(2023, time.May, 7, 12, 0, 0, 0, time.UTC)))
+	assert.Equal(t, time.Date(2023, time.May, 1, 0, 0, 0, 0, time.UTC), WeekStart(time.Date(2023, time.May, 1, 0, 0, 0, 0, time.UTC)))
+}
+
+func TestGroupStats_FillGaps(t *testing.T) {
+	s := &GroupStats{
+		Group:    uuid.New(),
+		From:     time.Date(2023, time.May, 3, 0, 0, 0, 0, time.UTC),
+		To:       time.Date(2023, time.May, 20, 0, 0, 0, 0, time.UTC),
+		Statuses: map[TaskStatus]int{StatusNew: 3},
+		Weeks:    []*WeeklyFlow{{Week: time.Date(2023, time.May, 8, 0, 0, 0, 0, time.UTC), Created: 2, Closed: 1}},
+		Reviews:  ReviewStats{Resolved: 4, Rejected: 1},
+	}
+	s.FillGaps()
+
+	assert.Len(t, s.Statuses, 6)
+	assert.Equal(t, 3, s.Statuses[StatusNew])
+	assert.Equal(t, 0, s.Statuses[StatusAccepted])
+	require.Len(t, s.Weeks, 3)
+	assert.Equal(t, time.Date(2023, time.May, 1, 0, 0, 0, 0, time.UTC), s.Weeks[0].Week)
+	assert.Zero(t, s.Weeks[0].Created)
+	assert.Equal(t, 2, s.Weeks[1].Created)
+	assert.Equal(t, time.Date(2023, time.May, 15, 0, 0, 0, 0, time.UTC), s.Weeks[2].Week)
+	assert.Equal(t, 0.25, s.Reviews.RejectionRate)
+
+	b, err := json.Marshal(s)
+	require.NoError(t, err)
+	var got map[string]any
+	require.NoError(t, json.Unmarshal(b, &got))
+	assert.Equal(t, float64(s.From.Unix()), got["from"])
+	assert.Equal(t, float64(s.Weeks[1].Week.Unix()), got["weeks"].([]any)[1].(map[string]any)["week"])
+	assert.Equal(t, float64(3), got["statuses"].(map[string]any)["NEW"])
+}
@@ -7,15 +7,80 @@ import (
 	"github.com/google/uuid"
 )
 
+// TaskStatus is status of task.
+type TaskStatus string
+
+// Statuses of task. Transitions between them are described in service.
+const (
+	StatusNew              TaskStatus = "NEW"
+	StatusInProgress       TaskStatus = "IN_PROGRESS"
+	StatusOnReview         TaskStatus = "ON_REVIEW"
+	StatusChangesRequested TaskStatus = "CHANGES_REQUESTED"
+	StatusClosed           TaskStatus = "CLOSED"
+	StatusAccepted         TaskStatus = "ACCEPTED"
+)
+
+// Finished return true if work on task with status is done.
+func (s TaskStatus) Finished() bool {
+	return s == StatusClosed || s == StatusAccepted
+}
+
+// Valid return true if status is one of known task statuses.
+func (s TaskStatus) Valid() bool {
+	switch s {
+	case StatusNew, StatusInProgress, StatusOnReview, StatusChangesRequested, StatusClosed, StatusAccepted:
+		return true
+	}
+	return false
+}
+
+// TaskSort is field by which tasks are sorted in listings.
+type TaskSort string
+
+// Fields by which tasks could be sorted.
+const (
+	SortCreatedAt TaskSort = "created-at"
+	SortDeadline  TaskSort = "deadline"
+	SortName      TaskSort = "name"
+)
+
+// Valid return true if tasks could be sorted by s.
+func (s TaskSort) Valid() bool {
+	switch s {
+	case SortCreatedAt, SortDeadline, SortName:
+		return true
+	}
+	return false
+}
+
 type (
 	// Task ...
 	Task struct {
-		ID          uuid.UUID `json:"id"`
-		Name        string    `json:"name"`
-		Description string    `json:"description"`
-		CreatedAt   time.Time `json:"-"`
-		CreatedBy   uuid.UUID `json:"created-by"`
-		Status      string    `json:"status"`
+		ID          uuid.UUID  `json:"id"`
+		Name        string     `json:"name"`
+		Description string     `json:"description"`
+		CreatedAt   time.Time  `json:"-"`
+		CreatedBy   uuid.UUID  `json:"created-by"`
+		Status      TaskStatus `json:"status" example:"NEW"`
+		// Deadline is optional time until which task must be done.
+		Deadline *time.Time `json:"-"`
+		// Parent is id of task which this task is subtask of.
+		Parent *uuid.UUID `json:"parent,omitempty"`
+		// Progress is summary of subtasks statuses. It is not provided if task has no subtasks.
+		Progress *TaskProgress `json:"progress,omitempty"`
+		// Labels is labels of task groups which are attached to task.
+		Labels []*Label `json:"labels,omitempty"`
+		// Checklist is summary of checklist items. It is not provided if task has no checklist.
+		Checklist *ChecklistSummary `json:"checklist,omitempty"`
+	}
+	// TaskProgress is summary of subtasks statuses.
+	TaskProgress struct {
+		// Done is count of subtasks which are closed or accepted.
+		Done int `json:"done"`
+		// Total is count of subtasks.
+		Total int `json:"total"`
+		// Percent is rounded down percent of done subtasks.
+		Percent int `json:"percent"`
 	}
 	// TaskCreateRequest ...
 	TaskCreateRequest struct {
@@ -28,14 +93,91 @@ type (
 		Users []uuid.UUID `json:"users"`
 		// Group - optional filed that show group to which task will be related.
 		Group *uuid.UUID `json:"group"`
+		// Deadline - optional time in RFC 3339 format with timezone until which task must be done.
+		Deadline *time.Time `json:"deadline" example:"2023-05-01T18:00:00+03:00"`
+		// Parent - optional id of task to which new task will be related as subtask.
+		Parent *uuid.UUID `json:"parent"`
+		// Template - optional id of group template from which task is created.
+		// Task is related to group of template, fields which are not provided are taken from template
+		// and labels and checklist of template are added to task.
+		Template *uuid.UUID `json:"template"`
+	}
+	// CreateTaskResponse is created task with assignees to whom task was not assigned.
+	CreateTaskResponse struct {
+		Task *Task `json:"task"`
+		// RejectedAssignees is users who have no common group with creator in which creator could create tasks.
+		RejectedAssignees []uuid.UUID `json:"rejected-assignees"`
+	}
+	// TaskUpdateRequest is request to change task.
+	//
+	// Fields which are not provided will not be changed. PUT requests must provide all fields.
+	TaskUpdateRequest struct {
+		// Name is new name of task.
+		Name *string `json:"name" example:"new name"`
+		// Description is new description of task.
+		Description *string `json:"description" example:"new description"`
+		// Deadline is new deadline of task in RFC 3339 format with timezone.
+		Deadline *time.Time `json:"deadline" example:"2023-05-01T18:00:00+03:00"`
+		// ClearDeadline removes deadline of task. It could not be used together with Deadline.
+		ClearDeadline bool `json:"clear-deadline"`
+	}
+	// UpdateTaskStatusRequest is request to change status of task.
+	UpdateTaskStatusRequest struct {
+		// Status is new status of task.
+		Status TaskStatus `json:"status" example:"IN_PROGRESS"`
+	}
+	// TaskFilter is set of conditions and pagination options which are applied to listing of tasks.
+	TaskFilter struct {
+		// Overdue selects only unfinished tasks which deadline has already passed.
+		Overdue bool
+		// DueBefore selects tasks with deadline before provided time.
+		DueBefore *time.Time
+		// DueAfter selects tasks with deadline after provided time.
+		DueAfter *time.Time
+		// Status selects tasks which have one of provided statuses.
+		Status []TaskStatus
+		// Group selects tasks which are related to group.
+		Group *uuid.UUID
+		// CreatedBy selects tasks which are created by user.
+		CreatedBy *uuid.UUID
+		// Assignee selects tasks which are assigned to user.
+		Assignee *uuid.UUID
+		// Sort is field by which tasks are sorted. Tasks with equal values are sorted by id.
+		Sort TaskSort
+		// Desc changes order of sorting to descending.
+		Desc bool
+		// Parent selects subtasks of task.
+		Parent *uuid.UUID
+		// Labels selects tasks to which all provided labels are attached.
+		Labels []uuid.UUID
+		// Cursor is id of last task of previous page. Only tasks after it will be returned.
+		Cursor *uuid.UUID
+		// Limit is maximum count of tasks to return. Zero value means no limit.
+		Limit int
 	}
 	// GetTasksResponse ...
 	GetTasksResponse struct {
 		Count int     `json:"count"`
 		Tasks []*Task `json:"tasks"`
+		// NextCursor is cursor of next page. It is not provided on last page.
+		NextCursor *uuid.UUID `json:"next-cursor,omitempty"`
 	}
 )
 
+// NewTaskProgress return progress of task with total subtasks of which done are finished.
+//
+// Nil is returned if task has no subtasks.
+func NewTaskProgress(done, total int) *TaskProgress {
+	if total <= 0 {
+		return nil
+	}
+	return &TaskProgress{
+		Done:    done,
+		Total:   total,
+		Percent: done * 100 / total,
+	}
+}
+
 // MarshalJSON implements json.Marshaler.
 // Used to pass correct time layout to user.
 func (task *Task) MarshalJSON() ([]byte, error) {
@@ -46,13 +188,18 @@ func (task *Task) MarshalJSON() ([]byte, error) {
 
 	aliasValue := &struct {
 		*TransactionAlias
-		Created int64 `json:"created-at"`
+		Created  int64  `json:"created-at"`
+		Deadline *int64 `json:"deadline,omitempty"`
 	}{
 		// задаём указатель на целевой объект
 		TransactionAlias: (*TransactionAlias)(task),
 		Created:          task.CreatedAt.Unix(),
 		// вызываем стандартный Unmarshal
 	}
+	if task.Deadline != nil {
+		deadline := task.Deadline.Unix()
+		aliasValue.Deadline = &deadline
+	}
 
 	return json.Marshal(aliasValue)
 }
@@ -66,7 +213,8 @@ func (task *Task) UnmarshalJSON(data []byte) (err error) {
 	type TaskAlias Task
 	alias := &struct {
 		*TaskAlias
-		Created int64 `json:"created-at"`
+		Created  int64  `json:"created-at"`
+		Deadline *int64 `json:"deadline,omitempty"`
 	}{
 		TaskAlias: (*TaskAlias)(task),
 	}
@@ -75,5 +223,9 @@ func (task *Task) UnmarshalJSON(data []byte) (err error) {
 	}
 
 	task.CreatedAt = time.Unix(alias.Created, 0)
+	if alias.Deadline != nil {
+		deadline := time.Unix(*alias.Deadline, 0)
+		task.Deadline = &deadline
+	}
 	return nil
 }
@@ -34,3 +34,50 @@ func TestTask_MarshalJSON(t *testing.T) {
 	newTask.CreatedAt = newTask.CreatedAt.Round(time.Second)
 	require.Equal(t, task, newTask)
 }
+
+func TestTask_MarshalJSON_Deadline(t *testing.T) {
+	deadline := time.Date(2023, 5, 1, 18, 0, 0, 0, time.FixedZone("MSK", 3*60*60))
+	task := &Task{ID: uuid.New(), Deadline: &deadline}
+
+	b, err := task.MarshalJSON()
+	require.NoError(t, err)
+	assert.Contains(t, string(b), `"deadline":1682953200`)
+
+	newTask := new(Task)
+	require.NoError(t, newTask.UnmarshalJSON(b))
+	if assert.NotNil(t, newTask.Deadline) {
+		assert.True(t, deadline.Equal(*newTask.Deadline))
+	}
+
+	b, err = (&Task{ID: uuid.New()}).MarshalJSON()
+	require.NoError(t, err)
+	assert.NotContains(t, string(b), "deadline")
+}
+
+func TestTaskStatus_Valid(t *testing.T) {
+	for _, s := range []TaskStatus{
+		StatusNew,
+		StatusInProgress,
+		StatusOnReview,
+		StatusChangesRequested,
+		StatusClosed,
+		StatusAccepted,
+	} {
+		assert.True(t, s.Valid(), s)
+	}
+	assert.False(t, TaskStatus("").Valid())
+	assert.False(t, TaskStatus("new").Valid())
+}
+
+func TestNewTaskProgress(t *testing.T) {
+	assert.Nil(t, NewTaskProgress(0, 0))
+	assert.Equal(t, &TaskProgress{Done: 1, Total: 3, Percent: 33}, NewTaskProgress(1, 3))
+	assert.Equal(t, &TaskProgress{Done: 2, Total: 2, Percent: 100}, NewTaskProgress(2, 2))
+}
+
+func TestTaskStatus_Finished(t *testing.T) {
+	assert.True(t, StatusClosed.Finished())
+	assert.True(t, StatusAccepted.Finished())
+	assert.False(t, StatusOnReview.Finished())
+	assert.False(t, StatusNew.Finished())
+}
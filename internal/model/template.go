@@ -0,0 +1,104 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type (
+	// TaskTemplate is blueprint of tasks in group.
+	//
+	// If template has recurrence rule then scheduler creates new task from template on every occurrence of rule.
+	TaskTemplate struct {
+		ID        uuid.UUID `json:"id"`
+		Group     uuid.UUID `json:"group"`
+		CreatedBy uuid.UUID `json:"created-by"`
+		// Name is pattern of name of created tasks. See TaskName for supported placeholders.
+		Name string `json:"name" example:"weekly report {week}"`
+		// Description is skeleton of description of created tasks.
+		Description string `json:"description"`
+		// Assignees is members of group to whom created tasks are assigned. If it is empty then tasks
+		// are assigned to all members of group.
+		Assignees []uuid.UUID `json:"assignees"`
+		// Labels is labels of group which are attached to created tasks.
+		Labels []uuid.UUID `json:"labels"`
+		// Checklist is texts of checklist items which are added to created tasks.
+		Checklist []string `json:"checklist"`
+		// Recurrence is cron expression or RRULE by which tasks are created.
+		Recurrence string `json:"recurrence,omitempty" example:"FREQ=WEEKLY;BYDAY=MO;BYHOUR=9;BYMINUTE=0"`
+		// RecurrenceStart is time from which runs of Recurrence are counted (DTSTART of rule).
+		RecurrenceStart *time.Time `json:"-"`
+		// NextRunAt is time when next task will be created from template.
+		NextRunAt *time.Time `json:"-"`
+		CreatedAt time.Time  `json:"-"`
+	}
+	// CreateTemplateRequest is request to create task template in group.
+	CreateTemplateRequest struct {
+		// Name is pattern of name of created tasks which could contain {date} and {week} placeholders.
+		Name string `json:"name" example:"release notes {date}"`
+		// Description is description of created tasks.
+		Description string `json:"description"`
+		// Assignees is members of group to whom created tasks are assigned.
+		Assignees []uuid.UUID `json:"assignees"`
+		// Labels is labels of group which are attached to created tasks.
+		Labels []uuid.UUID `json:"labels"`
+		// Checklist is texts of checklist items which are added to created tasks.
+		Checklist []string `json:"checklist" example:"write changelog,notify users"`
+		// Recurrence is optional cron expression or RRULE. Rules are evaluated in UTC.
+		Recurrence string `json:"recurrence" example:"0 9 * * MON"`
+	}
+	// UpdateTemplateRequest is request to change task template.
+	//
+	// Fields which are not provided are not changed. Empty lists clear assignees, labels or checklist of template.
+	UpdateTemplateRequest struct {
+		Name        *string     `json:"name" example:"release notes {date}"`
+		Description *string     `json:"description"`
+		Assignees   []uuid.UUID `json:"assignees"`
+		Labels      []uuid.UUID `json:"labels"`
+		Checklist   []string    `json:"checklist"`
+		// Recurrence is new cron expression or RRULE. Empty string stops recurring creation of tasks.
+		Recurrence *string `json:"recurrence" example:"FREQ=WEEKLY;BYDAY=MO"`
+	}
+	// GetTemplatesResponse ...
+	GetTemplatesResponse struct {
+		Count     int             `json:"count"`
+		Templates []*TaskTemplate `json:"templates"`
+	}
+)
+
+// MarshalJSON implements json.Marshaler. Times are passed to user as unix timestamps like in Task.
+func (tpl *TaskTemplate) MarshalJSON() ([]byte, error) {
+	type alias TaskTemplate
+
+	aliasValue := &struct {
+		*alias
+		Created   int64  `json:"created-at"`
+		NextRunAt *int64 `json:"next-run-at,omitempty"`
+	}{
+		alias:   (*alias)(tpl),
+		Created: tpl.CreatedAt.Unix(),
+	}
+	if tpl.NextRunAt != nil {
+		next := tpl.NextRunAt.Unix()
+		aliasValue.NextRunAt = &next
+	}
+
+	return json.Marshal(aliasValue)
+}
+
+// TaskName return name of task which is created from template at now.
+//
+// Placeholder {date} is replaced with date in format 2006-01-02 and {week} with ISO week in format 2006-W01.
+// Dates are evaluated in UTC.
+func (tpl *TaskTemplate) TaskName(now time.Time) string {
+	now = now.UTC()
+	year, week := now.ISOWeek()
+	return strings.NewReplacer(
+		"{date}", now.Format("2006-01-02"),
+		"{week}", fmt.Sprintf("%d-W%02d", year, week),
+	).Replace(tpl.Name)
+}
@@ -0,0 +1,55 @@
+package model
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskTemplate_MarshalJSON(t *testing.T) {
+	now := time.Unix(1682935200, 0)
+	tpl := &TaskTemplate{
+		ID:         uuid.New(),
+		Group:      uuid.New(),
+		CreatedBy:  uuid.New(),
+		Name:       "release notes",
+		Assignees:  []uuid.UUID{},
+		Labels:     []uuid.UUID{},
+		Checklist:  []string{"write changelog"},
+		Recurrence: "@weekly",
+		NextRunAt:  &now,
+		CreatedAt:  now,
+	}
+	data, err := json.Marshal(tpl)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+	"id": "`+tpl.ID.String()+`",
+	"group": "`+tpl.Group.String()+`",
+	"created-by": "`+tpl.CreatedBy.String()+`",
+	"name": "release notes",
+	"description": "",
+	"assignees": [],
+	"labels": [],
+	"checklist": ["write changelog"],
+	"recurrence": "@weekly",
+	"next-run-at": 1682935200,
+	"created-at": 1682935200
+}`, string(data))
+
+	tpl.NextRunAt, tpl.Recurrence = nil, ""
+	data, err = json.Marshal(tpl)
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "next-run-at")
+	assert.NotContains(t, string(data), "recurrence")
+}
+
+func TestTaskTemplate_TaskName(t *testing.T) {
+	now := time.Date(2023, time.January, 2, 23, 30, 0, 0, time.FixedZone("", -3*60*60))
+
+	assert.Equal(t, "plain", (&TaskTemplate{Name: "plain"}).TaskName(now))
+	assert.Equal(t, "report 2023-01-03 (2023-W01)", (&TaskTemplate{Name: "report {date} ({week})"}).TaskName(now))
+}
@@ -17,13 +17,20 @@ type (
 
 	// UserInGroup represents user in group object.
 	UserInGroup struct {
-		UserID  uuid.UUID
-		Email   string
-		IsAdmin bool
-		Members int
-		Tasks   int
-		Reviews int
-		Comment int
+		UserID  uuid.UUID `json:"user"`
+		Email   string    `json:"email" example:"user@example.com"`
+		IsAdmin bool      `json:"is-admin"`
+		// Role is id of group role of member.
+		Role        int32      `json:"role" example:"1"`
+		RoleName    string     `json:"role-name" example:"reviewer"`
+		Permissions Permission `json:"permissions" swaggertype:"array,string" example:"tasks.review,reviews.view"`
+		// InvitedBy is id of user who created invite by which member joined group.
+		InvitedBy *uuid.UUID `json:"invited-by,omitempty"`
+	}
+	// GetMembersResponse ...
+	GetMembersResponse struct {
+		Count   int            `json:"count"`
+		Members []*UserInGroup `json:"members"`
 	}
 	// RegisterUserRequest ...
 	RegisterUserRequest struct {
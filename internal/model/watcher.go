@@ -0,0 +1,27 @@
+package model
+
+import (
+	"github.com/google/uuid"
+)
+
+type (
+	// Watcher is user who is subscribed to changes of task.
+	//
+	// Creator, assignees, commenters and reviewers of task watch it automatically.
+	Watcher struct {
+		User  uuid.UUID `json:"user"`
+		Email string    `json:"email" example:"user@example.com"`
+	}
+	// GetWatchersResponse ...
+	GetWatchersResponse struct {
+		Count int `json:"count"`
+		// Watching is true if user who requested watchers watches task.
+		Watching bool       `json:"watching"`
+		Watchers []*Watcher `json:"watchers"`
+	}
+	// GetNotificationsResponse is changes of watched tasks which were made by other users.
+	GetNotificationsResponse struct {
+		Count   int           `json:"count"`
+		Changes []*TaskChange `json:"changes"`
+	}
+)
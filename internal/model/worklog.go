@@ -0,0 +1,187 @@
+package model
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ReportGrouping is key by which logged time is aggregated in report.
+type ReportGrouping string
+
+const (
+	ReportByUser  ReportGrouping = "user"
+	ReportByTask  ReportGrouping = "task"
+	ReportByGroup ReportGrouping = "group"
+)
+
+// Valid checks that grouping is one of known groupings.
+func (g ReportGrouping) Valid() bool {
+	switch g {
+	case ReportByUser, ReportByTask, ReportByGroup:
+		return true
+	}
+	return false
+}
+
+type (
+	// Worklog is time which user spent on task.
+	//
+	// Worklog which is not stopped yet is running timer of user.
+	Worklog struct {
+		ID          uuid.UUID `json:"id"`
+		Task        uuid.UUID `json:"task"`
+		User        uuid.UUID `json:"user"`
+		Description string    `json:"description"`
+		StartedAt   time.Time `json:"-"`
+		// StoppedAt is nil while timer is running.
+		StoppedAt *time.Time `json:"-"`
+	}
+	// StartTimerRequest is request to start timer on task.
+	StartTimerRequest struct {
+		// Description is optional description of work.
+		Description string `json:"description" example:"code review"`
+	}
+	// CreateWorklogRequest is request to log time which was spent on task manually.
+	CreateWorklogRequest struct {
+		// StartedAt is time in RFC 3339 format with timezone when work was started.
+		StartedAt time.Time `json:"started-at" example:"2023-05-01T10:00:00+03:00"`
+		// StoppedAt is time in RFC 3339 format with timezone when work was done.
+		StoppedAt time.Time `json:"stopped-at" example:"2023-05-01T12:30:00+03:00"`
+		// Description is optional description of work.
+		Description string `json:"description" example:"code review"`
+	}
+	// GetWorklogsResponse ...
+	GetWorklogsResponse struct {
+		Count    int        `json:"count"`
+		Worklogs []*Worklog `json:"worklogs"`
+	}
+	// WorklogReportFilter is parameters of report of logged time.
+	WorklogReportFilter struct {
+		// From is start of period of report. Worklogs which are started before it are not counted.
+		From time.Time
+		// To is end of period of report. Worklogs which are started at or after it are not counted.
+		To time.Time
+		// By is key by which logged time is aggregated.
+		By ReportGrouping
+		// Group limits report to tasks of group.
+		Group *uuid.UUID
+		// User limits report to worklogs of user.
+		User *uuid.UUID
+	}
+	// WorklogReportRow is logged time aggregated by user, task or group.
+	WorklogReportRow struct {
+		// ID is id of user, task or group.
+		ID uuid.UUID `json:"id"`
+		// Name is email of user, name of task or name of group.
+		Name string `json:"name"`
+		// Entries is count of worklogs.
+		Entries int `json:"entries"`
+		// Seconds is total logged time.
+		Seconds int64 `json:"seconds"`
+	}
+	// WorklogReport is logged time over period aggregated by user, task or group.
+	WorklogReport struct {
+		From time.Time      `json:"-"`
+		To   time.Time      `json:"-"`
+		By   ReportGrouping `json:"by" example:"user"`
+		// Seconds is total logged time of report.
+		Seconds int64               `json:"seconds"`
+		Rows    []*WorklogReportRow `json:"rows"`
+	}
+)
+
+// Duration return time which is logged by worklog. Time of running timer is counted until now.
+func (w *Worklog) Duration() time.Duration {
+	if w.StoppedAt == nil {
+		return time.Since(w.StartedAt)
+	}
+	return w.StoppedAt.Sub(w.StartedAt)
+}
+
+// MarshalJSON implements json.Marshaler. Times are passed to user as unix timestamps like in Task.
+func (w *Worklog) MarshalJSON() ([]byte, error) {
+	if w == nil {
+		return nil, nil
+	}
+	type alias Worklog
+
+	aliasValue := &struct {
+		*alias
+		Started int64  `json:"started-at"`
+		Stopped *int64 `json:"stopped-at,omitempty"`
+		Seconds int64  `json:"seconds"`
+		Running bool   `json:"running"`
+	}{
+		alias:   (*alias)(w),
+		Started: w.StartedAt.Unix(),
+		Seconds: int64(w.Duration() / time.Second),
+		Running: w.StoppedAt == nil,
+	}
+	if w.StoppedAt != nil {
+		stopped := w.StoppedAt.Unix()
+		aliasValue.Stopped = &stopped
+	}
+
+	return json.Marshal(aliasValue)
+}
+
+// NewWorklogReport return report with rows and total logged time.
+func NewWorklogReport(filter WorklogReportFilter, rows []*WorklogReportRow) *WorklogReport {
+	if rows == nil {
+		rows = []*WorklogReportRow{}
+	}
+	r := &WorklogReport{
+		From: filter.From,
+		To:   filter.To,
+		By:   filter.By,
+		Rows: rows,
+	}
+	for _, row := range rows {
+		r.Seconds += row.Seconds
+	}
+	return r
+}
+
+// MarshalJSON implements json.Marshaler. Times are passed to user as unix timestamps like in Task.
+func (r *WorklogReport) MarshalJSON() ([]byte, error) {
+	if r == nil {
+		return nil, nil
+	}
+	type alias WorklogReport
+
+	return json.Marshal(&struct {
+		*alias
+		From int64 `json:"from"`
+		To   int64 `json:"to"`
+	}{
+		alias: (*alias)(r),
+		From:  r.From.Unix(),
+		To:    r.To.Unix(),
+	})
+}
+
+// WriteCSV writes rows of report to w in CSV format with header.
+func (r *WorklogReport) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{string(r.By), "name", "entries", "seconds", "hours"}); err != nil {
+		return err
+	}
+	for _, row := range r.Rows {
+		if err := cw.Write([]string{
+			row.ID.String(),
+			row.Name,
+			strconv.Itoa(row.Entries),
+			strconv.FormatInt(row.Seconds, 10),
+			strconv.FormatFloat(float64(row.Seconds)/float64(time.Hour/time.Second), 'f', 2, 64),
+		}); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
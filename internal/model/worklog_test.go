@@ -0,0 +1,77 @@
+package model
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReportGrouping_Valid(t *testing.T) {
+	for _, g := range []ReportGrouping{ReportByUser, ReportByTask, ReportByGroup} {
+		assert.True(t, g.Valid())
+	}
+	assert.False(t, ReportGrouping("").Valid())
+	assert.False(t, ReportGrouping("day").Valid())
+}
+
+func TestWorklog_MarshalJSON(t *testing.T) {
+	b, err := (*Worklog)(nil).MarshalJSON()
+	assert.NoError(t, err)
+	assert.Nil(t, b)
+
+	started := time.Unix(1_000, 0)
+	stopped := started.Add(90 * time.Minute)
+	w := &Worklog{ID: uuid.New(), Task: uuid.New(), User: uuid.New(), StartedAt: started, StoppedAt: &stopped}
+	b, err = w.MarshalJSON()
+	require.NoError(t, err)
+
+	var got map[string]any
+	require.NoError(t, json.Unmarshal(b, &got))
+	assert.Equal(t, float64(1_000), got["started-at"])
+	assert.Equal(t, float64(stopped.Unix()), got["stopped-at"])
+	assert.Equal(t, float64(90*60), got["seconds"])
+	assert.Equal(t, false, got["running"])
+
+	w.StoppedAt = nil
+	b, err = w.MarshalJSON()
+	require.NoError(t, err)
+	got = nil
+	require.NoError(t, json.Unmarshal(b, &got))
+	assert.NotContains(t, got, "stopped-at")
+	assert.Equal(t, true, got["running"])
+}
+
+func TestNewWorklogReport(t *testing.T) {
+	filter := WorklogReportFilter{From: time.Unix(0, 0), To: time.Unix(100, 0), By: ReportByTask}
+	r := NewWorklogReport(filter, nil)
+	assert.NotNil(t, r.Rows)
+	assert.Zero(t, r.Seconds)
+
+	rows := []*WorklogReportRow{{Seconds: 60}, {Seconds: 30}}
+	r = NewWorklogReport(filter, rows)
+	assert.Equal(t, int64(90), r.Seconds)
+	assert.Equal(t, ReportByTask, r.By)
+
+	b, err := json.Marshal(r)
+	require.NoError(t, err)
+	var got map[string]any
+	require.NoError(t, json.Unmarshal(b, &got))
+	assert.Equal(t, float64(0), got["from"])
+	assert.Equal(t, float64(100), got["to"])
+}
+
+func TestWorklogReport_WriteCSV(t *testing.T) {
+	id := uuid.New()
+	r := NewWorklogReport(WorklogReportFilter{By: ReportByUser}, []*WorklogReportRow{
+		{ID: id, Name: "user, first", Entries: 2, Seconds: 5400},
+	})
+
+	var buf bytes.Buffer
+	require.NoError(t, r.WriteCSV(&buf))
+	assert.Equal(t, "user,name,entries,seconds,hours\n"+id.String()+",\"user, first\",2,5400,1.50\n", buf.String())
+}
@@ -14,6 +14,7 @@ const (
 	CodeConflict
 	CodeForbidden
 	CodeNoContent
+	CodeTooLarge
 )
 
 var httpCodes = map[int]int{
@@ -24,6 +25,7 @@ var httpCodes = map[int]int{
 	CodeForbidden:    http.StatusForbidden,
 	CodeConflict:     http.StatusConflict,
 	CodeNoContent:    http.StatusNoContent,
+	CodeTooLarge:     http.StatusRequestEntityTooLarge,
 }
 
 var grpcCodes = map[int]codes.Code{
@@ -34,4 +36,5 @@ var grpcCodes = map[int]codes.Code{
 	CodeNotFound:     codes.NotFound,
 	CodeForbidden:    codes.PermissionDenied,
 	CodeNoContent:    codes.OK,
+	CodeTooLarge:     codes.ResourceExhausted,
 }
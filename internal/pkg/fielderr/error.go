@@ -72,6 +72,20 @@ func (f *Error) With(fields ...zap.Field) *Error {
 	}
 }
 
+// WithData create new error object that copies error instead of data which will be returned to user.
+func (f *Error) WithData(data any) *Error {
+	if f == nil {
+		return &Error{data: data}
+	}
+	return &Error{
+		msg:    f.msg,
+		data:   data,
+		code:   f.code,
+		fields: f.fields,
+		parent: f,
+	}
+}
+
 // Data return data to return to user.
 func (f *Error) Data() any {
 	if f == nil {
@@ -101,6 +101,18 @@ func TestError_With(t *testing.T) {
 	assert.Equal(t, &Error{fields: fields}, err)
 }
 
+func TestError_WithData(t *testing.T) {
+	data := map[string]string{"xd": "xd"}
+	err := (*Error)(nil).WithData(data)
+	assert.Equal(t, &Error{data: data}, err)
+
+	parent := New("msg", nil, CodeConflict)
+	err = parent.WithData(data)
+	assert.Equal(t, data, err.Data())
+	assert.Equal(t, parent.Code(), err.Code())
+	assert.ErrorIs(t, error(err), error(parent))
+}
+
 func TestError_Code(t *testing.T) {
 	assert.Equal(t, 0, (*Error)(nil).Code())
 }
@@ -0,0 +1,76 @@
+// Package recurrence parses schedules of recurring events.
+//
+// Two formats are supported:
+//   - cron expression with five fields (minute, hour, day of month, month, day of week) or one of
+//     macros @hourly, @daily, @weekly, @monthly;
+//   - subset of RFC 5545 RRULE with FREQ (DAILY, WEEKLY, MONTHLY), INTERVAL, BYDAY, BYMONTHDAY, BYHOUR
+//     and BYMINUTE parts, for example "FREQ=WEEKLY;BYDAY=MO;BYHOUR=9;BYMINUTE=0".
+//
+// All schedules are evaluated in UTC with minute precision.
+package recurrence
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// ErrBadRule is returned when rule could not be parsed or has no occurrences.
+var ErrBadRule = errors.New("bad recurrence rule")
+
+// searchLimit is how far from provided time occurrences are looked for.
+const searchLimit = 5 * 366 * 24 * time.Hour
+
+// Rule is schedule of recurring event.
+type Rule interface {
+	// Next return first occurrence of event which is strictly after provided time.
+	//
+	// Zero time is returned if there are no occurrences in next five years.
+	Next(after time.Time) time.Time
+}
+
+// Parse parses cron expression or RRULE.
+//
+// Start is time from which intervals of RRULE are counted. It also provides defaults for parts of RRULE
+// which are not set: time of day, day of week for weekly and day of month for monthly rules.
+func Parse(rule string, start time.Time) (Rule, error) {
+	rule = strings.TrimSpace(rule)
+	start = start.UTC().Truncate(time.Minute)
+
+	var (
+		r   Rule
+		err error
+	)
+	upper := strings.ToUpper(rule)
+	if strings.HasPrefix(upper, "RRULE:") || strings.Contains(upper, "FREQ=") {
+		r, err = parseRRule(strings.TrimPrefix(upper, "RRULE:"), start)
+	} else {
+		r, err = parseCron(rule)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if r.Next(start).IsZero() {
+		return nil, ErrBadRule
+	}
+	return r, nil
+}
+
+// bits is set of small non-negative numbers.
+type bits uint64
+
+// has return true if n is in set.
+func (b bits) has(n int) bool {
+	return b&(1<<uint(n)) != 0
+}
+
+// set return set with n added.
+func (b bits) set(n int) bits {
+	return b | 1<<uint(n)
+}
+
+// startOfDay return midnight of day of t.
+func startOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,92 @@
+package recurrence
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// monday is 2023-05-01 10:30 UTC.
+var monday = time.Date(2023, time.May, 1, 10, 30, 0, 0, time.UTC)
+
+func TestParse_Bad(t *testing.T) {
+	for _, rule := range []string{
+		"",
+		"* * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"*/0 * * * *",
+		"5-1 * * * *",
+		"0 0 31 2 *",
+		"@yearly",
+		"FREQ=YEARLY",
+		"INTERVAL=2",
+		"FREQ=DAILY;INTERVAL=0",
+		"FREQ=DAILY;COUNT=5",
+		"FREQ=WEEKLY;BYDAY=XX",
+		"FREQ=DAILY;BYHOUR=25",
+		"FREQ=MONTHLY;BYMONTHDAY=0",
+		"FREQ",
+	} {
+		_, err := Parse(rule, monday)
+		assert.ErrorIs(t, err, ErrBadRule, rule)
+	}
+}
+
+func TestCron_Next(t *testing.T) {
+	tt := []struct {
+		rule  string
+		after time.Time
+		want  time.Time
+	}{
+		{"* * * * *", monday, monday.Add(time.Minute)},
+		{"*/15 * * * *", monday, time.Date(2023, time.May, 1, 10, 45, 0, 0, time.UTC)},
+		{"0 9 * * MON", monday, time.Date(2023, time.May, 8, 9, 0, 0, 0, time.UTC)},
+		{"0 9 * * 1-5", monday, time.Date(2023, time.May, 2, 9, 0, 0, 0, time.UTC)},
+		{"0 0 * * 7", monday, time.Date(2023, time.May, 7, 0, 0, 0, 0, time.UTC)},
+		{"30 10 1 * *", monday, time.Date(2023, time.June, 1, 10, 30, 0, 0, time.UTC)},
+		{"0 12 13 * FRI", monday, time.Date(2023, time.May, 5, 12, 0, 0, 0, time.UTC)},
+		{"0 0 29 feb *", monday, time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC)},
+		{"0 0 1 1,7 *", monday, time.Date(2023, time.July, 1, 0, 0, 0, 0, time.UTC)},
+		{"@daily", monday, time.Date(2023, time.May, 2, 0, 0, 0, 0, time.UTC)},
+		{"@weekly", monday, time.Date(2023, time.May, 7, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tc := range tt {
+		t.Run(tc.rule, func(t *testing.T) {
+			r, err := Parse(tc.rule, monday)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, r.Next(tc.after))
+		})
+	}
+}
+
+func TestRRule_Next(t *testing.T) {
+	tt := []struct {
+		rule  string
+		after time.Time
+		want  time.Time
+	}{
+		{"FREQ=DAILY", monday, time.Date(2023, time.May, 2, 10, 30, 0, 0, time.UTC)},
+		{"RRULE:FREQ=DAILY;INTERVAL=3;BYHOUR=9;BYMINUTE=0", monday, time.Date(2023, time.May, 4, 9, 0, 0, 0, time.UTC)},
+		{"FREQ=DAILY;BYDAY=SA,SU", monday, time.Date(2023, time.May, 6, 10, 30, 0, 0, time.UTC)},
+		{"FREQ=WEEKLY", monday, time.Date(2023, time.May, 8, 10, 30, 0, 0, time.UTC)},
+		{"freq=weekly;byday=mo,fr;byhour=9;byminute=0", monday, time.Date(2023, time.May, 5, 9, 0, 0, 0, time.UTC)},
+		{"FREQ=WEEKLY;INTERVAL=2;BYDAY=WE", monday, time.Date(2023, time.May, 3, 10, 30, 0, 0, time.UTC)},
+		{"FREQ=WEEKLY;INTERVAL=2;BYDAY=WE", time.Date(2023, time.May, 3, 11, 0, 0, 0, time.UTC), time.Date(2023, time.May, 17, 10, 30, 0, 0, time.UTC)},
+		{"FREQ=MONTHLY", monday, time.Date(2023, time.June, 1, 10, 30, 0, 0, time.UTC)},
+		{"FREQ=MONTHLY;BYMONTHDAY=31", monday, time.Date(2023, time.May, 31, 10, 30, 0, 0, time.UTC)},
+		{"FREQ=MONTHLY;INTERVAL=6;BYMONTHDAY=15;BYHOUR=8,20;BYMINUTE=0", time.Date(2023, time.May, 15, 9, 0, 0, 0, time.UTC), time.Date(2023, time.May, 15, 20, 0, 0, 0, time.UTC)},
+		{"FREQ=MONTHLY;INTERVAL=6;BYMONTHDAY=15;BYHOUR=8,20;BYMINUTE=0", time.Date(2023, time.May, 15, 21, 0, 0, 0, time.UTC), time.Date(2023, time.November, 15, 8, 0, 0, 0, time.UTC)},
+		// occurrences before start are skipped.
+		{"FREQ=DAILY", monday.AddDate(0, 0, -10), time.Date(2023, time.May, 1, 10, 30, 0, 0, time.UTC)},
+	}
+	for _, tc := range tt {
+		t.Run(tc.rule, func(t *testing.T) {
+			r, err := Parse(tc.rule, monday)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, r.Next(tc.after))
+		})
+	}
+}
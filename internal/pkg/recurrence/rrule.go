@@ -0,0 +1,180 @@
+package recurrence
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Frequencies of RRULE.
+const (
+	freqDaily   = "DAILY"
+	freqWeekly  = "WEEKLY"
+	freqMonthly = "MONTHLY"
+)
+
+// rruleDays is names of days of week in RRULE.
+var rruleDays = map[string]time.Weekday{
+	"SU": time.Sunday,
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+}
+
+// rrule is schedule which is set by subset of RFC 5545 recurrence rule.
+type rrule struct {
+	freq     string
+	interval int
+	byDay    bits
+	byMDay   bits
+	byHour   bits
+	byMinute bits
+	start    time.Time
+}
+
+// parseRRule parses RRULE parts separated by semicolon.
+func parseRRule(rule string, start time.Time) (*rrule, error) {
+	r := &rrule{
+		interval: 1,
+		start:    start,
+	}
+	for _, part := range strings.Split(rule, ";") {
+		if part == "" {
+			continue
+		}
+		key, val, ok := strings.Cut(part, "=")
+		if !ok || val == "" {
+			return nil, fmt.Errorf("%w: bad part %q", ErrBadRule, part)
+		}
+
+		var err error
+		switch key {
+		case "FREQ":
+			switch val {
+			case freqDaily, freqWeekly, freqMonthly:
+				r.freq = val
+			default:
+				return nil, fmt.Errorf("%w: frequency %q is not supported", ErrBadRule, val)
+			}
+		case "INTERVAL":
+			if r.interval, err = strconv.Atoi(val); err != nil || r.interval <= 0 {
+				return nil, fmt.Errorf("%w: interval must be positive number", ErrBadRule)
+			}
+		case "BYDAY":
+			for _, d := range strings.Split(val, ",") {
+				day, ok := rruleDays[d]
+				if !ok {
+					return nil, fmt.Errorf("%w: bad day %q", ErrBadRule, d)
+				}
+				r.byDay = r.byDay.set(int(day))
+			}
+		case "BYMONTHDAY":
+			r.byMDay, err = parseNumbers(val, 1, 31)
+		case "BYHOUR":
+			r.byHour, err = parseNumbers(val, 0, 23)
+		case "BYMINUTE":
+			r.byMinute, err = parseNumbers(val, 0, 59)
+		default:
+			return nil, fmt.Errorf("%w: part %q is not supported", ErrBadRule, key)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if r.freq == "" {
+		return nil, fmt.Errorf("%w: FREQ is required", ErrBadRule)
+	}
+	if r.byHour == 0 {
+		r.byHour = r.byHour.set(start.Hour())
+	}
+	if r.byMinute == 0 {
+		r.byMinute = r.byMinute.set(start.Minute())
+	}
+	switch {
+	case r.freq == freqWeekly && r.byDay == 0:
+		r.byDay = r.byDay.set(int(start.Weekday()))
+	case r.freq == freqMonthly && r.byDay == 0 && r.byMDay == 0:
+		r.byMDay = r.byMDay.set(start.Day())
+	}
+	return r, nil
+}
+
+// parseNumbers parses comma separated list of numbers in range from min to max.
+func parseNumbers(val string, min, max int) (bits, error) {
+	var res bits
+	for _, s := range strings.Split(val, ",") {
+		n, err := strconv.Atoi(s)
+		if err != nil || n < min || n > max {
+			return 0, fmt.Errorf("%w: value %q must be in range %d-%d", ErrBadRule, s, min, max)
+		}
+		res = res.set(n)
+	}
+	return res, nil
+}
+
+// inPeriod return true if day is in period which is selected by interval.
+func (r *rrule) inPeriod(day time.Time) bool {
+	start := startOfDay(r.start)
+	switch r.freq {
+	case freqDaily:
+		return int(day.Sub(start).Hours()/24)%r.interval == 0
+	case freqWeekly:
+		// weeks are started on monday.
+		monday := func(t time.Time) time.Time {
+			return t.AddDate(0, 0, -(int(t.Weekday())+6)%7)
+		}
+		return int(monday(day).Sub(monday(start)).Hours()/24/7)%r.interval == 0
+	default:
+		months := (day.Year()-start.Year())*12 + int(day.Month()) - int(start.Month())
+		return months%r.interval == 0
+	}
+}
+
+// dayMatches return true if events are occurred in day.
+func (r *rrule) dayMatches(day time.Time) bool {
+	if !r.inPeriod(day) {
+		return false
+	}
+	if r.byDay != 0 && !r.byDay.has(int(day.Weekday())) {
+		return false
+	}
+	if r.byMDay != 0 && !r.byMDay.has(day.Day()) {
+		return false
+	}
+	return true
+}
+
+// Next implements Rule.
+func (r *rrule) Next(after time.Time) time.Time {
+	after = after.UTC()
+	day := startOfDay(after)
+	if day.Before(startOfDay(r.start)) {
+		day = startOfDay(r.start)
+	}
+	limit := day.Add(searchLimit)
+	for ; day.Before(limit); day = day.AddDate(0, 0, 1) {
+		if !r.dayMatches(day) {
+			continue
+		}
+		for h := 0; h < 24; h++ {
+			if !r.byHour.has(h) {
+				continue
+			}
+			for m := 0; m < 60; m++ {
+				if !r.byMinute.has(m) {
+					continue
+				}
+				t := day.Add(time.Duration(h)*time.Hour + time.Duration(m)*time.Minute)
+				if t.After(after) && !t.Before(r.start) {
+					return t
+				}
+			}
+		}
+	}
+	return time.Time{}
+}
@@ -98,4 +98,142 @@ var (
 		"limit": "limit must be not null positive integer number",
 	}, fielderr.CodeBadRequest)
 	ErrTaskAlreadyExists = fielderr.New("unique violation", "task already exists", fielderr.CodeConflict)
+	ErrBadTaskStatus     = fielderr.New("bad status", map[string]string{
+		"status": "status must be one of NEW, IN_PROGRESS, ON_REVIEW, CHANGES_REQUESTED, CLOSED, ACCEPTED",
+	}, fielderr.CodeBadRequest)
+	ErrBadReviewStatus = fielderr.New("bad review status", map[string]string{
+		"status": "status must be CHANGES_REQUESTED or ACCEPTED",
+	}, fielderr.CodeBadRequest)
+	ErrReviewAlreadyResolved = fielderr.New("review already resolved", map[string]string{
+		"review": "review was already resolved",
+	}, fielderr.CodeConflict)
+	ErrStatusTransition = fielderr.New("status transition is not allowed", map[string]string{
+		"status": "transition is not allowed",
+	}, fielderr.CodeConflict)
+	ErrBadTaskName = fielderr.New("bad task name", map[string]string{
+		"name": "task name must not be empty",
+	}, fielderr.CodeBadRequest)
+	ErrBadTaskDeadline = fielderr.New("bad task deadline", map[string]string{
+		"deadline": "deadline could not be set and cleared at once",
+	}, fielderr.CodeBadRequest)
+	ErrBadTaskSort = fielderr.New("bad sort", map[string]string{
+		"sort": "sort must be one of created-at, deadline, name",
+	}, fielderr.CodeBadRequest)
+	ErrBadPageLimit = fielderr.New("bad limit", map[string]string{
+		"limit": "limit must be positive integer number",
+	}, fielderr.CodeBadRequest)
+	ErrEmptyComment = fielderr.New("empty comment", map[string]string{
+		"message": "comment message must not be empty",
+	}, fielderr.CodeBadRequest)
+	ErrBadReplyTo = fielderr.New("bad reply", map[string]string{
+		"reply-to": "comment to reply must exist in same task",
+	}, fielderr.CodeBadRequest)
+	ErrBadParentTask = fielderr.New("bad parent task", map[string]string{
+		"parent": "parent task must exist and be available to you",
+	}, fielderr.CodeBadRequest)
+	ErrOpenSubtasks = fielderr.New("task has open subtasks", map[string]string{
+		"status": "task could not be closed while it has subtasks which are not closed or accepted",
+	}, fielderr.CodeConflict)
+	ErrBadDependency = fielderr.New("bad dependency", map[string]string{
+		"blocked-by": "blocking task must exist, be available to you and be in same group with task",
+	}, fielderr.CodeBadRequest)
+	ErrDependencyCycle = fielderr.New("dependency cycle", map[string]string{
+		"blocked-by": "link would create cycle of dependencies",
+	}, fielderr.CodeConflict)
+	ErrTaskBlocked = fielderr.New("task is blocked", map[string]string{
+		"status": "task is blocked by tasks which are not closed or accepted",
+	}, fielderr.CodeConflict)
+	ErrBadLabelName = fielderr.New("bad label name", map[string]string{
+		"name": "label name must not be empty",
+	}, fielderr.CodeBadRequest)
+	ErrBadLabelColor = fielderr.New("bad label color", map[string]string{
+		"color": "color must be hex RGB color like #1f883d",
+	}, fielderr.CodeBadRequest)
+	ErrLabelAlreadyExists = fielderr.New("label already exists", map[string]string{
+		"name": "label with provided name already exists in group",
+	}, fielderr.CodeConflict)
+	ErrBadLabel = fielderr.New("bad label", map[string]string{
+		"label": "task must be related to group of label",
+	}, fielderr.CodeBadRequest)
+	ErrEmptySearchQuery = fielderr.New("empty search query", map[string]string{
+		"q": "search query must not be empty",
+	}, fielderr.CodeBadRequest)
+	ErrWIPLimitExceeded = fielderr.New("work in progress limit exceeded", map[string]string{
+		"status": "column has reached its work in progress limit",
+	}, fielderr.CodeConflict)
+	ErrBadBoardLimit = fielderr.New("bad board limit", map[string]string{
+		"limits": "limit of column must not be negative",
+	}, fielderr.CodeBadRequest)
+	ErrBadRecurrence = fielderr.New("bad recurrence rule", map[string]string{
+		"recurrence": "recurrence must be cron expression like \"0 9 * * MON\" or RRULE like \"FREQ=WEEKLY;BYDAY=MO\"",
+	}, fielderr.CodeBadRequest)
+	ErrBadTemplateAssignees = fielderr.New("bad template assignees", map[string]string{
+		"assignees": "assignees of template must be members of group",
+	}, fielderr.CodeBadRequest)
+	ErrBadTemplateLabels = fielderr.New("bad template labels", map[string]string{
+		"labels": "labels of template must belong to group",
+	}, fielderr.CodeBadRequest)
+	ErrBadTemplate = fielderr.New("bad template", map[string]string{
+		"template": "template must exist in group of task",
+	}, fielderr.CodeBadRequest)
+	ErrTaskNotOnBoard = fielderr.New("task is not on board", map[string]string{
+		"task": "task must be related to group of board",
+	}, fielderr.CodeBadRequest)
+	ErrAttachmentTooLarge = fielderr.New("attachment is too large", map[string]string{
+		"file": "size of file exceeds limit",
+	}, fielderr.CodeTooLarge)
+	ErrBadAttachment = fielderr.New("bad attachment", map[string]string{
+		"comment": "comment must be written by user in same task",
+	}, fielderr.CodeBadRequest)
+	ErrBadChecklistItem = fielderr.New("bad checklist item", map[string]string{
+		"text": "checklist item text must not be empty",
+	}, fielderr.CodeBadRequest)
+	ErrTimerAlreadyRunning = fielderr.New("timer is already running", map[string]string{
+		"timer": "stop running timer before starting new one",
+	}, fielderr.CodeConflict)
+	ErrNoRunningTimer = fielderr.New("no running timer", map[string]string{
+		"timer": "there is no running timer",
+	}, fielderr.CodeNotFound)
+	ErrBadWorklog = fielderr.New("bad worklog", map[string]string{
+		"stopped-at": "work must be stopped after it was started and not in future",
+	}, fielderr.CodeBadRequest)
+	ErrBadReportPeriod = fielderr.New("bad report period", map[string]string{
+		"from": "period must be set and start before its end",
+	}, fielderr.CodeBadRequest)
+	ErrBadReportGrouping = fielderr.New("bad report grouping", map[string]string{
+		"by": "report could be grouped by user, task or group",
+	}, fielderr.CodeBadRequest)
+	ErrBadBulkRequest = fielderr.New("bad bulk request", map[string]string{
+		"tasks": "provide from 1 to 100 task ids",
+	}, fielderr.CodeBadRequest)
+	ErrBadBulkOperation = fielderr.New("bad bulk operation", map[string]string{
+		"operation": "operation must be one of set-status, assign, unassign, add-label, set-deadline or delete",
+	}, fielderr.CodeBadRequest)
+	ErrBadAssignee = fielderr.New("bad assignee", map[string]string{
+		"user": "assignee must be member of group of task",
+	}, fielderr.CodeBadRequest)
+	ErrBadStatsPeriod = fielderr.New("bad statistics period", map[string]string{
+		"from": "period must start before its end and be at most two years long",
+	}, fielderr.CodeBadRequest)
+	ErrBadGroupProfile = fielderr.New("bad group profile", map[string]string{
+		"name": "name of group must not be empty",
+	}, fielderr.CodeBadRequest)
+	ErrLastAdmin = fielderr.New("last admin could not leave group", map[string]string{
+		"user": "make another member admin of group before leaving it",
+	}, fielderr.CodeConflict)
+	ErrBadMemberRole = fielderr.New("bad member role", map[string]string{
+		"role": "role must be one of roles of group",
+	}, fielderr.CodeBadRequest)
+	ErrRoleEscalation = fielderr.New("could not grant permissions", map[string]string{
+		"role": "you could not grant more permissions than you have",
+	}, fielderr.CodeForbidden)
+	ErrBadRole = fielderr.New("bad role", map[string]string{
+		"name": "name of role must not be empty and be at most 64 characters long",
+	}, fielderr.CodeBadRequest)
+	ErrRoleAlreadyExists = fielderr.New("role already exists", map[string]string{
+		"role": "group already has role with provided name or permissions",
+	}, fielderr.CodeConflict)
+	ErrRoleInUse = fielderr.New("role is in use", map[string]string{
+		"role": "role is given to members or invites of group",
+	}, fielderr.CodeConflict)
 )
@@ -3,6 +3,8 @@ package service
 
 import (
 	"context"
+	"io"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/vlad-marlo/godo/internal/model"
@@ -21,16 +23,148 @@ type Interface interface {
 	GetUserFromToken(ctx context.Context, t string) (uuid.UUID, error)
 	// CreateGroup create new group.
 	CreateGroup(ctx context.Context, user uuid.UUID, name, description string) (*model.CreateGroupResponse, error)
+	// GetGroups return groups in which user is member.
+	GetGroups(ctx context.Context, user uuid.UUID) (*model.GetGroupsResponse, error)
+	// GetGroup return profile of group.
+	GetGroup(ctx context.Context, user, group uuid.UUID) (*model.GroupProfile, error)
+	// UpdateGroup changes profile of group.
+	UpdateGroup(ctx context.Context, user, group uuid.UUID, req model.UpdateGroupRequest) (*model.GroupProfile, error)
+	// GetGroupMembers return members of group.
+	GetGroupMembers(ctx context.Context, user, group uuid.UUID) (*model.GetMembersResponse, error)
+	// RemoveGroupMember removes member from group.
+	RemoveGroupMember(ctx context.Context, user, group, member uuid.UUID) error
+	// LeaveGroup removes user from group.
+	LeaveGroup(ctx context.Context, user, group uuid.UUID) error
+	// UpdateGroupMember changes role and admin flag of member.
+	UpdateGroupMember(ctx context.Context, user, group, member uuid.UUID, req model.UpdateMemberRequest) (*model.UserInGroup, error)
+	// GetGroupAuditLog return audit log of group.
+	GetGroupAuditLog(ctx context.Context, user, group uuid.UUID, after int64, limit int) (*model.GetAuditLogResponse, error)
+	// GetGroupRoles return roles of group.
+	GetGroupRoles(ctx context.Context, user, group uuid.UUID) (*model.GetRolesResponse, error)
+	// CreateGroupRole creates named role of group.
+	CreateGroupRole(ctx context.Context, user, group uuid.UUID, req model.CreateRoleRequest) (*model.Role, error)
+	// DeleteGroupRole deletes role of group.
+	DeleteGroupRole(ctx context.Context, user, group uuid.UUID, role int32) error
 	// CreateInvite creates invite link on which user will insert into group.
-	CreateInvite(ctx context.Context, user uuid.UUID, group uuid.UUID, role *model.Role, limit int) (*model.CreateInviteResponse, error)
+	CreateInvite(ctx context.Context, user uuid.UUID, group uuid.UUID, role int32, limit int) (*model.CreateInviteResponse, error)
 	// UseInvite applies use to group if invite data is ok.
 	UseInvite(ctx context.Context, user uuid.UUID, group uuid.UUID, invite uuid.UUID) error
 	// GetMe ...
 	GetMe(ctx context.Context, user uuid.UUID) (*model.GetMeResponse, error)
-	// GetUserTasks return all tasks, related to user.
-	GetUserTasks(ctx context.Context, user uuid.UUID) (*model.GetTasksResponse, error)
+	// GetUserTasks return all tasks, related to user, which satisfy filter.
+	GetUserTasks(ctx context.Context, user uuid.UUID, filter model.TaskFilter) (*model.GetTasksResponse, error)
 	// GetTask return task by id if user is related to it.
 	GetTask(ctx context.Context, user, task uuid.UUID) (*model.Task, error)
-	// CreateTask ...
-	CreateTask(ctx context.Context, user uuid.UUID, task model.TaskCreateRequest) (*model.Task, error)
+	// CreateTask creates task and relates it to group and assignees in one transaction.
+	CreateTask(ctx context.Context, user uuid.UUID, task model.TaskCreateRequest) (*model.CreateTaskResponse, error)
+	// UpdateTask changes task if user is creator of task or could change all tasks in group.
+	UpdateTask(ctx context.Context, user, task uuid.UUID, req model.TaskUpdateRequest) (*model.Task, error)
+	// DeleteTask deletes task if user is creator of task or could change all tasks in group.
+	DeleteTask(ctx context.Context, user, task uuid.UUID) error
+	// BulkTasks applies one operation to many tasks in one transaction and return result for every task.
+	BulkTasks(ctx context.Context, user uuid.UUID, req model.BulkTaskRequest) (*model.BulkTaskResponse, error)
+	// GetTaskWatchers return users who watch task.
+	GetTaskWatchers(ctx context.Context, user, task uuid.UUID) (*model.GetWatchersResponse, error)
+	// WatchTask subscribes user to changes of task.
+	WatchTask(ctx context.Context, user, task uuid.UUID) error
+	// UnwatchTask unsubscribes user from changes of task.
+	UnwatchTask(ctx context.Context, user, task uuid.UUID) error
+	// GetNotifications return changes of tasks watched by user which were made by other users.
+	GetNotifications(ctx context.Context, user uuid.UUID, after int64, limit int) (*model.GetNotificationsResponse, error)
+	// GetTaskHistory return changes of task if user could read task.
+	GetTaskHistory(ctx context.Context, user, task uuid.UUID) (*model.GetTaskHistoryResponse, error)
+	// GetTaskAttachments return attachments of task which are visible to user.
+	GetTaskAttachments(ctx context.Context, user, task uuid.UUID) (*model.GetAttachmentsResponse, error)
+	// UploadAttachment stores file and attaches it to task or to comment of task.
+	UploadAttachment(ctx context.Context, user, task uuid.UUID, req model.UploadAttachmentRequest) (*model.Attachment, error)
+	// GetAttachment return attachment of task with reader of its content.
+	GetAttachment(ctx context.Context, user, task, attachment uuid.UUID) (*model.Attachment, io.ReadCloser, error)
+	// DeleteAttachment deletes attachment of task.
+	DeleteAttachment(ctx context.Context, user, task, attachment uuid.UUID) error
+	// GetChecklist return checklist of task ordered by position.
+	GetChecklist(ctx context.Context, user, task uuid.UUID) (*model.GetChecklistResponse, error)
+	// CreateChecklistItem adds item to the end of task checklist.
+	CreateChecklistItem(ctx context.Context, user, task uuid.UUID, req model.CreateChecklistItemRequest) (*model.ChecklistItem, error)
+	// ToggleChecklistItem marks checklist item as done or not done.
+	ToggleChecklistItem(ctx context.Context, user, task, item uuid.UUID, req model.ToggleChecklistItemRequest) (*model.ChecklistItem, error)
+	// MoveChecklistItem moves item to position in task checklist.
+	MoveChecklistItem(ctx context.Context, user, task, item uuid.UUID, req model.MoveChecklistItemRequest) (*model.GetChecklistResponse, error)
+	// DeleteChecklistItem deletes item from task checklist.
+	DeleteChecklistItem(ctx context.Context, user, task, item uuid.UUID) error
+	// StartTimer starts timer of user on task.
+	StartTimer(ctx context.Context, user, task uuid.UUID, req model.StartTimerRequest) (*model.Worklog, error)
+	// StopTimer stops running timer of user.
+	StopTimer(ctx context.Context, user uuid.UUID) (*model.Worklog, error)
+	// GetRunningTimer return running timer of user.
+	GetRunningTimer(ctx context.Context, user uuid.UUID) (*model.Worklog, error)
+	// CreateWorklog logs time which user spent on task manually.
+	CreateWorklog(ctx context.Context, user, task uuid.UUID, req model.CreateWorklogRequest) (*model.Worklog, error)
+	// GetTaskWorklogs return time which was logged on task.
+	GetTaskWorklogs(ctx context.Context, user, task uuid.UUID) (*model.GetWorklogsResponse, error)
+	// DeleteWorklog deletes worklog of task.
+	DeleteWorklog(ctx context.Context, user, task, worklog uuid.UUID) error
+	// GetGroupStats return statistics of group tasks over period.
+	GetGroupStats(ctx context.Context, user, group uuid.UUID, from, to time.Time) (*model.GroupStats, error)
+	// GetWorklogReport return logged time over period aggregated by user, task or group.
+	GetWorklogReport(ctx context.Context, user uuid.UUID, filter model.WorklogReportFilter) (*model.WorklogReport, error)
+	// PurgeOrphanedBlobs deletes contents of deleted attachments.
+	PurgeOrphanedBlobs(ctx context.Context) (int, error)
+	// GetSubtasks return subtasks of task which are visible to user.
+	GetSubtasks(ctx context.Context, user, task uuid.UUID) (*model.GetTasksResponse, error)
+	// AddDependency links task with task which blocks it.
+	AddDependency(ctx context.Context, user, task uuid.UUID, req model.AddDependencyRequest) (*model.TaskDependency, error)
+	// RemoveDependency deletes link between task and its blocker.
+	RemoveDependency(ctx context.Context, user, task, blocker uuid.UUID) error
+	// GetDependencyGraph return tasks of group and links between them.
+	GetDependencyGraph(ctx context.Context, user, group uuid.UUID) (*model.DependencyGraph, error)
+	// GetGroupLabels return labels of group.
+	GetGroupLabels(ctx context.Context, user, group uuid.UUID) (*model.GetLabelsResponse, error)
+	// CreateLabel creates label in group.
+	CreateLabel(ctx context.Context, user, group uuid.UUID, req model.CreateLabelRequest) (*model.Label, error)
+	// UpdateLabel changes name and color of label.
+	UpdateLabel(ctx context.Context, user, group, label uuid.UUID, req model.UpdateLabelRequest) (*model.Label, error)
+	// DeleteLabel deletes label of group.
+	DeleteLabel(ctx context.Context, user, group, label uuid.UUID) error
+	// AttachLabel attaches label to task.
+	AttachLabel(ctx context.Context, user, task, label uuid.UUID) error
+	// DetachLabel detaches label from task.
+	DetachLabel(ctx context.Context, user, task, label uuid.UUID) error
+	// GetBoard return tasks of group bucketed by status in manual order.
+	GetBoard(ctx context.Context, user, group uuid.UUID) (*model.Board, error)
+	// MoveTask changes status of task and its position on board of group.
+	MoveTask(ctx context.Context, user, group, task uuid.UUID, req model.MoveTaskRequest) (*model.Board, error)
+	// SetBoardLimits changes work-in-progress limits of board columns of group.
+	SetBoardLimits(ctx context.Context, user, group uuid.UUID, req model.BoardLimitsRequest) (*model.Board, error)
+	// GetGroupTemplates return task templates of group.
+	GetGroupTemplates(ctx context.Context, user, group uuid.UUID) (*model.GetTemplatesResponse, error)
+	// CreateTemplate creates task template in group.
+	CreateTemplate(ctx context.Context, user, group uuid.UUID, req model.CreateTemplateRequest) (*model.TaskTemplate, error)
+	// GetTemplate return task template of group.
+	GetTemplate(ctx context.Context, user, group, template uuid.UUID) (*model.TaskTemplate, error)
+	// UpdateTemplate changes task template of group.
+	UpdateTemplate(ctx context.Context, user, group, template uuid.UUID, req model.UpdateTemplateRequest) (*model.TaskTemplate, error)
+	// DeleteTemplate deletes task template of group.
+	DeleteTemplate(ctx context.Context, user, group, template uuid.UUID) error
+	// RunRecurringTasks creates tasks from recurring templates which runs are due at now.
+	RunRecurringTasks(ctx context.Context, now time.Time) (int, error)
+	// UpdateTaskStatus moves task into provided status.
+	UpdateTaskStatus(ctx context.Context, user, task uuid.UUID, status model.TaskStatus) (*model.Task, error)
+	// SubmitReview sends task to review.
+	SubmitReview(ctx context.Context, user, task uuid.UUID, msg string) (*model.Review, error)
+	// GetPendingReviews return reviews of group that are waiting for resolution.
+	GetPendingReviews(ctx context.Context, user, group uuid.UUID) (*model.GetReviewsResponse, error)
+	// ResolveReview resolves review and moves reviewed task into resolution status.
+	ResolveReview(ctx context.Context, user, review uuid.UUID, req model.ResolveReviewRequest) (*model.Review, error)
+	// GetTaskComments return comments of task which are available to user.
+	GetTaskComments(ctx context.Context, user, task uuid.UUID) (*model.GetCommentsResponse, error)
+	// CreateComment creates comment to task or reply to another comment.
+	CreateComment(ctx context.Context, user, task uuid.UUID, req model.CreateCommentRequest) (*model.Comment, error)
+	// UpdateComment changes message of comment and stores previous one in history.
+	UpdateComment(ctx context.Context, user, task, comment uuid.UUID, req model.UpdateCommentRequest) (*model.Comment, error)
+	// DeleteComment deletes comment.
+	DeleteComment(ctx context.Context, user, task, comment uuid.UUID) error
+	// GetCommentHistory return previous versions of comment.
+	GetCommentHistory(ctx context.Context, user, task, comment uuid.UUID) (*model.GetCommentHistoryResponse, error)
+	// Search return tasks visible to user which name, description or comments match query.
+	Search(ctx context.Context, user uuid.UUID, query string, limit int) (*model.SearchResponse, error)
 }
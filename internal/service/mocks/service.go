@@ -6,7 +6,9 @@ package mocks
 
 import (
 	context "context"
+	io "io"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	uuid "github.com/google/uuid"
@@ -36,94 +38,700 @@ func (m *MockInterface) EXPECT() *MockInterfaceMockRecorder {
 	return m.recorder
 }
 
+// AddDependency mocks base method.
+func (m *MockInterface) AddDependency(ctx context.Context, user, task uuid.UUID, req model.AddDependencyRequest) (*model.TaskDependency, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddDependency", ctx, user, task, req)
+	ret0, _ := ret[0].(*model.TaskDependency)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddDependency indicates an expected call of AddDependency.
+func (mr *MockInterfaceMockRecorder) AddDependency(ctx, user, task, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddDependency", reflect.TypeOf((*MockInterface)(nil).AddDependency), ctx, user, task, req)
+}
+
+// AttachLabel mocks base method.
+func (m *MockInterface) AttachLabel(ctx context.Context, user, task, label uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AttachLabel", ctx, user, task, label)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AttachLabel indicates an expected call of AttachLabel.
+func (mr *MockInterfaceMockRecorder) AttachLabel(ctx, user, task, label interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachLabel", reflect.TypeOf((*MockInterface)(nil).AttachLabel), ctx, user, task, label)
+}
+
+// BulkTasks mocks base method.
+func (m *MockInterface) BulkTasks(ctx context.Context, user uuid.UUID, req model.BulkTaskRequest) (*model.BulkTaskResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BulkTasks", ctx, user, req)
+	ret0, _ := ret[0].(*model.BulkTaskResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BulkTasks indicates an expected call of BulkTasks.
+func (mr *MockInterfaceMockRecorder) BulkTasks(ctx, user, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkTasks", reflect.TypeOf((*MockInterface)(nil).BulkTasks), ctx, user, req)
+}
+
+// CreateChecklistItem mocks base method.
+func (m *MockInterface) CreateChecklistItem(ctx context.Context, user, task uuid.UUID, req model.CreateChecklistItemRequest) (*model.ChecklistItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateChecklistItem", ctx, user, task, req)
+	ret0, _ := ret[0].(*model.ChecklistItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateChecklistItem indicates an expected call of CreateChecklistItem.
+func (mr *MockInterfaceMockRecorder) CreateChecklistItem(ctx, user, task, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateChecklistItem", reflect.TypeOf((*MockInterface)(nil).CreateChecklistItem), ctx, user, task, req)
+}
+
+// CreateComment mocks base method.
+func (m *MockInterface) CreateComment(ctx context.Context, user, task uuid.UUID, req model.CreateCommentRequest) (*model.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateComment", ctx, user, task, req)
+	ret0, _ := ret[0].(*model.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateComment indicates an expected call of CreateComment.
+func (mr *MockInterfaceMockRecorder) CreateComment(ctx, user, task, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateComment", reflect.TypeOf((*MockInterface)(nil).CreateComment), ctx, user, task, req)
+}
+
 // CreateGroup mocks base method.
 func (m *MockInterface) CreateGroup(ctx context.Context, user uuid.UUID, name, description string) (*model.CreateGroupResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateGroup", ctx, user, name, description)
-	ret0, _ := ret[0].(*model.CreateGroupResponse)
+	ret := m.ctrl.Call(m, "CreateGroup", ctx, user, name, description)
+	ret0, _ := ret[0].(*model.CreateGroupResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateGroup indicates an expected call of CreateGroup.
+func (mr *MockInterfaceMockRecorder) CreateGroup(ctx, user, name, description interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGroup", reflect.TypeOf((*MockInterface)(nil).CreateGroup), ctx, user, name, description)
+}
+
+// CreateGroupRole mocks base method.
+func (m *MockInterface) CreateGroupRole(ctx context.Context, user, group uuid.UUID, req model.CreateRoleRequest) (*model.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateGroupRole", ctx, user, group, req)
+	ret0, _ := ret[0].(*model.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateGroupRole indicates an expected call of CreateGroupRole.
+func (mr *MockInterfaceMockRecorder) CreateGroupRole(ctx, user, group, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGroupRole", reflect.TypeOf((*MockInterface)(nil).CreateGroupRole), ctx, user, group, req)
+}
+
+// CreateInvite mocks base method.
+func (m *MockInterface) CreateInvite(ctx context.Context, user, group uuid.UUID, role int32, limit int) (*model.CreateInviteResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateInvite", ctx, user, group, role, limit)
+	ret0, _ := ret[0].(*model.CreateInviteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateInvite indicates an expected call of CreateInvite.
+func (mr *MockInterfaceMockRecorder) CreateInvite(ctx, user, group, role, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInvite", reflect.TypeOf((*MockInterface)(nil).CreateInvite), ctx, user, group, role, limit)
+}
+
+// CreateLabel mocks base method.
+func (m *MockInterface) CreateLabel(ctx context.Context, user, group uuid.UUID, req model.CreateLabelRequest) (*model.Label, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLabel", ctx, user, group, req)
+	ret0, _ := ret[0].(*model.Label)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateLabel indicates an expected call of CreateLabel.
+func (mr *MockInterfaceMockRecorder) CreateLabel(ctx, user, group, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLabel", reflect.TypeOf((*MockInterface)(nil).CreateLabel), ctx, user, group, req)
+}
+
+// CreateTask mocks base method.
+func (m *MockInterface) CreateTask(ctx context.Context, user uuid.UUID, task model.TaskCreateRequest) (*model.CreateTaskResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTask", ctx, user, task)
+	ret0, _ := ret[0].(*model.CreateTaskResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTask indicates an expected call of CreateTask.
+func (mr *MockInterfaceMockRecorder) CreateTask(ctx, user, task interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTask", reflect.TypeOf((*MockInterface)(nil).CreateTask), ctx, user, task)
+}
+
+// CreateTemplate mocks base method.
+func (m *MockInterface) CreateTemplate(ctx context.Context, user, group uuid.UUID, req model.CreateTemplateRequest) (*model.TaskTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTemplate", ctx, user, group, req)
+	ret0, _ := ret[0].(*model.TaskTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTemplate indicates an expected call of CreateTemplate.
+func (mr *MockInterfaceMockRecorder) CreateTemplate(ctx, user, group, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTemplate", reflect.TypeOf((*MockInterface)(nil).CreateTemplate), ctx, user, group, req)
+}
+
+// CreateToken mocks base method.
+func (m *MockInterface) CreateToken(ctx context.Context, username, password, token string) (*model.CreateTokenResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateToken", ctx, username, password, token)
+	ret0, _ := ret[0].(*model.CreateTokenResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateToken indicates an expected call of CreateToken.
+func (mr *MockInterfaceMockRecorder) CreateToken(ctx, username, password, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateToken", reflect.TypeOf((*MockInterface)(nil).CreateToken), ctx, username, password, token)
+}
+
+// CreateWorklog mocks base method.
+func (m *MockInterface) CreateWorklog(ctx context.Context, user, task uuid.UUID, req model.CreateWorklogRequest) (*model.Worklog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWorklog", ctx, user, task, req)
+	ret0, _ := ret[0].(*model.Worklog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWorklog indicates an expected call of CreateWorklog.
+func (mr *MockInterfaceMockRecorder) CreateWorklog(ctx, user, task, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWorklog", reflect.TypeOf((*MockInterface)(nil).CreateWorklog), ctx, user, task, req)
+}
+
+// DeleteAttachment mocks base method.
+func (m *MockInterface) DeleteAttachment(ctx context.Context, user, task, attachment uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAttachment", ctx, user, task, attachment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAttachment indicates an expected call of DeleteAttachment.
+func (mr *MockInterfaceMockRecorder) DeleteAttachment(ctx, user, task, attachment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAttachment", reflect.TypeOf((*MockInterface)(nil).DeleteAttachment), ctx, user, task, attachment)
+}
+
+// DeleteChecklistItem mocks base method.
+func (m *MockInterface) DeleteChecklistItem(ctx context.Context, user, task, item uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteChecklistItem", ctx, user, task, item)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteChecklistItem indicates an expected call of DeleteChecklistItem.
+func (mr *MockInterfaceMockRecorder) DeleteChecklistItem(ctx, user, task, item interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteChecklistItem", reflect.TypeOf((*MockInterface)(nil).DeleteChecklistItem), ctx, user, task, item)
+}
+
+// DeleteComment mocks base method.
+func (m *MockInterface) DeleteComment(ctx context.Context, user, task, comment uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteComment", ctx, user, task, comment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteComment indicates an expected call of DeleteComment.
+func (mr *MockInterfaceMockRecorder) DeleteComment(ctx, user, task, comment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteComment", reflect.TypeOf((*MockInterface)(nil).DeleteComment), ctx, user, task, comment)
+}
+
+// DeleteGroupRole mocks base method.
+func (m *MockInterface) DeleteGroupRole(ctx context.Context, user, group uuid.UUID, role int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteGroupRole", ctx, user, group, role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteGroupRole indicates an expected call of DeleteGroupRole.
+func (mr *MockInterfaceMockRecorder) DeleteGroupRole(ctx, user, group, role interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGroupRole", reflect.TypeOf((*MockInterface)(nil).DeleteGroupRole), ctx, user, group, role)
+}
+
+// DeleteLabel mocks base method.
+func (m *MockInterface) DeleteLabel(ctx context.Context, user, group, label uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLabel", ctx, user, group, label)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteLabel indicates an expected call of DeleteLabel.
+func (mr *MockInterfaceMockRecorder) DeleteLabel(ctx, user, group, label interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLabel", reflect.TypeOf((*MockInterface)(nil).DeleteLabel), ctx, user, group, label)
+}
+
+// DeleteTask mocks base method.
+func (m *MockInterface) DeleteTask(ctx context.Context, user, task uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTask", ctx, user, task)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTask indicates an expected call of DeleteTask.
+func (mr *MockInterfaceMockRecorder) DeleteTask(ctx, user, task interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTask", reflect.TypeOf((*MockInterface)(nil).DeleteTask), ctx, user, task)
+}
+
+// DeleteTemplate mocks base method.
+func (m *MockInterface) DeleteTemplate(ctx context.Context, user, group, template uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTemplate", ctx, user, group, template)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTemplate indicates an expected call of DeleteTemplate.
+func (mr *MockInterfaceMockRecorder) DeleteTemplate(ctx, user, group, template interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTemplate", reflect.TypeOf((*MockInterface)(nil).DeleteTemplate), ctx, user, group, template)
+}
+
+// DeleteWorklog mocks base method.
+func (m *MockInterface) DeleteWorklog(ctx context.Context, user, task, worklog uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWorklog", ctx, user, task, worklog)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWorklog indicates an expected call of DeleteWorklog.
+func (mr *MockInterfaceMockRecorder) DeleteWorklog(ctx, user, task, worklog interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorklog", reflect.TypeOf((*MockInterface)(nil).DeleteWorklog), ctx, user, task, worklog)
+}
+
+// DetachLabel mocks base method.
+func (m *MockInterface) DetachLabel(ctx context.Context, user, task, label uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetachLabel", ctx, user, task, label)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DetachLabel indicates an expected call of DetachLabel.
+func (mr *MockInterfaceMockRecorder) DetachLabel(ctx, user, task, label interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetachLabel", reflect.TypeOf((*MockInterface)(nil).DetachLabel), ctx, user, task, label)
+}
+
+// GetAttachment mocks base method.
+func (m *MockInterface) GetAttachment(ctx context.Context, user, task, attachment uuid.UUID) (*model.Attachment, io.ReadCloser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttachment", ctx, user, task, attachment)
+	ret0, _ := ret[0].(*model.Attachment)
+	ret1, _ := ret[1].(io.ReadCloser)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAttachment indicates an expected call of GetAttachment.
+func (mr *MockInterfaceMockRecorder) GetAttachment(ctx, user, task, attachment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttachment", reflect.TypeOf((*MockInterface)(nil).GetAttachment), ctx, user, task, attachment)
+}
+
+// GetBoard mocks base method.
+func (m *MockInterface) GetBoard(ctx context.Context, user, group uuid.UUID) (*model.Board, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBoard", ctx, user, group)
+	ret0, _ := ret[0].(*model.Board)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBoard indicates an expected call of GetBoard.
+func (mr *MockInterfaceMockRecorder) GetBoard(ctx, user, group interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoard", reflect.TypeOf((*MockInterface)(nil).GetBoard), ctx, user, group)
+}
+
+// GetChecklist mocks base method.
+func (m *MockInterface) GetChecklist(ctx context.Context, user, task uuid.UUID) (*model.GetChecklistResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChecklist", ctx, user, task)
+	ret0, _ := ret[0].(*model.GetChecklistResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChecklist indicates an expected call of GetChecklist.
+func (mr *MockInterfaceMockRecorder) GetChecklist(ctx, user, task interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChecklist", reflect.TypeOf((*MockInterface)(nil).GetChecklist), ctx, user, task)
+}
+
+// GetCommentHistory mocks base method.
+func (m *MockInterface) GetCommentHistory(ctx context.Context, user, task, comment uuid.UUID) (*model.GetCommentHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCommentHistory", ctx, user, task, comment)
+	ret0, _ := ret[0].(*model.GetCommentHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCommentHistory indicates an expected call of GetCommentHistory.
+func (mr *MockInterfaceMockRecorder) GetCommentHistory(ctx, user, task, comment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommentHistory", reflect.TypeOf((*MockInterface)(nil).GetCommentHistory), ctx, user, task, comment)
+}
+
+// GetDependencyGraph mocks base method.
+func (m *MockInterface) GetDependencyGraph(ctx context.Context, user, group uuid.UUID) (*model.DependencyGraph, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDependencyGraph", ctx, user, group)
+	ret0, _ := ret[0].(*model.DependencyGraph)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDependencyGraph indicates an expected call of GetDependencyGraph.
+func (mr *MockInterfaceMockRecorder) GetDependencyGraph(ctx, user, group interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDependencyGraph", reflect.TypeOf((*MockInterface)(nil).GetDependencyGraph), ctx, user, group)
+}
+
+// GetGroup mocks base method.
+func (m *MockInterface) GetGroup(ctx context.Context, user, group uuid.UUID) (*model.GroupProfile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroup", ctx, user, group)
+	ret0, _ := ret[0].(*model.GroupProfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGroup indicates an expected call of GetGroup.
+func (mr *MockInterfaceMockRecorder) GetGroup(ctx, user, group interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroup", reflect.TypeOf((*MockInterface)(nil).GetGroup), ctx, user, group)
+}
+
+// GetGroupAuditLog mocks base method.
+func (m *MockInterface) GetGroupAuditLog(ctx context.Context, user, group uuid.UUID, after int64, limit int) (*model.GetAuditLogResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroupAuditLog", ctx, user, group, after, limit)
+	ret0, _ := ret[0].(*model.GetAuditLogResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGroupAuditLog indicates an expected call of GetGroupAuditLog.
+func (mr *MockInterfaceMockRecorder) GetGroupAuditLog(ctx, user, group, after, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupAuditLog", reflect.TypeOf((*MockInterface)(nil).GetGroupAuditLog), ctx, user, group, after, limit)
+}
+
+// GetGroupLabels mocks base method.
+func (m *MockInterface) GetGroupLabels(ctx context.Context, user, group uuid.UUID) (*model.GetLabelsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroupLabels", ctx, user, group)
+	ret0, _ := ret[0].(*model.GetLabelsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGroupLabels indicates an expected call of GetGroupLabels.
+func (mr *MockInterfaceMockRecorder) GetGroupLabels(ctx, user, group interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupLabels", reflect.TypeOf((*MockInterface)(nil).GetGroupLabels), ctx, user, group)
+}
+
+// GetGroupMembers mocks base method.
+func (m *MockInterface) GetGroupMembers(ctx context.Context, user, group uuid.UUID) (*model.GetMembersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroupMembers", ctx, user, group)
+	ret0, _ := ret[0].(*model.GetMembersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGroupMembers indicates an expected call of GetGroupMembers.
+func (mr *MockInterfaceMockRecorder) GetGroupMembers(ctx, user, group interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupMembers", reflect.TypeOf((*MockInterface)(nil).GetGroupMembers), ctx, user, group)
+}
+
+// GetGroupRoles mocks base method.
+func (m *MockInterface) GetGroupRoles(ctx context.Context, user, group uuid.UUID) (*model.GetRolesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroupRoles", ctx, user, group)
+	ret0, _ := ret[0].(*model.GetRolesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGroupRoles indicates an expected call of GetGroupRoles.
+func (mr *MockInterfaceMockRecorder) GetGroupRoles(ctx, user, group interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupRoles", reflect.TypeOf((*MockInterface)(nil).GetGroupRoles), ctx, user, group)
+}
+
+// GetGroupStats mocks base method.
+func (m *MockInterface) GetGroupStats(ctx context.Context, user, group uuid.UUID, from, to time.Time) (*model.GroupStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroupStats", ctx, user, group, from, to)
+	ret0, _ := ret[0].(*model.GroupStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGroupStats indicates an expected call of GetGroupStats.
+func (mr *MockInterfaceMockRecorder) GetGroupStats(ctx, user, group, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupStats", reflect.TypeOf((*MockInterface)(nil).GetGroupStats), ctx, user, group, from, to)
+}
+
+// GetGroupTemplates mocks base method.
+func (m *MockInterface) GetGroupTemplates(ctx context.Context, user, group uuid.UUID) (*model.GetTemplatesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroupTemplates", ctx, user, group)
+	ret0, _ := ret[0].(*model.GetTemplatesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGroupTemplates indicates an expected call of GetGroupTemplates.
+func (mr *MockInterfaceMockRecorder) GetGroupTemplates(ctx, user, group interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupTemplates", reflect.TypeOf((*MockInterface)(nil).GetGroupTemplates), ctx, user, group)
+}
+
+// GetGroups mocks base method.
+func (m *MockInterface) GetGroups(ctx context.Context, user uuid.UUID) (*model.GetGroupsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroups", ctx, user)
+	ret0, _ := ret[0].(*model.GetGroupsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGroups indicates an expected call of GetGroups.
+func (mr *MockInterfaceMockRecorder) GetGroups(ctx, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroups", reflect.TypeOf((*MockInterface)(nil).GetGroups), ctx, user)
+}
+
+// GetMe mocks base method.
+func (m *MockInterface) GetMe(ctx context.Context, user uuid.UUID) (*model.GetMeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMe", ctx, user)
+	ret0, _ := ret[0].(*model.GetMeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMe indicates an expected call of GetMe.
+func (mr *MockInterfaceMockRecorder) GetMe(ctx, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMe", reflect.TypeOf((*MockInterface)(nil).GetMe), ctx, user)
+}
+
+// GetNotifications mocks base method.
+func (m *MockInterface) GetNotifications(ctx context.Context, user uuid.UUID, after int64, limit int) (*model.GetNotificationsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotifications", ctx, user, after, limit)
+	ret0, _ := ret[0].(*model.GetNotificationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNotifications indicates an expected call of GetNotifications.
+func (mr *MockInterfaceMockRecorder) GetNotifications(ctx, user, after, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotifications", reflect.TypeOf((*MockInterface)(nil).GetNotifications), ctx, user, after, limit)
+}
+
+// GetPendingReviews mocks base method.
+func (m *MockInterface) GetPendingReviews(ctx context.Context, user, group uuid.UUID) (*model.GetReviewsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingReviews", ctx, user, group)
+	ret0, _ := ret[0].(*model.GetReviewsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPendingReviews indicates an expected call of GetPendingReviews.
+func (mr *MockInterfaceMockRecorder) GetPendingReviews(ctx, user, group interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingReviews", reflect.TypeOf((*MockInterface)(nil).GetPendingReviews), ctx, user, group)
+}
+
+// GetRunningTimer mocks base method.
+func (m *MockInterface) GetRunningTimer(ctx context.Context, user uuid.UUID) (*model.Worklog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRunningTimer", ctx, user)
+	ret0, _ := ret[0].(*model.Worklog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRunningTimer indicates an expected call of GetRunningTimer.
+func (mr *MockInterfaceMockRecorder) GetRunningTimer(ctx, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRunningTimer", reflect.TypeOf((*MockInterface)(nil).GetRunningTimer), ctx, user)
+}
+
+// GetSubtasks mocks base method.
+func (m *MockInterface) GetSubtasks(ctx context.Context, user, task uuid.UUID) (*model.GetTasksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubtasks", ctx, user, task)
+	ret0, _ := ret[0].(*model.GetTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSubtasks indicates an expected call of GetSubtasks.
+func (mr *MockInterfaceMockRecorder) GetSubtasks(ctx, user, task interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubtasks", reflect.TypeOf((*MockInterface)(nil).GetSubtasks), ctx, user, task)
+}
+
+// GetTask mocks base method.
+func (m *MockInterface) GetTask(ctx context.Context, user, task uuid.UUID) (*model.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTask", ctx, user, task)
+	ret0, _ := ret[0].(*model.Task)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateGroup indicates an expected call of CreateGroup.
-func (mr *MockInterfaceMockRecorder) CreateGroup(ctx, user, name, description interface{}) *gomock.Call {
+// GetTask indicates an expected call of GetTask.
+func (mr *MockInterfaceMockRecorder) GetTask(ctx, user, task interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGroup", reflect.TypeOf((*MockInterface)(nil).CreateGroup), ctx, user, name, description)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTask", reflect.TypeOf((*MockInterface)(nil).GetTask), ctx, user, task)
 }
 
-// CreateInvite mocks base method.
-func (m *MockInterface) CreateInvite(ctx context.Context, user, group uuid.UUID, role *model.Role, limit int) (*model.CreateInviteResponse, error) {
+// GetTaskAttachments mocks base method.
+func (m *MockInterface) GetTaskAttachments(ctx context.Context, user, task uuid.UUID) (*model.GetAttachmentsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateInvite", ctx, user, group, role, limit)
-	ret0, _ := ret[0].(*model.CreateInviteResponse)
+	ret := m.ctrl.Call(m, "GetTaskAttachments", ctx, user, task)
+	ret0, _ := ret[0].(*model.GetAttachmentsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateInvite indicates an expected call of CreateInvite.
-func (mr *MockInterfaceMockRecorder) CreateInvite(ctx, user, group, role, limit interface{}) *gomock.Call {
+// GetTaskAttachments indicates an expected call of GetTaskAttachments.
+func (mr *MockInterfaceMockRecorder) GetTaskAttachments(ctx, user, task interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInvite", reflect.TypeOf((*MockInterface)(nil).CreateInvite), ctx, user, group, role, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskAttachments", reflect.TypeOf((*MockInterface)(nil).GetTaskAttachments), ctx, user, task)
 }
 
-// CreateTask mocks base method.
-func (m *MockInterface) CreateTask(ctx context.Context, user uuid.UUID, task model.TaskCreateRequest) (*model.Task, error) {
+// GetTaskComments mocks base method.
+func (m *MockInterface) GetTaskComments(ctx context.Context, user, task uuid.UUID) (*model.GetCommentsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateTask", ctx, user, task)
-	ret0, _ := ret[0].(*model.Task)
+	ret := m.ctrl.Call(m, "GetTaskComments", ctx, user, task)
+	ret0, _ := ret[0].(*model.GetCommentsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateTask indicates an expected call of CreateTask.
-func (mr *MockInterfaceMockRecorder) CreateTask(ctx, user, task interface{}) *gomock.Call {
+// GetTaskComments indicates an expected call of GetTaskComments.
+func (mr *MockInterfaceMockRecorder) GetTaskComments(ctx, user, task interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTask", reflect.TypeOf((*MockInterface)(nil).CreateTask), ctx, user, task)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskComments", reflect.TypeOf((*MockInterface)(nil).GetTaskComments), ctx, user, task)
 }
 
-// CreateToken mocks base method.
-func (m *MockInterface) CreateToken(ctx context.Context, username, password, token string) (*model.CreateTokenResponse, error) {
+// GetTaskHistory mocks base method.
+func (m *MockInterface) GetTaskHistory(ctx context.Context, user, task uuid.UUID) (*model.GetTaskHistoryResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateToken", ctx, username, password, token)
-	ret0, _ := ret[0].(*model.CreateTokenResponse)
+	ret := m.ctrl.Call(m, "GetTaskHistory", ctx, user, task)
+	ret0, _ := ret[0].(*model.GetTaskHistoryResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateToken indicates an expected call of CreateToken.
-func (mr *MockInterfaceMockRecorder) CreateToken(ctx, username, password, token interface{}) *gomock.Call {
+// GetTaskHistory indicates an expected call of GetTaskHistory.
+func (mr *MockInterfaceMockRecorder) GetTaskHistory(ctx, user, task interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateToken", reflect.TypeOf((*MockInterface)(nil).CreateToken), ctx, username, password, token)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskHistory", reflect.TypeOf((*MockInterface)(nil).GetTaskHistory), ctx, user, task)
 }
 
-// GetMe mocks base method.
-func (m *MockInterface) GetMe(ctx context.Context, user uuid.UUID) (*model.GetMeResponse, error) {
+// GetTaskWatchers mocks base method.
+func (m *MockInterface) GetTaskWatchers(ctx context.Context, user, task uuid.UUID) (*model.GetWatchersResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetMe", ctx, user)
-	ret0, _ := ret[0].(*model.GetMeResponse)
+	ret := m.ctrl.Call(m, "GetTaskWatchers", ctx, user, task)
+	ret0, _ := ret[0].(*model.GetWatchersResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetMe indicates an expected call of GetMe.
-func (mr *MockInterfaceMockRecorder) GetMe(ctx, user interface{}) *gomock.Call {
+// GetTaskWatchers indicates an expected call of GetTaskWatchers.
+func (mr *MockInterfaceMockRecorder) GetTaskWatchers(ctx, user, task interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMe", reflect.TypeOf((*MockInterface)(nil).GetMe), ctx, user)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskWatchers", reflect.TypeOf((*MockInterface)(nil).GetTaskWatchers), ctx, user, task)
 }
 
-// GetTask mocks base method.
-func (m *MockInterface) GetTask(ctx context.Context, user, task uuid.UUID) (*model.Task, error) {
+// GetTaskWorklogs mocks base method.
+func (m *MockInterface) GetTaskWorklogs(ctx context.Context, user, task uuid.UUID) (*model.GetWorklogsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTask", ctx, user, task)
-	ret0, _ := ret[0].(*model.Task)
+	ret := m.ctrl.Call(m, "GetTaskWorklogs", ctx, user, task)
+	ret0, _ := ret[0].(*model.GetWorklogsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetTask indicates an expected call of GetTask.
-func (mr *MockInterfaceMockRecorder) GetTask(ctx, user, task interface{}) *gomock.Call {
+// GetTaskWorklogs indicates an expected call of GetTaskWorklogs.
+func (mr *MockInterfaceMockRecorder) GetTaskWorklogs(ctx, user, task interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTask", reflect.TypeOf((*MockInterface)(nil).GetTask), ctx, user, task)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskWorklogs", reflect.TypeOf((*MockInterface)(nil).GetTaskWorklogs), ctx, user, task)
+}
+
+// GetTemplate mocks base method.
+func (m *MockInterface) GetTemplate(ctx context.Context, user, group, template uuid.UUID) (*model.TaskTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTemplate", ctx, user, group, template)
+	ret0, _ := ret[0].(*model.TaskTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTemplate indicates an expected call of GetTemplate.
+func (mr *MockInterfaceMockRecorder) GetTemplate(ctx, user, group, template interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTemplate", reflect.TypeOf((*MockInterface)(nil).GetTemplate), ctx, user, group, template)
 }
 
 // GetUserFromToken mocks base method.
@@ -142,18 +750,77 @@ func (mr *MockInterfaceMockRecorder) GetUserFromToken(ctx, t interface{}) *gomoc
 }
 
 // GetUserTasks mocks base method.
-func (m *MockInterface) GetUserTasks(ctx context.Context, user uuid.UUID) (*model.GetTasksResponse, error) {
+func (m *MockInterface) GetUserTasks(ctx context.Context, user uuid.UUID, filter model.TaskFilter) (*model.GetTasksResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserTasks", ctx, user)
+	ret := m.ctrl.Call(m, "GetUserTasks", ctx, user, filter)
 	ret0, _ := ret[0].(*model.GetTasksResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetUserTasks indicates an expected call of GetUserTasks.
-func (mr *MockInterfaceMockRecorder) GetUserTasks(ctx, user interface{}) *gomock.Call {
+func (mr *MockInterfaceMockRecorder) GetUserTasks(ctx, user, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserTasks", reflect.TypeOf((*MockInterface)(nil).GetUserTasks), ctx, user, filter)
+}
+
+// GetWorklogReport mocks base method.
+func (m *MockInterface) GetWorklogReport(ctx context.Context, user uuid.UUID, filter model.WorklogReportFilter) (*model.WorklogReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorklogReport", ctx, user, filter)
+	ret0, _ := ret[0].(*model.WorklogReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorklogReport indicates an expected call of GetWorklogReport.
+func (mr *MockInterfaceMockRecorder) GetWorklogReport(ctx, user, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorklogReport", reflect.TypeOf((*MockInterface)(nil).GetWorklogReport), ctx, user, filter)
+}
+
+// LeaveGroup mocks base method.
+func (m *MockInterface) LeaveGroup(ctx context.Context, user, group uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LeaveGroup", ctx, user, group)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LeaveGroup indicates an expected call of LeaveGroup.
+func (mr *MockInterfaceMockRecorder) LeaveGroup(ctx, user, group interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LeaveGroup", reflect.TypeOf((*MockInterface)(nil).LeaveGroup), ctx, user, group)
+}
+
+// MoveChecklistItem mocks base method.
+func (m *MockInterface) MoveChecklistItem(ctx context.Context, user, task, item uuid.UUID, req model.MoveChecklistItemRequest) (*model.GetChecklistResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveChecklistItem", ctx, user, task, item, req)
+	ret0, _ := ret[0].(*model.GetChecklistResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveChecklistItem indicates an expected call of MoveChecklistItem.
+func (mr *MockInterfaceMockRecorder) MoveChecklistItem(ctx, user, task, item, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveChecklistItem", reflect.TypeOf((*MockInterface)(nil).MoveChecklistItem), ctx, user, task, item, req)
+}
+
+// MoveTask mocks base method.
+func (m *MockInterface) MoveTask(ctx context.Context, user, group, task uuid.UUID, req model.MoveTaskRequest) (*model.Board, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveTask", ctx, user, group, task, req)
+	ret0, _ := ret[0].(*model.Board)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveTask indicates an expected call of MoveTask.
+func (mr *MockInterfaceMockRecorder) MoveTask(ctx, user, group, task, req interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserTasks", reflect.TypeOf((*MockInterface)(nil).GetUserTasks), ctx, user)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveTask", reflect.TypeOf((*MockInterface)(nil).MoveTask), ctx, user, group, task, req)
 }
 
 // Ping mocks base method.
@@ -170,6 +837,21 @@ func (mr *MockInterfaceMockRecorder) Ping(ctx interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ping", reflect.TypeOf((*MockInterface)(nil).Ping), ctx)
 }
 
+// PurgeOrphanedBlobs mocks base method.
+func (m *MockInterface) PurgeOrphanedBlobs(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeOrphanedBlobs", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeOrphanedBlobs indicates an expected call of PurgeOrphanedBlobs.
+func (mr *MockInterfaceMockRecorder) PurgeOrphanedBlobs(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeOrphanedBlobs", reflect.TypeOf((*MockInterface)(nil).PurgeOrphanedBlobs), ctx)
+}
+
 // RegisterUser mocks base method.
 func (m *MockInterface) RegisterUser(ctx context.Context, email, password string) (*model.User, error) {
 	m.ctrl.T.Helper()
@@ -185,6 +867,288 @@ func (mr *MockInterfaceMockRecorder) RegisterUser(ctx, email, password interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterUser", reflect.TypeOf((*MockInterface)(nil).RegisterUser), ctx, email, password)
 }
 
+// RemoveDependency mocks base method.
+func (m *MockInterface) RemoveDependency(ctx context.Context, user, task, blocker uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveDependency", ctx, user, task, blocker)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveDependency indicates an expected call of RemoveDependency.
+func (mr *MockInterfaceMockRecorder) RemoveDependency(ctx, user, task, blocker interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveDependency", reflect.TypeOf((*MockInterface)(nil).RemoveDependency), ctx, user, task, blocker)
+}
+
+// RemoveGroupMember mocks base method.
+func (m *MockInterface) RemoveGroupMember(ctx context.Context, user, group, member uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveGroupMember", ctx, user, group, member)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveGroupMember indicates an expected call of RemoveGroupMember.
+func (mr *MockInterfaceMockRecorder) RemoveGroupMember(ctx, user, group, member interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveGroupMember", reflect.TypeOf((*MockInterface)(nil).RemoveGroupMember), ctx, user, group, member)
+}
+
+// ResolveReview mocks base method.
+func (m *MockInterface) ResolveReview(ctx context.Context, user, review uuid.UUID, req model.ResolveReviewRequest) (*model.Review, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveReview", ctx, user, review, req)
+	ret0, _ := ret[0].(*model.Review)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveReview indicates an expected call of ResolveReview.
+func (mr *MockInterfaceMockRecorder) ResolveReview(ctx, user, review, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveReview", reflect.TypeOf((*MockInterface)(nil).ResolveReview), ctx, user, review, req)
+}
+
+// RunRecurringTasks mocks base method.
+func (m *MockInterface) RunRecurringTasks(ctx context.Context, now time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunRecurringTasks", ctx, now)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunRecurringTasks indicates an expected call of RunRecurringTasks.
+func (mr *MockInterfaceMockRecorder) RunRecurringTasks(ctx, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunRecurringTasks", reflect.TypeOf((*MockInterface)(nil).RunRecurringTasks), ctx, now)
+}
+
+// Search mocks base method.
+func (m *MockInterface) Search(ctx context.Context, user uuid.UUID, query string, limit int) (*model.SearchResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, user, query, limit)
+	ret0, _ := ret[0].(*model.SearchResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockInterfaceMockRecorder) Search(ctx, user, query, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockInterface)(nil).Search), ctx, user, query, limit)
+}
+
+// SetBoardLimits mocks base method.
+func (m *MockInterface) SetBoardLimits(ctx context.Context, user, group uuid.UUID, req model.BoardLimitsRequest) (*model.Board, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetBoardLimits", ctx, user, group, req)
+	ret0, _ := ret[0].(*model.Board)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetBoardLimits indicates an expected call of SetBoardLimits.
+func (mr *MockInterfaceMockRecorder) SetBoardLimits(ctx, user, group, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBoardLimits", reflect.TypeOf((*MockInterface)(nil).SetBoardLimits), ctx, user, group, req)
+}
+
+// StartTimer mocks base method.
+func (m *MockInterface) StartTimer(ctx context.Context, user, task uuid.UUID, req model.StartTimerRequest) (*model.Worklog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTimer", ctx, user, task, req)
+	ret0, _ := ret[0].(*model.Worklog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartTimer indicates an expected call of StartTimer.
+func (mr *MockInterfaceMockRecorder) StartTimer(ctx, user, task, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTimer", reflect.TypeOf((*MockInterface)(nil).StartTimer), ctx, user, task, req)
+}
+
+// StopTimer mocks base method.
+func (m *MockInterface) StopTimer(ctx context.Context, user uuid.UUID) (*model.Worklog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopTimer", ctx, user)
+	ret0, _ := ret[0].(*model.Worklog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StopTimer indicates an expected call of StopTimer.
+func (mr *MockInterfaceMockRecorder) StopTimer(ctx, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopTimer", reflect.TypeOf((*MockInterface)(nil).StopTimer), ctx, user)
+}
+
+// SubmitReview mocks base method.
+func (m *MockInterface) SubmitReview(ctx context.Context, user, task uuid.UUID, msg string) (*model.Review, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubmitReview", ctx, user, task, msg)
+	ret0, _ := ret[0].(*model.Review)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubmitReview indicates an expected call of SubmitReview.
+func (mr *MockInterfaceMockRecorder) SubmitReview(ctx, user, task, msg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitReview", reflect.TypeOf((*MockInterface)(nil).SubmitReview), ctx, user, task, msg)
+}
+
+// ToggleChecklistItem mocks base method.
+func (m *MockInterface) ToggleChecklistItem(ctx context.Context, user, task, item uuid.UUID, req model.ToggleChecklistItemRequest) (*model.ChecklistItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ToggleChecklistItem", ctx, user, task, item, req)
+	ret0, _ := ret[0].(*model.ChecklistItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ToggleChecklistItem indicates an expected call of ToggleChecklistItem.
+func (mr *MockInterfaceMockRecorder) ToggleChecklistItem(ctx, user, task, item, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToggleChecklistItem", reflect.TypeOf((*MockInterface)(nil).ToggleChecklistItem), ctx, user, task, item, req)
+}
+
+// UnwatchTask mocks base method.
+func (m *MockInterface) UnwatchTask(ctx context.Context, user, task uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnwatchTask", ctx, user, task)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnwatchTask indicates an expected call of UnwatchTask.
+func (mr *MockInterfaceMockRecorder) UnwatchTask(ctx, user, task interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnwatchTask", reflect.TypeOf((*MockInterface)(nil).UnwatchTask), ctx, user, task)
+}
+
+// UpdateComment mocks base method.
+func (m *MockInterface) UpdateComment(ctx context.Context, user, task, comment uuid.UUID, req model.UpdateCommentRequest) (*model.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateComment", ctx, user, task, comment, req)
+	ret0, _ := ret[0].(*model.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateComment indicates an expected call of UpdateComment.
+func (mr *MockInterfaceMockRecorder) UpdateComment(ctx, user, task, comment, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateComment", reflect.TypeOf((*MockInterface)(nil).UpdateComment), ctx, user, task, comment, req)
+}
+
+// UpdateGroup mocks base method.
+func (m *MockInterface) UpdateGroup(ctx context.Context, user, group uuid.UUID, req model.UpdateGroupRequest) (*model.GroupProfile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateGroup", ctx, user, group, req)
+	ret0, _ := ret[0].(*model.GroupProfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateGroup indicates an expected call of UpdateGroup.
+func (mr *MockInterfaceMockRecorder) UpdateGroup(ctx, user, group, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGroup", reflect.TypeOf((*MockInterface)(nil).UpdateGroup), ctx, user, group, req)
+}
+
+// UpdateGroupMember mocks base method.
+func (m *MockInterface) UpdateGroupMember(ctx context.Context, user, group, member uuid.UUID, req model.UpdateMemberRequest) (*model.UserInGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateGroupMember", ctx, user, group, member, req)
+	ret0, _ := ret[0].(*model.UserInGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateGroupMember indicates an expected call of UpdateGroupMember.
+func (mr *MockInterfaceMockRecorder) UpdateGroupMember(ctx, user, group, member, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGroupMember", reflect.TypeOf((*MockInterface)(nil).UpdateGroupMember), ctx, user, group, member, req)
+}
+
+// UpdateLabel mocks base method.
+func (m *MockInterface) UpdateLabel(ctx context.Context, user, group, label uuid.UUID, req model.UpdateLabelRequest) (*model.Label, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLabel", ctx, user, group, label, req)
+	ret0, _ := ret[0].(*model.Label)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateLabel indicates an expected call of UpdateLabel.
+func (mr *MockInterfaceMockRecorder) UpdateLabel(ctx, user, group, label, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLabel", reflect.TypeOf((*MockInterface)(nil).UpdateLabel), ctx, user, group, label, req)
+}
+
+// UpdateTask mocks base method.
+func (m *MockInterface) UpdateTask(ctx context.Context, user, task uuid.UUID, req model.TaskUpdateRequest) (*model.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTask", ctx, user, task, req)
+	ret0, _ := ret[0].(*model.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTask indicates an expected call of UpdateTask.
+func (mr *MockInterfaceMockRecorder) UpdateTask(ctx, user, task, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTask", reflect.TypeOf((*MockInterface)(nil).UpdateTask), ctx, user, task, req)
+}
+
+// UpdateTaskStatus mocks base method.
+func (m *MockInterface) UpdateTaskStatus(ctx context.Context, user, task uuid.UUID, status model.TaskStatus) (*model.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTaskStatus", ctx, user, task, status)
+	ret0, _ := ret[0].(*model.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTaskStatus indicates an expected call of UpdateTaskStatus.
+func (mr *MockInterfaceMockRecorder) UpdateTaskStatus(ctx, user, task, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskStatus", reflect.TypeOf((*MockInterface)(nil).UpdateTaskStatus), ctx, user, task, status)
+}
+
+// UpdateTemplate mocks base method.
+func (m *MockInterface) UpdateTemplate(ctx context.Context, user, group, template uuid.UUID, req model.UpdateTemplateRequest) (*model.TaskTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTemplate", ctx, user, group, template, req)
+	ret0, _ := ret[0].(*model.TaskTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTemplate indicates an expected call of UpdateTemplate.
+func (mr *MockInterfaceMockRecorder) UpdateTemplate(ctx, user, group, template, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTemplate", reflect.TypeOf((*MockInterface)(nil).UpdateTemplate), ctx, user, group, template, req)
+}
+
+// UploadAttachment mocks base method.
+func (m *MockInterface) UploadAttachment(ctx context.Context, user, task uuid.UUID, req model.UploadAttachmentRequest) (*model.Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadAttachment", ctx, user, task, req)
+	ret0, _ := ret[0].(*model.Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadAttachment indicates an expected call of UploadAttachment.
+func (mr *MockInterfaceMockRecorder) UploadAttachment(ctx, user, task, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadAttachment", reflect.TypeOf((*MockInterface)(nil).UploadAttachment), ctx, user, task, req)
+}
+
 // UseInvite mocks base method.
 func (m *MockInterface) UseInvite(ctx context.Context, user, group, invite uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -198,3 +1162,17 @@ func (mr *MockInterfaceMockRecorder) UseInvite(ctx, user, group, invite interfac
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseInvite", reflect.TypeOf((*MockInterface)(nil).UseInvite), ctx, user, group, invite)
 }
+
+// WatchTask mocks base method.
+func (m *MockInterface) WatchTask(ctx context.Context, user, task uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchTask", ctx, user, task)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WatchTask indicates an expected call of WatchTask.
+func (mr *MockInterfaceMockRecorder) WatchTask(ctx, user, task interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchTask", reflect.TypeOf((*MockInterface)(nil).WatchTask), ctx, user, task)
+}
@@ -0,0 +1,272 @@
+package production
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/vlad-marlo/godo/internal/model"
+	"github.com/vlad-marlo/godo/internal/service"
+	"github.com/vlad-marlo/godo/internal/store"
+	"go.uber.org/zap"
+)
+
+const (
+	// orphanedBlobsBatchSize is max count of blobs which are deleted by one call of PurgeOrphanedBlobs.
+	orphanedBlobsBatchSize = 100
+	// defaultAttachmentName is name of uploaded file which has no name.
+	defaultAttachmentName = "file"
+	// defaultAttachmentType is content type of uploaded file which has no content type.
+	defaultAttachmentType = "application/octet-stream"
+)
+
+// visibleAttachments return attachments which user could see.
+//
+// Attachments of task are visible to everyone who could read task. Attachments of comments are visible
+// only to users who could read comments to which they are attached.
+func (s *Service) visibleAttachments(ctx context.Context, user uuid.UUID, task *model.Task, attachments []*model.Attachment) ([]*model.Attachment, error) {
+	var commented bool
+	for _, a := range attachments {
+		commented = commented || a.Comment != nil
+	}
+	if !commented {
+		return attachments, nil
+	}
+
+	perms, err := s.issuePermissions(ctx, user, task)
+	if err != nil {
+		return nil, err
+	}
+	if perms.Has(model.PermViewIssues) {
+		return attachments, nil
+	}
+
+	comments, err := s.store.Comment().AllByTask(ctx, task.ID)
+	if err != nil {
+		return nil, service.ErrInternal.With(zap.Error(err))
+	}
+	visible := make(map[uuid.UUID]struct{})
+	for _, c := range relatedComments(user, comments) {
+		visible[c.ID] = struct{}{}
+	}
+
+	res := make([]*model.Attachment, 0, len(attachments))
+	for _, a := range attachments {
+		if a.Comment != nil {
+			if _, ok := visible[*a.Comment]; !ok {
+				continue
+			}
+		}
+		res = append(res, a)
+	}
+	return res, nil
+}
+
+// getAttachment return attachment of task if user could see it.
+func (s *Service) getAttachment(ctx context.Context, user, task, attachment uuid.UUID) (*model.Task, *model.Attachment, error) {
+	t, err := s.GetTask(ctx, user, task)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	a, err := s.store.Attachment().Get(ctx, attachment)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return nil, nil, service.ErrNotFound
+		}
+		return nil, nil, service.ErrInternal.With(zap.Error(err))
+	}
+	if a.Task != t.ID {
+		return nil, nil, service.ErrNotFound
+	}
+
+	visible, err := s.visibleAttachments(ctx, user, t, []*model.Attachment{a})
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(visible) == 0 {
+		return nil, nil, service.ErrNotFound
+	}
+	return t, a, nil
+}
+
+// GetTaskAttachments return attachments of task and of its comments which are visible to user.
+func (s *Service) GetTaskAttachments(ctx context.Context, user, task uuid.UUID) (*model.GetAttachmentsResponse, error) {
+	t, err := s.GetTask(ctx, user, task)
+	if err != nil {
+		return nil, err
+	}
+
+	attachments, err := s.store.Attachment().AllByTask(ctx, t.ID)
+	if err != nil {
+		return nil, service.ErrInternal.With(zap.Error(err))
+	}
+	if attachments, err = s.visibleAttachments(ctx, user, t, attachments); err != nil {
+		return nil, err
+	}
+
+	return &model.GetAttachmentsResponse{
+		Count:       len(attachments),
+		Attachments: attachments,
+	}, nil
+}
+
+// UploadAttachment stores file and attaches it to task or to comment of task.
+//
+// Files could be attached to task by users who could change related tasks and to comment only by its author.
+// Files which are larger than limit from config are rejected.
+func (s *Service) UploadAttachment(ctx context.Context, user, task uuid.UUID, req model.UploadAttachmentRequest) (*model.Attachment, error) {
+	t, err := s.GetTask(ctx, user, task)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Comment != nil {
+		var c *model.Comment
+		if c, err = s.store.Comment().Get(ctx, *req.Comment); err != nil {
+			if errors.Is(err, store.ErrNotFound) {
+				return nil, service.ErrBadAttachment
+			}
+			return nil, service.ErrInternal.With(zap.Error(err))
+		}
+		if c.Task != t.ID || c.CreatedBy != user {
+			return nil, service.ErrBadAttachment
+		}
+	} else if err = s.authorizeTask(ctx, user, t, model.PermEditRelatedTasks); err != nil {
+		return nil, err
+	}
+
+	a := &model.Attachment{
+		ID:          uuid.New(),
+		Task:        t.ID,
+		Comment:     req.Comment,
+		UploadedBy:  user,
+		Name:        attachmentName(req.Name),
+		ContentType: req.ContentType,
+		BlobKey:     uuid.NewString(),
+		CreatedAt:   time.Now(),
+	}
+	if a.ContentType == "" {
+		a.ContentType = defaultAttachmentType
+	}
+
+	limit := s.cfg.Server.MaxAttachmentSize
+	// one extra byte is read to find out that file exceeds limit.
+	tooLarge := service.ErrAttachmentTooLarge.WithData(map[string]string{
+		"file": fmt.Sprintf("size of file must not exceed %d bytes", limit),
+	})
+	if a.Size, err = s.blobs.Put(ctx, a.BlobKey, io.LimitReader(req.Body, limit+1)); err != nil {
+		s.deleteBlobs(ctx, a.BlobKey)
+		// body of request could be limited by caller too, so reaching of that limit is not an internal error.
+		if errors.As(err, new(*http.MaxBytesError)) {
+			return nil, tooLarge.With(zap.Error(err))
+		}
+		return nil, service.ErrInternal.With(zap.Error(err))
+	}
+	if a.Size > limit {
+		s.deleteBlobs(ctx, a.BlobKey)
+		return nil, tooLarge
+	}
+
+	if err = s.store.Attachment().Create(ctx, a); err != nil {
+		s.deleteBlobs(ctx, a.BlobKey)
+		if errors.Is(err, store.ErrFKViolation) {
+			return nil, service.ErrNotFound.With(zap.Error(err))
+		}
+		return nil, service.ErrInternal.With(zap.Error(err))
+	}
+	return a, nil
+}
+
+// attachmentName return name of uploaded file without path.
+func attachmentName(name string) string {
+	name = strings.TrimSpace(filepath.Base(strings.ReplaceAll(name, `\`, "/")))
+	if name == "" || name == "." || name == "/" {
+		return defaultAttachmentName
+	}
+	return name
+}
+
+// GetAttachment return attachment with reader of its content. Reader must be closed by caller.
+//
+// Permissions are equal to GetTaskAttachments.
+func (s *Service) GetAttachment(ctx context.Context, user, task, attachment uuid.UUID) (*model.Attachment, io.ReadCloser, error) {
+	_, a, err := s.getAttachment(ctx, user, task, attachment)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	content, err := s.blobs.Get(ctx, a.BlobKey)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return nil, nil, service.ErrNotFound.With(zap.Error(err))
+		}
+		return nil, nil, service.ErrInternal.With(zap.Error(err))
+	}
+	return a, content, nil
+}
+
+// DeleteAttachment deletes attachment. It could be deleted by user who uploaded it or who could change task.
+func (s *Service) DeleteAttachment(ctx context.Context, user, task, attachment uuid.UUID) error {
+	t, a, err := s.getAttachment(ctx, user, task, attachment)
+	if err != nil {
+		return err
+	}
+	if a.UploadedBy != user {
+		if err = s.authorizeTask(ctx, user, t, model.PermEditTasks); err != nil {
+			return err
+		}
+	}
+
+	if err = s.store.Attachment().Delete(ctx, a.ID); err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return service.ErrNotFound
+		}
+		return service.ErrInternal.With(zap.Error(err))
+	}
+	s.purgeOrphanedBlobs(ctx)
+	return nil
+}
+
+// PurgeOrphanedBlobs deletes contents of attachments which were deleted directly or with their tasks or comments.
+//
+// Count of deleted blobs is returned.
+func (s *Service) PurgeOrphanedBlobs(ctx context.Context) (int, error) {
+	keys, err := s.store.Attachment().Orphaned(ctx, orphanedBlobsBatchSize)
+	if err != nil {
+		return 0, service.ErrInternal.With(zap.Error(err))
+	}
+	if len(keys) == 0 {
+		return 0, nil
+	}
+
+	if err = s.blobs.Delete(ctx, keys...); err != nil {
+		return 0, service.ErrInternal.With(zap.Error(err))
+	}
+	if err = s.store.Attachment().ForgetOrphaned(ctx, keys); err != nil {
+		return 0, service.ErrInternal.With(zap.Error(err))
+	}
+	return len(keys), nil
+}
+
+// purgeOrphanedBlobs deletes orphaned blobs right after deletion of attachments.
+//
+// Blobs which were not deleted are deleted later by scheduler, so error is only logged.
+func (s *Service) purgeOrphanedBlobs(ctx context.Context) {
+	if _, err := s.PurgeOrphanedBlobs(ctx); err != nil {
+		s.log.Warn("purge orphaned blobs", zap.Error(err))
+	}
+}
+
+// deleteBlobs deletes blobs which were not related to attachments.
+func (s *Service) deleteBlobs(ctx context.Context, keys ...string) {
+	if err := s.blobs.Delete(ctx, keys...); err != nil {
+		s.log.Warn("delete blobs", zap.Strings("keys", keys), zap.Error(err))
+	}
+}
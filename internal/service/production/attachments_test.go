@@ -0,0 +1,307 @@
+package production
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vlad-marlo/godo/internal/config"
+	"github.com/vlad-marlo/godo/internal/model"
+	"github.com/vlad-marlo/godo/internal/service"
+	"github.com/vlad-marlo/godo/internal/store"
+	"github.com/vlad-marlo/godo/internal/store/mocks"
+	"go.uber.org/zap"
+)
+
+// testAttachmentService return service with blob store and attachments limited by maxSize bytes.
+func testAttachmentService(t testing.TB, str store.Store, blobs store.BlobStore, maxSize int64) *Service {
+	t.Helper()
+	cfg := &config.Config{}
+	cfg.Server.MaxAttachmentSize = maxSize
+	return New(str, blobs, cfg, zap.L())
+}
+
+func TestAttachmentName(t *testing.T) {
+	tt := []struct {
+		name string
+		want string
+	}{
+		{"report.pdf", "report.pdf"},
+		{"../../etc/passwd", "passwd"},
+		{`C:\Users\user\report.pdf`, "report.pdf"},
+		{" ", defaultAttachmentName},
+		{"", defaultAttachmentName},
+		{"/", defaultAttachmentName},
+	}
+	for _, tc := range tt {
+		assert.Equal(t, tc.want, attachmentName(tc.name), tc.name)
+	}
+}
+
+func TestService_UploadAttachment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	task := &model.Task{ID: uuid.New(), CreatedBy: TestUser1.ID}
+
+	taskRepo := mocks.NewMockTaskRepository(ctrl)
+	taskRepo.EXPECT().GetByUserAndID(gomock.Any(), TestUser1.ID, task.ID).Return(task, nil).AnyTimes()
+	taskRepo.EXPECT().GetRoleOfMember(gomock.Any(), TestUser1.ID, task.ID).Return(nil, store.ErrNotFound).AnyTimes()
+	var key string
+	blobs := mocks.NewMockBlobStore(ctrl)
+	blobs.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, k string, r io.Reader) (int64, error) {
+			key = k
+			data, err := io.ReadAll(r)
+			return int64(len(data)), err
+		},
+	).Times(2)
+	attachRepo := mocks.NewMockAttachmentRepository(ctrl)
+	attachRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+	str := mocks.NewMockStore(ctrl)
+	str.EXPECT().Task().Return(taskRepo).AnyTimes()
+	str.EXPECT().Attachment().Return(attachRepo).AnyTimes()
+
+	s := testAttachmentService(t, str, blobs, 5)
+	a, err := s.UploadAttachment(context.Background(), TestUser1.ID, task.ID, model.UploadAttachmentRequest{
+		Name: "dir/notes.txt",
+		Body: strings.NewReader("notes"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, task.ID, a.Task)
+	assert.Equal(t, TestUser1.ID, a.UploadedBy)
+	assert.Equal(t, "notes.txt", a.Name)
+	assert.Equal(t, defaultAttachmentType, a.ContentType)
+	assert.EqualValues(t, 5, a.Size)
+	assert.Equal(t, key, a.BlobKey)
+
+	// blob of rejected file is deleted.
+	blobs.EXPECT().Delete(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, keys ...string) error {
+		assert.Equal(t, []string{key}, keys)
+		return nil
+	})
+	_, err = s.UploadAttachment(context.Background(), TestUser1.ID, task.ID, model.UploadAttachmentRequest{
+		Body: strings.NewReader("too large"),
+	})
+	assert.ErrorIs(t, err, service.ErrAttachmentTooLarge)
+}
+
+func TestService_UploadAttachment_Negative(t *testing.T) {
+	user := uuid.New()
+	comment := &model.Comment{ID: uuid.New(), CreatedBy: user}
+	tt := []struct {
+		name       string
+		createdBy  uuid.UUID
+		role       *model.Role
+		comment    *model.Comment
+		putErr     error
+		createErr  error
+		want       error
+		deleteBlob bool
+	}{
+		{"no permission", uuid.New(), ReadOnlyRole, nil, nil, nil, service.ErrForbidden, false},
+		{"comment of other task", uuid.New(), ReadOnlyRole, comment, nil, nil, service.ErrBadAttachment, false},
+		{"comment of other user", user, nil, &model.Comment{ID: uuid.New(), CreatedBy: uuid.New()}, nil, nil, service.ErrBadAttachment, false},
+		{"put err", user, nil, nil, errors.New(""), nil, service.ErrInternal, true},
+		{"request body limit exceeded", user, nil, nil, fmt.Errorf("fs: %w", &http.MaxBytesError{Limit: 1}), nil, service.ErrAttachmentTooLarge, true},
+		{"task deleted concurrently", user, nil, nil, nil, store.ErrFKViolation, service.ErrNotFound, true},
+		{"create unknown err", user, nil, nil, nil, errors.New(""), service.ErrInternal, true},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			task := &model.Task{ID: uuid.New(), CreatedBy: tc.createdBy}
+			req := model.UploadAttachmentRequest{Body: strings.NewReader("")}
+
+			taskRepo := mocks.NewMockTaskRepository(ctrl)
+			taskRepo.EXPECT().GetByUserAndID(gomock.Any(), user, task.ID).Return(task, nil)
+			if tc.role != nil {
+				taskRepo.EXPECT().GetRoleOfMember(gomock.Any(), user, task.ID).Return(tc.role, nil).AnyTimes()
+			} else {
+				taskRepo.EXPECT().GetRoleOfMember(gomock.Any(), user, task.ID).Return(nil, store.ErrNotFound).AnyTimes()
+			}
+			commentRepo := mocks.NewMockCommentRepository(ctrl)
+			if tc.comment != nil {
+				req.Comment = &tc.comment.ID
+				commentRepo.EXPECT().Get(gomock.Any(), tc.comment.ID).Return(tc.comment, nil)
+			}
+			blobs := mocks.NewMockBlobStore(ctrl)
+			blobs.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return(int64(0), tc.putErr).AnyTimes()
+			if tc.deleteBlob {
+				blobs.EXPECT().Delete(gomock.Any(), gomock.Any()).Return(nil)
+			}
+			attachRepo := mocks.NewMockAttachmentRepository(ctrl)
+			attachRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(tc.createErr).AnyTimes()
+			str := mocks.NewMockStore(ctrl)
+			str.EXPECT().Task().Return(taskRepo).AnyTimes()
+			str.EXPECT().Comment().Return(commentRepo).AnyTimes()
+			str.EXPECT().Attachment().Return(attachRepo).AnyTimes()
+
+			s := testAttachmentService(t, str, blobs, 10)
+			_, err := s.UploadAttachment(context.Background(), user, task.ID, req)
+			assert.ErrorIs(t, err, tc.want)
+		})
+	}
+}
+
+func TestService_GetTaskAttachments(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	user := uuid.New()
+	task := &model.Task{ID: uuid.New(), CreatedBy: uuid.New()}
+	own := &model.Comment{ID: uuid.New(), Task: task.ID, CreatedBy: user}
+	foreign := &model.Comment{ID: uuid.New(), Task: task.ID, CreatedBy: uuid.New()}
+	attachments := []*model.Attachment{
+		{ID: uuid.New(), Task: task.ID},
+		{ID: uuid.New(), Task: task.ID, Comment: &own.ID},
+		{ID: uuid.New(), Task: task.ID, Comment: &foreign.ID},
+	}
+
+	taskRepo := mocks.NewMockTaskRepository(ctrl)
+	taskRepo.EXPECT().GetByUserAndID(gomock.Any(), user, task.ID).Return(task, nil).Times(2)
+	taskRepo.EXPECT().GetRoleOfMember(gomock.Any(), user, task.ID).Return(ReadOnlyRole, nil).Times(2)
+	taskRepo.EXPECT().GetRoleOfMember(gomock.Any(), task.CreatedBy, task.ID).Return(ReadOnlyRole, nil)
+	taskRepo.EXPECT().GetByUserAndID(gomock.Any(), task.CreatedBy, task.ID).Return(task, nil)
+	taskRepo.EXPECT().IsAssignee(gomock.Any(), task.ID, user).Return(false).Times(2)
+	commentRepo := mocks.NewMockCommentRepository(ctrl)
+	commentRepo.EXPECT().AllByTask(gomock.Any(), task.ID).Return([]*model.Comment{own, foreign}, nil).Times(2)
+	attachRepo := mocks.NewMockAttachmentRepository(ctrl)
+	attachRepo.EXPECT().AllByTask(gomock.Any(), task.ID).Return(attachments, nil).Times(2)
+	attachRepo.EXPECT().Get(gomock.Any(), attachments[2].ID).Return(attachments[2], nil)
+	str := mocks.NewMockStore(ctrl)
+	str.EXPECT().Task().Return(taskRepo).AnyTimes()
+	str.EXPECT().Comment().Return(commentRepo).AnyTimes()
+	str.EXPECT().Attachment().Return(attachRepo).AnyTimes()
+
+	s := testService(t, str)
+	// user who could not read all comments does not see attachments of comments of other users.
+	resp, err := s.GetTaskAttachments(context.Background(), user, task.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 2, resp.Count)
+	assert.Equal(t, attachments[:2], resp.Attachments)
+
+	_, _, err = s.GetAttachment(context.Background(), user, task.ID, attachments[2].ID)
+	assert.ErrorIs(t, err, service.ErrNotFound)
+
+	// creator of task could read all comments.
+	resp, err = s.GetTaskAttachments(context.Background(), task.CreatedBy, task.ID)
+	require.NoError(t, err)
+	assert.Equal(t, attachments, resp.Attachments)
+}
+
+func TestService_GetAttachment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	a := &model.Attachment{ID: uuid.New(), Task: TestTask1.ID, BlobKey: uuid.NewString()}
+	other := &model.Attachment{ID: uuid.New(), Task: uuid.New()}
+	missing := &model.Attachment{ID: uuid.New(), Task: TestTask1.ID, BlobKey: uuid.NewString()}
+
+	taskRepo := mocks.NewMockTaskRepository(ctrl)
+	taskRepo.EXPECT().GetByUserAndID(gomock.Any(), TestUser1.ID, TestTask1.ID).Return(TestTask1, nil).AnyTimes()
+	attachRepo := mocks.NewMockAttachmentRepository(ctrl)
+	attachRepo.EXPECT().Get(gomock.Any(), a.ID).Return(a, nil)
+	attachRepo.EXPECT().Get(gomock.Any(), other.ID).Return(other, nil)
+	attachRepo.EXPECT().Get(gomock.Any(), missing.ID).Return(missing, nil)
+	attachRepo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, store.ErrNotFound)
+	blobs := mocks.NewMockBlobStore(ctrl)
+	blobs.EXPECT().Get(gomock.Any(), a.BlobKey).Return(io.NopCloser(strings.NewReader("content")), nil)
+	blobs.EXPECT().Get(gomock.Any(), missing.BlobKey).Return(nil, store.ErrNotFound)
+	str := mocks.NewMockStore(ctrl)
+	str.EXPECT().Task().Return(taskRepo).AnyTimes()
+	str.EXPECT().Attachment().Return(attachRepo).AnyTimes()
+
+	s := testAttachmentService(t, str, blobs, 10)
+	got, content, err := s.GetAttachment(context.Background(), TestUser1.ID, TestTask1.ID, a.ID)
+	require.NoError(t, err)
+	assert.Equal(t, a, got)
+	data, err := io.ReadAll(content)
+	require.NoError(t, err)
+	assert.Equal(t, "content", string(data))
+
+	for _, id := range []uuid.UUID{other.ID, missing.ID, uuid.New()} {
+		_, _, err = s.GetAttachment(context.Background(), TestUser1.ID, TestTask1.ID, id)
+		assert.ErrorIs(t, err, service.ErrNotFound)
+	}
+}
+
+func TestService_DeleteAttachment(t *testing.T) {
+	user := uuid.New()
+	tt := []struct {
+		name       string
+		uploadedBy uuid.UUID
+		role       *model.Role
+		deleteErr  error
+		want       error
+	}{
+		{"uploader", user, ReadOnlyRole, nil, nil},
+		{"group moderator", uuid.New(), SudoRole, nil, nil},
+		{"no permission", uuid.New(), ReadOnlyRole, nil, service.ErrForbidden},
+		{"deleted concurrently", user, nil, store.ErrNotFound, service.ErrNotFound},
+		{"unknown err", user, nil, errors.New(""), service.ErrInternal},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			task := &model.Task{ID: uuid.New(), CreatedBy: uuid.New()}
+			a := &model.Attachment{ID: uuid.New(), Task: task.ID, UploadedBy: tc.uploadedBy}
+
+			taskRepo := mocks.NewMockTaskRepository(ctrl)
+			taskRepo.EXPECT().GetByUserAndID(gomock.Any(), user, task.ID).Return(task, nil)
+			if tc.role != nil {
+				taskRepo.EXPECT().GetRoleOfMember(gomock.Any(), user, task.ID).Return(tc.role, nil).AnyTimes()
+			} else {
+				taskRepo.EXPECT().GetRoleOfMember(gomock.Any(), user, task.ID).Return(nil, store.ErrNotFound).AnyTimes()
+			}
+			attachRepo := mocks.NewMockAttachmentRepository(ctrl)
+			attachRepo.EXPECT().Get(gomock.Any(), a.ID).Return(a, nil)
+			attachRepo.EXPECT().Delete(gomock.Any(), a.ID).Return(tc.deleteErr).AnyTimes()
+			attachRepo.EXPECT().Orphaned(gomock.Any(), orphanedBlobsBatchSize).Return(nil, nil).AnyTimes()
+			str := mocks.NewMockStore(ctrl)
+			str.EXPECT().Task().Return(taskRepo).AnyTimes()
+			str.EXPECT().Attachment().Return(attachRepo).AnyTimes()
+
+			s := testService(t, str)
+			err := s.DeleteAttachment(context.Background(), user, task.ID, a.ID)
+			if tc.want != nil {
+				assert.ErrorIs(t, err, tc.want)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestService_PurgeOrphanedBlobs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	keys := []string{uuid.NewString(), uuid.NewString()}
+
+	attachRepo := mocks.NewMockAttachmentRepository(ctrl)
+	gomock.InOrder(
+		attachRepo.EXPECT().Orphaned(gomock.Any(), orphanedBlobsBatchSize).Return(keys, nil),
+		attachRepo.EXPECT().ForgetOrphaned(gomock.Any(), keys).Return(nil),
+		attachRepo.EXPECT().Orphaned(gomock.Any(), orphanedBlobsBatchSize).Return(nil, nil),
+		attachRepo.EXPECT().Orphaned(gomock.Any(), orphanedBlobsBatchSize).Return(keys, nil),
+	)
+	blobs := mocks.NewMockBlobStore(ctrl)
+	blobs.EXPECT().Delete(gomock.Any(), keys[0], keys[1]).Return(nil)
+	// keys are kept if blobs were not deleted.
+	blobs.EXPECT().Delete(gomock.Any(), keys[0], keys[1]).Return(errors.New(""))
+	str := mocks.NewMockStore(ctrl)
+	str.EXPECT().Attachment().Return(attachRepo).AnyTimes()
+
+	s := testAttachmentService(t, str, blobs, 10)
+	n, err := s.PurgeOrphanedBlobs(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+
+	n, err = s.PurgeOrphanedBlobs(context.Background())
+	require.NoError(t, err)
+	assert.Zero(t, n)
+
+	_, err = s.PurgeOrphanedBlobs(context.Background())
+	assert.ErrorIs(t, err, service.ErrInternal)
+}
@@ -0,0 +1,153 @@
+package production
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/vlad-marlo/godo/internal/model"
+	"github.com/vlad-marlo/godo/internal/service"
+	"github.com/vlad-marlo/godo/internal/store"
+	"go.uber.org/zap"
+)
+
+// Permissions of task creator and assignees are given on top of permissions which they have in groups of task.
+const (
+	// creatorPermissions is permissions which creator of task always has on it.
+	creatorPermissions = model.PermEditRelatedTasks | model.PermEditTasks | model.PermDeleteTasks |
+		model.PermReviewTasks | model.PermViewIssues | model.PermCreateIssues | model.PermEditRelatedIssues |
+		model.PermModerateIssues
+	// assigneePermissions is permissions which assignees of task always have on it.
+	assigneePermissions = model.PermViewIssues | model.PermCreateIssues | model.PermEditRelatedIssues
+)
+
+// groupRole return role of user in group. Admins of group have all permissions.
+//
+// If user is not member of group then service.ErrForbidden will be returned.
+func (s *Service) groupRole(ctx context.Context, user, group uuid.UUID) (*model.Role, error) {
+	role, err := s.store.Group().GetRoleOfMember(ctx, user, group)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return nil, service.ErrForbidden
+		}
+		return nil, service.ErrInternal.With(zap.Error(err))
+	}
+	return role, nil
+}
+
+// authorize checks that user is member of group and has all permissions of perm.
+// Zero perm checks only membership in group.
+func (s *Service) authorize(ctx context.Context, user, group uuid.UUID, perm model.Permission) error {
+	_, err := s.authorizeRole(ctx, user, group, perm)
+	return err
+}
+
+// authorizeRole is authorize which also return role of user in group.
+func (s *Service) authorizeRole(ctx context.Context, user, group uuid.UUID, perm model.Permission) (*model.Role, error) {
+	role, err := s.groupRole(ctx, user, group)
+	if err != nil {
+		return nil, err
+	}
+	if !role.Permissions.Has(perm) {
+		return nil, service.ErrForbidden
+	}
+	return role, nil
+}
+
+// authorizeAdmin checks that user is admin of group.
+func (s *Service) authorizeAdmin(ctx context.Context, user, group uuid.UUID) error {
+	if !s.store.Group().IsAdmin(ctx, group, user) {
+		return service.ErrForbidden
+	}
+	return nil
+}
+
+// authorizeMember checks that user could remove member of group or change role of member.
+// Role of user and member are returned.
+//
+// Admins are changed only by other admins. User who could remove members changes anyone else and user who could
+// remove related members changes only users who joined group by invites of that user.
+func (s *Service) authorizeMember(ctx context.Context, user, group, member uuid.UUID) (*model.Role, *model.UserInGroup, error) {
+	role, err := s.groupRole(ctx, user, group)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !role.Permissions.Any(model.PermRemoveMembers | model.PermRemoveRelatedMembers) {
+		return nil, nil, service.ErrForbidden
+	}
+
+	m, err := s.store.Group().GetMember(ctx, group, member)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return nil, nil, service.ErrNotFound
+		}
+		return nil, nil, service.ErrInternal.With(zap.Error(err))
+	}
+	switch {
+	case m.IsAdmin:
+		if err = s.authorizeAdmin(ctx, user, group); err != nil {
+			return nil, nil, err
+		}
+	case role.Permissions.Has(model.PermRemoveMembers):
+	case m.InvitedBy == nil || *m.InvitedBy != user:
+		return nil, nil, service.ErrForbidden
+	}
+	return role, m, nil
+}
+
+// taskPermissions return permissions of user which are applied to task.
+//
+// Permissions of user in all groups of task are united and creator of task gets creatorPermissions on top of them.
+// User who is not member of task groups has no permissions except of creator ones.
+func (s *Service) taskPermissions(ctx context.Context, user uuid.UUID, task *model.Task) (model.Permission, error) {
+	var perms model.Permission
+	role, err := s.store.Task().GetRoleOfMember(ctx, user, task.ID)
+	if err != nil {
+		if !errors.Is(err, store.ErrNotFound) {
+			return 0, service.ErrInternal.With(zap.Error(err))
+		}
+	} else {
+		perms = role.Permissions
+	}
+
+	if task.CreatedBy == user {
+		perms |= creatorPermissions
+	}
+	return perms, nil
+}
+
+// issuePermissions return permissions of user which are applied to issues of task.
+//
+// Assignees of task could always discuss it in addition to taskPermissions.
+func (s *Service) issuePermissions(ctx context.Context, user uuid.UUID, task *model.Task) (model.Permission, error) {
+	perms, err := s.taskPermissions(ctx, user, task)
+	if err != nil {
+		return 0, err
+	}
+	if !perms.Has(assigneePermissions) && s.store.Task().IsAssignee(ctx, task.ID, user) {
+		perms |= assigneePermissions
+	}
+	return perms, nil
+}
+
+// authorizeTask checks that user has all permissions of perm on task.
+func (s *Service) authorizeTask(ctx context.Context, user uuid.UUID, task *model.Task, perm model.Permission) error {
+	perms, err := s.taskPermissions(ctx, user, task)
+	if err != nil {
+		return err
+	}
+	if !perms.Has(perm) {
+		return service.ErrForbidden
+	}
+	return nil
+}
+
+// authorizeGrant checks that user could give role to member whose current permissions are old.
+//
+// Permissions which member does not have yet could be granted only by user who has them.
+func authorizeGrant(held, old model.Permission, role *model.Role) error {
+	if !held.Has(role.Permissions &^ old) {
+		return service.ErrRoleEscalation
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+package production
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/vlad-marlo/godo/internal/model"
+	"github.com/vlad-marlo/godo/internal/service"
+	"github.com/vlad-marlo/godo/internal/store"
+	"go.uber.org/zap"
+)
+
+// GetBoard return tasks of group which are visible to user bucketed by status in manual order.
+//
+// Board is available to every member of group.
+func (s *Service) GetBoard(ctx context.Context, user, group uuid.UUID) (*model.Board, error) {
+	if err := s.authorize(ctx, user, group, 0); err != nil {
+		return nil, err
+	}
+
+	tasks, err := s.store.Task().AllByGroupAndUser(ctx, group, user)
+	if err != nil && !errors.Is(err, store.ErrNotFound) {
+		return nil, service.ErrInternal.With(zap.Error(err))
+	}
+
+	limits, err := s.store.Board().Limits(ctx, group)
+	if err != nil {
+		return nil, service.ErrInternal.With(zap.Error(err))
+	}
+
+	return model.NewBoard(group, tasks, limits), nil
+}
+
+// MoveTask changes status of task and its position in column of group board in one call.
+//
+// Status transition has same rules as UpdateTaskStatus. Reordering of tasks inside of one column requires
+// permission to edit tasks. Moves beyond work-in-progress limit of column are rejected.
+func (s *Service) MoveTask(ctx context.Context, user, group, task uuid.UUID, req model.MoveTaskRequest) (*model.Board, error) {
+	if !req.Status.Valid() {
+		return nil, service.ErrBadTaskStatus
+	}
+	if err := s.authorize(ctx, user, group, 0); err != nil {
+		return nil, err
+	}
+
+	t, err := s.GetTask(ctx, user, task)
+	if err != nil {
+		return nil, err
+	}
+
+	if t.Status != req.Status {
+		if err = s.checkStatusChange(ctx, user, t, req.Status); err != nil {
+			return nil, err
+		}
+	} else if err = s.authorizeTask(ctx, user, t, model.PermEditRelatedTasks); err != nil {
+		return nil, err
+	}
+
+	var changes []*model.TaskChange
+	if t.Status != req.Status {
+		changes = append(changes, statusChange(t.ID, user, t.Status, req.Status))
+	}
+	if err = s.store.Board().Move(ctx, group, t.ID, t.Status, req.Status, req.Position, changes...); err != nil {
+		switch {
+		case errors.Is(err, store.ErrLimitExceeded):
+			return nil, s.errWIPLimit(ctx, group, req.Status)
+		case errors.Is(err, store.ErrBadData):
+			return nil, service.ErrTaskNotOnBoard
+		case errors.Is(err, store.ErrNotFound):
+			return nil, service.ErrConflict.With(zap.Error(err))
+		default:
+			return nil, service.ErrInternal.With(zap.Error(err))
+		}
+	}
+
+	return s.GetBoard(ctx, user, group)
+}
+
+// errColumnLimit return conflict error with column into which task could not be moved on board of some its group.
+func errColumnLimit(status model.TaskStatus) error {
+	return service.ErrWIPLimitExceeded.WithData(map[string]any{
+		"status": fmt.Sprintf("column %s has reached its work in progress limit", status),
+	})
+}
+
+// errWIPLimit return conflict error with limit of column into which task could not be moved.
+func (s *Service) errWIPLimit(ctx context.Context, group uuid.UUID, status model.TaskStatus) error {
+	data := map[string]any{
+		"status": fmt.Sprintf("column %s has reached its work in progress limit", status),
+	}
+	if limits, err := s.store.Board().Limits(ctx, group); err == nil {
+		if limit, ok := limits[status]; ok {
+			data["status"] = fmt.Sprintf("column %s has reached its work in progress limit of %d tasks", status, limit)
+			data["limit"] = limit
+		}
+	}
+	return service.ErrWIPLimitExceeded.WithData(data)
+}
+
+// SetBoardLimits changes work-in-progress limits of board columns of group.
+//
+// Limits could be changed by admin of group or user who could change all tasks of group.
+func (s *Service) SetBoardLimits(ctx context.Context, user, group uuid.UUID, req model.BoardLimitsRequest) (*model.Board, error) {
+	for status, limit := range req.Limits {
+		if !status.Valid() {
+			return nil, service.ErrBadTaskStatus
+		}
+		if limit != nil && *limit < 0 {
+			return nil, service.ErrBadBoardLimit
+		}
+	}
+	if err := s.authorize(ctx, user, group, model.PermManageTasks); err != nil {
+		return nil, err
+	}
+
+	if err := s.store.Board().SetLimits(ctx, group, req.Limits); err != nil {
+		return nil, service.ErrInternal.With(zap.Error(err))
+	}
+
+	return s.GetBoard(ctx, user, group)
+}
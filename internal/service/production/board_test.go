@@ -0,0 +1,171 @@
+package production
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vlad-marlo/godo/internal/model"
+	"github.com/vlad-marlo/godo/internal/pkg/fielderr"
+	"github.com/vlad-marlo/godo/internal/service"
+	"github.com/vlad-marlo/godo/internal/store"
+	"github.com/vlad-marlo/godo/internal/store/mocks"
+)
+
+func TestService_GetBoard(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	group := uuid.New()
+	a := &model.Task{ID: uuid.New(), Status: model.StatusInProgress}
+	b := &model.Task{ID: uuid.New(), Status: model.StatusInProgress}
+
+	groupRepo := mocks.NewMockGroupRepository(ctrl)
+	groupRepo.EXPECT().GetRoleOfMember(gomock.Any(), TestUser1.ID, group).Return(&model.Role{}, nil)
+	groupRepo.EXPECT().GetRoleOfMember(gomock.Any(), uuid.Nil, group).Return(nil, store.ErrNotFound)
+	taskRepo := mocks.NewMockTaskRepository(ctrl)
+	taskRepo.EXPECT().AllByGroupAndUser(gomock.Any(), group, TestUser1.ID).Return([]*model.Task{b, a}, nil)
+	boardRepo := mocks.NewMockBoardRepository(ctrl)
+	boardRepo.EXPECT().Limits(gomock.Any(), group).Return(map[model.TaskStatus]int{model.StatusInProgress: 2}, nil)
+	str := mocks.NewMockStore(ctrl)
+	str.EXPECT().Group().Return(groupRepo).AnyTimes()
+	str.EXPECT().Task().Return(taskRepo).AnyTimes()
+	str.EXPECT().Board().Return(boardRepo).AnyTimes()
+
+	s := testService(t, str)
+	board, err := s.GetBoard(context.Background(), TestUser1.ID, group)
+	require.NoError(t, err)
+	assert.Equal(t, []*model.Task{b, a}, board.Columns[1].Tasks)
+	require.NotNil(t, board.Columns[1].Limit)
+	assert.Equal(t, 2, *board.Columns[1].Limit)
+
+	_, err = s.GetBoard(context.Background(), uuid.Nil, group)
+	assert.ErrorIs(t, err, service.ErrForbidden)
+}
+
+func TestService_MoveTask(t *testing.T) {
+	group := uuid.New()
+	tt := []struct {
+		name    string
+		from    model.TaskStatus
+		to      model.TaskStatus
+		role    *model.Role
+		moveErr error
+		want    error
+	}{
+		{"move into column", model.StatusNew, model.StatusInProgress, &model.Role{}, nil, nil},
+		{"reorder", model.StatusNew, model.StatusNew, &model.Role{Permissions: model.PermEditRelatedTasks}, nil, nil},
+		{"reorder without permission", model.StatusNew, model.StatusNew, &model.Role{}, nil, service.ErrForbidden},
+		{"not allowed transition", model.StatusNew, model.StatusAccepted, &model.Role{}, nil, service.ErrStatusTransition},
+		{"limit exceeded", model.StatusNew, model.StatusInProgress, &model.Role{}, store.ErrLimitExceeded, service.ErrWIPLimitExceeded},
+		{"not on board", model.StatusNew, model.StatusInProgress, &model.Role{}, store.ErrBadData, service.ErrTaskNotOnBoard},
+		{"status changed", model.StatusNew, model.StatusInProgress, &model.Role{}, store.ErrNotFound, service.ErrConflict},
+		{"unknown", model.StatusNew, model.StatusInProgress, &model.Role{}, errors.New(""), service.ErrInternal},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			task := &model.Task{ID: uuid.New(), CreatedBy: uuid.New(), Status: tc.from}
+
+			groupRepo := mocks.NewMockGroupRepository(ctrl)
+			groupRepo.EXPECT().GetRoleOfMember(gomock.Any(), TestUser1.ID, group).Return(&model.Role{}, nil).AnyTimes()
+			taskRepo := mocks.NewMockTaskRepository(ctrl)
+			taskRepo.EXPECT().GetByUserAndID(gomock.Any(), TestUser1.ID, task.ID).Return(task, nil)
+			taskRepo.EXPECT().GetRoleOfMember(gomock.Any(), TestUser1.ID, task.ID).Return(tc.role, nil).AnyTimes()
+			taskRepo.EXPECT().AllByGroupAndUser(gomock.Any(), group, TestUser1.ID).Return([]*model.Task{task}, nil).MaxTimes(1)
+			depRepo := mocks.NewMockDependencyRepository(ctrl)
+			depRepo.EXPECT().OpenBlockers(gomock.Any(), task.ID).Return(nil, nil).AnyTimes()
+			boardRepo := mocks.NewMockBoardRepository(ctrl)
+			// status change is recorded to history in the same transaction as move.
+			changes := 0
+			if tc.from != tc.to {
+				changes = 1
+			}
+			boardRepo.EXPECT().Move(gomock.Any(), group, task.ID, tc.from, tc.to, 1, gomock.Len(changes)).Return(tc.moveErr).MaxTimes(1)
+			boardRepo.EXPECT().Limits(gomock.Any(), group).Return(map[model.TaskStatus]int{model.StatusInProgress: 1}, nil).AnyTimes()
+			str := mocks.NewMockStore(ctrl)
+			str.EXPECT().Group().Return(groupRepo).AnyTimes()
+			str.EXPECT().Task().Return(taskRepo).AnyTimes()
+			str.EXPECT().Dependency().Return(depRepo).AnyTimes()
+			str.EXPECT().Board().Return(boardRepo).AnyTimes()
+
+			s := testService(t, str)
+			board, err := s.MoveTask(context.Background(), TestUser1.ID, group, task.ID, model.MoveTaskRequest{Status: tc.to, Position: 1})
+			if tc.want != nil {
+				assert.ErrorIs(t, err, tc.want)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, group, board.Group)
+		})
+	}
+}
+
+func TestService_MoveTask_LimitData(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	group := uuid.New()
+	task := &model.Task{ID: uuid.New(), CreatedBy: TestUser1.ID, Status: model.StatusNew}
+
+	groupRepo := mocks.NewMockGroupRepository(ctrl)
+	groupRepo.EXPECT().GetRoleOfMember(gomock.Any(), TestUser1.ID, group).Return(&model.Role{}, nil)
+	taskRepo := mocks.NewMockTaskRepository(ctrl)
+	taskRepo.EXPECT().GetByUserAndID(gomock.Any(), TestUser1.ID, task.ID).Return(task, nil)
+	taskRepo.EXPECT().GetRoleOfMember(gomock.Any(), TestUser1.ID, task.ID).Return(nil, store.ErrNotFound)
+	boardRepo := mocks.NewMockBoardRepository(ctrl)
+	boardRepo.EXPECT().Move(gomock.Any(), group, task.ID, model.StatusNew, model.StatusClosed, 0, gomock.Any()).Return(store.ErrLimitExceeded)
+	boardRepo.EXPECT().Limits(gomock.Any(), group).Return(map[model.TaskStatus]int{model.StatusClosed: 5}, nil)
+	str := mocks.NewMockStore(ctrl)
+	str.EXPECT().Group().Return(groupRepo).AnyTimes()
+	str.EXPECT().Task().Return(taskRepo).AnyTimes()
+	str.EXPECT().Board().Return(boardRepo).AnyTimes()
+
+	s := testService(t, str)
+	_, err := s.MoveTask(context.Background(), TestUser1.ID, group, task.ID, model.MoveTaskRequest{Status: model.StatusClosed})
+	require.ErrorIs(t, err, service.ErrWIPLimitExceeded)
+	var fErr *fielderr.Error
+	require.True(t, errors.As(err, &fErr))
+	assert.Equal(t, 5, fErr.Data().(map[string]any)["limit"])
+}
+
+func TestService_MoveTask_BadStatus(t *testing.T) {
+	s := testService(t, nil)
+	_, err := s.MoveTask(context.Background(), TestUser1.ID, uuid.New(), uuid.New(), model.MoveTaskRequest{Status: "DONE"})
+	assert.ErrorIs(t, err, service.ErrBadTaskStatus)
+}
+
+func TestService_SetBoardLimits(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	group := uuid.New()
+	limit, negative := 3, -1
+	limits := map[model.TaskStatus]*int{model.StatusInProgress: &limit, model.StatusOnReview: nil}
+
+	groupRepo := mocks.NewMockGroupRepository(ctrl)
+	groupRepo.EXPECT().GetRoleOfMember(gomock.Any(), TestUser1.ID, group).Return(&model.Role{Permissions: model.PermManageTasks}, nil).Times(2)
+	groupRepo.EXPECT().GetRoleOfMember(gomock.Any(), uuid.Nil, group).Return(&model.Role{Permissions: model.PermEditTasks}, nil)
+	taskRepo := mocks.NewMockTaskRepository(ctrl)
+	taskRepo.EXPECT().AllByGroupAndUser(gomock.Any(), group, TestUser1.ID).Return(nil, store.ErrNotFound)
+	boardRepo := mocks.NewMockBoardRepository(ctrl)
+	boardRepo.EXPECT().SetLimits(gomock.Any(), group, limits).Return(nil)
+	boardRepo.EXPECT().Limits(gomock.Any(), group).Return(map[model.TaskStatus]int{model.StatusInProgress: limit}, nil)
+	str := mocks.NewMockStore(ctrl)
+	str.EXPECT().Group().Return(groupRepo).AnyTimes()
+	str.EXPECT().Task().Return(taskRepo).AnyTimes()
+	str.EXPECT().Board().Return(boardRepo).AnyTimes()
+
+	s := testService(t, str)
+	board, err := s.SetBoardLimits(context.Background(), TestUser1.ID, group, model.BoardLimitsRequest{Limits: limits})
+	require.NoError(t, err)
+	require.NotNil(t, board.Columns[1].Limit)
+	assert.Equal(t, limit, *board.Columns[1].Limit)
+
+	_, err = s.SetBoardLimits(context.Background(), uuid.Nil, group, model.BoardLimitsRequest{Limits: limits})
+	assert.ErrorIs(t, err, service.ErrForbidden)
+
+	_, err = s.SetBoardLimits(context.Background(), TestUser1.ID, group, model.BoardLimitsRequest{Limits: map[model.TaskStatus]*int{model.StatusNew: &negative}})
+	assert.ErrorIs(t, err, service.ErrBadBoardLimit)
+
+	_, err = s.SetBoardLimits(context.Background(), TestUser1.ID, group, model.BoardLimitsRequest{Limits: map[model.TaskStatus]*int{"DONE": &limit}})
+	assert.ErrorIs(t, err, service.ErrBadTaskStatus)
+}
@@ -0,0 +1,217 @@
+package production
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/vlad-marlo/godo/internal/model"
+	"github.com/vlad-marlo/godo/internal/pkg/fielderr"
+	"github.com/vlad-marlo/godo/internal/service"
+	"github.com/vlad-marlo/godo/internal/store"
+	"go.uber.org/zap"
+)
+
+// maxBulkTasks is maximum count of tasks in one bulk request.
+const maxBulkTasks = 100
+
+// validateBulkRequest checks that request has unique tasks and all data required by its operation.
+func validateBulkRequest(req model.BulkTaskRequest) error {
+	if len(req.Tasks) == 0 || len(req.Tasks) > maxBulkTasks {
+		return service.ErrBadBulkRequest
+	}
+	seen := make(map[uuid.UUID]struct{}, len(req.Tasks))
+	for _, id := range req.Tasks {
+		if _, ok := seen[id]; ok {
+			return service.ErrBadBulkRequest.WithData(map[string]string{
+				"tasks": "task ids must be unique",
+			})
+		}
+		seen[id] = struct{}{}
+	}
+
+	switch req.Operation {
+	case model.BulkSetStatus:
+		if !req.Status.Valid() {
+			return service.ErrBadTaskStatus
+		}
+	case model.BulkAssign, model.BulkUnassign:
+		if req.User == nil {
+			return service.ErrBadBulkOperation.WithData(map[string]string{
+				"user": "user is required by assign and unassign",
+			})
+		}
+	case model.BulkAddLabel:
+		if req.Label == nil {
+			return service.ErrBadBulkOperation.WithData(map[string]string{
+				"label": "label is required by add-label",
+			})
+		}
+	case model.BulkSetDeadline, model.BulkDelete:
+	default:
+		return service.ErrBadBulkOperation
+	}
+	return nil
+}
+
+// BulkTasks applies one operation to many tasks.
+//
+// Permissions are checked for every task like for single task operation. Operation is applied to all tasks which
+// passed checks in one transaction, and results are returned in order in which tasks were provided.
+func (s *Service) BulkTasks(ctx context.Context, user uuid.UUID, req model.BulkTaskRequest) (*model.BulkTaskResponse, error) {
+	if err := validateBulkRequest(req); err != nil {
+		return nil, err
+	}
+
+	var (
+		label    *model.Label
+		labelErr error
+	)
+	if req.Operation == model.BulkAddLabel {
+		l, err := s.store.Label().Get(ctx, *req.Label)
+		if err != nil {
+			if errors.Is(err, store.ErrNotFound) {
+				return nil, service.ErrNotFound
+			}
+			return nil, service.ErrInternal.With(zap.Error(err))
+		}
+		label = l
+		// permission to manage labels is the same for every task of group.
+		labelErr = s.checkManageLabels(ctx, user, l.Group)
+	}
+
+	results := make([]*model.BulkTaskResult, len(req.Tasks))
+	eligible := make([]*model.Task, 0, len(req.Tasks))
+	var (
+		passed  []*model.BulkTaskResult
+		changes []*model.TaskChange
+	)
+	for i, id := range req.Tasks {
+		results[i] = &model.BulkTaskResult{Task: id}
+
+		t, taskChanges, err := s.checkBulkTask(ctx, user, id, req, label, labelErr)
+		if err != nil {
+			s.failBulkTask(results[i], err)
+			continue
+		}
+		eligible = append(eligible, t)
+		passed = append(passed, results[i])
+		changes = append(changes, taskChanges...)
+	}
+
+	if len(eligible) > 0 {
+		if err := s.store.Task().Bulk(ctx, &req, eligible, changes...); err != nil {
+			var reason error
+			switch {
+			case errors.Is(err, store.ErrNotFound):
+				// one of tasks was changed concurrently, so nothing was applied.
+				reason = service.ErrConflict
+			case errors.Is(err, store.ErrLimitExceeded):
+				// tasks do not fit into column of board, so nothing was applied.
+				reason = errColumnLimit(req.Status)
+			default:
+				return nil, service.ErrInternal.With(zap.Error(err))
+			}
+			for _, r := range passed {
+				s.failBulkTask(r, reason)
+			}
+			return model.NewBulkTaskResponse(results), nil
+		}
+		for _, r := range passed {
+			r.OK = true
+		}
+		if req.Operation == model.BulkDelete {
+			s.purgeOrphanedBlobs(ctx)
+		}
+	}
+
+	return model.NewBulkTaskResponse(results), nil
+}
+
+// checkBulkTask checks that operation of request could be applied to task by user.
+//
+// Task and its changes which must be recorded to history after operation is applied are returned.
+func (s *Service) checkBulkTask(
+	ctx context.Context,
+	user, task uuid.UUID,
+	req model.BulkTaskRequest,
+	label *model.Label,
+	labelErr error,
+) (*model.Task, []*model.TaskChange, error) {
+	t, err := s.GetTask(ctx, user, task)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	switch req.Operation {
+	case model.BulkSetStatus:
+		if err = s.checkStatusChange(ctx, user, t, req.Status); err != nil {
+			return nil, nil, err
+		}
+		return t, []*model.TaskChange{statusChange(t.ID, user, t.Status, req.Status)}, nil
+
+	case model.BulkAddLabel:
+		if labelErr != nil {
+			return nil, nil, labelErr
+		}
+		if !s.store.Task().InGroup(ctx, t.ID, label.Group) {
+			return nil, nil, service.ErrBadLabel
+		}
+		for _, l := range t.Labels {
+			if l.ID == label.ID {
+				return t, nil, nil
+			}
+		}
+		return t, []*model.TaskChange{model.NewTaskChange(t.ID, user, model.TaskFieldLabels, nil, &label.Name)}, nil
+	}
+
+	// other operations change task itself or its assignees.
+	perm := model.PermEditTasks
+	if req.Operation == model.BulkDelete {
+		perm = model.PermDeleteTasks
+	}
+	if err = s.authorizeTask(ctx, user, t, perm); err != nil {
+		return nil, nil, err
+	}
+
+	switch req.Operation {
+	case model.BulkAssign:
+		if _, err = s.store.Task().GetRoleOfMember(ctx, *req.User, t.ID); err != nil {
+			if errors.Is(err, store.ErrNotFound) {
+				return nil, nil, service.ErrBadAssignee
+			}
+			return nil, nil, service.ErrInternal.With(zap.Error(err))
+		}
+		if s.store.Task().IsAssignee(ctx, t.ID, *req.User) {
+			return t, nil, nil
+		}
+		return t, assigneeChanges(t.ID, user, []uuid.UUID{*req.User}), nil
+
+	case model.BulkUnassign:
+		if !s.store.Task().IsAssignee(ctx, t.ID, *req.User) {
+			return t, nil, nil
+		}
+		id := req.User.String()
+		return t, []*model.TaskChange{model.NewTaskChange(t.ID, user, model.TaskFieldAssignees, &id, nil)}, nil
+
+	case model.BulkSetDeadline:
+		old := *t
+		t.Deadline = req.Deadline
+		return t, model.DiffTask(user, &old, t), nil
+	}
+	// deleted task has no history.
+	return t, nil, nil
+}
+
+// failBulkTask marks result as failed with message and field errors of err.
+func (s *Service) failBulkTask(result *model.BulkTaskResult, err error) {
+	var fErr *fielderr.Error
+	if !errors.As(err, &fErr) {
+		fErr = service.ErrInternal.With(zap.Error(err))
+	}
+	if fErr.Code() == fielderr.CodeInternal {
+		s.log.Error("check bulk operation on task", append(fErr.Fields(), zap.Stringer("task", result.Task))...)
+	}
+	result.Error = fErr.Error()
+	result.Reason = fErr.Data()
+}
@@ -0,0 +1,216 @@
+package production
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vlad-marlo/godo/internal/model"
+	"github.com/vlad-marlo/godo/internal/service"
+	"github.com/vlad-marlo/godo/internal/store"
+	"github.com/vlad-marlo/godo/internal/store/mocks"
+)
+
+// ownTask return task which is created by TestUser1.
+func ownTask() *model.Task {
+	return &model.Task{ID: uuid.New(), Name: "own", CreatedBy: TestUser1.ID, Status: model.StatusNew}
+}
+
+func TestService_BulkTasks_BadRequest(t *testing.T) {
+	s := testService(t, nil)
+	id, user := uuid.New(), uuid.New()
+
+	tt := []struct {
+		name string
+		req  model.BulkTaskRequest
+		want error
+	}{
+		{"no tasks", model.BulkTaskRequest{Operation: model.BulkDelete}, service.ErrBadBulkRequest},
+		{"too many tasks", model.BulkTaskRequest{Tasks: make([]uuid.UUID, maxBulkTasks+1), Operation: model.BulkDelete}, service.ErrBadBulkRequest},
+		{"duplicated tasks", model.BulkTaskRequest{Tasks: []uuid.UUID{id, id}, Operation: model.BulkDelete}, service.ErrBadBulkRequest},
+		{"unknown operation", model.BulkTaskRequest{Tasks: []uuid.UUID{id}, Operation: "rename"}, service.ErrBadBulkOperation},
+		{"bad status", model.BulkTaskRequest{Tasks: []uuid.UUID{id}, Operation: model.BulkSetStatus, Status: "DONE"}, service.ErrBadTaskStatus},
+		{"assign without user", model.BulkTaskRequest{Tasks: []uuid.UUID{id}, Operation: model.BulkAssign}, service.ErrBadBulkOperation},
+		{"add label without label", model.BulkTaskRequest{Tasks: []uuid.UUID{id}, Operation: model.BulkAddLabel, User: &user}, service.ErrBadBulkOperation},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := s.BulkTasks(context.Background(), TestUser1.ID, tc.req)
+			assert.ErrorIs(t, err, tc.want)
+		})
+	}
+}
+
+func TestService_BulkTasks_SetStatus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	own, foreign, missing := ownTask(), foreignTask(), uuid.New()
+
+	taskRepo := mocks.NewMockTaskRepository(ctrl)
+	taskRepo.EXPECT().GetByUserAndID(gomock.Any(), TestUser1.ID, own.ID).Return(own, nil)
+	taskRepo.EXPECT().GetByUserAndID(gomock.Any(), TestUser1.ID, foreign.ID).Return(foreign, nil)
+	taskRepo.EXPECT().GetByUserAndID(gomock.Any(), TestUser1.ID, missing).Return(nil, store.ErrNotFound)
+	taskRepo.EXPECT().GetRoleOfMember(gomock.Any(), TestUser1.ID, gomock.Any()).Return(ReadOnlyRole, nil).AnyTimes()
+	taskRepo.EXPECT().Bulk(gomock.Any(), gomock.Any(), []*model.Task{own}, gomock.Len(1)).Return(nil)
+	str := mocks.NewMockStore(ctrl)
+	str.EXPECT().Task().Return(taskRepo).AnyTimes()
+
+	s := testService(t, str)
+	resp, err := s.BulkTasks(context.Background(), TestUser1.ID, model.BulkTaskRequest{
+		Tasks:     []uuid.UUID{foreign.ID, own.ID, missing},
+		Operation: model.BulkSetStatus,
+		Status:    model.StatusClosed,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, resp.Applied)
+	assert.Equal(t, 2, resp.Failed)
+	require.Len(t, resp.Results, 3)
+	assert.Equal(t, &model.BulkTaskResult{Task: own.ID, OK: true}, resp.Results[1])
+	assert.Equal(t, foreign.ID, resp.Results[0].Task)
+	assert.Equal(t, service.ErrForbidden.Error(), resp.Results[0].Error)
+	assert.Equal(t, service.ErrForbidden.Data(), resp.Results[0].Reason)
+	assert.Equal(t, service.ErrNotFound.Error(), resp.Results[2].Error)
+}
+
+func TestService_BulkTasks_StoreError(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		bulkErr error
+		failed  error
+		want    error
+	}{
+		{"changed concurrently", store.ErrNotFound, service.ErrConflict, nil},
+		{"limit exceeded", store.ErrLimitExceeded, service.ErrWIPLimitExceeded, nil},
+		{"unknown error", errors.New(""), nil, service.ErrInternal},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			task := ownTask()
+
+			taskRepo := mocks.NewMockTaskRepository(ctrl)
+			taskRepo.EXPECT().GetByUserAndID(gomock.Any(), TestUser1.ID, task.ID).Return(task, nil)
+			taskRepo.EXPECT().GetRoleOfMember(gomock.Any(), TestUser1.ID, task.ID).Return(nil, store.ErrNotFound)
+			taskRepo.EXPECT().Bulk(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(tc.bulkErr)
+			str := mocks.NewMockStore(ctrl)
+			str.EXPECT().Task().Return(taskRepo).AnyTimes()
+
+			s := testService(t, str)
+			resp, err := s.BulkTasks(context.Background(), TestUser1.ID, model.BulkTaskRequest{
+				Tasks:     []uuid.UUID{task.ID},
+				Operation: model.BulkDelete,
+			})
+			if tc.want != nil {
+				assert.ErrorIs(t, err, tc.want)
+				return
+			}
+			require.NoError(t, err)
+			assert.Zero(t, resp.Applied)
+			assert.Equal(t, tc.failed.Error(), resp.Results[0].Error)
+		})
+	}
+}
+
+func TestService_BulkTasks_Assign(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	member := uuid.New()
+	a, b, c := ownTask(), ownTask(), ownTask()
+
+	taskRepo := mocks.NewMockTaskRepository(ctrl)
+	for _, task := range []*model.Task{a, b, c} {
+		taskRepo.EXPECT().GetByUserAndID(gomock.Any(), TestUser1.ID, task.ID).Return(task, nil)
+		taskRepo.EXPECT().GetRoleOfMember(gomock.Any(), TestUser1.ID, task.ID).Return(nil, store.ErrNotFound)
+	}
+	taskRepo.EXPECT().GetRoleOfMember(gomock.Any(), member, a.ID).Return(ReadOnlyRole, nil)
+	taskRepo.EXPECT().GetRoleOfMember(gomock.Any(), member, b.ID).Return(nil, store.ErrNotFound)
+	taskRepo.EXPECT().GetRoleOfMember(gomock.Any(), member, c.ID).Return(ReadOnlyRole, nil)
+	taskRepo.EXPECT().IsAssignee(gomock.Any(), a.ID, member).Return(false)
+	taskRepo.EXPECT().IsAssignee(gomock.Any(), c.ID, member).Return(true)
+	// user which is already assigned to task is not recorded again.
+	taskRepo.EXPECT().Bulk(gomock.Any(), gomock.Any(), []*model.Task{a, c}, gomock.Len(1)).Return(nil)
+	str := mocks.NewMockStore(ctrl)
+	str.EXPECT().Task().Return(taskRepo).AnyTimes()
+
+	s := testService(t, str)
+	resp, err := s.BulkTasks(context.Background(), TestUser1.ID, model.BulkTaskRequest{
+		Tasks:     []uuid.UUID{a.ID, b.ID, c.ID},
+		Operation: model.BulkAssign,
+		User:      &member,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, resp.Applied)
+	assert.Equal(t, service.ErrBadAssignee.Error(), resp.Results[1].Error)
+}
+
+func TestService_BulkTasks_AddLabel(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	group := uuid.New()
+	label := &model.Label{ID: uuid.New(), Group: group, Name: "sprint"}
+	a, b := foreignTask(), foreignTask()
+
+	taskRepo := mocks.NewMockTaskRepository(ctrl)
+	taskRepo.EXPECT().GetByUserAndID(gomock.Any(), TestUser1.ID, a.ID).Return(a, nil)
+	taskRepo.EXPECT().GetByUserAndID(gomock.Any(), TestUser1.ID, b.ID).Return(b, nil)
+	taskRepo.EXPECT().InGroup(gomock.Any(), a.ID, group).Return(true)
+	taskRepo.EXPECT().InGroup(gomock.Any(), b.ID, group).Return(false)
+	taskRepo.EXPECT().Bulk(gomock.Any(), gomock.Any(), []*model.Task{a}, gomock.Len(1)).Return(nil)
+	labelRepo := mocks.NewMockLabelRepository(ctrl)
+	labelRepo.EXPECT().Get(gomock.Any(), label.ID).Return(label, nil)
+	labelRepo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, store.ErrNotFound)
+	groupRepo := mocks.NewMockGroupRepository(ctrl)
+	groupRepo.EXPECT().GetRoleOfMember(gomock.Any(), TestUser1.ID, group).Return(SudoRole, nil)
+	str := mocks.NewMockStore(ctrl)
+	str.EXPECT().Task().Return(taskRepo).AnyTimes()
+	str.EXPECT().Label().Return(labelRepo).AnyTimes()
+	str.EXPECT().Group().Return(groupRepo).AnyTimes()
+
+	s := testService(t, str)
+	resp, err := s.BulkTasks(context.Background(), TestUser1.ID, model.BulkTaskRequest{
+		Tasks:     []uuid.UUID{a.ID, b.ID},
+		Operation: model.BulkAddLabel,
+		Label:     &label.ID,
+	})
+	require.NoError(t, err)
+	assert.True(t, resp.Results[0].OK)
+	assert.Equal(t, service.ErrBadLabel.Error(), resp.Results[1].Error)
+
+	unknown := uuid.New()
+	_, err = s.BulkTasks(context.Background(), TestUser1.ID, model.BulkTaskRequest{
+		Tasks:     []uuid.UUID{a.ID},
+		Operation: model.BulkAddLabel,
+		Label:     &unknown,
+	})
+	assert.ErrorIs(t, err, service.ErrNotFound)
+}
+
+func TestService_BulkTasks_SetDeadline(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	task := ownTask()
+	deadline := time.Now().Add(time.Hour)
+
+	taskRepo := mocks.NewMockTaskRepository(ctrl)
+	taskRepo.EXPECT().GetByUserAndID(gomock.Any(), TestUser1.ID, task.ID).Return(task, nil)
+	taskRepo.EXPECT().GetRoleOfMember(gomock.Any(), TestUser1.ID, task.ID).Return(nil, store.ErrNotFound)
+	taskRepo.EXPECT().Bulk(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *model.BulkTaskRequest, tasks []*model.Task, changes ...*model.TaskChange) error {
+			assert.Equal(t, &deadline, req.Deadline)
+			assert.Len(t, tasks, 1)
+			assert.Len(t, changes, 1)
+			return nil
+		},
+	)
+	str := mocks.NewMockStore(ctrl)
+	str.EXPECT().Task().Return(taskRepo).AnyTimes()
+
+	s := testService(t, str)
+	resp, err := s.BulkTasks(context.Background(), TestUser1.ID, model.BulkTaskRequest{
+		Tasks:     []uuid.UUID{task.ID},
+		Operation: model.BulkSetDeadline,
+		Deadline:  &deadline,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, resp.Applied)
+}
@@ -0,0 +1,159 @@
+package production
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/vlad-marlo/godo/internal/model"
+	"github.com/vlad-marlo/godo/internal/service"
+	"github.com/vlad-marlo/godo/internal/store"
+	"go.uber.org/zap"
+)
+
+// getChecklistItem return item of task checklist.
+func (s *Service) getChecklistItem(ctx context.Context, task *model.Task, item uuid.UUID) (*model.ChecklistItem, error) {
+	i, err := s.store.Checklist().Get(ctx, item)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return nil, service.ErrNotFound
+		}
+		return nil, service.ErrInternal.With(zap.Error(err))
+	}
+	if i.Task != task.ID {
+		return nil, service.ErrNotFound
+	}
+	return i, nil
+}
+
+// checklist return checklist of task with summary.
+func (s *Service) checklist(ctx context.Context, task uuid.UUID) (*model.GetChecklistResponse, error) {
+	items, err := s.store.Checklist().AllByTask(ctx, task)
+	if err != nil {
+		return nil, service.ErrInternal.With(zap.Error(err))
+	}
+	return model.NewGetChecklistResponse(items), nil
+}
+
+// GetChecklist return checklist of task ordered by position.
+func (s *Service) GetChecklist(ctx context.Context, user, task uuid.UUID) (*model.GetChecklistResponse, error) {
+	t, err := s.GetTask(ctx, user, task)
+	if err != nil {
+		return nil, err
+	}
+	return s.checklist(ctx, t.ID)
+}
+
+// CreateChecklistItem adds item to the end of task checklist. Permissions are equal to UpdateTask.
+func (s *Service) CreateChecklistItem(ctx context.Context, user, task uuid.UUID, req model.CreateChecklistItemRequest) (*model.ChecklistItem, error) {
+	if strings.TrimSpace(req.Text) == "" {
+		return nil, service.ErrBadChecklistItem
+	}
+
+	t, err := s.GetTask(ctx, user, task)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.authorizeTask(ctx, user, t, model.PermEditTasks); err != nil {
+		return nil, err
+	}
+
+	item := &model.ChecklistItem{
+		ID:   uuid.New(),
+		Task: t.ID,
+		Text: req.Text,
+	}
+	if err = s.store.Checklist().Create(ctx, item); err != nil {
+		switch {
+		case errors.Is(err, store.ErrFKViolation):
+			return nil, service.ErrNotFound.With(zap.Error(err))
+		default:
+			return nil, service.ErrInternal.With(zap.Error(err))
+		}
+	}
+	return item, nil
+}
+
+// ToggleChecklistItem marks checklist item as done or not done.
+//
+// Besides users who could change task any assignee of task could toggle items of its checklist.
+func (s *Service) ToggleChecklistItem(ctx context.Context, user, task, item uuid.UUID, req model.ToggleChecklistItemRequest) (*model.ChecklistItem, error) {
+	t, err := s.GetTask(ctx, user, task)
+	if err != nil {
+		return nil, err
+	}
+
+	if !s.store.Task().IsAssignee(ctx, t.ID, user) {
+		if err = s.authorizeTask(ctx, user, t, model.PermEditTasks); err != nil {
+			return nil, err
+		}
+	}
+
+	i, err := s.getChecklistItem(ctx, t, item)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.store.Checklist().SetDone(ctx, i.ID, req.Done); err != nil {
+		switch {
+		case errors.Is(err, store.ErrNotFound):
+			return nil, service.ErrNotFound
+		default:
+			return nil, service.ErrInternal.With(zap.Error(err))
+		}
+	}
+	i.Done = req.Done
+	return i, nil
+}
+
+// MoveChecklistItem moves item to position in task checklist and return reordered checklist.
+// Permissions are equal to UpdateTask.
+func (s *Service) MoveChecklistItem(ctx context.Context, user, task, item uuid.UUID, req model.MoveChecklistItemRequest) (*model.GetChecklistResponse, error) {
+	t, err := s.GetTask(ctx, user, task)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.authorizeTask(ctx, user, t, model.PermEditTasks); err != nil {
+		return nil, err
+	}
+
+	if err = s.store.Checklist().Move(ctx, t.ID, item, req.Position); err != nil {
+		switch {
+		case errors.Is(err, store.ErrNotFound):
+			return nil, service.ErrNotFound
+		default:
+			return nil, service.ErrInternal.With(zap.Error(err))
+		}
+	}
+	return s.checklist(ctx, t.ID)
+}
+
+// DeleteChecklistItem deletes item from task checklist. Permissions are equal to UpdateTask.
+func (s *Service) DeleteChecklistItem(ctx context.Context, user, task, item uuid.UUID) error {
+	t, err := s.GetTask(ctx, user, task)
+	if err != nil {
+		return err
+	}
+
+	if err = s.authorizeTask(ctx, user, t, model.PermEditTasks); err != nil {
+		return err
+	}
+
+	i, err := s.getChecklistItem(ctx, t, item)
+	if err != nil {
+		return err
+	}
+
+	if err = s.store.Checklist().Delete(ctx, i.ID); err != nil {
+		switch {
+		case errors.Is(err, store.ErrNotFound):
+			return service.ErrNotFound
+		default:
+			return service.ErrInternal.With(zap.Error(err))
+		}
+	}
+	return nil
+}
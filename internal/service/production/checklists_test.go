@@ -0,0 +1,160 @@
+package production
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vlad-marlo/godo/internal/model"
+	"github.com/vlad-marlo/godo/internal/service"
+	"github.com/vlad-marlo/godo/internal/store"
+	"github.com/vlad-marlo/godo/internal/store/mocks"
+)
+
+// foreignTask return task which is created by another user.
+func foreignTask() *model.Task {
+	return &model.Task{ID: uuid.New(), Name: "foreign", CreatedBy: uuid.New(), Status: model.StatusNew}
+}
+
+func TestService_GetChecklist(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	items := []*model.ChecklistItem{
+		{ID: uuid.New(), Task: TestTask1.ID, Text: "a", Done: true},
+		{ID: uuid.New(), Task: TestTask1.ID, Text: "b", Position: 1},
+	}
+	broken := uuid.New()
+
+	taskRepo := mocks.NewMockTaskRepository(ctrl)
+	taskRepo.EXPECT().GetByUserAndID(gomock.Any(), TestUser1.ID, TestTask1.ID).Return(TestTask1, nil)
+	taskRepo.EXPECT().GetByUserAndID(gomock.Any(), TestUser1.ID, broken).Return(&model.Task{ID: broken}, nil)
+	checkRepo := mocks.NewMockChecklistRepository(ctrl)
+	checkRepo.EXPECT().AllByTask(gomock.Any(), TestTask1.ID).Return(items, nil)
+	checkRepo.EXPECT().AllByTask(gomock.Any(), broken).Return(nil, errors.New(""))
+	str := mocks.NewMockStore(ctrl)
+	str.EXPECT().Task().Return(taskRepo).AnyTimes()
+	str.EXPECT().Checklist().Return(checkRepo).AnyTimes()
+
+	s := testService(t, str)
+	resp, err := s.GetChecklist(context.Background(), TestUser1.ID, TestTask1.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "1/2 done", resp.Checklist.Summary)
+	assert.Equal(t, items, resp.Items)
+
+	_, err = s.GetChecklist(context.Background(), TestUser1.ID, broken)
+	assert.ErrorIs(t, err, service.ErrInternal)
+}
+
+func TestService_CreateChecklistItem(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	foreign := foreignTask()
+
+	taskRepo := mocks.NewMockTaskRepository(ctrl)
+	taskRepo.EXPECT().GetByUserAndID(gomock.Any(), TestUser1.ID, TestTask1.ID).Return(TestTask1, nil)
+	taskRepo.EXPECT().GetByUserAndID(gomock.Any(), TestUser1.ID, foreign.ID).Return(foreign, nil)
+	taskRepo.EXPECT().GetRoleOfMember(gomock.Any(), TestUser1.ID, TestTask1.ID).Return(nil, store.ErrNotFound)
+	taskRepo.EXPECT().GetRoleOfMember(gomock.Any(), TestUser1.ID, foreign.ID).Return(ReadOnlyRole, nil)
+	checkRepo := mocks.NewMockChecklistRepository(ctrl)
+	checkRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, item *model.ChecklistItem) error {
+		assert.Equal(t, TestTask1.ID, item.Task)
+		item.Position = 3
+		return nil
+	})
+	str := mocks.NewMockStore(ctrl)
+	str.EXPECT().Task().Return(taskRepo).AnyTimes()
+	str.EXPECT().Checklist().Return(checkRepo).AnyTimes()
+
+	s := testService(t, str)
+	_, err := s.CreateChecklistItem(context.Background(), TestUser1.ID, TestTask1.ID, model.CreateChecklistItemRequest{Text: "  "})
+	assert.ErrorIs(t, err, service.ErrBadChecklistItem)
+
+	item, err := s.CreateChecklistItem(context.Background(), TestUser1.ID, TestTask1.ID, model.CreateChecklistItemRequest{Text: "write tests"})
+	require.NoError(t, err)
+	assert.Equal(t, "write tests", item.Text)
+	assert.Equal(t, 3, item.Position)
+
+	_, err = s.CreateChecklistItem(context.Background(), TestUser1.ID, foreign.ID, model.CreateChecklistItemRequest{Text: "write tests"})
+	assert.ErrorIs(t, err, service.ErrForbidden)
+}
+
+func TestService_ToggleChecklistItem(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	assigned, foreign := foreignTask(), foreignTask()
+	item := &model.ChecklistItem{ID: uuid.New(), Task: assigned.ID, Text: "a"}
+	other := &model.ChecklistItem{ID: uuid.New(), Task: uuid.New(), Text: "b"}
+
+	taskRepo := mocks.NewMockTaskRepository(ctrl)
+	taskRepo.EXPECT().GetByUserAndID(gomock.Any(), TestUser1.ID, assigned.ID).Return(assigned, nil).Times(2)
+	taskRepo.EXPECT().GetByUserAndID(gomock.Any(), TestUser1.ID, foreign.ID).Return(foreign, nil)
+	taskRepo.EXPECT().IsAssignee(gomock.Any(), assigned.ID, TestUser1.ID).Return(true).Times(2)
+	taskRepo.EXPECT().IsAssignee(gomock.Any(), foreign.ID, TestUser1.ID).Return(false)
+	taskRepo.EXPECT().GetRoleOfMember(gomock.Any(), TestUser1.ID, foreign.ID).Return(nil, store.ErrNotFound)
+	checkRepo := mocks.NewMockChecklistRepository(ctrl)
+	checkRepo.EXPECT().Get(gomock.Any(), item.ID).Return(item, nil)
+	checkRepo.EXPECT().Get(gomock.Any(), other.ID).Return(other, nil)
+	checkRepo.EXPECT().SetDone(gomock.Any(), item.ID, true).Return(nil)
+	str := mocks.NewMockStore(ctrl)
+	str.EXPECT().Task().Return(taskRepo).AnyTimes()
+	str.EXPECT().Checklist().Return(checkRepo).AnyTimes()
+
+	s := testService(t, str)
+	resp, err := s.ToggleChecklistItem(context.Background(), TestUser1.ID, assigned.ID, item.ID, model.ToggleChecklistItemRequest{Done: true})
+	require.NoError(t, err)
+	assert.True(t, resp.Done)
+
+	_, err = s.ToggleChecklistItem(context.Background(), TestUser1.ID, assigned.ID, other.ID, model.ToggleChecklistItemRequest{Done: true})
+	assert.ErrorIs(t, err, service.ErrNotFound)
+
+	_, err = s.ToggleChecklistItem(context.Background(), TestUser1.ID, foreign.ID, item.ID, model.ToggleChecklistItemRequest{Done: true})
+	assert.ErrorIs(t, err, service.ErrForbidden)
+}
+
+func TestService_MoveChecklistItem(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	item := uuid.New()
+	items := []*model.ChecklistItem{{ID: item, Task: TestTask1.ID, Text: "a"}}
+
+	taskRepo := mocks.NewMockTaskRepository(ctrl)
+	taskRepo.EXPECT().GetByUserAndID(gomock.Any(), TestUser1.ID, TestTask1.ID).Return(TestTask1, nil).Times(2)
+	taskRepo.EXPECT().GetRoleOfMember(gomock.Any(), TestUser1.ID, TestTask1.ID).Return(ReadOnlyRole, nil).Times(2)
+	checkRepo := mocks.NewMockChecklistRepository(ctrl)
+	checkRepo.EXPECT().Move(gomock.Any(), TestTask1.ID, item, 0).Return(nil)
+	checkRepo.EXPECT().Move(gomock.Any(), TestTask1.ID, gomock.Not(item), 0).Return(store.ErrNotFound)
+	checkRepo.EXPECT().AllByTask(gomock.Any(), TestTask1.ID).Return(items, nil)
+	str := mocks.NewMockStore(ctrl)
+	str.EXPECT().Task().Return(taskRepo).AnyTimes()
+	str.EXPECT().Checklist().Return(checkRepo).AnyTimes()
+
+	s := testService(t, str)
+	resp, err := s.MoveChecklistItem(context.Background(), TestUser1.ID, TestTask1.ID, item, model.MoveChecklistItemRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, items, resp.Items)
+
+	_, err = s.MoveChecklistItem(context.Background(), TestUser1.ID, TestTask1.ID, uuid.New(), model.MoveChecklistItemRequest{})
+	assert.ErrorIs(t, err, service.ErrNotFound)
+}
+
+func TestService_DeleteChecklistItem(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	foreign := foreignTask()
+	item := &model.ChecklistItem{ID: uuid.New(), Task: TestTask1.ID, Text: "a"}
+
+	taskRepo := mocks.NewMockTaskRepository(ctrl)
+	taskRepo.EXPECT().GetByUserAndID(gomock.Any(), TestUser1.ID, TestTask1.ID).Return(TestTask1, nil)
+	taskRepo.EXPECT().GetByUserAndID(gomock.Any(), TestUser1.ID, foreign.ID).Return(foreign, nil)
+	taskRepo.EXPECT().GetRoleOfMember(gomock.Any(), TestUser1.ID, TestTask1.ID).Return(ReadOnlyRole, nil)
+	taskRepo.EXPECT().GetRoleOfMember(gomock.Any(), TestUser1.ID, foreign.ID).Return(ReadOnlyRole, nil)
+	checkRepo := mocks.NewMockChecklistRepository(ctrl)
+	checkRepo.EXPECT().Get(gomock.Any(), item.ID).Return(item, nil)
+	checkRepo.EXPECT().Delete(gomock.Any(), item.ID).Return(nil)
+	str := mocks.NewMockStore(ctrl)
+	str.EXPECT().Task().Return(taskRepo).AnyTimes()
+	str.EXPECT().Checklist().Return(checkRepo).AnyTimes()
+
+	s := testService(t, str)
+	assert.NoError(t, s.DeleteChecklistItem(context.Background(), TestUser1.ID, TestTask1.ID, item.ID))
+	assert.ErrorIs(t, s.DeleteChecklistItem(context.Background(), TestUser1.ID, foreign.ID, item.ID), service.ErrForbidden)
+}
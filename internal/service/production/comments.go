@@ -0,0 +1,229 @@
+package production
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/vlad-marlo/godo/internal/model"
+	"github.com/vlad-marlo/godo/internal/service"
+	"github.com/vlad-marlo/godo/internal/store"
+	"go.uber.org/zap"
+)
+
+// getComment return comment of task and checks that user could change it.
+func (s *Service) getComment(ctx context.Context, user, task, comment uuid.UUID) (*model.Comment, error) {
+	t, err := s.GetTask(ctx, user, task)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := s.store.Comment().Get(ctx, comment)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return nil, service.ErrNotFound
+		}
+		return nil, service.ErrInternal.With(zap.Error(err))
+	}
+	if c.Task != t.ID {
+		return nil, service.ErrNotFound
+	}
+
+	// moderators change any issue of task, other users change only own comments.
+	perms, err := s.issuePermissions(ctx, user, t)
+	if err != nil {
+		return nil, err
+	}
+	if !perms.Has(model.PermModerateIssues) && (c.CreatedBy != user || !perms.Has(model.PermEditRelatedIssues)) {
+		return nil, service.ErrForbidden
+	}
+	return c, nil
+}
+
+// relatedComments return comments which are written by user or answer to comments of user.
+func relatedComments(user uuid.UUID, comments []*model.Comment) []*model.Comment {
+	own := make(map[uuid.UUID]struct{})
+	for _, c := range comments {
+		if c.CreatedBy == user {
+			own[c.ID] = struct{}{}
+		}
+	}
+
+	res := make([]*model.Comment, 0, len(own))
+	for _, c := range comments {
+		if _, ok := own[c.ID]; ok {
+			res = append(res, c)
+			continue
+		}
+		if c.ReplyTo != nil {
+			if _, ok := own[*c.ReplyTo]; ok {
+				res = append(res, c)
+			}
+		}
+	}
+	return res
+}
+
+// GetTaskComments return comments of task ordered by creation time.
+//
+// User who can not view issues of task will get only own comments and replies to them.
+func (s *Service) GetTaskComments(ctx context.Context, user, task uuid.UUID) (*model.GetCommentsResponse, error) {
+	t, err := s.GetTask(ctx, user, task)
+	if err != nil {
+		return nil, err
+	}
+
+	perms, err := s.issuePermissions(ctx, user, t)
+	if err != nil {
+		return nil, err
+	}
+
+	comments, err := s.store.Comment().AllByTask(ctx, t.ID)
+	if err != nil {
+		return nil, service.ErrInternal.With(zap.Error(err))
+	}
+	if !perms.Has(model.PermViewIssues) {
+		comments = relatedComments(user, comments)
+	}
+
+	return &model.GetCommentsResponse{
+		Count:    len(comments),
+		Comments: comments,
+	}, nil
+}
+
+// CreateComment creates comment to task or reply to another comment of same task.
+func (s *Service) CreateComment(ctx context.Context, user, task uuid.UUID, req model.CreateCommentRequest) (*model.Comment, error) {
+	if strings.TrimSpace(req.Message) == "" {
+		return nil, service.ErrEmptyComment
+	}
+
+	t, err := s.GetTask(ctx, user, task)
+	if err != nil {
+		return nil, err
+	}
+
+	perms, err := s.issuePermissions(ctx, user, t)
+	if err != nil {
+		return nil, err
+	}
+	if !perms.Has(model.PermCreateIssues) {
+		return nil, service.ErrForbidden
+	}
+
+	if req.ReplyTo != nil {
+		var parent *model.Comment
+		parent, err = s.store.Comment().Get(ctx, *req.ReplyTo)
+		if err != nil {
+			if errors.Is(err, store.ErrNotFound) {
+				return nil, service.ErrBadReplyTo
+			}
+			return nil, service.ErrInternal.With(zap.Error(err))
+		}
+		if parent.Task != t.ID {
+			return nil, service.ErrBadReplyTo
+		}
+	}
+
+	comment := &model.Comment{
+		ID:        uuid.New(),
+		Task:      t.ID,
+		ReplyTo:   req.ReplyTo,
+		Message:   req.Message,
+		CreatedBy: user,
+	}
+	if err = s.store.Comment().Create(ctx, comment); err != nil {
+		switch {
+		case errors.Is(err, store.ErrFKViolation):
+			return nil, service.ErrBadData.With(zap.Error(err))
+		default:
+			return nil, service.ErrInternal.With(zap.Error(err))
+		}
+	}
+
+	return comment, nil
+}
+
+// UpdateComment changes message of comment. Previous message is kept in history of comment.
+//
+// Author could edit own comment and users who can change all comments could edit any comment of task.
+func (s *Service) UpdateComment(ctx context.Context, user, task, comment uuid.UUID, req model.UpdateCommentRequest) (*model.Comment, error) {
+	if strings.TrimSpace(req.Message) == "" {
+		return nil, service.ErrEmptyComment
+	}
+
+	c, err := s.getComment(ctx, user, task, comment)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Message = req.Message
+	if err = s.store.Comment().Update(ctx, c, user); err != nil {
+		switch {
+		case errors.Is(err, store.ErrNotFound):
+			return nil, service.ErrNotFound
+		default:
+			return nil, service.ErrInternal.With(zap.Error(err))
+		}
+	}
+
+	return c, nil
+}
+
+// DeleteComment deletes comment. Permissions are equal to UpdateComment.
+func (s *Service) DeleteComment(ctx context.Context, user, task, comment uuid.UUID) error {
+	c, err := s.getComment(ctx, user, task, comment)
+	if err != nil {
+		return err
+	}
+
+	if err = s.store.Comment().Delete(ctx, c.ID); err != nil {
+		switch {
+		case errors.Is(err, store.ErrNotFound):
+			return service.ErrNotFound
+		default:
+			return service.ErrInternal.With(zap.Error(err))
+		}
+	}
+	// attachments of comment are deleted with it.
+	s.purgeOrphanedBlobs(ctx)
+	return nil
+}
+
+// GetCommentHistory return previous versions of comment.
+func (s *Service) GetCommentHistory(ctx context.Context, user, task, comment uuid.UUID) (*model.GetCommentHistoryResponse, error) {
+	t, err := s.GetTask(ctx, user, task)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := s.store.Comment().Get(ctx, comment)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return nil, service.ErrNotFound
+		}
+		return nil, service.ErrInternal.With(zap.Error(err))
+	}
+	if c.Task != t.ID {
+		return nil, service.ErrNotFound
+	}
+
+	perms, err := s.issuePermissions(ctx, user, t)
+	if err != nil {
+		return nil, err
+	}
+	if !perms.Has(model.PermViewIssues) && c.CreatedBy != user {
+		return nil, service.ErrForbidden
+	}
+
+	edits, err := s.store.Comment().History(ctx, c.ID)
+	if err != nil {
+		return nil, service.ErrInternal.With(zap.Error(err))
+	}
+
+	return &model.GetCommentHistoryResponse{
+		Count: len(edits),
+		Edits: edits,
+	}, nil
+}
@@ -0,0 +1,303 @@
+package production
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vlad-marlo/godo/internal/model"
+	"github.com/vlad-marlo/godo/internal/service"
+	"github.com/vlad-marlo/godo/internal/store"
+	"github.com/vlad-marlo/godo/internal/store/mocks"
+)
+
+func TestService_GetTaskComments(t *testing.T) {
+	user := uuid.New()
+	own := &model.Comment{ID: uuid.New(), CreatedBy: user}
+	reply := &model.Comment{ID: uuid.New(), CreatedBy: uuid.New(), ReplyTo: &own.ID}
+	other := &model.Comment{ID: uuid.New(), CreatedBy: uuid.New()}
+	comments := []*model.Comment{own, other, reply}
+
+	tt := []struct {
+		name string
+		role *model.Role
+		want []*model.Comment
+	}{
+		{"read all", SudoRole, comments},
+		{"read related", ReadOnlyRole, []*model.Comment{own, reply}},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			task := &model.Task{ID: uuid.New(), CreatedBy: uuid.New()}
+
+			taskRepo := mocks.NewMockTaskRepository(ctrl)
+			taskRepo.EXPECT().GetByUserAndID(gomock.Any(), user, task.ID).Return(task, nil)
+			taskRepo.EXPECT().GetRoleOfMember(gomock.Any(), user, task.ID).Return(tc.role, nil)
+			taskRepo.EXPECT().IsAssignee(gomock.Any(), task.ID, user).Return(false).AnyTimes()
+			commentRepo := mocks.NewMockCommentRepository(ctrl)
+			commentRepo.EXPECT().AllByTask(gomock.Any(), task.ID).Return(comments, nil)
+			str := mocks.NewMockStore(ctrl)
+			str.EXPECT().Task().Return(taskRepo).AnyTimes()
+			str.EXPECT().Comment().Return(commentRepo)
+
+			s := testService(t, str)
+			resp, err := s.GetTaskComments(context.Background(), user, task.ID)
+			require.NoError(t, err)
+			assert.Equal(t, &model.GetCommentsResponse{Count: len(tc.want), Comments: tc.want}, resp)
+		})
+	}
+}
+
+func TestService_CreateComment_Positive(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	user := uuid.New()
+	task := &model.Task{ID: uuid.New(), CreatedBy: uuid.New()}
+	parent := &model.Comment{ID: uuid.New(), Task: task.ID}
+
+	taskRepo := mocks.NewMockTaskRepository(ctrl)
+	taskRepo.EXPECT().GetByUserAndID(gomock.Any(), user, task.ID).Return(task, nil)
+	taskRepo.EXPECT().GetRoleOfMember(gomock.Any(), user, task.ID).Return(nil, store.ErrNotFound)
+	taskRepo.EXPECT().IsAssignee(gomock.Any(), task.ID, user).Return(true)
+	commentRepo := mocks.NewMockCommentRepository(ctrl)
+	commentRepo.EXPECT().Get(gomock.Any(), parent.ID).Return(parent, nil)
+	commentRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+	str := mocks.NewMockStore(ctrl)
+	str.EXPECT().Task().Return(taskRepo).AnyTimes()
+	str.EXPECT().Comment().Return(commentRepo).AnyTimes()
+
+	s := testService(t, str)
+	comment, err := s.CreateComment(context.Background(), user, task.ID, model.CreateCommentRequest{
+		Message: "msg",
+		ReplyTo: &parent.ID,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, task.ID, comment.Task)
+	assert.Equal(t, user, comment.CreatedBy)
+	assert.Equal(t, &parent.ID, comment.ReplyTo)
+	assert.Equal(t, "msg", comment.Message)
+}
+
+func TestService_CreateComment_Negative(t *testing.T) {
+	otherTaskComment := &model.Comment{ID: uuid.New(), Task: uuid.New()}
+	tt := []struct {
+		name      string
+		msg       string
+		role      *model.Role
+		replyTo   *uuid.UUID
+		parentErr error
+		createErr error
+		want      error
+	}{
+		{"empty message", " ", SudoRole, nil, nil, nil, service.ErrEmptyComment},
+		{"no permission", "msg", ReadOnlyRole, nil, nil, nil, service.ErrForbidden},
+		{"reply to unknown comment", "msg", SudoRole, &otherTaskComment.ID, store.ErrNotFound, nil, service.ErrBadReplyTo},
+		{"reply to comment of other task", "msg", SudoRole, &otherTaskComment.ID, nil, nil, service.ErrBadReplyTo},
+		{"fk violation", "msg", SudoRole, nil, nil, store.ErrFKViolation, service.ErrBadData},
+		{"unknown", "msg", SudoRole, nil, nil, errors.New(""), service.ErrInternal},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			user := uuid.New()
+			task := &model.Task{ID: uuid.New(), CreatedBy: uuid.New()}
+
+			taskRepo := mocks.NewMockTaskRepository(ctrl)
+			taskRepo.EXPECT().GetByUserAndID(gomock.Any(), user, task.ID).Return(task, nil).AnyTimes()
+			taskRepo.EXPECT().GetRoleOfMember(gomock.Any(), user, task.ID).Return(tc.role, nil).AnyTimes()
+			taskRepo.EXPECT().IsAssignee(gomock.Any(), task.ID, user).Return(false).AnyTimes()
+			commentRepo := mocks.NewMockCommentRepository(ctrl)
+			if tc.parentErr != nil {
+				commentRepo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, tc.parentErr).AnyTimes()
+			} else {
+				commentRepo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(otherTaskComment, nil).AnyTimes()
+			}
+			commentRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(tc.createErr).AnyTimes()
+			str := mocks.NewMockStore(ctrl)
+			str.EXPECT().Task().Return(taskRepo).AnyTimes()
+			str.EXPECT().Comment().Return(commentRepo).AnyTimes()
+
+			s := testService(t, str)
+			comment, err := s.CreateComment(context.Background(), user, task.ID, model.CreateCommentRequest{
+				Message: tc.msg,
+				ReplyTo: tc.replyTo,
+			})
+			assert.Nil(t, comment)
+			assert.ErrorIs(t, err, tc.want)
+		})
+	}
+}
+
+func TestService_UpdateComment(t *testing.T) {
+	user := uuid.New()
+	tt := []struct {
+		name   string
+		author uuid.UUID
+		role   *model.Role
+		task   bool
+		err    error
+		want   error
+	}{
+		{"author", user, &model.Role{Permissions: model.PermEditRelatedIssues}, true, nil, nil},
+		{"moderator", uuid.New(), SudoRole, true, nil, nil},
+		{"not author", uuid.New(), &model.Role{Permissions: model.PermEditRelatedIssues}, true, nil, service.ErrForbidden},
+		{"author without permission", user, ReadOnlyRole, true, nil, service.ErrForbidden},
+		{"comment of other task", user, SudoRole, false, nil, service.ErrNotFound},
+		{"deleted concurrently", user, SudoRole, true, store.ErrNotFound, service.ErrNotFound},
+		{"unknown", user, SudoRole, true, errors.New(""), service.ErrInternal},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			task := &model.Task{ID: uuid.New(), CreatedBy: uuid.New()}
+			comment := &model.Comment{ID: uuid.New(), Task: uuid.New(), CreatedBy: tc.author, Message: "old"}
+			if tc.task {
+				comment.Task = task.ID
+			}
+
+			taskRepo := mocks.NewMockTaskRepository(ctrl)
+			taskRepo.EXPECT().GetByUserAndID(gomock.Any(), user, task.ID).Return(task, nil)
+			taskRepo.EXPECT().GetRoleOfMember(gomock.Any(), user, task.ID).Return(tc.role, nil).AnyTimes()
+			taskRepo.EXPECT().IsAssignee(gomock.Any(), task.ID, user).Return(false).AnyTimes()
+			commentRepo := mocks.NewMockCommentRepository(ctrl)
+			commentRepo.EXPECT().Get(gomock.Any(), comment.ID).Return(comment, nil)
+			commentRepo.EXPECT().Update(gomock.Any(), comment, user).Return(tc.err).AnyTimes()
+			str := mocks.NewMockStore(ctrl)
+			str.EXPECT().Task().Return(taskRepo).AnyTimes()
+			str.EXPECT().Comment().Return(commentRepo).AnyTimes()
+
+			s := testService(t, str)
+			resp, err := s.UpdateComment(context.Background(), user, task.ID, comment.ID, model.UpdateCommentRequest{Message: "new"})
+			if tc.want != nil {
+				assert.Nil(t, resp)
+				assert.ErrorIs(t, err, tc.want)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "new", resp.Message)
+		})
+	}
+}
+
+func TestService_UpdateComment_Empty(t *testing.T) {
+	s := testService(t, nil)
+	resp, err := s.UpdateComment(context.Background(), uuid.New(), uuid.New(), uuid.New(), model.UpdateCommentRequest{})
+	assert.Nil(t, resp)
+	assert.ErrorIs(t, err, service.ErrEmptyComment)
+}
+
+func TestService_DeleteComment(t *testing.T) {
+	tt := []struct {
+		name string
+		err  error
+		want error
+	}{
+		{"ok", nil, nil},
+		{"not found", store.ErrNotFound, service.ErrNotFound},
+		{"unknown", errors.New(""), service.ErrInternal},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			user := uuid.New()
+			task := &model.Task{ID: uuid.New(), CreatedBy: user}
+			comment := &model.Comment{ID: uuid.New(), Task: task.ID, CreatedBy: uuid.New()}
+
+			taskRepo := mocks.NewMockTaskRepository(ctrl)
+			taskRepo.EXPECT().GetByUserAndID(gomock.Any(), user, task.ID).Return(task, nil)
+			taskRepo.EXPECT().GetRoleOfMember(gomock.Any(), user, task.ID).Return(nil, store.ErrNotFound)
+			commentRepo := mocks.NewMockCommentRepository(ctrl)
+			commentRepo.EXPECT().Get(gomock.Any(), comment.ID).Return(comment, nil)
+			commentRepo.EXPECT().Delete(gomock.Any(), comment.ID).Return(tc.err)
+			attachRepo := mocks.NewMockAttachmentRepository(ctrl)
+			attachRepo.EXPECT().Orphaned(gomock.Any(), orphanedBlobsBatchSize).Return(nil, nil).AnyTimes()
+			str := mocks.NewMockStore(ctrl)
+			str.EXPECT().Task().Return(taskRepo).AnyTimes()
+			str.EXPECT().Attachment().Return(attachRepo).AnyTimes()
+			str.EXPECT().Comment().Return(commentRepo).AnyTimes()
+
+			s := testService(t, str)
+			err := s.DeleteComment(context.Background(), user, task.ID, comment.ID)
+			if tc.want != nil {
+				assert.ErrorIs(t, err, tc.want)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestService_GetCommentHistory(t *testing.T) {
+	user := uuid.New()
+	edits := []*model.CommentEdit{{Message: "first", EditedBy: user}}
+	tt := []struct {
+		name   string
+		author uuid.UUID
+		role   *model.Role
+		want   error
+	}{
+		{"author", user, ReadOnlyRole, nil},
+		{"reader", uuid.New(), &model.Role{Permissions: model.PermViewIssues}, nil},
+		{"no permission", uuid.New(), ReadOnlyRole, service.ErrForbidden},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			task := &model.Task{ID: uuid.New(), CreatedBy: uuid.New()}
+			comment := &model.Comment{ID: uuid.New(), Task: task.ID, CreatedBy: tc.author}
+
+			taskRepo := mocks.NewMockTaskRepository(ctrl)
+			taskRepo.EXPECT().GetByUserAndID(gomock.Any(), user, task.ID).Return(task, nil)
+			taskRepo.EXPECT().GetRoleOfMember(gomock.Any(), user, task.ID).Return(tc.role, nil)
+			taskRepo.EXPECT().IsAssignee(gomock.Any(), task.ID, user).Return(false).AnyTimes()
+			commentRepo := mocks.NewMockCommentRepository(ctrl)
+			commentRepo.EXPECT().Get(gomock.Any(), comment.ID).Return(comment, nil)
+			commentRepo.EXPECT().History(gomock.Any(), comment.ID).Return(edits, nil).AnyTimes()
+			str := mocks.NewMockStore(ctrl)
+			str.EXPECT().Task().Return(taskRepo).AnyTimes()
+			str.EXPECT().Comment().Return(commentRepo).AnyTimes()
+
+			s := testService(t, str)
+			resp, err := s.GetCommentHistory(context.Background(), user, task.ID, comment.ID)
+			if tc.want != nil {
+				assert.Nil(t, resp)
+				assert.ErrorIs(t, err, tc.want)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, &model.GetCommentHistoryResponse{Count: len(edits), Edits: edits}, resp)
+		})
+	}
+}
+
+func TestService_IssuePermissions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	creator, assignee, stranger := uuid.New(), uuid.New(), uuid.New()
+	task := &model.Task{ID: uuid.New(), CreatedBy: creator}
+
+	taskRepo := mocks.NewMockTaskRepository(ctrl)
+	taskRepo.EXPECT().GetRoleOfMember(gomock.Any(), creator, task.ID).Return(&model.Role{}, nil)
+	taskRepo.EXPECT().GetRoleOfMember(gomock.Any(), assignee, task.ID).Return(nil, store.ErrNotFound)
+	taskRepo.EXPECT().GetRoleOfMember(gomock.Any(), stranger, task.ID).Return(nil, store.ErrNotFound)
+	taskRepo.EXPECT().IsAssignee(gomock.Any(), task.ID, assignee).Return(true)
+	taskRepo.EXPECT().IsAssignee(gomock.Any(), task.ID, stranger).Return(false)
+	str := mocks.NewMockStore(ctrl)
+	str.EXPECT().Task().Return(taskRepo).AnyTimes()
+
+	s := testService(t, str)
+	got, err := s.issuePermissions(context.Background(), creator, task)
+	require.NoError(t, err)
+	assert.Equal(t, creatorPermissions, got)
+
+	got, err = s.issuePermissions(context.Background(), assignee, task)
+	require.NoError(t, err)
+	assert.Equal(t, assigneePermissions, got)
+
+	got, err = s.issuePermissions(context.Background(), stranger, task)
+	require.NoError(t, err)
+	assert.Zero(t, got)
+}
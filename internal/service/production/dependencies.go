@@ -0,0 +1,142 @@
+package production
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/vlad-marlo/godo/internal/model"
+	"github.com/vlad-marlo/godo/internal/service"
+	"github.com/vlad-marlo/godo/internal/store"
+	"go.uber.org/zap"
+)
+
+// reaches return true if there is path from one task to another by links from blocker to blocked task.
+func reaches(edges []*model.TaskDependency, from, to uuid.UUID) bool {
+	next := make(map[uuid.UUID][]uuid.UUID, len(edges))
+	for _, e := range edges {
+		next[e.Blocker] = append(next[e.Blocker], e.Blocked)
+	}
+
+	visited := map[uuid.UUID]struct{}{from: {}}
+	queue := []uuid.UUID{from}
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+		if cur == to {
+			return true
+		}
+		for _, n := range next[cur] {
+			if _, ok := visited[n]; !ok {
+				visited[n] = struct{}{}
+				queue = append(queue, n)
+			}
+		}
+	}
+	return false
+}
+
+// AddDependency links task with task which blocks it.
+//
+// Both tasks must be related to one group and link must not create cycle of dependencies.
+// User must be able to change blocked task.
+func (s *Service) AddDependency(ctx context.Context, user, task uuid.UUID, req model.AddDependencyRequest) (*model.TaskDependency, error) {
+	if req.BlockedBy == task {
+		return nil, service.ErrDependencyCycle
+	}
+
+	t, err := s.GetTask(ctx, user, task)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.authorizeTask(ctx, user, t, model.PermEditTasks); err != nil {
+		return nil, err
+	}
+
+	if _, err = s.GetTask(ctx, user, req.BlockedBy); err != nil {
+		if errors.Is(err, service.ErrNotFound) {
+			return nil, service.ErrBadDependency
+		}
+		return nil, err
+	}
+	if !s.store.Task().ShareGroup(ctx, t.ID, req.BlockedBy) {
+		return nil, service.ErrBadDependency
+	}
+
+	dep := &model.TaskDependency{
+		Blocker:   req.BlockedBy,
+		Blocked:   t.ID,
+		CreatedBy: user,
+	}
+	// new link from blocker to task creates cycle if blocker is already reachable from task.
+	err = s.store.Dependency().Create(ctx, dep, func(downstream []*model.TaskDependency) error {
+		if reaches(downstream, t.ID, req.BlockedBy) {
+			return service.ErrDependencyCycle
+		}
+		return nil
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrDependencyCycle):
+			return nil, service.ErrDependencyCycle
+		case errors.Is(err, store.ErrUniqueViolation):
+			return nil, service.ErrConflict.With(zap.Error(err))
+		case errors.Is(err, store.ErrFKViolation):
+			return nil, service.ErrBadDependency.With(zap.Error(err))
+		default:
+			return nil, service.ErrInternal.With(zap.Error(err))
+		}
+	}
+	return dep, nil
+}
+
+// RemoveDependency deletes link between task and its blocker. Permissions are equal to AddDependency.
+func (s *Service) RemoveDependency(ctx context.Context, user, task, blocker uuid.UUID) error {
+	t, err := s.GetTask(ctx, user, task)
+	if err != nil {
+		return err
+	}
+	if err = s.authorizeTask(ctx, user, t, model.PermEditTasks); err != nil {
+		return err
+	}
+
+	if err = s.store.Dependency().Delete(ctx, blocker, t.ID); err != nil {
+		switch {
+		case errors.Is(err, store.ErrNotFound):
+			return service.ErrNotFound
+		default:
+			return service.ErrInternal.With(zap.Error(err))
+		}
+	}
+	return nil
+}
+
+// GetDependencyGraph return tasks of group and links between them.
+//
+// User must be admin of group or could see all tasks of group.
+func (s *Service) GetDependencyGraph(ctx context.Context, user, group uuid.UUID) (*model.DependencyGraph, error) {
+	if err := s.authorize(ctx, user, group, model.PermViewTasks); err != nil {
+		return nil, err
+	}
+
+	g, err := s.store.Dependency().GraphByGroup(ctx, group)
+	if err != nil {
+		return nil, service.ErrInternal.With(zap.Error(err))
+	}
+	return g, nil
+}
+
+// checkBlockers checks that task is not blocked by tasks which are not done yet.
+func (s *Service) checkBlockers(ctx context.Context, task uuid.UUID) error {
+	blockers, err := s.store.Dependency().OpenBlockers(ctx, task)
+	if err != nil {
+		return service.ErrInternal.With(zap.Error(err))
+	}
+	if len(blockers) > 0 {
+		return service.ErrTaskBlocked.WithData(map[string]any{
+			"status":     "task is blocked by tasks which are not closed or accepted",
+			"blocked-by": blockers,
+		})
+	}
+	return nil
+}
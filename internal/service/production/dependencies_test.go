@@ -0,0 +1,160 @@
+package production
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vlad-marlo/godo/internal/model"
+	"github.com/vlad-marlo/godo/internal/pkg/fielderr"
+	"github.com/vlad-marlo/godo/internal/service"
+	"github.com/vlad-marlo/godo/internal/store"
+	"github.com/vlad-marlo/godo/internal/store/mocks"
+)
+
+func TestReaches(t *testing.T) {
+	a, b, c, d := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	edges := []*model.TaskDependency{
+		{Blocker: a, Blocked: b},
+		{Blocker: b, Blocked: c},
+		{Blocker: c, Blocked: a},
+	}
+	assert.True(t, reaches(edges, a, c))
+	assert.True(t, reaches(edges, c, b))
+	assert.True(t, reaches(edges, a, a))
+	assert.False(t, reaches(edges, a, d))
+	assert.False(t, reaches(nil, a, b))
+}
+
+func TestService_AddDependency(t *testing.T) {
+	user := uuid.New()
+	task := &model.Task{ID: uuid.New(), CreatedBy: user}
+	blocker := &model.Task{ID: uuid.New()}
+	middle := uuid.New()
+
+	tt := []struct {
+		name       string
+		shareGroup bool
+		downstream []*model.TaskDependency
+		createErr  error
+		want       error
+	}{
+		{"positive", true, nil, nil, nil},
+		{"other group", false, nil, nil, service.ErrBadDependency},
+		{"unrelated links", true, []*model.TaskDependency{{Blocker: task.ID, Blocked: middle}, {Blocker: uuid.New(), Blocked: blocker.ID}}, nil, nil},
+		{"cycle", true, []*model.TaskDependency{{Blocker: task.ID, Blocked: middle}, {Blocker: middle, Blocked: blocker.ID}}, nil, service.ErrDependencyCycle},
+		{"direct cycle", true, []*model.TaskDependency{{Blocker: task.ID, Blocked: blocker.ID}}, nil, service.ErrDependencyCycle},
+		{"already exists", true, nil, store.ErrUniqueViolation, service.ErrConflict},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			taskRepo := mocks.NewMockTaskRepository(ctrl)
+			taskRepo.EXPECT().GetByUserAndID(gomock.Any(), user, task.ID).Return(task, nil)
+			taskRepo.EXPECT().GetByUserAndID(gomock.Any(), user, blocker.ID).Return(blocker, nil)
+			taskRepo.EXPECT().GetRoleOfMember(gomock.Any(), user, task.ID).Return(nil, store.ErrNotFound)
+			taskRepo.EXPECT().ShareGroup(gomock.Any(), task.ID, blocker.ID).Return(tc.shareGroup)
+			depRepo := mocks.NewMockDependencyRepository(ctrl)
+			depRepo.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, _ *model.TaskDependency, check func([]*model.TaskDependency) error) error {
+					if err := check(tc.downstream); err != nil {
+						return err
+					}
+					return tc.createErr
+				},
+			).MaxTimes(1)
+			str := mocks.NewMockStore(ctrl)
+			str.EXPECT().Task().Return(taskRepo).AnyTimes()
+			str.EXPECT().Dependency().Return(depRepo).AnyTimes()
+
+			s := testService(t, str)
+			dep, err := s.AddDependency(context.Background(), user, task.ID, model.AddDependencyRequest{BlockedBy: blocker.ID})
+			if tc.want != nil {
+				assert.ErrorIs(t, err, tc.want)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, blocker.ID, dep.Blocker)
+			assert.Equal(t, task.ID, dep.Blocked)
+			assert.Equal(t, user, dep.CreatedBy)
+		})
+	}
+}
+
+func TestService_AddDependency_Self(t *testing.T) {
+	s := testService(t, nil)
+	task := uuid.New()
+	_, err := s.AddDependency(context.Background(), uuid.Nil, task, model.AddDependencyRequest{BlockedBy: task})
+	assert.ErrorIs(t, err, service.ErrDependencyCycle)
+}
+
+func TestService_RemoveDependency(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	user := uuid.New()
+	task := &model.Task{ID: uuid.New(), CreatedBy: user}
+	blocker, other := uuid.New(), uuid.New()
+
+	taskRepo := mocks.NewMockTaskRepository(ctrl)
+	taskRepo.EXPECT().GetByUserAndID(gomock.Any(), user, task.ID).Return(task, nil).Times(2)
+	taskRepo.EXPECT().GetRoleOfMember(gomock.Any(), user, task.ID).Return(nil, store.ErrNotFound).Times(2)
+	depRepo := mocks.NewMockDependencyRepository(ctrl)
+	depRepo.EXPECT().Delete(gomock.Any(), blocker, task.ID).Return(nil)
+	depRepo.EXPECT().Delete(gomock.Any(), other, task.ID).Return(store.ErrNotFound)
+	str := mocks.NewMockStore(ctrl)
+	str.EXPECT().Task().Return(taskRepo).AnyTimes()
+	str.EXPECT().Dependency().Return(depRepo).AnyTimes()
+
+	s := testService(t, str)
+	assert.NoError(t, s.RemoveDependency(context.Background(), user, task.ID, blocker))
+	assert.ErrorIs(t, s.RemoveDependency(context.Background(), user, task.ID, other), service.ErrNotFound)
+}
+
+func TestService_GetDependencyGraph(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	reader, stranger, group := uuid.New(), uuid.New(), uuid.New()
+	graph := &model.DependencyGraph{Nodes: []*model.DependencyNode{{ID: uuid.New()}}}
+
+	groupRepo := mocks.NewMockGroupRepository(ctrl)
+	groupRepo.EXPECT().GetRoleOfMember(gomock.Any(), reader, group).Return(&model.Role{Permissions: model.PermViewTasks}, nil)
+	groupRepo.EXPECT().GetRoleOfMember(gomock.Any(), stranger, group).Return(nil, store.ErrNotFound)
+	depRepo := mocks.NewMockDependencyRepository(ctrl)
+	depRepo.EXPECT().GraphByGroup(gomock.Any(), group).Return(graph, nil)
+	str := mocks.NewMockStore(ctrl)
+	str.EXPECT().Group().Return(groupRepo).AnyTimes()
+	str.EXPECT().Dependency().Return(depRepo).AnyTimes()
+
+	s := testService(t, str)
+	got, err := s.GetDependencyGraph(context.Background(), reader, group)
+	require.NoError(t, err)
+	assert.Equal(t, graph, got)
+
+	_, err = s.GetDependencyGraph(context.Background(), stranger, group)
+	assert.ErrorIs(t, err, service.ErrForbidden)
+}
+
+func TestService_UpdateTaskStatus_Blocked(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	user := uuid.New()
+	task := &model.Task{ID: uuid.New(), CreatedBy: user, Status: model.StatusNew}
+	blockers := []uuid.UUID{uuid.New()}
+
+	taskRepo := mocks.NewMockTaskRepository(ctrl)
+	taskRepo.EXPECT().GetByUserAndID(gomock.Any(), user, task.ID).Return(task, nil)
+	taskRepo.EXPECT().GetRoleOfMember(gomock.Any(), user, task.ID).Return(nil, store.ErrNotFound)
+	depRepo := mocks.NewMockDependencyRepository(ctrl)
+	depRepo.EXPECT().OpenBlockers(gomock.Any(), task.ID).Return(blockers, nil)
+	str := mocks.NewMockStore(ctrl)
+	str.EXPECT().Task().Return(taskRepo).AnyTimes()
+	str.EXPECT().Dependency().Return(depRepo)
+
+	s := testService(t, str)
+	_, err := s.UpdateTaskStatus(context.Background(), user, task.ID, model.StatusInProgress)
+	assert.ErrorIs(t, err, service.ErrTaskBlocked)
+	var fErr *fielderr.Error
+	if assert.ErrorAs(t, err, &fErr) {
+		assert.Equal(t, blockers, fErr.Data().(map[string]any)["blocked-by"])
+	}
+}
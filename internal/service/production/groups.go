@@ -3,6 +3,9 @@ package production
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strings"
+
 	"github.com/google/uuid"
 	"github.com/vlad-marlo/godo/internal/model"
 	"github.com/vlad-marlo/godo/internal/service"
@@ -10,7 +13,13 @@ import (
 	"go.uber.org/zap"
 )
 
-// CreateGroup creates group in storage and prepares response to user.
+const (
+	maxGroupFields         = 32
+	maxGroupFieldKeyLength = 64
+	maxGroupFieldLength    = 1024
+)
+
+// CreateGroup creates group with admin role and owner in storage and prepares response to user.
 func (s *Service) CreateGroup(ctx context.Context, user uuid.UUID, name, description string) (*model.CreateGroupResponse, error) {
 	if user == uuid.Nil {
 		return nil, service.ErrBadAuthCredentials
@@ -22,7 +31,11 @@ func (s *Service) CreateGroup(ctx context.Context, user uuid.UUID, name, descrip
 		Description: description,
 	}
 
-	if err := s.store.Group().Create(ctx, grp); err != nil {
+	role := &model.Role{
+		Name:        adminRoleName,
+		Permissions: model.PermAll,
+	}
+	if err := s.store.Group().CreateWithOwner(ctx, grp, role); err != nil {
 		switch {
 		case errors.Is(err, store.ErrUniqueViolation):
 			return nil, service.ErrGroupAlreadyExists
@@ -33,18 +46,6 @@ func (s *Service) CreateGroup(ctx context.Context, user uuid.UUID, name, descrip
 
 		return nil, service.ErrInternal.With(zap.Error(err))
 	}
-	role := &model.Role{
-		Members:  model.PermChangeAll,
-		Tasks:    model.PermChangeAll,
-		Reviews:  model.PermChangeAll,
-		Comments: model.PermChangeAll,
-	}
-	if err := s.store.Role().Get(ctx, role); err != nil {
-		return nil, service.ErrInternal.With(zap.Error(err), zap.String("at", "role get id"))
-	}
-	if err := s.store.Group().AddUser(ctx, role.ID, grp.ID, user, true); err != nil {
-		return nil, service.ErrInternal.With(zap.Error(err), zap.String("at", "add user to group"))
-	}
 
 	return &model.CreateGroupResponse{
 		ID:          grp.ID,
@@ -79,3 +80,126 @@ func (s *Service) UseInvite(ctx context.Context, user uuid.UUID, group uuid.UUID
 
 	return nil
 }
+
+// GetGroups return short info about groups in which user is member.
+func (s *Service) GetGroups(ctx context.Context, user uuid.UUID) (*model.GetGroupsResponse, error) {
+	groups, err := s.store.Group().GetByUser(ctx, user)
+	if err != nil && !errors.Is(err, store.ErrNotFound) {
+		return nil, service.ErrInternal.With(zap.Error(err))
+	}
+
+	resp := &model.GetGroupsResponse{
+		Count:  len(groups),
+		Groups: make([]*model.GroupInUser, 0, len(groups)),
+	}
+	for _, g := range groups {
+		resp.Groups = append(resp.Groups, &model.GroupInUser{
+			ID:          g.ID,
+			Name:        g.Name,
+			Description: g.Description,
+		})
+	}
+	return resp, nil
+}
+
+// getGroup return group with profile if user is admin of it.
+func (s *Service) getGroup(ctx context.Context, user, group uuid.UUID) (*model.Group, error) {
+	if err := s.authorizeAdmin(ctx, user, group); err != nil {
+		return nil, err
+	}
+
+	g, err := s.store.Group().Get(ctx, group)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return nil, service.ErrNotFound
+		}
+		return nil, service.ErrInternal.With(zap.Error(err))
+	}
+	return g, nil
+}
+
+// GetGroup return profile of group. Profile is available only to admins of group.
+func (s *Service) GetGroup(ctx context.Context, user, group uuid.UUID) (*model.GroupProfile, error) {
+	g, err := s.getGroup(ctx, user, group)
+	if err != nil {
+		return nil, err
+	}
+	return g.Profile(), nil
+}
+
+// UpdateGroup changes profile of group. Profile could be changed only by admins of group.
+func (s *Service) UpdateGroup(ctx context.Context, user, group uuid.UUID, req model.UpdateGroupRequest) (*model.GroupProfile, error) {
+	g, err := s.getGroup(ctx, user, group)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		g.Name = strings.TrimSpace(*req.Name)
+	}
+	if req.Description != nil {
+		g.Description = *req.Description
+	}
+	if req.TelegramChat != nil {
+		g.TelegramChat = strings.TrimSpace(*req.TelegramChat)
+	}
+	if req.CompanyName != nil {
+		g.CompanyName = *req.CompanyName
+	}
+	if req.CompanyInfo != nil {
+		g.CompanyInfo = *req.CompanyInfo
+	}
+	if g.Fields == nil {
+		g.Fields = make(map[string]string, len(req.Fields))
+	}
+	for key, value := range req.Fields {
+		if value == nil {
+			delete(g.Fields, key)
+			continue
+		}
+		g.Fields[key] = *value
+	}
+	if err = validateGroupProfile(g); err != nil {
+		return nil, err
+	}
+
+	if err = s.store.Group().Update(ctx, g); err != nil {
+		switch {
+		case errors.Is(err, store.ErrUniqueViolation):
+			return nil, service.ErrGroupAlreadyExists
+		case errors.Is(err, store.ErrNotFound):
+			return nil, service.ErrNotFound
+		}
+		return nil, service.ErrInternal.With(zap.Error(err))
+	}
+	return g.Profile(), nil
+}
+
+// validateGroupProfile checks name, telegram chat and custom fields of group.
+func validateGroupProfile(g *model.Group) error {
+	if g.Name == "" {
+		return service.ErrBadGroupProfile
+	}
+	if g.TelegramChat != "" && !model.ValidTelegramChat(g.TelegramChat) {
+		return service.ErrBadGroupProfile.WithData(map[string]string{
+			"telegram-chat": "telegram chat must be username like @godo_team or link like https://t.me/godo_team",
+		})
+	}
+	if len(g.Fields) > maxGroupFields {
+		return service.ErrBadGroupProfile.WithData(map[string]string{
+			"fields": fmt.Sprintf("group could have at most %d custom fields", maxGroupFields),
+		})
+	}
+	for key, value := range g.Fields {
+		if strings.TrimSpace(key) == "" || len(key) > maxGroupFieldKeyLength || len(value) > maxGroupFieldLength {
+			return service.ErrBadGroupProfile.WithData(map[string]string{
+				"fields": fmt.Sprintf(
+					"names of custom fields must not be empty or longer than %d bytes and values must not be longer than %d bytes",
+					maxGroupFieldKeyLength,
+					maxGroupFieldLength,
+				),
+			})
+		}
+	}
+	return nil
+}
@@ -19,24 +19,18 @@ func TestService_CreateGroup_Positive(t *testing.T) {
 
 	str := mocks.NewMockStore(ctrl)
 	grp := mocks.NewMockGroupRepository(ctrl)
-	roleRepo := mocks.NewMockRoleRepository(ctrl)
 
 	grp.
 		EXPECT().
-		Create(gomock.Any(), gomock.Any()).
-		DoAndReturn(func(ctx context.Context, g *model.Group) error {
+		CreateWithOwner(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, g *model.Group, role *model.Role) error {
 			assert.Equal(t, TestGroup1.Owner, g.Owner)
+			assert.Equal(t, adminRoleName, role.Name)
+			assert.Equal(t, model.PermAll, role.Permissions)
 			return nil
 		})
-	roleRepo.
-		EXPECT().
-		Get(gomock.Any(), gomock.Any()).Return(nil)
-	grp.
-		EXPECT().
-		AddUser(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), true)
 
-	str.EXPECT().Group().Return(grp).Times(2)
-	str.EXPECT().Role().Return(roleRepo)
+	str.EXPECT().Group().Return(grp)
 
 	srv := testService(t, str)
 
@@ -54,7 +48,7 @@ func TestService_CreateGroup_Negative_ErrGroupAlreadyExists(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	str := mocks.NewMockStore(ctrl)
 	grp := mocks.NewMockGroupRepository(ctrl)
-	grp.EXPECT().Create(gomock.Any(), gomock.Any()).Return(store.ErrUniqueViolation)
+	grp.EXPECT().CreateWithOwner(gomock.Any(), gomock.Any(), gomock.Any()).Return(store.ErrUniqueViolation)
 
 	str.EXPECT().Group().Return(grp)
 
@@ -65,62 +59,6 @@ func TestService_CreateGroup_Negative_ErrGroupAlreadyExists(t *testing.T) {
 	assert.ErrorIs(t, err, service.ErrGroupAlreadyExists)
 }
 
-func TestService_CreateGroup_Negative_ErrWhileGettingRole(t *testing.T) {
-	ctrl := gomock.NewController(t)
-	str := mocks.NewMockStore(ctrl)
-	grpRepo := mocks.NewMockGroupRepository(ctrl)
-	roleRepo := mocks.NewMockRoleRepository(ctrl)
-	grpRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
-	roleRepo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(errors.New(""))
-	str.EXPECT().Group().Return(grpRepo)
-	str.EXPECT().Role().Return(roleRepo)
-	s := testService(t, str)
-	resp, err := s.CreateGroup(context.Background(), uuid.New(), uuid.NewString(), uuid.NewString())
-	assert.Nil(t, resp)
-	if assert.Error(t, err) {
-		assert.ErrorIs(t, err, service.ErrInternal)
-	}
-}
-
-func TestService_CreateGroup_Negative_ErrWhileAddingUser(t *testing.T) {
-	ctrl := gomock.NewController(t)
-
-	str := mocks.NewMockStore(ctrl)
-	grp := mocks.NewMockGroupRepository(ctrl)
-	roleRepo := mocks.NewMockRoleRepository(ctrl)
-
-	grp.
-		EXPECT().
-		Create(gomock.Any(), gomock.Any()).
-		DoAndReturn(func(ctx context.Context, g *model.Group) error {
-			assert.Equal(t, TestGroup1.Owner, g.Owner)
-			return nil
-		})
-	roleRepo.
-		EXPECT().
-		Get(gomock.Any(), gomock.Any()).
-		DoAndReturn(func(ctx context.Context, role *model.Role) error {
-			role.ID = 10
-			return nil
-		})
-	grp.
-		EXPECT().
-		AddUser(gomock.Any(), int32(10), gomock.Any(), TestGroup1.Owner, true).Return(errors.New(""))
-
-	str.EXPECT().Group().Return(grp).Times(2)
-	str.EXPECT().Role().Return(roleRepo)
-
-	srv := testService(t, str)
-
-	ctx := context.Background()
-
-	resp, err := srv.CreateGroup(ctx, TestGroup1.Owner, TestGroup1.Name, TestGroup1.Description)
-	if assert.Error(t, err) {
-		assert.ErrorIs(t, err, service.ErrInternal)
-	}
-	assert.Nil(t, resp)
-}
-
 func TestService_CreateGroup_BadUser(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	str := mocks.NewMockStore(ctrl)
@@ -136,7 +74,7 @@ func TestService_CreateGroup_BadRequest(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	str := mocks.NewMockStore(ctrl)
 	grp := mocks.NewMockGroupRepository(ctrl)
-	grp.EXPECT().Create(gomock.Any(), gomock.Any()).Return(errors.New(errMsg))
+	grp.EXPECT().CreateWithOwner(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New(errMsg))
 	str.EXPECT().Group().Return(grp)
 
 	srv := testService(t, str)
@@ -206,7 +144,7 @@ func TestService_CreateGroup_NilReq(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			groupRepository := mocks.NewMockGroupRepository(ctrl)
-			groupRepository.EXPECT().Create(gomock.Any(), gomock.Any()).Return(tc.err)
+			groupRepository.EXPECT().CreateWithOwner(gomock.Any(), gomock.Any(), gomock.Any()).Return(tc.err)
 			st := mocks.NewMockStore(ctrl)
 			st.EXPECT().Group().Return(groupRepository)
 
@@ -219,3 +157,143 @@ func TestService_CreateGroup_NilReq(t *testing.T) {
 		})
 	}
 }
+
+func TestService_GetGroups(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	g := &model.Group{ID: uuid.New(), Name: "name", Description: "description", CompanyName: "company"}
+	broken := uuid.New()
+
+	groupRepo := mocks.NewMockGroupRepository(ctrl)
+	groupRepo.EXPECT().GetByUser(gomock.Any(), TestUser1.ID).Return([]*model.Group{g}, nil)
+	groupRepo.EXPECT().GetByUser(gomock.Any(), uuid.Nil).Return(nil, nil)
+	groupRepo.EXPECT().GetByUser(gomock.Any(), broken).Return(nil, errors.New(""))
+	str := mocks.NewMockStore(ctrl)
+	str.EXPECT().Group().Return(groupRepo).AnyTimes()
+
+	s := testService(t, str)
+	resp, err := s.GetGroups(context.Background(), TestUser1.ID)
+	require.NoError(t, err)
+	assert.Equal(t, &model.GetGroupsResponse{
+		Count:  1,
+		Groups: []*model.GroupInUser{{ID: g.ID, Name: "name", Description: "description"}},
+	}, resp)
+
+	resp, err = s.GetGroups(context.Background(), uuid.Nil)
+	require.NoError(t, err)
+	assert.Equal(t, &model.GetGroupsResponse{Groups: []*model.GroupInUser{}}, resp)
+
+	_, err = s.GetGroups(context.Background(), broken)
+	assert.ErrorIs(t, err, service.ErrInternal)
+}
+
+func TestService_GetGroup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	g := &model.Group{ID: uuid.New(), Name: "name", Fields: map[string]string{"site": "example.com"}}
+	missing, broken, member := uuid.New(), uuid.New(), uuid.New()
+
+	groupRepo := mocks.NewMockGroupRepository(ctrl)
+	groupRepo.EXPECT().IsAdmin(gomock.Any(), gomock.Any(), TestUser1.ID).Return(true).AnyTimes()
+	groupRepo.EXPECT().IsAdmin(gomock.Any(), g.ID, member).Return(false)
+	groupRepo.EXPECT().Get(gomock.Any(), g.ID).Return(g, nil)
+	groupRepo.EXPECT().Get(gomock.Any(), missing).Return(nil, store.ErrNotFound)
+	groupRepo.EXPECT().Get(gomock.Any(), broken).Return(nil, errors.New(""))
+	str := mocks.NewMockStore(ctrl)
+	str.EXPECT().Group().Return(groupRepo).AnyTimes()
+
+	s := testService(t, str)
+	resp, err := s.GetGroup(context.Background(), TestUser1.ID, g.ID)
+	require.NoError(t, err)
+	assert.Equal(t, g.Profile(), resp)
+
+	_, err = s.GetGroup(context.Background(), member, g.ID)
+	assert.ErrorIs(t, err, service.ErrForbidden)
+	_, err = s.GetGroup(context.Background(), TestUser1.ID, missing)
+	assert.ErrorIs(t, err, service.ErrNotFound)
+	_, err = s.GetGroup(context.Background(), TestUser1.ID, broken)
+	assert.ErrorIs(t, err, service.ErrInternal)
+}
+
+func TestService_UpdateGroup(t *testing.T) {
+	name, chat, site := " new name ", "@godo_team", "example.com"
+	tooMany := make(map[string]*string, maxGroupFields+1)
+	for i := 0; i <= maxGroupFields; i++ {
+		tooMany[uuid.NewString()] = &site
+	}
+
+	tt := []struct {
+		name     string
+		req      model.UpdateGroupRequest
+		storeErr error
+		want     *model.Group
+		wantErr  error
+	}{
+		{
+			name: "positive",
+			req: model.UpdateGroupRequest{
+				Name:         &name,
+				TelegramChat: &chat,
+				Fields:       map[string]*string{"site": &site, "old": nil},
+			},
+			want: &model.Group{Name: "new name", TelegramChat: chat, Fields: map[string]string{"site": site}},
+		},
+		{
+			name:    "empty name",
+			req:     model.UpdateGroupRequest{Name: new(string)},
+			wantErr: service.ErrBadGroupProfile,
+		},
+		{
+			name:    "bad telegram chat",
+			req:     model.UpdateGroupRequest{TelegramChat: &site},
+			wantErr: service.ErrBadGroupProfile,
+		},
+		{
+			name:    "empty field name",
+			req:     model.UpdateGroupRequest{Fields: map[string]*string{" ": &site}},
+			wantErr: service.ErrBadGroupProfile,
+		},
+		{
+			name:    "too many fields",
+			req:     model.UpdateGroupRequest{Fields: tooMany},
+			wantErr: service.ErrBadGroupProfile,
+		},
+		{
+			name:     "name is taken",
+			req:      model.UpdateGroupRequest{Name: &name},
+			storeErr: store.ErrUniqueViolation,
+			wantErr:  service.ErrGroupAlreadyExists,
+		},
+		{
+			name:     "store error",
+			storeErr: errors.New(""),
+			wantErr:  service.ErrInternal,
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			group := uuid.New()
+
+			groupRepo := mocks.NewMockGroupRepository(ctrl)
+			groupRepo.EXPECT().IsAdmin(gomock.Any(), group, TestUser1.ID).Return(true)
+			groupRepo.EXPECT().Get(gomock.Any(), group).Return(&model.Group{
+				ID:     group,
+				Name:   "name",
+				Fields: map[string]string{"old": "value"},
+			}, nil)
+			if tc.want != nil || tc.storeErr != nil {
+				groupRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(tc.storeErr)
+			}
+			str := mocks.NewMockStore(ctrl)
+			str.EXPECT().Group().Return(groupRepo).AnyTimes()
+
+			resp, err := testService(t, str).UpdateGroup(context.Background(), TestUser1.ID, group, tc.req)
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			tc.want.ID = group
+			assert.Equal(t, tc.want.Profile(), resp)
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package production
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/vlad-marlo/godo/internal/model"
+	"github.com/vlad-marlo/godo/internal/service"
+	"go.uber.org/zap"
+)
+
+// GetTaskHistory return changes of task in order in which they were made. Permissions are equal to GetTask.
+func (s *Service) GetTaskHistory(ctx context.Context, user, task uuid.UUID) (*model.GetTaskHistoryResponse, error) {
+	t, err := s.GetTask(ctx, user, task)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := s.store.History().AllByTask(ctx, t.ID)
+	if err != nil {
+		return nil, service.ErrInternal.With(zap.Error(err))
+	}
+
+	return &model.GetTaskHistoryResponse{
+		Count:   len(changes),
+		Changes: changes,
+	}, nil
+}
+
+// statusChange return change of task status.
+func statusChange(task, actor uuid.UUID, from, to model.TaskStatus) *model.TaskChange {
+	oldValue, newValue := string(from), string(to)
+	return model.NewTaskChange(task, actor, model.TaskFieldStatus, &oldValue, &newValue)
+}
+
+// assigneeChanges return changes for every user who was assigned to task.
+func assigneeChanges(task, actor uuid.UUID, users []uuid.UUID) []*model.TaskChange {
+	changes := make([]*model.TaskChange, 0, len(users))
+	for _, u := range users {
+		id := u.String()
+		changes = append(changes, model.NewTaskChange(task, actor, model.TaskFieldAssignees, nil, &id))
+	}
+	return changes
+}
@@ -0,0 +1,47 @@
+package production
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vlad-marlo/godo/internal/model"
+	"github.com/vlad-marlo/godo/internal/service"
+	"github.com/vlad-marlo/godo/internal/store"
+	"github.com/vlad-marlo/godo/internal/store/mocks"
+)
+
+func TestService_GetTaskHistory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	hidden, broken := uuid.New(), uuid.New()
+	changes := []*model.TaskChange{
+		model.NewTaskChange(TestTask1.ID, TestUser1.ID, model.TaskFieldCreated, nil, &TestTask1.Name),
+	}
+
+	taskRepo := mocks.NewMockTaskRepository(ctrl)
+	taskRepo.EXPECT().GetByUserAndID(gomock.Any(), TestUser1.ID, TestTask1.ID).Return(TestTask1, nil)
+	taskRepo.EXPECT().GetByUserAndID(gomock.Any(), TestUser1.ID, hidden).Return(nil, store.ErrNotFound)
+	taskRepo.EXPECT().GetByUserAndID(gomock.Any(), TestUser1.ID, broken).Return(&model.Task{ID: broken}, nil)
+	historyRepo := mocks.NewMockHistoryRepository(ctrl)
+	historyRepo.EXPECT().AllByTask(gomock.Any(), TestTask1.ID).Return(changes, nil)
+	historyRepo.EXPECT().AllByTask(gomock.Any(), broken).Return(nil, errors.New(""))
+	str := mocks.NewMockStore(ctrl)
+	str.EXPECT().Task().Return(taskRepo).AnyTimes()
+	str.EXPECT().History().Return(historyRepo).AnyTimes()
+
+	s := testService(t, str)
+	resp, err := s.GetTaskHistory(context.Background(), TestUser1.ID, TestTask1.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 1, resp.Count)
+	assert.Equal(t, changes, resp.Changes)
+
+	_, err = s.GetTaskHistory(context.Background(), TestUser1.ID, hidden)
+	assert.ErrorIs(t, err, service.ErrNotFound)
+
+	_, err = s.GetTaskHistory(context.Background(), TestUser1.ID, broken)
+	assert.ErrorIs(t, err, service.ErrInternal)
+}
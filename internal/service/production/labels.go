@@ -0,0 +1,183 @@
+package production
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/vlad-marlo/godo/internal/model"
+	"github.com/vlad-marlo/godo/internal/service"
+	"github.com/vlad-marlo/godo/internal/store"
+	"go.uber.org/zap"
+)
+
+// checkManageLabels checks that user is admin of group or could manage tasks of group.
+func (s *Service) checkManageLabels(ctx context.Context, user, group uuid.UUID) error {
+	return s.authorize(ctx, user, group, model.PermManageTasks)
+}
+
+// getLabel return label of group and checks that user could manage labels of group.
+func (s *Service) getLabel(ctx context.Context, user, group, label uuid.UUID) (*model.Label, error) {
+	if err := s.checkManageLabels(ctx, user, group); err != nil {
+		return nil, err
+	}
+
+	l, err := s.store.Label().Get(ctx, label)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return nil, service.ErrNotFound
+		}
+		return nil, service.ErrInternal.With(zap.Error(err))
+	}
+	if l.Group != group {
+		return nil, service.ErrNotFound
+	}
+	return l, nil
+}
+
+// labelStoreErr converts error of label storage into service error.
+func labelStoreErr(err error) error {
+	switch {
+	case errors.Is(err, store.ErrUniqueViolation):
+		return service.ErrLabelAlreadyExists.With(zap.Error(err))
+	case errors.Is(err, store.ErrNotFound):
+		return service.ErrNotFound
+	case errors.Is(err, store.ErrFKViolation):
+		return service.ErrBadData.With(zap.Error(err))
+	default:
+		return service.ErrInternal.With(zap.Error(err))
+	}
+}
+
+// validateLabel checks name and color of label.
+func validateLabel(name, color string) error {
+	if strings.TrimSpace(name) == "" {
+		return service.ErrBadLabelName
+	}
+	if !model.ValidLabelColor(color) {
+		return service.ErrBadLabelColor
+	}
+	return nil
+}
+
+// GetGroupLabels return labels of group. Labels are available to every member of group.
+func (s *Service) GetGroupLabels(ctx context.Context, user, group uuid.UUID) (*model.GetLabelsResponse, error) {
+	if err := s.authorize(ctx, user, group, 0); err != nil {
+		return nil, err
+	}
+
+	labels, err := s.store.Label().AllByGroup(ctx, group)
+	if err != nil {
+		return nil, service.ErrInternal.With(zap.Error(err))
+	}
+
+	return &model.GetLabelsResponse{
+		Count:  len(labels),
+		Labels: labels,
+	}, nil
+}
+
+// CreateLabel creates label in group.
+func (s *Service) CreateLabel(ctx context.Context, user, group uuid.UUID, req model.CreateLabelRequest) (*model.Label, error) {
+	if err := validateLabel(req.Name, req.Color); err != nil {
+		return nil, err
+	}
+	if err := s.checkManageLabels(ctx, user, group); err != nil {
+		return nil, err
+	}
+
+	l := &model.Label{
+		ID:    uuid.New(),
+		Group: group,
+		Name:  req.Name,
+		Color: strings.ToLower(req.Color),
+	}
+	if err := s.store.Label().Create(ctx, l); err != nil {
+		return nil, labelStoreErr(err)
+	}
+	return l, nil
+}
+
+// UpdateLabel changes name and color of label.
+func (s *Service) UpdateLabel(ctx context.Context, user, group, label uuid.UUID, req model.UpdateLabelRequest) (*model.Label, error) {
+	l, err := s.getLabel(ctx, user, group, label)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		l.Name = *req.Name
+	}
+	if req.Color != nil {
+		l.Color = strings.ToLower(*req.Color)
+	}
+	if err = validateLabel(l.Name, l.Color); err != nil {
+		return nil, err
+	}
+
+	if err = s.store.Label().Update(ctx, l); err != nil {
+		return nil, labelStoreErr(err)
+	}
+	return l, nil
+}
+
+// DeleteLabel deletes label of group and detaches it from all tasks.
+func (s *Service) DeleteLabel(ctx context.Context, user, group, label uuid.UUID) error {
+	l, err := s.getLabel(ctx, user, group, label)
+	if err != nil {
+		return err
+	}
+
+	if err = s.store.Label().Delete(ctx, l.ID, user); err != nil {
+		return labelStoreErr(err)
+	}
+	return nil
+}
+
+// AttachLabel attaches label to task. Task must be related to group of label.
+func (s *Service) AttachLabel(ctx context.Context, user, task, label uuid.UUID) error {
+	l, err := s.store.Label().Get(ctx, label)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return service.ErrNotFound
+		}
+		return service.ErrInternal.With(zap.Error(err))
+	}
+	if err = s.checkManageLabels(ctx, user, l.Group); err != nil {
+		return err
+	}
+
+	change := model.NewTaskChange(task, user, model.TaskFieldLabels, nil, &l.Name)
+	if err = s.store.Label().Attach(ctx, task, l.ID, change); err != nil {
+		switch {
+		case errors.Is(err, store.ErrNotFound):
+			return service.ErrBadLabel
+		case errors.Is(err, store.ErrUniqueViolation):
+			return service.ErrConflict.With(zap.Error(err))
+		default:
+			return service.ErrInternal.With(zap.Error(err))
+		}
+	}
+	return nil
+}
+
+// DetachLabel detaches label from task. Permissions are equal to AttachLabel.
+func (s *Service) DetachLabel(ctx context.Context, user, task, label uuid.UUID) error {
+	l, err := s.store.Label().Get(ctx, label)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return service.ErrNotFound
+		}
+		return service.ErrInternal.With(zap.Error(err))
+	}
+	if err = s.checkManageLabels(ctx, user, l.Group); err != nil {
+		return err
+	}
+
+	change := model.NewTaskChange(task, user, model.TaskFieldLabels, &l.Name, nil)
+	if err = s.store.Label().Detach(ctx, task, l.ID, change); err != nil {
+		return labelStoreErr(err)
+	}
+	return nil
+}
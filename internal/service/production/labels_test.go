@@ -0,0 +1,176 @@
+package production
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vlad-marlo/godo/internal/model"
+	"github.com/vlad-marlo/godo/internal/service"
+	"github.com/vlad-marlo/godo/internal/store"
+	"github.com/vlad-marlo/godo/internal/store/mocks"
+)
+
+func TestService_CreateLabel_BadRequest(t *testing.T) {
+	s := testService(t, nil)
+
+	_, err := s.CreateLabel(context.Background(), uuid.Nil, uuid.Nil, model.CreateLabelRequest{Name: " ", Color: "#ffffff"})
+	assert.ErrorIs(t, err, service.ErrBadLabelName)
+
+	_, err = s.CreateLabel(context.Background(), uuid.Nil, uuid.Nil, model.CreateLabelRequest{Name: "bug", Color: "red"})
+	assert.ErrorIs(t, err, service.ErrBadLabelColor)
+}
+
+func TestService_CreateLabel(t *testing.T) {
+	group := uuid.New()
+	tt := []struct {
+		name     string
+		role     *model.Role
+		roleErr  error
+		storeErr error
+		want     error
+	}{
+		{"admin", &model.Role{Permissions: model.PermAll}, nil, nil, nil},
+		{"task manager", &model.Role{Permissions: model.PermManageTasks}, nil, nil, nil},
+		{"not enough permissions", &model.Role{Permissions: model.PermEditTasks}, nil, nil, service.ErrForbidden},
+		{"not member", nil, store.ErrNotFound, nil, service.ErrForbidden},
+		{"already exists", &model.Role{Permissions: model.PermManageTasks}, nil, store.ErrUniqueViolation, service.ErrLabelAlreadyExists},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			groupRepo := mocks.NewMockGroupRepository(ctrl)
+			groupRepo.EXPECT().GetRoleOfMember(gomock.Any(), TestUser1.ID, group).Return(tc.role, tc.roleErr)
+			labelRepo := mocks.NewMockLabelRepository(ctrl)
+			labelRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(tc.storeErr).MaxTimes(1)
+			str := mocks.NewMockStore(ctrl)
+			str.EXPECT().Group().Return(groupRepo)
+			str.EXPECT().Label().Return(labelRepo).AnyTimes()
+
+			s := testService(t, str)
+			l, err := s.CreateLabel(context.Background(), TestUser1.ID, group, model.CreateLabelRequest{Name: "bug", Color: "#D73A4A"})
+			if tc.want != nil {
+				assert.ErrorIs(t, err, tc.want)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, group, l.Group)
+			assert.Equal(t, "#d73a4a", l.Color)
+		})
+	}
+}
+
+func TestService_UpdateLabel(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	group := uuid.New()
+	label := &model.Label{ID: uuid.New(), Group: group, Name: "bug", Color: "#d73a4a"}
+	foreign := &model.Label{ID: uuid.New(), Group: uuid.New()}
+
+	groupRepo := mocks.NewMockGroupRepository(ctrl)
+	groupRepo.EXPECT().GetRoleOfMember(gomock.Any(), TestUser1.ID, group).Return(&model.Role{Permissions: model.PermManageTasks}, nil).AnyTimes()
+	labelRepo := mocks.NewMockLabelRepository(ctrl)
+	labelRepo.EXPECT().Get(gomock.Any(), label.ID).Return(label, nil).Times(2)
+	labelRepo.EXPECT().Get(gomock.Any(), foreign.ID).Return(foreign, nil)
+	labelRepo.EXPECT().Update(gomock.Any(), label).Return(nil)
+	str := mocks.NewMockStore(ctrl)
+	str.EXPECT().Group().Return(groupRepo).AnyTimes()
+	str.EXPECT().Label().Return(labelRepo).AnyTimes()
+
+	s := testService(t, str)
+	name := "defect"
+	got, err := s.UpdateLabel(context.Background(), TestUser1.ID, group, label.ID, model.UpdateLabelRequest{Name: &name})
+	require.NoError(t, err)
+	assert.Equal(t, name, got.Name)
+	assert.Equal(t, "#d73a4a", got.Color)
+
+	color := "blue"
+	_, err = s.UpdateLabel(context.Background(), TestUser1.ID, group, label.ID, model.UpdateLabelRequest{Color: &color})
+	assert.ErrorIs(t, err, service.ErrBadLabelColor)
+
+	_, err = s.UpdateLabel(context.Background(), TestUser1.ID, group, foreign.ID, model.UpdateLabelRequest{Name: &name})
+	assert.ErrorIs(t, err, service.ErrNotFound)
+}
+
+func TestService_DeleteLabel(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	group := uuid.New()
+	label := &model.Label{ID: uuid.New(), Group: group}
+
+	groupRepo := mocks.NewMockGroupRepository(ctrl)
+	groupRepo.EXPECT().GetRoleOfMember(gomock.Any(), TestUser1.ID, group).Return(&model.Role{Permissions: model.PermAll}, nil)
+	labelRepo := mocks.NewMockLabelRepository(ctrl)
+	labelRepo.EXPECT().Get(gomock.Any(), label.ID).Return(label, nil)
+	labelRepo.EXPECT().Delete(gomock.Any(), label.ID, TestUser1.ID).Return(nil)
+	str := mocks.NewMockStore(ctrl)
+	str.EXPECT().Group().Return(groupRepo)
+	str.EXPECT().Label().Return(labelRepo).AnyTimes()
+
+	s := testService(t, str)
+	assert.NoError(t, s.DeleteLabel(context.Background(), TestUser1.ID, group, label.ID))
+}
+
+func TestService_GetGroupLabels(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	group := uuid.New()
+	labels := []*model.Label{{ID: uuid.New(), Group: group}}
+
+	groupRepo := mocks.NewMockGroupRepository(ctrl)
+	groupRepo.EXPECT().GetRoleOfMember(gomock.Any(), TestUser1.ID, group).Return(&model.Role{}, nil)
+	groupRepo.EXPECT().GetRoleOfMember(gomock.Any(), uuid.Nil, group).Return(nil, store.ErrNotFound)
+	labelRepo := mocks.NewMockLabelRepository(ctrl)
+	labelRepo.EXPECT().AllByGroup(gomock.Any(), group).Return(labels, nil)
+	str := mocks.NewMockStore(ctrl)
+	str.EXPECT().Group().Return(groupRepo).AnyTimes()
+	str.EXPECT().Label().Return(labelRepo).AnyTimes()
+
+	s := testService(t, str)
+	resp, err := s.GetGroupLabels(context.Background(), TestUser1.ID, group)
+	require.NoError(t, err)
+	assert.Equal(t, 1, resp.Count)
+
+	_, err = s.GetGroupLabels(context.Background(), uuid.Nil, group)
+	assert.ErrorIs(t, err, service.ErrForbidden)
+}
+
+func TestService_AttachLabel(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	group, task, other := uuid.New(), uuid.New(), uuid.New()
+	label := &model.Label{ID: uuid.New(), Group: group}
+
+	groupRepo := mocks.NewMockGroupRepository(ctrl)
+	groupRepo.EXPECT().GetRoleOfMember(gomock.Any(), TestUser1.ID, group).Return(&model.Role{Permissions: model.PermManageTasks}, nil).AnyTimes()
+	labelRepo := mocks.NewMockLabelRepository(ctrl)
+	labelRepo.EXPECT().Get(gomock.Any(), label.ID).Return(label, nil).AnyTimes()
+	// change of labels is recorded to history of task in the same transaction.
+	labelRepo.EXPECT().Attach(gomock.Any(), task, label.ID, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _, _ uuid.UUID, changes ...*model.TaskChange) error {
+			require.Len(t, changes, 1)
+			assert.Equal(t, model.TaskFieldLabels, changes[0].Field)
+			assert.Nil(t, changes[0].OldValue)
+			assert.Equal(t, label.Name, *changes[0].NewValue)
+			return nil
+		},
+	)
+	labelRepo.EXPECT().Attach(gomock.Any(), other, label.ID, gomock.Any()).Return(store.ErrNotFound)
+	labelRepo.EXPECT().Detach(gomock.Any(), task, label.ID, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _, _ uuid.UUID, changes ...*model.TaskChange) error {
+			require.Len(t, changes, 1)
+			assert.Equal(t, label.Name, *changes[0].OldValue)
+			assert.Nil(t, changes[0].NewValue)
+			return nil
+		},
+	)
+	labelRepo.EXPECT().Detach(gomock.Any(), other, label.ID, gomock.Any()).Return(store.ErrNotFound)
+	str := mocks.NewMockStore(ctrl)
+	str.EXPECT().Group().Return(groupRepo).AnyTimes()
+	str.EXPECT().Label().Return(labelRepo).AnyTimes()
+
+	s := testService(t, str)
+	assert.NoError(t, s.AttachLabel(context.Background(), TestUser1.ID, task, label.ID))
+	assert.ErrorIs(t, s.AttachLabel(context.Background(), TestUser1.ID, other, label.ID), service.ErrBadLabel)
+	assert.NoError(t, s.DetachLabel(context.Background(), TestUser1.ID, task, label.ID))
+	assert.ErrorIs(t, s.DetachLabel(context.Background(), TestUser1.ID, other, label.ID), service.ErrNotFound)
+}
@@ -0,0 +1,158 @@
+package production
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/vlad-marlo/godo/internal/model"
+	"github.com/vlad-marlo/godo/internal/service"
+	"github.com/vlad-marlo/godo/internal/store"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultAuditLogLimit = 50
+	maxAuditLogLimit     = 200
+)
+
+// GetGroupMembers return members of group with their roles. Members are available to users who could view them.
+func (s *Service) GetGroupMembers(ctx context.Context, user, group uuid.UUID) (*model.GetMembersResponse, error) {
+	if err := s.authorize(ctx, user, group, model.PermViewMembers); err != nil {
+		return nil, err
+	}
+
+	members, err := s.store.Group().Members(ctx, group)
+	if err != nil {
+		return nil, service.ErrInternal.With(zap.Error(err))
+	}
+	if members == nil {
+		members = []*model.UserInGroup{}
+	}
+	return &model.GetMembersResponse{
+		Count:   len(members),
+		Members: members,
+	}, nil
+}
+
+// RemoveGroupMember removes member from group.
+//
+// User who could remove related members removes only users who joined group by invites of that user, user who could
+// remove members removes anyone except admins. Admins could be removed only by other admins.
+func (s *Service) RemoveGroupMember(ctx context.Context, user, group, member uuid.UUID) error {
+	if user == member {
+		return s.LeaveGroup(ctx, user, group)
+	}
+
+	if _, _, err := s.authorizeMember(ctx, user, group, member); err != nil {
+		return err
+	}
+	return s.removeUser(ctx, user, group, member)
+}
+
+// LeaveGroup removes user from group. The last admin of group could not leave it.
+func (s *Service) LeaveGroup(ctx context.Context, user, group uuid.UUID) error {
+	return s.removeUser(ctx, user, group, user)
+}
+
+// removeUser removes member from group and records removal to audit log of group and unassignment of member
+// from open tasks of group to their history.
+func (s *Service) removeUser(ctx context.Context, user, group, member uuid.UUID) error {
+	entry := model.NewMemberRemovalEntry(group, user, member)
+	if err := s.store.Group().RemoveUser(ctx, group, member, entry); err != nil {
+		switch {
+		case errors.Is(err, store.ErrNotFound):
+			return service.ErrNotFound
+		case errors.Is(err, store.ErrLastAdmin):
+			return service.ErrLastAdmin
+		}
+		return service.ErrInternal.With(zap.Error(err))
+	}
+	return nil
+}
+
+// UpdateGroupMember changes role and admin flag of member.
+//
+// Members which could be removed by user could be changed by user too. Permissions which member does not have yet
+// are granted only by users who have them and admin flag is granted or revoked only by admins. Every change is
+// recorded in audit log of group.
+func (s *Service) UpdateGroupMember(
+	ctx context.Context,
+	user, group, member uuid.UUID,
+	req model.UpdateMemberRequest,
+) (*model.UserInGroup, error) {
+	role, m, err := s.authorizeMember(ctx, user, group, member)
+	if err != nil {
+		return nil, err
+	}
+
+	old := m.MemberRole()
+	updated := old
+	if req.IsAdmin != nil {
+		updated.IsAdmin = *req.IsAdmin
+	}
+	if req.Role != nil && *req.Role != old.Role {
+		var r *model.Role
+		if r, err = s.store.Role().Get(ctx, group, *req.Role); err != nil {
+			if errors.Is(err, store.ErrNotFound) {
+				return nil, service.ErrBadMemberRole
+			}
+			return nil, service.ErrInternal.With(zap.Error(err))
+		}
+		if err = authorizeGrant(role.Permissions, old.Permissions, r); err != nil {
+			return nil, err
+		}
+		updated = updated.WithRole(r)
+	}
+	if updated.IsAdmin != old.IsAdmin && s.authorizeAdmin(ctx, user, group) != nil {
+		return nil, service.ErrRoleEscalation
+	}
+	if updated == old {
+		return m, nil
+	}
+
+	entry := model.NewMemberRoleEntry(group, user, member, old, updated)
+	if err = s.store.Group().SetMemberRole(ctx, group, member, updated.Role, updated.IsAdmin, entry); err != nil {
+		switch {
+		case errors.Is(err, store.ErrNotFound):
+			return nil, service.ErrNotFound
+		case errors.Is(err, store.ErrLastAdmin):
+			return nil, service.ErrLastAdmin
+		}
+		return nil, service.ErrInternal.With(zap.Error(err))
+	}
+
+	m.IsAdmin, m.Role, m.RoleName, m.Permissions = updated.IsAdmin, updated.Role, updated.Name, updated.Permissions
+	return m, nil
+}
+
+// GetGroupAuditLog return changes of group members which were made after entry with id after.
+// Audit log is available only to admins of group.
+func (s *Service) GetGroupAuditLog(ctx context.Context, user, group uuid.UUID, after int64, limit int) (*model.GetAuditLogResponse, error) {
+	switch {
+	case limit < 0:
+		return nil, service.ErrBadPageLimit
+	case limit == 0:
+		limit = defaultAuditLogLimit
+	case limit > maxAuditLogLimit:
+		limit = maxAuditLogLimit
+	}
+	if after < 0 {
+		after = 0
+	}
+	if err := s.authorizeAdmin(ctx, user, group); err != nil {
+		return nil, err
+	}
+
+	entries, err := s.store.Group().AuditLog(ctx, group, after, limit)
+	if err != nil {
+		return nil, service.ErrInternal.With(zap.Error(err))
+	}
+	if entries == nil {
+		entries = []*model.AuditEntry{}
+	}
+	return &model.GetAuditLogResponse{
+		Count:   len(entries),
+		Entries: entries,
+	}, nil
+}
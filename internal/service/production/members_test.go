@@ -0,0 +1,344 @@
+package production
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vlad-marlo/godo/internal/model"
+	"github.com/vlad-marlo/godo/internal/service"
+	"github.com/vlad-marlo/godo/internal/store"
+	"github.com/vlad-marlo/godo/internal/store/mocks"
+)
+
+func TestService_GetGroupMembers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	group, reader, stranger, broken := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	members := []*model.UserInGroup{{UserID: TestUser1.ID, Email: TestUser1.Email, IsAdmin: true}}
+
+	groupRepo := mocks.NewMockGroupRepository(ctrl)
+	groupRepo.EXPECT().GetRoleOfMember(gomock.Any(), TestUser1.ID, gomock.Any()).Return(&model.Role{Permissions: model.PermViewMembers}, nil).AnyTimes()
+	groupRepo.EXPECT().GetRoleOfMember(gomock.Any(), reader, group).Return(ReadOnlyRole, nil)
+	groupRepo.EXPECT().GetRoleOfMember(gomock.Any(), stranger, group).Return(nil, store.ErrNotFound)
+	groupRepo.EXPECT().Members(gomock.Any(), group).Return(members, nil)
+	groupRepo.EXPECT().Members(gomock.Any(), broken).Return(nil, errors.New(""))
+	str := mocks.NewMockStore(ctrl)
+	str.EXPECT().Group().Return(groupRepo).AnyTimes()
+
+	s := testService(t, str)
+	resp, err := s.GetGroupMembers(context.Background(), TestUser1.ID, group)
+	require.NoError(t, err)
+	assert.Equal(t, &model.GetMembersResponse{Count: 1, Members: members}, resp)
+
+	_, err = s.GetGroupMembers(context.Background(), reader, group)
+	assert.ErrorIs(t, err, service.ErrForbidden)
+	_, err = s.GetGroupMembers(context.Background(), stranger, group)
+	assert.ErrorIs(t, err, service.ErrForbidden)
+	_, err = s.GetGroupMembers(context.Background(), TestUser1.ID, broken)
+	assert.ErrorIs(t, err, service.ErrInternal)
+}
+
+func TestService_RemoveGroupMember(t *testing.T) {
+	invitedByActor := &model.UserInGroup{InvitedBy: &TestUser1.ID}
+	invitedByOther := &model.UserInGroup{InvitedBy: new(uuid.UUID)}
+	admin := &model.UserInGroup{IsAdmin: true}
+
+	tt := []struct {
+		name    string
+		role    *model.Role
+		roleErr error
+		member  *model.UserInGroup
+		memErr  error
+		isAdmin bool
+		removed bool
+		want    error
+	}{
+		{"inviter", &model.Role{Permissions: model.PermRemoveRelatedMembers}, nil, invitedByActor, nil, false, true, nil},
+		{"not inviter", &model.Role{Permissions: model.PermRemoveRelatedMembers}, nil, invitedByOther, nil, false, false, service.ErrForbidden},
+		{"not invited", &model.Role{Permissions: model.PermRemoveRelatedMembers}, nil, &model.UserInGroup{}, nil, false, false, service.ErrForbidden},
+		{"moderator", &model.Role{Permissions: model.PermRemoveMembers}, nil, invitedByOther, nil, false, true, nil},
+		{"admin by moderator", &model.Role{Permissions: model.PermRemoveMembers}, nil, admin, nil, false, false, service.ErrForbidden},
+		{"admin by admin", SudoRole, nil, admin, nil, true, true, nil},
+		{"no permission", &model.Role{Permissions: model.PermViewMembers}, nil, nil, nil, false, false, service.ErrForbidden},
+		{"not member", nil, store.ErrNotFound, nil, nil, false, false, service.ErrForbidden},
+		{"role error", nil, errors.New(""), nil, nil, false, false, service.ErrInternal},
+		{"member not found", SudoRole, nil, nil, store.ErrNotFound, false, false, service.ErrNotFound},
+		{"member error", SudoRole, nil, nil, errors.New(""), false, false, service.ErrInternal},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			group, member := uuid.New(), uuid.New()
+
+			groupRepo := mocks.NewMockGroupRepository(ctrl)
+			groupRepo.EXPECT().GetRoleOfMember(gomock.Any(), TestUser1.ID, group).Return(tc.role, tc.roleErr)
+			groupRepo.EXPECT().GetMember(gomock.Any(), group, member).Return(tc.member, tc.memErr).MaxTimes(1)
+			groupRepo.EXPECT().IsAdmin(gomock.Any(), group, TestUser1.ID).Return(tc.isAdmin).AnyTimes()
+			if tc.removed {
+				groupRepo.EXPECT().RemoveUser(gomock.Any(), group, member, gomock.Any()).DoAndReturn(
+					func(_ context.Context, _, _ uuid.UUID, entry *model.AuditEntry) error {
+						assert.Equal(t, model.AuditMemberRemoved, entry.Action)
+						assert.Equal(t, TestUser1.ID, entry.Actor)
+						assert.Equal(t, member, entry.Member)
+						return nil
+					},
+				)
+			}
+			str := mocks.NewMockStore(ctrl)
+			str.EXPECT().Group().Return(groupRepo).AnyTimes()
+
+			err := testService(t, str).RemoveGroupMember(context.Background(), TestUser1.ID, group, member)
+			assert.ErrorIs(t, err, tc.want)
+		})
+	}
+}
+
+func TestService_LeaveGroup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	group, lonely, missing, broken := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+
+	groupRepo := mocks.NewMockGroupRepository(ctrl)
+	groupRepo.EXPECT().RemoveUser(gomock.Any(), group, TestUser1.ID, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _, _ uuid.UUID, entry *model.AuditEntry) error {
+			assert.Equal(t, model.AuditMemberLeft, entry.Action)
+			return nil
+		},
+	).Times(2)
+	groupRepo.EXPECT().RemoveUser(gomock.Any(), lonely, TestUser1.ID, gomock.Any()).Return(store.ErrLastAdmin)
+	groupRepo.EXPECT().RemoveUser(gomock.Any(), missing, TestUser1.ID, gomock.Any()).Return(store.ErrNotFound)
+	groupRepo.EXPECT().RemoveUser(gomock.Any(), broken, TestUser1.ID, gomock.Any()).Return(errors.New(""))
+	str := mocks.NewMockStore(ctrl)
+	str.EXPECT().Group().Return(groupRepo).AnyTimes()
+
+	s := testService(t, str)
+	assert.NoError(t, s.LeaveGroup(context.Background(), TestUser1.ID, group))
+	assert.NoError(t, s.RemoveGroupMember(context.Background(), TestUser1.ID, group, TestUser1.ID))
+	assert.ErrorIs(t, s.LeaveGroup(context.Background(), TestUser1.ID, lonely), service.ErrLastAdmin)
+	assert.ErrorIs(t, s.LeaveGroup(context.Background(), TestUser1.ID, missing), service.ErrNotFound)
+	assert.ErrorIs(t, s.LeaveGroup(context.Background(), TestUser1.ID, broken), service.ErrInternal)
+}
+
+func TestService_UpdateGroupMember(t *testing.T) {
+	admin := true
+	moderator := &model.Role{Permissions: model.PermRemoveMembers | model.PermEditTasks | model.PermViewIssues}
+	inviter := &model.Role{Permissions: model.PermRemoveRelatedMembers | model.PermEditTasks}
+	editor := &model.Role{ID: 2, Name: "editor", Permissions: model.PermEditTasks}
+	reviewer := &model.Role{ID: 3, Name: "reviewer", Permissions: model.PermReviewTasks | model.PermViewIssues}
+	readOnly := &model.Role{ID: 4, Name: "read only"}
+	invitedByActor := &model.UserInGroup{Role: 1, Permissions: model.PermViewIssues, InvitedBy: &TestUser1.ID}
+	roleID := func(r *model.Role) *int32 { return &r.ID }
+	missing := int32(100)
+
+	tt := []struct {
+		name    string
+		role    *model.Role
+		isAdmin bool
+		member  *model.UserInGroup
+		req     model.UpdateMemberRequest
+		newRole *model.Role
+		getErr  error
+		setErr  error
+		want    *model.MemberRole
+		wantErr error
+	}{
+		{
+			name:    "grant held permissions",
+			role:    moderator,
+			member:  &model.UserInGroup{Role: 1},
+			req:     model.UpdateMemberRequest{Role: roleID(editor)},
+			newRole: editor,
+			want:    &model.MemberRole{Role: editor.ID, Name: editor.Name, Permissions: editor.Permissions},
+		},
+		{
+			name:    "grant not held permissions",
+			role:    moderator,
+			member:  &model.UserInGroup{Role: 1},
+			req:     model.UpdateMemberRequest{Role: roleID(reviewer)},
+			newRole: reviewer,
+			wantErr: service.ErrRoleEscalation,
+		},
+		{
+			name:    "keep not held permissions",
+			role:    moderator,
+			member:  &model.UserInGroup{Role: 1, Permissions: model.PermReviewTasks | model.PermDeleteTasks},
+			req:     model.UpdateMemberRequest{Role: roleID(reviewer)},
+			newRole: reviewer,
+			want:    &model.MemberRole{Role: reviewer.ID, Name: reviewer.Name, Permissions: reviewer.Permissions},
+		},
+		{
+			name:    "grant admin by moderator",
+			role:    moderator,
+			member:  &model.UserInGroup{},
+			req:     model.UpdateMemberRequest{IsAdmin: &admin},
+			wantErr: service.ErrRoleEscalation,
+		},
+		{
+			name:    "change admin by moderator",
+			role:    moderator,
+			member:  &model.UserInGroup{IsAdmin: true},
+			req:     model.UpdateMemberRequest{Role: roleID(editor)},
+			wantErr: service.ErrForbidden,
+		},
+		{
+			name:    "grant admin by admin",
+			role:    SudoRole,
+			isAdmin: true,
+			member:  &model.UserInGroup{},
+			req:     model.UpdateMemberRequest{IsAdmin: &admin},
+			want:    &model.MemberRole{IsAdmin: true},
+		},
+		{
+			name:    "revoke last admin",
+			role:    SudoRole,
+			isAdmin: true,
+			member:  &model.UserInGroup{IsAdmin: true},
+			req:     model.UpdateMemberRequest{IsAdmin: new(bool)},
+			setErr:  store.ErrLastAdmin,
+			wantErr: service.ErrLastAdmin,
+		},
+		{
+			name:    "invited by user",
+			role:    inviter,
+			member:  invitedByActor,
+			req:     model.UpdateMemberRequest{Role: roleID(readOnly)},
+			newRole: readOnly,
+			want:    &model.MemberRole{Role: readOnly.ID, Name: readOnly.Name},
+		},
+		{
+			name:    "not invited by user",
+			role:    inviter,
+			member:  &model.UserInGroup{Role: 1},
+			req:     model.UpdateMemberRequest{Role: roleID(readOnly)},
+			wantErr: service.ErrForbidden,
+		},
+		{
+			name:    "role of other group",
+			role:    SudoRole,
+			isAdmin: true,
+			member:  &model.UserInGroup{},
+			req:     model.UpdateMemberRequest{Role: &missing},
+			getErr:  store.ErrNotFound,
+			wantErr: service.ErrBadMemberRole,
+		},
+		{
+			name:    "role error",
+			role:    SudoRole,
+			isAdmin: true,
+			member:  &model.UserInGroup{},
+			req:     model.UpdateMemberRequest{Role: &missing},
+			getErr:  errors.New(""),
+			wantErr: service.ErrInternal,
+		},
+		{
+			name:    "no permission",
+			role:    &model.Role{Permissions: model.PermViewMembers},
+			wantErr: service.ErrForbidden,
+		},
+		{
+			name:    "store error",
+			role:    SudoRole,
+			isAdmin: true,
+			member:  &model.UserInGroup{},
+			req:     model.UpdateMemberRequest{Role: roleID(editor)},
+			newRole: editor,
+			setErr:  errors.New(""),
+			wantErr: service.ErrInternal,
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			group, member := uuid.New(), uuid.New()
+
+			groupRepo := mocks.NewMockGroupRepository(ctrl)
+			groupRepo.EXPECT().GetRoleOfMember(gomock.Any(), TestUser1.ID, group).Return(tc.role, nil)
+			groupRepo.EXPECT().IsAdmin(gomock.Any(), group, TestUser1.ID).Return(tc.isAdmin).AnyTimes()
+			if tc.member != nil {
+				m := *tc.member
+				groupRepo.EXPECT().GetMember(gomock.Any(), group, member).Return(&m, nil)
+			}
+			roleRepo := mocks.NewMockRoleRepository(ctrl)
+			if tc.req.Role != nil {
+				roleRepo.EXPECT().Get(gomock.Any(), group, *tc.req.Role).Return(tc.newRole, tc.getErr).MaxTimes(1)
+			}
+			if tc.want != nil || tc.setErr != nil {
+				groupRepo.EXPECT().
+					SetMemberRole(gomock.Any(), group, member, gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _, _ uuid.UUID, role int32, isAdmin bool, entry *model.AuditEntry) error {
+						if tc.want != nil {
+							assert.Equal(t, tc.want.Role, role)
+							assert.Equal(t, tc.want.IsAdmin, isAdmin)
+						}
+						assert.Equal(t, model.AuditMemberRole, entry.Action)
+						assert.Equal(t, TestUser1.ID, entry.Actor)
+						assert.Equal(t, member, entry.Member)
+						return tc.setErr
+					})
+			}
+			str := mocks.NewMockStore(ctrl)
+			str.EXPECT().Group().Return(groupRepo).AnyTimes()
+			str.EXPECT().Role().Return(roleRepo).AnyTimes()
+
+			resp, err := testService(t, str).UpdateGroupMember(context.Background(), TestUser1.ID, group, member, tc.req)
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, *tc.want, resp.MemberRole())
+		})
+	}
+}
+
+func TestService_UpdateGroupMember_NothingChanged(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	group, member := uuid.New(), uuid.New()
+	m := &model.UserInGroup{UserID: member, Role: 1, RoleName: "editor", Permissions: model.PermEditTasks}
+	role := int32(1)
+
+	groupRepo := mocks.NewMockGroupRepository(ctrl)
+	groupRepo.EXPECT().GetRoleOfMember(gomock.Any(), TestUser1.ID, group).Return(SudoRole, nil)
+	groupRepo.EXPECT().GetMember(gomock.Any(), group, member).Return(m, nil)
+	str := mocks.NewMockStore(ctrl)
+	str.EXPECT().Group().Return(groupRepo).AnyTimes()
+
+	resp, err := testService(t, str).UpdateGroupMember(context.Background(), TestUser1.ID, group, member, model.UpdateMemberRequest{Role: &role})
+	require.NoError(t, err)
+	assert.Equal(t, m, resp)
+}
+
+func TestService_GetGroupAuditLog(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	group, foreign, broken := uuid.New(), uuid.New(), uuid.New()
+	entries := []*model.AuditEntry{model.NewMemberRoleEntry(group, TestUser1.ID, uuid.New(), model.MemberRole{}, model.MemberRole{Role: 1})}
+
+	groupRepo := mocks.NewMockGroupRepository(ctrl)
+	groupRepo.EXPECT().IsAdmin(gomock.Any(), gomock.Any(), TestUser1.ID).DoAndReturn(func(_ context.Context, g, _ uuid.UUID) bool {
+		return g != foreign
+	}).AnyTimes()
+	groupRepo.EXPECT().AuditLog(gomock.Any(), group, int64(0), defaultAuditLogLimit).Return(entries, nil)
+	groupRepo.EXPECT().AuditLog(gomock.Any(), group, int64(3), maxAuditLogLimit).Return(nil, nil)
+	groupRepo.EXPECT().AuditLog(gomock.Any(), broken, int64(0), 1).Return(nil, errors.New(""))
+	str := mocks.NewMockStore(ctrl)
+	str.EXPECT().Group().Return(groupRepo).AnyTimes()
+
+	s := testService(t, str)
+	resp, err := s.GetGroupAuditLog(context.Background(), TestUser1.ID, group, -1, 0)
+	require.NoError(t, err)
+	assert.Equal(t, &model.GetAuditLogResponse{Count: 1, Entries: entries}, resp)
+
+	resp, err = s.GetGroupAuditLog(context.Background(), TestUser1.ID, group, 3, maxAuditLogLimit+1)
+	require.NoError(t, err)
+	assert.Equal(t, &model.GetAuditLogResponse{Entries: []*model.AuditEntry{}}, resp)
+
+	_, err = s.GetGroupAuditLog(context.Background(), TestUser1.ID, foreign, 0, 0)
+	assert.ErrorIs(t, err, service.ErrForbidden)
+	_, err = s.GetGroupAuditLog(context.Background(), TestUser1.ID, group, 0, -1)
+	assert.ErrorIs(t, err, service.ErrBadPageLimit)
+	_, err = s.GetGroupAuditLog(context.Background(), TestUser1.ID, broken, 0, 1)
+	assert.ErrorIs(t, err, service.ErrInternal)
+}
@@ -0,0 +1,112 @@
+package production
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/vlad-marlo/godo/internal/model"
+	"github.com/vlad-marlo/godo/internal/service"
+	"github.com/vlad-marlo/godo/internal/store"
+	"go.uber.org/zap"
+)
+
+// SubmitReview sends task to review with provided message.
+func (s *Service) SubmitReview(ctx context.Context, user, task uuid.UUID, msg string) (*model.Review, error) {
+	t, err := s.GetTask(ctx, user, task)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.checkTransition(ctx, user, t, model.StatusOnReview, true); err != nil {
+		return nil, err
+	}
+
+	review := &model.Review{
+		ID:      uuid.New(),
+		Task:    t.ID,
+		User:    user,
+		Message: msg,
+		Status:  model.ReviewPending,
+	}
+	if err = s.store.Review().Create(ctx, review, t.Status, statusChange(t.ID, user, t.Status, model.StatusOnReview)); err != nil {
+		switch {
+		case errors.Is(err, store.ErrNotFound):
+			return nil, service.ErrConflict.With(zap.Error(err))
+		case errors.Is(err, store.ErrLimitExceeded):
+			return nil, errColumnLimit(model.StatusOnReview)
+		case errors.Is(err, store.ErrFKViolation):
+			return nil, service.ErrBadData.With(zap.Error(err))
+		default:
+			return nil, service.ErrInternal.With(zap.Error(err))
+		}
+	}
+
+	return review, nil
+}
+
+// GetPendingReviews return reviews of group tasks that are waiting for resolution.
+//
+// Only users who could view review queue of group have access to it.
+func (s *Service) GetPendingReviews(ctx context.Context, user, group uuid.UUID) (*model.GetReviewsResponse, error) {
+	if err := s.authorize(ctx, user, group, model.PermViewReviews); err != nil {
+		return nil, err
+	}
+
+	reviews, err := s.store.Review().PendingByGroup(ctx, group)
+	if err != nil {
+		return nil, service.ErrInternal.With(zap.Error(err))
+	}
+
+	return &model.GetReviewsResponse{
+		Count:   len(reviews),
+		Reviews: reviews,
+	}, nil
+}
+
+// ResolveReview resolves review as changes requested or accepted and moves reviewed task into equal status.
+//
+// Review could be resolved by group admin, creator of task or user who could review tasks in group of task.
+func (s *Service) ResolveReview(ctx context.Context, user, review uuid.UUID, req model.ResolveReviewRequest) (*model.Review, error) {
+	status, ok := req.Status.TaskStatus()
+	if !ok {
+		return nil, service.ErrBadReviewStatus
+	}
+
+	r, err := s.store.Review().Get(ctx, review)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return nil, service.ErrNotFound
+		}
+		return nil, service.ErrInternal.With(zap.Error(err))
+	}
+	if r.Status != model.ReviewPending {
+		return nil, service.ErrReviewAlreadyResolved
+	}
+
+	var t *model.Task
+	t, err = s.GetTask(ctx, user, r.Task)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.checkTransition(ctx, user, t, status, true); err != nil {
+		return nil, err
+	}
+
+	r.Status = req.Status
+	r.ResolvedBy = &user
+	r.Answer = req.Message
+	if err = s.store.Review().Resolve(ctx, r, status, statusChange(t.ID, user, t.Status, status)); err != nil {
+		switch {
+		case errors.Is(err, store.ErrNotFound):
+			return nil, service.ErrReviewAlreadyResolved.With(zap.Error(err))
+		case errors.Is(err, store.ErrLimitExceeded):
+			return nil, errColumnLimit(status)
+		default:
+			return nil, service.ErrInternal.With(zap.Error(err))
+		}
+	}
+
+	return r, nil
+}
@@ -0,0 +1,200 @@
+package production
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vlad-marlo/godo/internal/model"
+	"github.com/vlad-marlo/godo/internal/service"
+	"github.com/vlad-marlo/godo/internal/store"
+	"github.com/vlad-marlo/godo/internal/store/mocks"
+)
+
+func TestService_SubmitReview_Positive(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	user := uuid.New()
+	task := &model.Task{ID: uuid.New(), CreatedBy: uuid.New(), Status: model.StatusInProgress}
+
+	taskRepo := mocks.NewMockTaskRepository(ctrl)
+	taskRepo.EXPECT().GetByUserAndID(gomock.Any(), user, task.ID).Return(task, nil)
+	taskRepo.EXPECT().GetRoleOfMember(gomock.Any(), user, task.ID).Return(ReadOnlyRole, nil)
+	reviewRepo := mocks.NewMockReviewRepository(ctrl)
+	reviewRepo.EXPECT().Create(gomock.Any(), gomock.Any(), model.StatusInProgress, gomock.Len(1)).Return(nil)
+	str := mocks.NewMockStore(ctrl)
+	str.EXPECT().Task().Return(taskRepo).AnyTimes()
+	str.EXPECT().Review().Return(reviewRepo)
+
+	s := testService(t, str)
+	review, err := s.SubmitReview(context.Background(), user, task.ID, "done")
+	require.NoError(t, err)
+	assert.Equal(t, task.ID, review.Task)
+	assert.Equal(t, user, review.User)
+	assert.Equal(t, "done", review.Message)
+	assert.Equal(t, model.ReviewPending, review.Status)
+}
+
+func TestService_SubmitReview_Negative(t *testing.T) {
+	tt := []struct {
+		name   string
+		status model.TaskStatus
+		err    error
+		want   error
+	}{
+		{"bad status", model.StatusNew, nil, service.ErrStatusTransition},
+		{"concurrent change", model.StatusInProgress, store.ErrNotFound, service.ErrConflict},
+		{"unknown", model.StatusInProgress, errors.New(""), service.ErrInternal},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			user := uuid.New()
+			task := &model.Task{ID: uuid.New(), CreatedBy: user, Status: tc.status}
+
+			taskRepo := mocks.NewMockTaskRepository(ctrl)
+			taskRepo.EXPECT().GetByUserAndID(gomock.Any(), user, task.ID).Return(task, nil)
+			taskRepo.EXPECT().GetRoleOfMember(gomock.Any(), user, task.ID).Return(nil, store.ErrNotFound).AnyTimes()
+			reviewRepo := mocks.NewMockReviewRepository(ctrl)
+			reviewRepo.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(tc.err).AnyTimes()
+			str := mocks.NewMockStore(ctrl)
+			str.EXPECT().Task().Return(taskRepo).AnyTimes()
+			str.EXPECT().Review().Return(reviewRepo).AnyTimes()
+
+			s := testService(t, str)
+			review, err := s.SubmitReview(context.Background(), user, task.ID, "")
+			assert.Nil(t, review)
+			assert.ErrorIs(t, err, tc.want)
+		})
+	}
+}
+
+func TestService_GetPendingReviews(t *testing.T) {
+	reviews := []*model.Review{{ID: uuid.New()}, {ID: uuid.New()}}
+	tt := []struct {
+		name    string
+		role    *model.Role
+		roleErr error
+		want    error
+	}{
+		{"ok", SudoRole, nil, nil},
+		{"no permission", ReadOnlyRole, nil, service.ErrForbidden},
+		{"not member", nil, store.ErrNotFound, service.ErrForbidden},
+		{"unknown", nil, errors.New(""), service.ErrInternal},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			groupRepo := mocks.NewMockGroupRepository(ctrl)
+			groupRepo.EXPECT().GetRoleOfMember(gomock.Any(), gomock.Any(), gomock.Any()).Return(tc.role, tc.roleErr)
+			reviewRepo := mocks.NewMockReviewRepository(ctrl)
+			reviewRepo.EXPECT().PendingByGroup(gomock.Any(), gomock.Any()).Return(reviews, nil).AnyTimes()
+			str := mocks.NewMockStore(ctrl)
+			str.EXPECT().Group().Return(groupRepo)
+			str.EXPECT().Review().Return(reviewRepo).AnyTimes()
+
+			s := testService(t, str)
+			resp, err := s.GetPendingReviews(context.Background(), uuid.New(), uuid.New())
+			if tc.want != nil {
+				assert.Nil(t, resp)
+				assert.ErrorIs(t, err, tc.want)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, &model.GetReviewsResponse{Count: len(reviews), Reviews: reviews}, resp)
+		})
+	}
+}
+
+func TestService_ResolveReview_Positive(t *testing.T) {
+	tt := []struct {
+		name    string
+		creator bool
+		role    *model.Role
+		roleErr error
+	}{
+		{"creator", true, nil, store.ErrNotFound},
+		{"reviewer", false, SudoRole, nil},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			user := uuid.New()
+			task := &model.Task{ID: uuid.New(), CreatedBy: uuid.New(), Status: model.StatusOnReview}
+			if tc.creator {
+				task.CreatedBy = user
+			}
+			review := &model.Review{ID: uuid.New(), Task: task.ID, User: uuid.New(), Status: model.ReviewPending}
+
+			reviewRepo := mocks.NewMockReviewRepository(ctrl)
+			reviewRepo.EXPECT().Get(gomock.Any(), review.ID).Return(review, nil)
+			reviewRepo.EXPECT().Resolve(gomock.Any(), review, model.StatusAccepted, gomock.Len(1)).Return(nil)
+			taskRepo := mocks.NewMockTaskRepository(ctrl)
+			taskRepo.EXPECT().GetByUserAndID(gomock.Any(), user, task.ID).Return(task, nil)
+			taskRepo.EXPECT().GetRoleOfMember(gomock.Any(), user, task.ID).Return(tc.role, tc.roleErr)
+			str := mocks.NewMockStore(ctrl)
+			str.EXPECT().Review().Return(reviewRepo).AnyTimes()
+			str.EXPECT().Task().Return(taskRepo).AnyTimes()
+
+			s := testService(t, str)
+			got, err := s.ResolveReview(context.Background(), user, review.ID, model.ResolveReviewRequest{
+				Status:  model.ReviewAccepted,
+				Message: "ok",
+			})
+			require.NoError(t, err)
+			assert.Equal(t, model.ReviewAccepted, got.Status)
+			assert.Equal(t, "ok", got.Answer)
+			if assert.NotNil(t, got.ResolvedBy) {
+				assert.Equal(t, user, *got.ResolvedBy)
+			}
+		})
+	}
+}
+
+func TestService_ResolveReview_Negative(t *testing.T) {
+	user := uuid.New()
+	tt := []struct {
+		name       string
+		status     model.ReviewStatus
+		review     *model.Review
+		getErr     error
+		role       *model.Role
+		roleErr    error
+		resolveErr error
+		want       error
+	}{
+		{"bad status", model.ReviewPending, nil, nil, nil, nil, nil, service.ErrBadReviewStatus},
+		{"not found", model.ReviewAccepted, nil, store.ErrNotFound, nil, nil, nil, service.ErrNotFound},
+		{"get unknown", model.ReviewAccepted, nil, errors.New(""), nil, nil, nil, service.ErrInternal},
+		{"already resolved", model.ReviewAccepted, &model.Review{Status: model.ReviewAccepted}, nil, nil, nil, nil, service.ErrReviewAlreadyResolved},
+		{"no permission", model.ReviewAccepted, &model.Review{Status: model.ReviewPending}, nil, ReadOnlyRole, nil, nil, service.ErrForbidden},
+		{"not member", model.ReviewAccepted, &model.Review{Status: model.ReviewPending}, nil, nil, store.ErrNotFound, nil, service.ErrForbidden},
+		{"concurrent resolve", model.ReviewChangesRequested, &model.Review{Status: model.ReviewPending}, nil, SudoRole, nil, store.ErrNotFound, service.ErrReviewAlreadyResolved},
+		{"limit exceeded", model.ReviewChangesRequested, &model.Review{Status: model.ReviewPending}, nil, SudoRole, nil, store.ErrLimitExceeded, service.ErrWIPLimitExceeded},
+		{"resolve unknown", model.ReviewChangesRequested, &model.Review{Status: model.ReviewPending}, nil, SudoRole, nil, errors.New(""), service.ErrInternal},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			task := &model.Task{ID: uuid.New(), CreatedBy: uuid.New(), Status: model.StatusOnReview}
+
+			reviewRepo := mocks.NewMockReviewRepository(ctrl)
+			reviewRepo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(tc.review, tc.getErr).AnyTimes()
+			reviewRepo.EXPECT().Resolve(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(tc.resolveErr).AnyTimes()
+			taskRepo := mocks.NewMockTaskRepository(ctrl)
+			taskRepo.EXPECT().GetByUserAndID(gomock.Any(), user, gomock.Any()).Return(task, nil).AnyTimes()
+			taskRepo.EXPECT().GetRoleOfMember(gomock.Any(), user, task.ID).Return(tc.role, tc.roleErr).AnyTimes()
+			str := mocks.NewMockStore(ctrl)
+			str.EXPECT().Review().Return(reviewRepo).AnyTimes()
+			str.EXPECT().Task().Return(taskRepo).AnyTimes()
+
+			s := testService(t, str)
+			got, err := s.ResolveReview(context.Background(), user, uuid.New(), model.ResolveReviewRequest{Status: tc.status})
+			assert.Nil(t, got)
+			assert.ErrorIs(t, err, tc.want)
+		})
+	}
+}
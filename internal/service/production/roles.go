@@ -0,0 +1,93 @@
+package production
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/vlad-marlo/godo/internal/model"
+	"github.com/vlad-marlo/godo/internal/service"
+	"github.com/vlad-marlo/godo/internal/store"
+	"go.uber.org/zap"
+)
+
+const (
+	// adminRoleName is name of role with all permissions which is given to creator of group.
+	adminRoleName     = "admin"
+	maxRoleNameLength = 64
+)
+
+// GetGroupRoles return roles of group. Roles are available to every member of group.
+func (s *Service) GetGroupRoles(ctx context.Context, user, group uuid.UUID) (*model.GetRolesResponse, error) {
+	if err := s.authorize(ctx, user, group, 0); err != nil {
+		return nil, err
+	}
+
+	roles, err := s.store.Role().AllByGroup(ctx, group)
+	if err != nil {
+		return nil, service.ErrInternal.With(zap.Error(err))
+	}
+	if roles == nil {
+		roles = []*model.Role{}
+	}
+	return &model.GetRolesResponse{
+		Count: len(roles),
+		Roles: roles,
+	}, nil
+}
+
+// CreateGroupRole creates named role of group. Roles are created only by admins of group.
+//
+// Every role of group must have unique name and unique set of permissions.
+func (s *Service) CreateGroupRole(ctx context.Context, user, group uuid.UUID, req model.CreateRoleRequest) (*model.Role, error) {
+	name := strings.TrimSpace(req.Name)
+	if name == "" || utf8.RuneCountInString(name) > maxRoleNameLength {
+		return nil, service.ErrBadRole
+	}
+	if !req.Permissions.Valid() {
+		return nil, service.ErrBadRole
+	}
+	if err := s.authorizeAdmin(ctx, user, group); err != nil {
+		return nil, err
+	}
+
+	role := &model.Role{
+		Group:       group,
+		Name:        name,
+		Permissions: req.Permissions,
+	}
+	if err := s.store.Role().Create(ctx, role); err != nil {
+		switch {
+		case errors.Is(err, store.ErrUniqueViolation):
+			return nil, service.ErrRoleAlreadyExists.With(zap.Error(err))
+		case errors.Is(err, store.ErrFKViolation):
+			return nil, service.ErrNotFound
+		default:
+			return nil, service.ErrInternal.With(zap.Error(err))
+		}
+	}
+	return role, nil
+}
+
+// DeleteGroupRole deletes role of group. Roles are deleted only by admins of group.
+//
+// Role which is given to members or invites could not be deleted.
+func (s *Service) DeleteGroupRole(ctx context.Context, user, group uuid.UUID, role int32) error {
+	if err := s.authorizeAdmin(ctx, user, group); err != nil {
+		return err
+	}
+
+	if err := s.store.Role().Delete(ctx, group, role); err != nil {
+		switch {
+		case errors.Is(err, store.ErrNotFound):
+			return service.ErrNotFound
+		case errors.Is(err, store.ErrFKViolation):
+			return service.ErrRoleInUse.With(zap.Error(err))
+		default:
+			return service.ErrInternal.With(zap.Error(err))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,139 @@
+package production
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vlad-marlo/godo/internal/model"
+	"github.com/vlad-marlo/godo/internal/service"
+	"github.com/vlad-marlo/godo/internal/store"
+	"github.com/vlad-marlo/godo/internal/store/mocks"
+)
+
+func TestService_GetGroupRoles(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	group, empty, stranger := uuid.New(), uuid.New(), uuid.New()
+	roles := []*model.Role{{ID: 1, Group: group, Name: "admin", Permissions: model.PermAll}}
+
+	groupRepo := mocks.NewMockGroupRepository(ctrl)
+	groupRepo.EXPECT().GetRoleOfMember(gomock.Any(), TestUser1.ID, gomock.Any()).Return(ReadOnlyRole, nil).Times(2)
+	groupRepo.EXPECT().GetRoleOfMember(gomock.Any(), stranger, group).Return(nil, store.ErrNotFound)
+	roleRepo := mocks.NewMockRoleRepository(ctrl)
+	roleRepo.EXPECT().AllByGroup(gomock.Any(), group).Return(roles, nil)
+	roleRepo.EXPECT().AllByGroup(gomock.Any(), empty).Return(nil, nil)
+	str := mocks.NewMockStore(ctrl)
+	str.EXPECT().Group().Return(groupRepo).AnyTimes()
+	str.EXPECT().Role().Return(roleRepo).AnyTimes()
+
+	s := testService(t, str)
+	resp, err := s.GetGroupRoles(context.Background(), TestUser1.ID, group)
+	require.NoError(t, err)
+	assert.Equal(t, &model.GetRolesResponse{Count: 1, Roles: roles}, resp)
+
+	resp, err = s.GetGroupRoles(context.Background(), TestUser1.ID, empty)
+	require.NoError(t, err)
+	assert.Equal(t, &model.GetRolesResponse{Roles: []*model.Role{}}, resp)
+
+	_, err = s.GetGroupRoles(context.Background(), stranger, group)
+	assert.ErrorIs(t, err, service.ErrForbidden)
+}
+
+func TestService_CreateGroupRole_BadRequest(t *testing.T) {
+	s := testService(t, nil)
+
+	_, err := s.CreateGroupRole(context.Background(), uuid.Nil, uuid.Nil, model.CreateRoleRequest{Name: " "})
+	assert.ErrorIs(t, err, service.ErrBadRole)
+
+	_, err = s.CreateGroupRole(context.Background(), uuid.Nil, uuid.Nil, model.CreateRoleRequest{Name: strings.Repeat("r", maxRoleNameLength+1)})
+	assert.ErrorIs(t, err, service.ErrBadRole)
+
+	_, err = s.CreateGroupRole(context.Background(), uuid.Nil, uuid.Nil, model.CreateRoleRequest{Name: "reviewer", Permissions: model.PermAll + 1})
+	assert.ErrorIs(t, err, service.ErrBadRole)
+}
+
+func TestService_CreateGroupRole(t *testing.T) {
+	tt := []struct {
+		name     string
+		isAdmin  bool
+		storeErr error
+		want     error
+	}{
+		{"admin", true, nil, nil},
+		{"not admin", false, nil, service.ErrForbidden},
+		{"already exists", true, store.ErrUniqueViolation, service.ErrRoleAlreadyExists},
+		{"group deleted", true, store.ErrFKViolation, service.ErrNotFound},
+		{"unknown", true, errors.New(""), service.ErrInternal},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			group := uuid.New()
+			req := model.CreateRoleRequest{Name: " reviewer ", Permissions: model.PermReviewTasks | model.PermViewReviews}
+
+			groupRepo := mocks.NewMockGroupRepository(ctrl)
+			groupRepo.EXPECT().IsAdmin(gomock.Any(), group, TestUser1.ID).Return(tc.isAdmin)
+			roleRepo := mocks.NewMockRoleRepository(ctrl)
+			roleRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, r *model.Role) error {
+				r.ID = 5
+				return tc.storeErr
+			}).MaxTimes(1)
+			str := mocks.NewMockStore(ctrl)
+			str.EXPECT().Group().Return(groupRepo)
+			str.EXPECT().Role().Return(roleRepo).AnyTimes()
+
+			role, err := testService(t, str).CreateGroupRole(context.Background(), TestUser1.ID, group, req)
+			if tc.want != nil {
+				assert.ErrorIs(t, err, tc.want)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, &model.Role{ID: 5, Group: group, Name: "reviewer", Permissions: req.Permissions}, role)
+		})
+	}
+}
+
+func TestService_DeleteGroupRole(t *testing.T) {
+	tt := []struct {
+		name     string
+		isAdmin  bool
+		storeErr error
+		want     error
+	}{
+		{"admin", true, nil, nil},
+		{"not admin", false, nil, service.ErrForbidden},
+		{"not found", true, store.ErrNotFound, service.ErrNotFound},
+		{"in use", true, store.ErrFKViolation, service.ErrRoleInUse},
+		{"unknown", true, errors.New(""), service.ErrInternal},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			group := uuid.New()
+
+			groupRepo := mocks.NewMockGroupRepository(ctrl)
+			groupRepo.EXPECT().IsAdmin(gomock.Any(), group, TestUser1.ID).Return(tc.isAdmin)
+			roleRepo := mocks.NewMockRoleRepository(ctrl)
+			roleRepo.EXPECT().Delete(gomock.Any(), group, int32(5)).Return(tc.storeErr).MaxTimes(1)
+			str := mocks.NewMockStore(ctrl)
+			str.EXPECT().Group().Return(groupRepo)
+			str.EXPECT().Role().Return(roleRepo).AnyTimes()
+
+			err := testService(t, str).DeleteGroupRole(context.Background(), TestUser1.ID, group, 5)
+			assert.ErrorIs(t, err, tc.want)
+		})
+	}
+}
+
+func TestAuthorizeGrant(t *testing.T) {
+	reviewer := &model.Role{Permissions: model.PermReviewTasks | model.PermViewReviews}
+
+	assert.NoError(t, authorizeGrant(model.PermAll, 0, reviewer))
+	assert.NoError(t, authorizeGrant(model.PermViewReviews, model.PermReviewTasks, reviewer))
+	assert.ErrorIs(t, authorizeGrant(model.PermViewReviews, 0, reviewer), service.ErrRoleEscalation)
+}
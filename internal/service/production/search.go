@@ -0,0 +1,42 @@
+package production
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/vlad-marlo/godo/internal/model"
+	"github.com/vlad-marlo/godo/internal/service"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultSearchLimit = 20
+	maxSearchLimit     = 100
+)
+
+// Search return tasks visible to user which name, description or comments match query ordered by relevance.
+func (s *Service) Search(ctx context.Context, user uuid.UUID, query string, limit int) (*model.SearchResponse, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil, service.ErrEmptySearchQuery
+	}
+	switch {
+	case limit < 0:
+		return nil, service.ErrBadPageLimit
+	case limit == 0:
+		limit = defaultSearchLimit
+	case limit > maxSearchLimit:
+		limit = maxSearchLimit
+	}
+
+	res, err := s.store.Task().Search(ctx, user, query, limit)
+	if err != nil {
+		return nil, service.ErrInternal.With(zap.Error(err))
+	}
+
+	return &model.SearchResponse{
+		Count:   len(res),
+		Results: res,
+	}, nil
+}
@@ -0,0 +1,66 @@
+package production
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vlad-marlo/godo/internal/model"
+	"github.com/vlad-marlo/godo/internal/service"
+	"github.com/vlad-marlo/godo/internal/store/mocks"
+)
+
+func TestService_Search_BadRequest(t *testing.T) {
+	s := testService(t, nil)
+
+	_, err := s.Search(context.Background(), uuid.Nil, "   ", 0)
+	assert.ErrorIs(t, err, service.ErrEmptySearchQuery)
+
+	_, err = s.Search(context.Background(), uuid.Nil, "task", -1)
+	assert.ErrorIs(t, err, service.ErrBadPageLimit)
+}
+
+func TestService_Search(t *testing.T) {
+	tt := []struct {
+		name  string
+		limit int
+		want  int
+	}{
+		{"default limit", 0, defaultSearchLimit},
+		{"custom limit", 5, 5},
+		{"too big limit", maxSearchLimit + 1, maxSearchLimit},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			results := []*model.SearchResult{{Task: TestTask1, Rank: 0.5, Snippet: "<b>task</b>"}}
+
+			taskRepo := mocks.NewMockTaskRepository(ctrl)
+			taskRepo.EXPECT().Search(gomock.Any(), TestUser1.ID, "task", tc.want).Return(results, nil)
+			str := mocks.NewMockStore(ctrl)
+			str.EXPECT().Task().Return(taskRepo)
+
+			s := testService(t, str)
+			resp, err := s.Search(context.Background(), TestUser1.ID, " task ", tc.limit)
+			require.NoError(t, err)
+			assert.Equal(t, 1, resp.Count)
+			assert.Equal(t, results, resp.Results)
+		})
+	}
+}
+
+func TestService_Search_Internal(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	taskRepo := mocks.NewMockTaskRepository(ctrl)
+	taskRepo.EXPECT().Search(gomock.Any(), uuid.Nil, "task", defaultSearchLimit).Return(nil, errors.New(""))
+	str := mocks.NewMockStore(ctrl)
+	str.EXPECT().Task().Return(taskRepo)
+
+	s := testService(t, str)
+	_, err := s.Search(context.Background(), uuid.Nil, "task", 0)
+	assert.ErrorIs(t, err, service.ErrInternal)
+}
@@ -13,14 +13,16 @@ import (
 // TODO:decompose logic as in storage - user, group, ...
 type Service struct {
 	store store.Store
+	blobs store.BlobStore
 	cfg   *config.Config
 	log   *zap.Logger
 }
 
 // New ...
-func New(store store.Store, cfg *config.Config, log *zap.Logger) *Service {
+func New(store store.Store, blobs store.BlobStore, cfg *config.Config, log *zap.Logger) *Service {
 	return &Service{
 		store: store,
+		blobs: blobs,
 		cfg:   cfg,
 		log:   log,
 	}
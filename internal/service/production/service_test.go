@@ -34,8 +34,10 @@ func TestService_Ping_Negative(t *testing.T) {
 func TestNew(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	store := mocks.NewMockStore(ctrl)
-	s := New(store, config.New(), zap.L())
+	blobs := mocks.NewMockBlobStore(ctrl)
+	s := New(store, blobs, config.New(), zap.L())
 	assert.NotNil(t, s)
+	assert.Equal(t, blobs, s.blobs)
 }
 
 func TestMain(m *testing.M) {
@@ -0,0 +1,45 @@
+package production
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/vlad-marlo/godo/internal/model"
+	"github.com/vlad-marlo/godo/internal/service"
+	"go.uber.org/zap"
+)
+
+const (
+	// defaultStatsPeriod is period of group statistics if its start is not provided.
+	defaultStatsPeriod = 12 * 7 * 24 * time.Hour
+	// maxStatsPeriod is maximum period of group statistics.
+	maxStatsPeriod = 2 * 366 * 24 * time.Hour
+)
+
+// GetGroupStats return statistics of group tasks over period.
+//
+// Period ends now and lasts 12 weeks if its bounds are not provided. Only admins of group and members who could
+// read all tasks of group could see statistics.
+func (s *Service) GetGroupStats(ctx context.Context, user, group uuid.UUID, from, to time.Time) (*model.GroupStats, error) {
+	if to.IsZero() {
+		to = time.Now()
+	}
+	if from.IsZero() {
+		from = to.Add(-defaultStatsPeriod)
+	}
+	if !to.After(from) || to.Sub(from) > maxStatsPeriod {
+		return nil, service.ErrBadStatsPeriod
+	}
+
+	if err := s.authorize(ctx, user, group, model.PermViewTasks); err != nil {
+		return nil, err
+	}
+
+	stats, err := s.store.Group().Stats(ctx, model.GroupStatsFilter{Group: group, From: from, To: to})
+	if err != nil {
+		return nil, service.ErrInternal.With(zap.Error(err))
+	}
+	stats.FillGaps()
+	return stats, nil
+}
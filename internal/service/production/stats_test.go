@@ -0,0 +1,73 @@
+package production
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vlad-marlo/godo/internal/model"
+	"github.com/vlad-marlo/godo/internal/service"
+	"github.com/vlad-marlo/godo/internal/store"
+	"github.com/vlad-marlo/godo/internal/store/mocks"
+)
+
+func TestService_GetGroupStats(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	group, stranger, reader := uuid.New(), uuid.New(), uuid.New()
+	from := time.Date(2023, time.May, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 14)
+
+	groupRepo := mocks.NewMockGroupRepository(ctrl)
+	groupRepo.EXPECT().GetRoleOfMember(gomock.Any(), TestUser1.ID, group).Return(&model.Role{Permissions: model.PermViewTasks}, nil).AnyTimes()
+	groupRepo.EXPECT().GetRoleOfMember(gomock.Any(), reader, group).Return(ReadOnlyRole, nil)
+	groupRepo.EXPECT().GetRoleOfMember(gomock.Any(), stranger, group).Return(nil, store.ErrNotFound)
+	groupRepo.EXPECT().
+		Stats(gomock.Any(), model.GroupStatsFilter{Group: group, From: from, To: to}).
+		Return(&model.GroupStats{Group: group, From: from, To: to, Reviews: model.ReviewStats{Resolved: 4, Rejected: 1}}, nil)
+	groupRepo.EXPECT().
+		Stats(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, filter model.GroupStatsFilter) (*model.GroupStats, error) {
+			assert.WithinDuration(t, time.Now(), filter.To, time.Minute)
+			assert.Equal(t, defaultStatsPeriod, filter.To.Sub(filter.From))
+			return nil, errors.New("")
+		})
+	str := mocks.NewMockStore(ctrl)
+	str.EXPECT().Group().Return(groupRepo).AnyTimes()
+
+	s := testService(t, str)
+	stats, err := s.GetGroupStats(context.Background(), TestUser1.ID, group, from, to)
+	require.NoError(t, err)
+	assert.Len(t, stats.Weeks, 2)
+	assert.Equal(t, 0.25, stats.Reviews.RejectionRate)
+	assert.Contains(t, stats.Statuses, model.StatusNew)
+
+	_, err = s.GetGroupStats(context.Background(), TestUser1.ID, group, time.Time{}, time.Time{})
+	assert.ErrorIs(t, err, service.ErrInternal)
+	_, err = s.GetGroupStats(context.Background(), reader, group, from, to)
+	assert.ErrorIs(t, err, service.ErrForbidden)
+	_, err = s.GetGroupStats(context.Background(), stranger, group, from, to)
+	assert.ErrorIs(t, err, service.ErrForbidden)
+}
+
+func TestService_GetGroupStats_BadPeriod(t *testing.T) {
+	s := testService(t, nil)
+	from := time.Date(2023, time.May, 1, 0, 0, 0, 0, time.UTC)
+	for _, tc := range []struct {
+		name     string
+		from, to time.Time
+	}{
+		{"empty", from, from},
+		{"reversed", from, from.Add(-time.Hour)},
+		{"too long", from, from.Add(maxStatsPeriod + time.Hour)},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := s.GetGroupStats(context.Background(), TestUser1.ID, uuid.New(), tc.from, tc.to)
+			assert.ErrorIs(t, err, service.ErrBadStatsPeriod)
+		})
+	}
+}
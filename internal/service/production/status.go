@@ -0,0 +1,88 @@
+package production
+
+import (
+	"fmt"
+
+	"github.com/vlad-marlo/godo/internal/model"
+	"github.com/vlad-marlo/godo/internal/pkg/fielderr"
+	"github.com/vlad-marlo/godo/internal/service"
+)
+
+// transition is allowed change of task status.
+type transition struct {
+	// to is status into which task could be moved.
+	to model.TaskStatus
+	// perm is permissions which are required to do transition.
+	perm model.Permission
+	// review is true if transition is done only by submitting or resolving review of task,
+	// so task is on review exactly while it has pending review.
+	review bool
+}
+
+// transitions is table of allowed status transitions.
+//
+// Work on task (take it, submit it to review, return it back) is available for every user who can see task.
+// Closing and reopening of task require model.PermEditRelatedTasks, returning accepted task into work requires
+// model.PermEditTasks. Task leaves review only when review is resolved by user with model.PermReviewTasks.
+var transitions = map[model.TaskStatus][]transition{
+	model.StatusNew: {
+		{model.StatusInProgress, 0, false},
+		{model.StatusClosed, model.PermEditRelatedTasks, false},
+	},
+	model.StatusInProgress: {
+		{model.StatusNew, 0, false},
+		{model.StatusOnReview, 0, true},
+		{model.StatusClosed, model.PermEditRelatedTasks, false},
+	},
+	model.StatusOnReview: {
+		{model.StatusChangesRequested, model.PermReviewTasks, true},
+		{model.StatusAccepted, model.PermReviewTasks, true},
+	},
+	model.StatusChangesRequested: {
+		{model.StatusInProgress, 0, false},
+		{model.StatusClosed, model.PermEditRelatedTasks, false},
+	},
+	model.StatusAccepted: {
+		{model.StatusInProgress, model.PermEditTasks, false},
+		{model.StatusClosed, model.PermEditRelatedTasks, false},
+	},
+	model.StatusClosed: {
+		{model.StatusNew, model.PermEditRelatedTasks, false},
+	},
+}
+
+// allowedStatuses return statuses into which task could be moved from provided one by review or without it.
+func allowedStatuses(from model.TaskStatus, review bool) []model.TaskStatus {
+	res := make([]model.TaskStatus, 0, len(transitions[from]))
+	for _, t := range transitions[from] {
+		if t.review == review {
+			res = append(res, t.to)
+		}
+	}
+	return res
+}
+
+// transitionPermission return permission that is required to move task from one status to another
+// by review or without it.
+//
+// ok will be false if transition is not allowed at all or is done other way.
+func transitionPermission(from, to model.TaskStatus, review bool) (perm model.Permission, ok bool) {
+	for _, t := range transitions[from] {
+		if t.to == to && t.review == review {
+			return t.perm, true
+		}
+	}
+	return 0, false
+}
+
+// errTransition return conflict error with statuses into which task could be moved by review or without it.
+func errTransition(from, to model.TaskStatus, review bool) *fielderr.Error {
+	msg := fmt.Sprintf("transition from %s to %s is not allowed", from, to)
+	if _, ok := transitionPermission(from, to, true); ok && !review {
+		msg = fmt.Sprintf("transition from %s to %s is done by review", from, to)
+	}
+	return service.ErrStatusTransition.WithData(map[string]any{
+		"status":  msg,
+		"allowed": allowedStatuses(from, review),
+	})
+}
@@ -8,12 +8,53 @@ import (
 	"github.com/vlad-marlo/godo/internal/service"
 	"github.com/vlad-marlo/godo/internal/store"
 	"go.uber.org/zap"
+	"strings"
 	"time"
 )
 
-// GetUserTasks return tasks related to user with provided id.
-func (s *Service) GetUserTasks(ctx context.Context, user uuid.UUID) (*model.GetTasksResponse, error) {
-	tasks, err := s.store.Task().AllByUser(ctx, user)
+const (
+	// defaultTasksLimit is size of tasks page if it is not provided by user.
+	defaultTasksLimit = 50
+	// maxTasksLimit is maximum size of tasks page.
+	maxTasksLimit = 200
+)
+
+// prepareTaskFilter validates filter and sets default sorting and size of page.
+func prepareTaskFilter(filter *model.TaskFilter) error {
+	if filter.Sort == "" {
+		filter.Sort = model.SortCreatedAt
+	}
+	if !filter.Sort.Valid() {
+		return service.ErrBadTaskSort
+	}
+	for _, status := range filter.Status {
+		if !status.Valid() {
+			return service.ErrBadTaskStatus
+		}
+	}
+	switch {
+	case filter.Limit < 0:
+		return service.ErrBadPageLimit
+	case filter.Limit == 0:
+		filter.Limit = defaultTasksLimit
+	case filter.Limit > maxTasksLimit:
+		filter.Limit = maxTasksLimit
+	}
+	return nil
+}
+
+// GetUserTasks return page of tasks related to user with provided id which satisfy filter.
+//
+// If there are more tasks after returned page then cursor of next page will be set in response.
+func (s *Service) GetUserTasks(ctx context.Context, user uuid.UUID, filter model.TaskFilter) (*model.GetTasksResponse, error) {
+	if err := prepareTaskFilter(&filter); err != nil {
+		return nil, err
+	}
+	limit := filter.Limit
+	// one more task is requested to know is there next page or not.
+	filter.Limit++
+
+	tasks, err := s.store.Task().AllByUser(ctx, user, filter)
 	if err != nil {
 		switch {
 		case errors.Is(err, store.ErrNotFound):
@@ -23,10 +64,16 @@ func (s *Service) GetUserTasks(ctx context.Context, user uuid.UUID) (*model.GetT
 		}
 	}
 
-	return &model.GetTasksResponse{
+	resp := &model.GetTasksResponse{
 		Count: len(tasks),
 		Tasks: tasks,
-	}, nil
+	}
+	if len(tasks) > limit {
+		resp.Tasks = tasks[:limit]
+		resp.Count = limit
+		resp.NextCursor = &tasks[limit-1].ID
+	}
+	return resp, nil
 }
 
 // GetTask return task by user and task id.
@@ -47,89 +94,244 @@ func (s *Service) GetTask(ctx context.Context, user, task uuid.UUID) (*model.Tas
 	return t, nil
 }
 
-func (s *Service) addTaskToUser(ctx context.Context, user, task, to uuid.UUID, pool int) {
-	if err := s.store.Task().AddToUser(ctx, user, task, to); err != nil {
-		s.log.Warn("error while adding task to user", zap.Int("pool", pool))
+// CreateTask creates task and relates it to group and assignees in one transaction.
+//
+// If group is provided then user must be admin of group or could create tasks in it.
+// Assignees who have no common group with user in which user could create tasks are reported in response.
+// If template is provided then task is created in group of template from its fields which are not set in request.
+func (s *Service) CreateTask(ctx context.Context, user uuid.UUID, req model.TaskCreateRequest) (*model.CreateTaskResponse, error) {
+	var tpl *model.TaskTemplate
+	if req.Template != nil {
+		var err error
+		if tpl, err = s.fillFromTemplate(ctx, user, &req, time.Now()); err != nil {
+			return nil, err
+		}
 	}
+
+	task := &model.Task{
+		ID:          uuid.New(),
+		Name:        req.Name,
+		Description: req.Description,
+		CreatedAt:   time.Now(),
+		CreatedBy:   user,
+		Status:      model.StatusNew,
+		Deadline:    req.Deadline,
+		Parent:      req.Parent,
+	}
+
+	if req.Parent != nil {
+		if _, err := s.GetTask(ctx, user, *req.Parent); err != nil {
+			if errors.Is(err, service.ErrNotFound) {
+				return nil, service.ErrBadParentTask
+			}
+			return nil, err
+		}
+	}
+
+	if req.Group != nil {
+		if err := s.authorize(ctx, user, *req.Group, model.PermCreateTasks); err != nil {
+			return nil, err
+		}
+	}
+
+	// storage checks permission in group again in transaction in which task is related to group.
+	rejected, err := s.store.Task().CreateWithRelations(ctx, task, req.Group, req.Users, tpl)
+	if err != nil {
+		switch {
+		case errors.Is(err, store.ErrNotAuthorized):
+			return nil, service.ErrForbidden.With(zap.Error(err))
+
+		case errors.Is(err, store.ErrUniqueViolation):
+			return nil, service.ErrTaskAlreadyExists
+
+		case errors.Is(err, store.ErrFKViolation):
+			return nil, service.ErrBadData
+
+		default:
+			return nil, service.ErrInternal.With(zap.Error(err))
+		}
+	}
+
+	return &model.CreateTaskResponse{
+		Task:              task,
+		RejectedAssignees: rejected,
+	}, nil
 }
 
-func (s *Service) addTaskToGroup(ctx context.Context, user, task, group uuid.UUID) {
-	r, err := s.store.Group().GetRoleOfMember(ctx, user, group)
+// fillFromTemplate sets group of template to request and fills fields which are not provided from template.
+//
+// If users are not provided then task is assigned to assignees of template or to all members of group.
+// Templates of groups in which user is not member are reported like missing ones, so their existence is not revealed.
+func (s *Service) fillFromTemplate(ctx context.Context, user uuid.UUID, req *model.TaskCreateRequest, now time.Time) (*model.TaskTemplate, error) {
+	tpl, err := s.store.Template().Get(ctx, *req.Template)
 	if err != nil {
-		s.log.Warn("error while getting role of member", zap.Error(err))
-		return
+		if errors.Is(err, store.ErrNotFound) {
+			return nil, service.ErrBadTemplate
+		}
+		return nil, service.ErrInternal.With(zap.Error(err))
 	}
-	if r.Tasks >= model.PermCreate {
-		if err = s.store.Task().AddToGroup(ctx, task, user); err != nil {
-			s.log.Warn("error while adding task to group", zap.Error(err))
+	if err = s.authorize(ctx, user, tpl.Group, 0); err != nil {
+		if errors.Is(err, service.ErrForbidden) {
+			return nil, service.ErrBadTemplate
 		}
+		return nil, err
+	}
+	if req.Group != nil && *req.Group != tpl.Group {
+		return nil, service.ErrBadTemplate
+	}
+
+	req.Group = &tpl.Group
+	if req.Name == "" {
+		req.Name = tpl.TaskName(now)
 	}
+	if req.Description == "" {
+		req.Description = tpl.Description
+	}
+	if req.Users == nil && len(tpl.Assignees) > 0 {
+		req.Users = tpl.Assignees
+	}
+	return tpl, nil
 }
 
-func (s *Service) addToUsers(ctx context.Context, user, task uuid.UUID, users []uuid.UUID) {
-	for p, u := range users {
-		select {
-		case <-ctx.Done():
-			return
-		default:
-			go s.addTaskToUser(ctx, user, task, u, p)
-		}
+// checkTransition checks that user could move task into provided status by review or without it.
+func (s *Service) checkTransition(ctx context.Context, user uuid.UUID, task *model.Task, to model.TaskStatus, review bool) error {
+	perm, ok := transitionPermission(task.Status, to, review)
+	if !ok {
+		return errTransition(task.Status, to, review)
+	}
+
+	return s.authorizeTask(ctx, user, task, perm)
+}
+
+// checkStatusChange checks that user could move task into provided status and that task is ready for it.
+func (s *Service) checkStatusChange(ctx context.Context, user uuid.UUID, t *model.Task, status model.TaskStatus) error {
+	if err := s.checkTransition(ctx, user, t, status, false); err != nil {
+		return err
+	}
+	// parent task could not be closed while work on its subtasks is not done.
+	if status == model.StatusClosed && t.Progress != nil && t.Progress.Done < t.Progress.Total {
+		return service.ErrOpenSubtasks
 	}
+	if status == model.StatusInProgress {
+		return s.checkBlockers(ctx, t.ID)
+	}
+	return nil
 }
 
-func (s *Service) addToGroupUsers(ctx context.Context, group, task uuid.UUID) {
-	ids, err := s.store.Group().GetUserIDs(ctx, group)
+// UpdateTaskStatus moves task into provided status if transition is allowed and user has permission to do it.
+func (s *Service) UpdateTaskStatus(ctx context.Context, user, task uuid.UUID, status model.TaskStatus) (*model.Task, error) {
+	if !status.Valid() {
+		return nil, service.ErrBadTaskStatus
+	}
+
+	t, err := s.GetTask(ctx, user, task)
 	if err != nil {
-		s.log.Error("service: add to group users: store: group: get user ids", zap.Error(err))
-		return
+		return nil, err
+	}
+
+	if err = s.checkStatusChange(ctx, user, t, status); err != nil {
+		return nil, err
 	}
 
-	for p, u := range ids {
-		select {
-		case <-ctx.Done():
-			return
+	if err = s.store.Task().UpdateStatus(ctx, t.ID, t.Status, status, statusChange(t.ID, user, t.Status, status)); err != nil {
+		switch {
+		case errors.Is(err, store.ErrNotFound):
+			return nil, service.ErrConflict.With(zap.Error(err))
+		case errors.Is(err, store.ErrLimitExceeded):
+			return nil, errColumnLimit(status)
 		default:
-			if err = s.store.Task().ForceAddToUser(ctx, u, task); err != nil {
-				s.log.Error("add task to user", zap.Error(err), zap.Int("pool", p))
-			}
+			return nil, service.ErrInternal.With(zap.Error(err))
 		}
 	}
+
+	t.Status = status
+	return t, nil
 }
 
-// CreateTask create record about task in database.
-func (s *Service) CreateTask(ctx context.Context, user uuid.UUID, req model.TaskCreateRequest) (*model.Task, error) {
-	task := &model.Task{
-		ID:          uuid.New(),
-		Name:        req.Name,
-		Description: req.Description,
-		CreatedAt:   time.Now(),
-		CreatedBy:   user,
-		Status:      "NEW",
+// GetSubtasks return subtasks of task which are visible to user.
+func (s *Service) GetSubtasks(ctx context.Context, user, task uuid.UUID) (*model.GetTasksResponse, error) {
+	t, err := s.GetTask(ctx, user, task)
+	if err != nil {
+		return nil, err
 	}
 
-	if err := s.store.Task().Create(ctx, task); err != nil {
-		switch {
-		case errors.Is(err, store.ErrUniqueViolation):
-			return nil, service.ErrTaskAlreadyExists
+	tasks, err := s.store.Task().AllByUser(ctx, user, model.TaskFilter{
+		Parent: &t.ID,
+		Sort:   model.SortCreatedAt,
+	})
+	if err != nil {
+		return nil, service.ErrInternal.With(zap.Error(err))
+	}
 
-		case errors.Is(err, store.ErrFKViolation):
-			return nil, service.ErrBadData
+	return &model.GetTasksResponse{
+		Count: len(tasks),
+		Tasks: tasks,
+	}, nil
+}
+
+// UpdateTask changes name, description and deadline of task. Deadline is removed if it is cleared by request.
+//
+// Task could be changed by its creator or user who could edit tasks in group of task.
+func (s *Service) UpdateTask(ctx context.Context, user, task uuid.UUID, req model.TaskUpdateRequest) (*model.Task, error) {
+	if req.Name != nil && strings.TrimSpace(*req.Name) == "" {
+		return nil, service.ErrBadTaskName
+	}
+	if req.ClearDeadline && req.Deadline != nil {
+		return nil, service.ErrBadTaskDeadline
+	}
+
+	t, err := s.GetTask(ctx, user, task)
+	if err != nil {
+		return nil, err
+	}
 
+	if err = s.authorizeTask(ctx, user, t, model.PermEditTasks); err != nil {
+		return nil, err
+	}
+
+	old := *t
+	if req.Name != nil {
+		t.Name = *req.Name
+	}
+	if req.Description != nil {
+		t.Description = *req.Description
+	}
+	if req.Deadline != nil || req.ClearDeadline {
+		t.Deadline = req.Deadline
+	}
+
+	if err = s.store.Task().Update(ctx, t, model.DiffTask(user, &old, t)...); err != nil {
+		switch {
+		case errors.Is(err, store.ErrNotFound):
+			return nil, service.ErrNotFound
 		default:
 			return nil, service.ErrInternal.With(zap.Error(err))
 		}
 	}
 
-	// async add task to group and users
-	if req.Group != nil {
-		go s.addTaskToGroup(context.Background(), user, task.ID, *req.Group)
-		if req.Users == nil {
-			go s.addToGroupUsers(context.Background(), *req.Group, task.ID)
-		}
+	return t, nil
+}
+
+// DeleteTask deletes task. Task could be deleted by its creator or user who could delete tasks in group of task.
+func (s *Service) DeleteTask(ctx context.Context, user, task uuid.UUID) error {
+	t, err := s.GetTask(ctx, user, task)
+	if err != nil {
+		return err
 	}
-	if req.Users != nil {
-		go s.addToUsers(context.Background(), user, task.ID, req.Users)
+
+	if err = s.authorizeTask(ctx, user, t, model.PermDeleteTasks); err != nil {
+		return err
 	}
 
-	return task, nil
+	if err = s.store.Task().Delete(ctx, t.ID); err != nil {
+		switch {
+		case errors.Is(err, store.ErrNotFound):
+			return service.ErrNotFound
+		default:
+			return service.ErrInternal.With(zap.Error(err))
+		}
+	}
+	// attachments of task and of its comments are deleted with it.
+	s.purgeOrphanedBlobs(ctx)
+	return nil
 }
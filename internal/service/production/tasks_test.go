@@ -40,8 +40,11 @@ func TestService_CreateTask_Positive(t *testing.T) {
 
 			taskRepo := mocks.NewMockTaskRepository(ctrl)
 
-			taskRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(tc.err)
+			taskRepo.EXPECT().CreateWithRelations(gomock.Any(), gomock.Any(), &grpID, req.Users, gomock.Any()).Return(nil, tc.err)
+			groupRepo := mocks.NewMockGroupRepository(ctrl)
+			groupRepo.EXPECT().GetRoleOfMember(gomock.Any(), uuid.Nil, grpID).Return(&model.Role{Permissions: model.PermCreateTasks}, nil)
 			str.EXPECT().Task().Return(taskRepo)
+			str.EXPECT().Group().Return(groupRepo)
 
 			s := testService(t, str)
 
@@ -63,13 +66,14 @@ func TestService_CreateTask_Negative(t *testing.T) {
 		{"unknown", errors.New(""), service.ErrInternal},
 		{"FK violation", store.ErrFKViolation, service.ErrBadData},
 		{"unique violation", store.ErrUniqueViolation, service.ErrTaskAlreadyExists},
+		{"not authorized", store.ErrNotAuthorized, service.ErrForbidden},
 	}
 
 	for _, tc := range tt {
 		t.Run(tc.name, func(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			taskRepo := mocks.NewMockTaskRepository(ctrl)
-			taskRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(tc.err)
+			taskRepo.EXPECT().CreateWithRelations(gomock.Any(), gomock.Any(), nil, nil, gomock.Any()).Return(nil, tc.err)
 			str := mocks.NewMockStore(ctrl)
 			str.EXPECT().Task().Return(taskRepo)
 
@@ -87,18 +91,18 @@ func TestService_GetUserTasks_Positive(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	taskRepo := mocks.NewMockTaskRepository(ctrl)
 	tasks := []*model.Task{
-		{uuid.New(), uuid.NewString(), uuid.NewString(), time.Now(), uuid.Nil, "NEW"},
-		{uuid.New(), uuid.NewString(), uuid.NewString(), time.Now(), uuid.Nil, uuid.NewString()},
-		{uuid.New(), uuid.NewString(), uuid.NewString(), time.Now(), uuid.Nil, uuid.NewString()},
-		{uuid.New(), uuid.NewString(), uuid.NewString(), time.Now(), uuid.Nil, uuid.NewString()},
-		{uuid.New(), uuid.NewString(), uuid.NewString(), time.Now(), uuid.Nil, uuid.NewString()},
+		{ID: uuid.New(), Name: uuid.NewString(), Description: uuid.NewString(), CreatedAt: time.Now(), CreatedBy: uuid.Nil, Status: model.StatusNew},
+		{ID: uuid.New(), Name: uuid.NewString(), Description: uuid.NewString(), CreatedAt: time.Now(), CreatedBy: uuid.Nil, Status: model.StatusInProgress},
+		{ID: uuid.New(), Name: uuid.NewString(), Description: uuid.NewString(), CreatedAt: time.Now(), CreatedBy: uuid.Nil, Status: model.StatusInProgress},
+		{ID: uuid.New(), Name: uuid.NewString(), Description: uuid.NewString(), CreatedAt: time.Now(), CreatedBy: uuid.Nil, Status: model.StatusInProgress},
+		{ID: uuid.New(), Name: uuid.NewString(), Description: uuid.NewString(), CreatedAt: time.Now(), CreatedBy: uuid.Nil, Status: model.StatusInProgress},
 	}
-	taskRepo.EXPECT().AllByUser(gomock.Any(), uuid.Nil).Return(tasks, nil)
+	taskRepo.EXPECT().AllByUser(gomock.Any(), uuid.Nil, model.TaskFilter{Sort: model.SortCreatedAt, Limit: defaultTasksLimit + 1}).Return(tasks, nil)
 	str := mocks.NewMockStore(ctrl)
 	str.EXPECT().Task().Return(taskRepo)
 
 	s := testService(t, str)
-	resp, err := s.GetUserTasks(context.Background(), uuid.Nil)
+	resp, err := s.GetUserTasks(context.Background(), uuid.Nil, model.TaskFilter{})
 	require.NoError(t, err)
 	expected := &model.GetTasksResponse{
 		Count: len(tasks),
@@ -121,12 +125,12 @@ func TestService_GetUserTasks_Negative(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			taskRepo := mocks.NewMockTaskRepository(ctrl)
-			taskRepo.EXPECT().AllByUser(gomock.Any(), uuid.Nil).Return(nil, tc.err)
+			taskRepo.EXPECT().AllByUser(gomock.Any(), uuid.Nil, model.TaskFilter{Sort: model.SortCreatedAt, Limit: defaultTasksLimit + 1}).Return(nil, tc.err)
 			str := mocks.NewMockStore(ctrl)
 			str.EXPECT().Task().Return(taskRepo)
 
 			s := testService(t, str)
-			resp, err := s.GetUserTasks(context.Background(), uuid.Nil)
+			resp, err := s.GetUserTasks(context.Background(), uuid.Nil, model.TaskFilter{})
 			require.Nil(t, resp)
 			if assert.Error(t, err) {
 				assert.ErrorIs(t, err, tc.expect)
@@ -144,7 +148,7 @@ func TestService_GetTask_Positive(t *testing.T) {
 		Description: uuid.NewString(),
 		CreatedAt:   time.Now(),
 		CreatedBy:   uuid.Nil,
-		Status:      uuid.NewString(),
+		Status:      model.StatusNew,
 	}
 	taskRepo.EXPECT().GetByUserAndID(gomock.Any(), uuid.Nil, uuid.Nil).Return(task, nil)
 	st := mocks.NewMockStore(ctrl)
@@ -186,104 +190,420 @@ func TestService_GetTask_Negative(t *testing.T) {
 	}
 }
 
-func TestService_AddTaskToUser(t *testing.T) {
+func TestService_CreateTask_RejectedAssignees(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	user := uuid.New()
+	grpID := uuid.New()
+	rejected := []uuid.UUID{uuid.New()}
+	req := model.TaskCreateRequest{
+		Name:        uuid.NewString(),
+		Description: uuid.NewString(),
+		Users:       []uuid.UUID{user, rejected[0]},
+		Group:       &grpID,
+	}
+
+	taskRepo := mocks.NewMockTaskRepository(ctrl)
+	taskRepo.EXPECT().CreateWithRelations(gomock.Any(), gomock.Any(), &grpID, req.Users, gomock.Any()).DoAndReturn(
+		func(_ context.Context, task *model.Task, _ *uuid.UUID, _ []uuid.UUID, _ *model.TaskTemplate) ([]uuid.UUID, error) {
+			assert.Equal(t, req.Name, task.Name)
+			assert.Equal(t, req.Description, task.Description)
+			assert.Equal(t, user, task.CreatedBy)
+			assert.Equal(t, model.StatusNew, task.Status)
+			return rejected, nil
+		},
+	)
+	groupRepo := mocks.NewMockGroupRepository(ctrl)
+	groupRepo.EXPECT().GetRoleOfMember(gomock.Any(), user, grpID).Return(SudoRole, nil)
+	str := mocks.NewMockStore(ctrl)
+	str.EXPECT().Task().Return(taskRepo)
+	str.EXPECT().Group().Return(groupRepo)
+
+	s := testService(t, str)
+	resp, err := s.CreateTask(context.Background(), user, req)
+	require.NoError(t, err)
+	assert.Equal(t, req.Name, resp.Task.Name)
+	assert.Equal(t, rejected, resp.RejectedAssignees)
+}
+
+func TestService_UpdateTaskStatus_BadStatus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	s := testService(t, mocks.NewMockStore(ctrl))
+
+	task, err := s.UpdateTaskStatus(context.Background(), uuid.New(), uuid.New(), "bad status")
+	assert.Nil(t, task)
+	assert.ErrorIs(t, err, service.ErrBadTaskStatus)
+}
+
+func TestService_UpdateTaskStatus_Positive(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	user := uuid.New()
+	task := &model.Task{
+		ID:        uuid.New(),
+		CreatedBy: uuid.New(),
+		Status:    model.StatusNew,
+	}
+
+	taskRepo := mocks.NewMockTaskRepository(ctrl)
+	taskRepo.EXPECT().GetByUserAndID(gomock.Any(), user, task.ID).Return(task, nil)
+	taskRepo.EXPECT().GetRoleOfMember(gomock.Any(), user, task.ID).Return(nil, store.ErrNotFound)
+	taskRepo.EXPECT().UpdateStatus(gomock.Any(), task.ID, model.StatusNew, model.StatusInProgress, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ uuid.UUID, _, _ model.TaskStatus, changes ...*model.TaskChange) error {
+			require.Len(t, changes, 1)
+			assert.Equal(t, task.ID, changes[0].Task)
+			assert.Equal(t, user, changes[0].Actor)
+			assert.Equal(t, model.TaskFieldStatus, changes[0].Field)
+			assert.Equal(t, string(model.StatusNew), *changes[0].OldValue)
+			assert.Equal(t, string(model.StatusInProgress), *changes[0].NewValue)
+			return nil
+		},
+	)
+	depRepo := mocks.NewMockDependencyRepository(ctrl)
+	depRepo.EXPECT().OpenBlockers(gomock.Any(), task.ID).Return(nil, nil)
+	str := mocks.NewMockStore(ctrl)
+	str.EXPECT().Task().Return(taskRepo).AnyTimes()
+	str.EXPECT().Dependency().Return(depRepo)
+
+	s := testService(t, str)
+	got, err := s.UpdateTaskStatus(context.Background(), user, task.ID, model.StatusInProgress)
+	require.NoError(t, err)
+	assert.Equal(t, model.StatusInProgress, got.Status)
+}
+
+func TestService_UpdateTaskStatus_Negative(t *testing.T) {
+	user := uuid.New()
 	tt := []struct {
-		name string
-		err  error
+		name      string
+		createdBy uuid.UUID
+		from      model.TaskStatus
+		to        model.TaskStatus
+		role      *model.Role
+		roleErr   error
+		updateErr error
+		want      error
 	}{
-		{"nil", nil},
-		{"not nil", errors.New("")},
+		{"not allowed transition", user, model.StatusNew, model.StatusAccepted, nil, nil, nil, service.ErrStatusTransition},
+		{"no permission", uuid.New(), model.StatusAccepted, model.StatusInProgress, ReadOnlyRole, nil, nil, service.ErrForbidden},
+		{"submit without review", user, model.StatusInProgress, model.StatusOnReview, nil, nil, nil, service.ErrStatusTransition},
+		{"resolve without review", uuid.New(), model.StatusOnReview, model.StatusAccepted, SudoRole, nil, nil, service.ErrStatusTransition},
+		{"not member", uuid.New(), model.StatusNew, model.StatusClosed, nil, store.ErrNotFound, nil, service.ErrForbidden},
+		{"role unknown err", uuid.New(), model.StatusNew, model.StatusClosed, nil, errors.New(""), nil, service.ErrInternal},
+		{"concurrent change", uuid.New(), model.StatusNew, model.StatusClosed, SudoRole, nil, store.ErrNotFound, service.ErrConflict},
+		{"limit exceeded", user, model.StatusNew, model.StatusClosed, SudoRole, nil, store.ErrLimitExceeded, service.ErrWIPLimitExceeded},
+		{"update unknown err", user, model.StatusNew, model.StatusClosed, SudoRole, nil, errors.New(""), service.ErrInternal},
 	}
 	for _, tc := range tt {
 		t.Run(tc.name, func(t *testing.T) {
 			ctrl := gomock.NewController(t)
+			task := &model.Task{
+				ID:        uuid.New(),
+				CreatedBy: tc.createdBy,
+				Status:    tc.from,
+			}
+
 			taskRepo := mocks.NewMockTaskRepository(ctrl)
-			taskRepo.EXPECT().AddToUser(gomock.Any(), uuid.Nil, uuid.Nil, uuid.Nil).Return(tc.err)
+			taskRepo.EXPECT().GetByUserAndID(gomock.Any(), user, task.ID).Return(task, nil)
+			taskRepo.EXPECT().GetRoleOfMember(gomock.Any(), user, task.ID).Return(tc.role, tc.roleErr).AnyTimes()
+			taskRepo.EXPECT().UpdateStatus(gomock.Any(), task.ID, tc.from, tc.to, gomock.Any()).Return(tc.updateErr).AnyTimes()
 			str := mocks.NewMockStore(ctrl)
-			str.EXPECT().Task().Return(taskRepo)
+			str.EXPECT().Task().Return(taskRepo).AnyTimes()
+
 			s := testService(t, str)
-			s.addTaskToUser(context.Background(), uuid.Nil, uuid.Nil, uuid.Nil, 1)
+			got, err := s.UpdateTaskStatus(context.Background(), user, task.ID, tc.to)
+			assert.Nil(t, got)
+			assert.ErrorIs(t, err, tc.want)
 		})
 	}
-
 }
 
-func TestService_AddTaskToUsers(t *testing.T) {
+func TestService_UpdateTask(t *testing.T) {
+	user := uuid.New()
+	name, description, empty := "new name", "new description", " "
+	tt := []struct {
+		name      string
+		createdBy uuid.UUID
+		req       model.TaskUpdateRequest
+		role      *model.Role
+		roleErr   error
+		updateErr error
+		want      error
+	}{
+		{"author", user, model.TaskUpdateRequest{Name: &name}, nil, store.ErrNotFound, nil, nil},
+		{"group moderator", uuid.New(), model.TaskUpdateRequest{Name: &name, Description: &description}, SudoRole, nil, nil, nil},
+		{"empty name", user, model.TaskUpdateRequest{Name: &empty}, nil, nil, nil, service.ErrBadTaskName},
+		{"no permission", uuid.New(), model.TaskUpdateRequest{Name: &name}, &model.Role{Permissions: model.PermEditRelatedTasks}, nil, nil, service.ErrForbidden},
+		{"not member", uuid.New(), model.TaskUpdateRequest{Name: &name}, nil, store.ErrNotFound, nil, service.ErrForbidden},
+		{"role unknown err", uuid.New(), model.TaskUpdateRequest{Name: &name}, nil, errors.New(""), nil, service.ErrInternal},
+		{"deleted concurrently", user, model.TaskUpdateRequest{Name: &name}, SudoRole, nil, store.ErrNotFound, service.ErrNotFound},
+		{"update unknown err", user, model.TaskUpdateRequest{Name: &name}, SudoRole, nil, errors.New(""), service.ErrInternal},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			task := &model.Task{
+				ID:          uuid.New(),
+				Name:        "name",
+				Description: "description",
+				CreatedBy:   tc.createdBy,
+				Status:      model.StatusNew,
+			}
 
-}
+			taskRepo := mocks.NewMockTaskRepository(ctrl)
+			taskRepo.EXPECT().GetByUserAndID(gomock.Any(), user, task.ID).Return(task, nil).AnyTimes()
+			taskRepo.EXPECT().GetRoleOfMember(gomock.Any(), user, task.ID).Return(tc.role, tc.roleErr).AnyTimes()
+			taskRepo.EXPECT().Update(gomock.Any(), task, gomock.Any()).Return(tc.updateErr).AnyTimes()
+			str := mocks.NewMockStore(ctrl)
+			str.EXPECT().Task().Return(taskRepo).AnyTimes()
 
-func TestService_AddTaskToGroup_Negative_NoPermission(t *testing.T) {
-	role := &model.Role{
-		ID:       0,
-		Members:  model.PermCreate,
-		Tasks:    model.PermReadRelated,
-		Reviews:  model.PermChangeRelated,
-		Comments: model.PermChangeAll,
+			s := testService(t, str)
+			got, err := s.UpdateTask(context.Background(), user, task.ID, tc.req)
+			if tc.want != nil {
+				assert.Nil(t, got)
+				assert.ErrorIs(t, err, tc.want)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, *tc.req.Name, got.Name)
+			if tc.req.Description != nil {
+				assert.Equal(t, *tc.req.Description, got.Description)
+			} else {
+				assert.Equal(t, "description", got.Description)
+			}
+		})
 	}
+}
+
+func TestService_UpdateTask_Deadline(t *testing.T) {
 	ctrl := gomock.NewController(t)
-	groupRepo := mocks.NewMockGroupRepository(ctrl)
-	groupRepo.EXPECT().GetRoleOfMember(gomock.Any(), uuid.Nil, uuid.Nil).Return(role, nil)
+	user := uuid.New()
+	deadline := time.Now().Add(time.Hour)
+	task := &model.Task{ID: uuid.New(), Name: "name", CreatedBy: user, Status: model.StatusNew, Deadline: &deadline}
+
+	taskRepo := mocks.NewMockTaskRepository(ctrl)
+	taskRepo.EXPECT().GetByUserAndID(gomock.Any(), user, task.ID).DoAndReturn(func(context.Context, uuid.UUID, uuid.UUID) (*model.Task, error) {
+		cp := *task
+		return &cp, nil
+	}).Times(2)
+	taskRepo.EXPECT().GetRoleOfMember(gomock.Any(), user, task.ID).Return(nil, store.ErrNotFound).Times(2)
+	taskRepo.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(2)
 	str := mocks.NewMockStore(ctrl)
-	str.EXPECT().Group().Return(groupRepo)
+	str.EXPECT().Task().Return(taskRepo).AnyTimes()
+
 	s := testService(t, str)
-	s.addTaskToGroup(context.Background(), uuid.Nil, uuid.Nil, uuid.Nil)
+	name := "new name"
+	// deadline is kept while it is not provided.
+	got, err := s.UpdateTask(context.Background(), user, task.ID, model.TaskUpdateRequest{Name: &name})
+	require.NoError(t, err)
+	assert.Equal(t, &deadline, got.Deadline)
+
+	got, err = s.UpdateTask(context.Background(), user, task.ID, model.TaskUpdateRequest{ClearDeadline: true})
+	require.NoError(t, err)
+	assert.Nil(t, got.Deadline)
+
+	_, err = s.UpdateTask(context.Background(), user, task.ID, model.TaskUpdateRequest{Deadline: &deadline, ClearDeadline: true})
+	assert.ErrorIs(t, err, service.ErrBadTaskDeadline)
 }
 
-func TestService_AddTaskToGroup_Positive(t *testing.T) {
-	ctrl := gomock.NewController(t)
+func TestService_DeleteTask(t *testing.T) {
+	user := uuid.New()
+	tt := []struct {
+		name      string
+		createdBy uuid.UUID
+		role      *model.Role
+		getErr    error
+		deleteErr error
+		want      error
+	}{
+		{"author", user, ReadOnlyRole, nil, nil, nil},
+		{"group moderator", uuid.New(), SudoRole, nil, nil, nil},
+		{"not visible", user, nil, store.ErrNotFound, nil, service.ErrNotFound},
+		{"no permission", uuid.New(), ReadOnlyRole, nil, nil, service.ErrForbidden},
+		{"deleted concurrently", user, SudoRole, nil, store.ErrNotFound, service.ErrNotFound},
+		{"delete unknown err", user, SudoRole, nil, errors.New(""), service.ErrInternal},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			task := &model.Task{ID: uuid.New(), CreatedBy: tc.createdBy, Status: model.StatusNew}
 
-	role := &model.Role{
-		ID:       0,
-		Members:  model.PermCreate,
-		Tasks:    model.PermChangeAll,
-		Reviews:  model.PermChangeRelated,
-		Comments: model.PermChangeAll,
+			taskRepo := mocks.NewMockTaskRepository(ctrl)
+			if tc.getErr != nil {
+				taskRepo.EXPECT().GetByUserAndID(gomock.Any(), user, task.ID).Return(nil, tc.getErr)
+			} else {
+				taskRepo.EXPECT().GetByUserAndID(gomock.Any(), user, task.ID).Return(task, nil)
+			}
+			taskRepo.EXPECT().GetRoleOfMember(gomock.Any(), user, task.ID).Return(tc.role, nil).AnyTimes()
+			taskRepo.EXPECT().Delete(gomock.Any(), task.ID).Return(tc.deleteErr).AnyTimes()
+			attachRepo := mocks.NewMockAttachmentRepository(ctrl)
+			attachRepo.EXPECT().Orphaned(gomock.Any(), orphanedBlobsBatchSize).Return(nil, nil).AnyTimes()
+			str := mocks.NewMockStore(ctrl)
+			str.EXPECT().Task().Return(taskRepo).AnyTimes()
+			str.EXPECT().Attachment().Return(attachRepo).AnyTimes()
+
+			s := testService(t, str)
+			err := s.DeleteTask(context.Background(), user, task.ID)
+			if tc.want != nil {
+				assert.ErrorIs(t, err, tc.want)
+				return
+			}
+			assert.NoError(t, err)
+		})
 	}
+}
+
+func TestService_CreateTask_Deadline(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	deadline := time.Now().Add(time.Hour)
 
-	str := mocks.NewMockStore(ctrl)
 	taskRepo := mocks.NewMockTaskRepository(ctrl)
-	groupRepo := mocks.NewMockGroupRepository(ctrl)
+	taskRepo.EXPECT().CreateWithRelations(gomock.Any(), gomock.Any(), nil, nil, gomock.Any()).DoAndReturn(
+		func(_ context.Context, task *model.Task, _ *uuid.UUID, _ []uuid.UUID, _ *model.TaskTemplate) ([]uuid.UUID, error) {
+			assert.Equal(t, &deadline, task.Deadline)
+			return []uuid.UUID{}, nil
+		},
+	)
+	str := mocks.NewMockStore(ctrl)
+	str.EXPECT().Task().Return(taskRepo)
 
-	groupRepo.EXPECT().GetRoleOfMember(gomock.Any(), uuid.Nil, uuid.Nil).Return(role, nil)
-	taskRepo.EXPECT().AddToGroup(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	s := testService(t, str)
+	resp, err := s.CreateTask(context.Background(), uuid.Nil, model.TaskCreateRequest{Deadline: &deadline})
+	require.NoError(t, err)
+	assert.Equal(t, &deadline, resp.Task.Deadline)
+}
 
-	str.EXPECT().Group().Return(groupRepo)
+func TestService_GetUserTasks_Filter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	before := time.Now()
+	filter := model.TaskFilter{Overdue: true, DueBefore: &before}
+
+	taskRepo := mocks.NewMockTaskRepository(ctrl)
+	taskRepo.EXPECT().AllByUser(gomock.Any(), uuid.Nil, model.TaskFilter{
+		Overdue:   true,
+		DueBefore: &before,
+		Sort:      model.SortCreatedAt,
+		Limit:     defaultTasksLimit + 1,
+	}).Return([]*model.Task{TestTask1}, nil)
+	str := mocks.NewMockStore(ctrl)
 	str.EXPECT().Task().Return(taskRepo)
 
 	s := testService(t, str)
-	s.addTaskToGroup(context.Background(), uuid.Nil, uuid.Nil, uuid.Nil)
+	resp, err := s.GetUserTasks(context.Background(), uuid.Nil, filter)
+	require.NoError(t, err)
+	assert.Equal(t, 1, resp.Count)
+}
+
+func TestService_GetUserTasks_Pagination(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	tasks := []*model.Task{{ID: uuid.New()}, {ID: uuid.New()}, {ID: uuid.New()}}
+
+	taskRepo := mocks.NewMockTaskRepository(ctrl)
+	taskRepo.EXPECT().AllByUser(gomock.Any(), uuid.Nil, model.TaskFilter{
+		Sort:  model.SortName,
+		Desc:  true,
+		Limit: 3,
+	}).Return(tasks, nil)
+	taskRepo.EXPECT().AllByUser(gomock.Any(), uuid.Nil, model.TaskFilter{
+		Sort:   model.SortCreatedAt,
+		Cursor: &tasks[1].ID,
+		Limit:  maxTasksLimit + 1,
+	}).Return(tasks[2:], nil)
+	str := mocks.NewMockStore(ctrl)
+	str.EXPECT().Task().Return(taskRepo).AnyTimes()
+
+	s := testService(t, str)
+	resp, err := s.GetUserTasks(context.Background(), uuid.Nil, model.TaskFilter{Sort: model.SortName, Desc: true, Limit: 2})
+	require.NoError(t, err)
+	assert.Equal(t, &model.GetTasksResponse{Count: 2, Tasks: tasks[:2], NextCursor: &tasks[1].ID}, resp)
+
+	resp, err = s.GetUserTasks(context.Background(), uuid.Nil, model.TaskFilter{Cursor: resp.NextCursor, Limit: 1000})
+	require.NoError(t, err)
+	assert.Equal(t, &model.GetTasksResponse{Count: 1, Tasks: tasks[2:]}, resp)
 }
 
-func TestService_AddTaskToGroup_Negative_ErrWhileAdding(t *testing.T) {
-	role := &model.Role{
-		ID:       0,
-		Members:  model.PermCreate,
-		Tasks:    model.PermChangeAll,
-		Reviews:  model.PermChangeRelated,
-		Comments: model.PermChangeAll,
+func TestService_GetUserTasks_BadFilter(t *testing.T) {
+	tt := []struct {
+		name   string
+		filter model.TaskFilter
+		want   error
+	}{
+		{"bad sort", model.TaskFilter{Sort: "id"}, service.ErrBadTaskSort},
+		{"bad status", model.TaskFilter{Status: []model.TaskStatus{model.StatusNew, "DONE"}}, service.ErrBadTaskStatus},
+		{"bad limit", model.TaskFilter{Limit: -1}, service.ErrBadPageLimit},
 	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			s := testService(t, nil)
+			resp, err := s.GetUserTasks(context.Background(), uuid.Nil, tc.filter)
+			assert.Nil(t, resp)
+			assert.ErrorIs(t, err, tc.want)
+		})
+	}
+}
+
+func TestService_UpdateTaskStatus_OpenSubtasks(t *testing.T) {
 	ctrl := gomock.NewController(t)
-	groupRepo := mocks.NewMockGroupRepository(ctrl)
-	groupRepo.EXPECT().GetRoleOfMember(gomock.Any(), uuid.Nil, uuid.Nil).Return(role, nil)
+	user := uuid.New()
+	task := &model.Task{
+		ID:        uuid.New(),
+		CreatedBy: user,
+		Status:    model.StatusInProgress,
+		Progress:  model.NewTaskProgress(1, 2),
+	}
+
 	taskRepo := mocks.NewMockTaskRepository(ctrl)
-	taskRepo.EXPECT().AddToGroup(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New(""))
+	taskRepo.EXPECT().GetByUserAndID(gomock.Any(), user, task.ID).Return(task, nil)
+	taskRepo.EXPECT().GetRoleOfMember(gomock.Any(), user, task.ID).Return(nil, store.ErrNotFound)
 	str := mocks.NewMockStore(ctrl)
-	str.EXPECT().Group().Return(groupRepo)
-	str.EXPECT().Task().Return(taskRepo)
+	str.EXPECT().Task().Return(taskRepo).AnyTimes()
+
 	s := testService(t, str)
-	s.addTaskToGroup(context.Background(), uuid.Nil, uuid.Nil, uuid.Nil)
+	_, err := s.UpdateTaskStatus(context.Background(), user, task.ID, model.StatusClosed)
+	assert.ErrorIs(t, err, service.ErrOpenSubtasks)
 }
 
-func TestService_AddTaskToGroup_Negative_ErrWhileGettingRole(t *testing.T) {
+func TestService_CreateTask_Parent(t *testing.T) {
 	ctrl := gomock.NewController(t)
+	user, parent, hidden := uuid.New(), uuid.New(), uuid.New()
 
+	taskRepo := mocks.NewMockTaskRepository(ctrl)
+	taskRepo.EXPECT().GetByUserAndID(gomock.Any(), user, parent).Return(&model.Task{ID: parent}, nil)
+	taskRepo.EXPECT().GetByUserAndID(gomock.Any(), user, hidden).Return(nil, store.ErrNotFound)
+	taskRepo.EXPECT().CreateWithRelations(gomock.Any(), gomock.Any(), nil, nil, gomock.Any()).DoAndReturn(
+		func(_ context.Context, task *model.Task, _ *uuid.UUID, _ []uuid.UUID, _ *model.TaskTemplate) ([]uuid.UUID, error) {
+			assert.Equal(t, &parent, task.Parent)
+			return []uuid.UUID{}, nil
+		},
+	)
 	str := mocks.NewMockStore(ctrl)
-	groupRepo := mocks.NewMockGroupRepository(ctrl)
+	str.EXPECT().Task().Return(taskRepo).AnyTimes()
 
-	groupRepo.EXPECT().GetRoleOfMember(gomock.Any(), uuid.Nil, uuid.Nil).Return(nil, errors.New(""))
+	s := testService(t, str)
+	resp, err := s.CreateTask(context.Background(), user, model.TaskCreateRequest{Name: "subtask", Parent: &parent})
+	require.NoError(t, err)
+	assert.Equal(t, &parent, resp.Task.Parent)
 
-	str.EXPECT().Group().Return(groupRepo)
+	_, err = s.CreateTask(context.Background(), user, model.TaskCreateRequest{Name: "subtask", Parent: &hidden})
+	assert.ErrorIs(t, err, service.ErrBadParentTask)
+}
+
+func TestService_GetSubtasks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	user := uuid.New()
+	parent := &model.Task{ID: uuid.New(), Progress: model.NewTaskProgress(0, 1)}
+	subtasks := []*model.Task{{ID: uuid.New(), Parent: &parent.ID}}
+
+	taskRepo := mocks.NewMockTaskRepository(ctrl)
+	taskRepo.EXPECT().GetByUserAndID(gomock.Any(), user, parent.ID).Return(parent, nil)
+	taskRepo.EXPECT().GetByUserAndID(gomock.Any(), user, gomock.Any()).Return(nil, store.ErrNotFound)
+	taskRepo.EXPECT().AllByUser(gomock.Any(), user, model.TaskFilter{Parent: &parent.ID, Sort: model.SortCreatedAt}).Return(subtasks, nil)
+	str := mocks.NewMockStore(ctrl)
+	str.EXPECT().Task().Return(taskRepo).AnyTimes()
 
 	s := testService(t, str)
-	s.addTaskToGroup(context.Background(), uuid.Nil, uuid.Nil, uuid.Nil)
+	resp, err := s.GetSubtasks(context.Background(), user, parent.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 1, resp.Count)
+	assert.Equal(t, subtasks, resp.Tasks)
+
+	_, err = s.GetSubtasks(context.Background(), user, uuid.New())
+	assert.ErrorIs(t, err, service.ErrNotFound)
 }
@@ -0,0 +1,337 @@
+package production
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/vlad-marlo/godo/internal/model"
+	"github.com/vlad-marlo/godo/internal/pkg/recurrence"
+	"github.com/vlad-marlo/godo/internal/service"
+	"github.com/vlad-marlo/godo/internal/store"
+	"go.uber.org/zap"
+)
+
+// recurringBatchSize is maximum count of templates which are materialized by one run of scheduler.
+const recurringBatchSize = 100
+
+// checkTemplateAssignees checks that all assignees are members of group.
+func (s *Service) checkTemplateAssignees(ctx context.Context, group uuid.UUID, assignees []uuid.UUID) error {
+	if len(assignees) == 0 {
+		return nil
+	}
+
+	members, err := s.store.Group().GetUserIDs(ctx, group)
+	if err != nil && !errors.Is(err, store.ErrNotFound) {
+		return service.ErrInternal.With(zap.Error(err))
+	}
+	isMember := make(map[uuid.UUID]bool, len(members))
+	for _, m := range members {
+		isMember[m] = true
+	}
+
+	var bad []uuid.UUID
+	for _, a := range assignees {
+		if !isMember[a] {
+			bad = append(bad, a)
+		}
+	}
+	if len(bad) > 0 {
+		return service.ErrBadTemplateAssignees.WithData(map[string]any{
+			"assignees": "assignees of template must be members of group",
+			"rejected":  bad,
+		})
+	}
+	return nil
+}
+
+// checkTemplateLabels checks that all labels belong to group.
+func (s *Service) checkTemplateLabels(ctx context.Context, group uuid.UUID, labels []uuid.UUID) error {
+	if len(labels) == 0 {
+		return nil
+	}
+
+	groupLabels, err := s.store.Label().AllByGroup(ctx, group)
+	if err != nil && !errors.Is(err, store.ErrNotFound) {
+		return service.ErrInternal.With(zap.Error(err))
+	}
+	inGroup := make(map[uuid.UUID]bool, len(groupLabels))
+	for _, l := range groupLabels {
+		inGroup[l.ID] = true
+	}
+
+	for _, l := range labels {
+		if !inGroup[l] {
+			return service.ErrBadTemplateLabels
+		}
+	}
+	return nil
+}
+
+// prepareTemplate validates name, checklist and recurrence of template and sets its next run.
+//
+// Runs of recurrence are counted from now which is stored as start of recurrence.
+func prepareTemplate(tpl *model.TaskTemplate, now time.Time) error {
+	if strings.TrimSpace(tpl.Name) == "" {
+		return service.ErrBadTaskName
+	}
+	for _, item := range tpl.Checklist {
+		if strings.TrimSpace(item) == "" {
+			return service.ErrBadChecklistItem
+		}
+	}
+	if tpl.Assignees == nil {
+		tpl.Assignees = []uuid.UUID{}
+	}
+	if tpl.Labels == nil {
+		tpl.Labels = []uuid.UUID{}
+	}
+	if tpl.Checklist == nil {
+		tpl.Checklist = []string{}
+	}
+
+	tpl.Recurrence = strings.TrimSpace(tpl.Recurrence)
+	tpl.RecurrenceStart, tpl.NextRunAt = nil, nil
+	if tpl.Recurrence != "" {
+		rule, err := recurrence.Parse(tpl.Recurrence, now)
+		if err != nil {
+			return service.ErrBadRecurrence.With(zap.Error(err))
+		}
+		next := rule.Next(now)
+		tpl.RecurrenceStart, tpl.NextRunAt = &now, &next
+	}
+	return nil
+}
+
+// checkTemplateRelations checks that assignees and labels of template belong to its group.
+func (s *Service) checkTemplateRelations(ctx context.Context, tpl *model.TaskTemplate) error {
+	if err := s.checkTemplateAssignees(ctx, tpl.Group, tpl.Assignees); err != nil {
+		return err
+	}
+	return s.checkTemplateLabels(ctx, tpl.Group, tpl.Labels)
+}
+
+// getTemplate return template of group.
+func (s *Service) getTemplate(ctx context.Context, group, template uuid.UUID) (*model.TaskTemplate, error) {
+	tpl, err := s.store.Template().Get(ctx, template)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return nil, service.ErrNotFound
+		}
+		return nil, service.ErrInternal.With(zap.Error(err))
+	}
+	if tpl.Group != group {
+		return nil, service.ErrNotFound
+	}
+	return tpl, nil
+}
+
+// checkChangeTemplate checks that user could change or delete template.
+//
+// Template could be changed by its creator while creator could create tasks in group or by user who could manage
+// tasks of group.
+func (s *Service) checkChangeTemplate(ctx context.Context, user uuid.UUID, tpl *model.TaskTemplate) error {
+	perm := model.PermManageTasks
+	if tpl.CreatedBy == user {
+		perm = model.PermCreateTasks
+	}
+	return s.authorize(ctx, user, tpl.Group, perm)
+}
+
+// GetGroupTemplates return task templates of group. Templates are available to every member of group.
+func (s *Service) GetGroupTemplates(ctx context.Context, user, group uuid.UUID) (*model.GetTemplatesResponse, error) {
+	if err := s.authorize(ctx, user, group, 0); err != nil {
+		return nil, err
+	}
+
+	templates, err := s.store.Template().AllByGroup(ctx, group)
+	if err != nil {
+		return nil, service.ErrInternal.With(zap.Error(err))
+	}
+
+	return &model.GetTemplatesResponse{
+		Count:     len(templates),
+		Templates: templates,
+	}, nil
+}
+
+// CreateTemplate creates task template in group. User must be able to create tasks in group.
+//
+// If recurrence rule is provided then tasks will be created from template by scheduler.
+func (s *Service) CreateTemplate(ctx context.Context, user, group uuid.UUID, req model.CreateTemplateRequest) (*model.TaskTemplate, error) {
+	now := time.Now()
+	tpl := &model.TaskTemplate{
+		ID:          uuid.New(),
+		Group:       group,
+		CreatedBy:   user,
+		Name:        req.Name,
+		Description: req.Description,
+		Assignees:   req.Assignees,
+		Labels:      req.Labels,
+		Checklist:   req.Checklist,
+		Recurrence:  req.Recurrence,
+		CreatedAt:   now,
+	}
+	if err := prepareTemplate(tpl, now); err != nil {
+		return nil, err
+	}
+
+	if err := s.authorize(ctx, user, group, model.PermCreateTasks); err != nil {
+		return nil, err
+	}
+	if err := s.checkTemplateRelations(ctx, tpl); err != nil {
+		return nil, err
+	}
+
+	if err := s.store.Template().Create(ctx, tpl); err != nil {
+		switch {
+		case errors.Is(err, store.ErrFKViolation):
+			return nil, service.ErrBadData.With(zap.Error(err))
+		default:
+			return nil, service.ErrInternal.With(zap.Error(err))
+		}
+	}
+	return tpl, nil
+}
+
+// GetTemplate return task template of group. Templates are available to every member of group.
+func (s *Service) GetTemplate(ctx context.Context, user, group, template uuid.UUID) (*model.TaskTemplate, error) {
+	if err := s.authorize(ctx, user, group, 0); err != nil {
+		return nil, err
+	}
+	return s.getTemplate(ctx, group, template)
+}
+
+// UpdateTemplate changes task template of group. Tasks which were already created from template are not changed.
+//
+// Template could be changed by its creator or user who could change all tasks of group.
+// If recurrence rule is changed then next run of template is planned from now.
+func (s *Service) UpdateTemplate(ctx context.Context, user, group, template uuid.UUID, req model.UpdateTemplateRequest) (*model.TaskTemplate, error) {
+	tpl, err := s.getTemplate(ctx, group, template)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.checkChangeTemplate(ctx, user, tpl); err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		tpl.Name = *req.Name
+	}
+	if req.Description != nil {
+		tpl.Description = *req.Description
+	}
+	if req.Assignees != nil {
+		tpl.Assignees = req.Assignees
+	}
+	if req.Labels != nil {
+		tpl.Labels = req.Labels
+	}
+	if req.Checklist != nil {
+		tpl.Checklist = req.Checklist
+	}
+	start, next := tpl.RecurrenceStart, tpl.NextRunAt
+	if req.Recurrence != nil {
+		tpl.Recurrence = *req.Recurrence
+	}
+	if err = prepareTemplate(tpl, time.Now()); err != nil {
+		return nil, err
+	}
+	// planned run is kept while rule of template is not changed.
+	if req.Recurrence == nil && tpl.Recurrence != "" {
+		tpl.RecurrenceStart, tpl.NextRunAt = start, next
+	}
+	if err = s.checkTemplateRelations(ctx, tpl); err != nil {
+		return nil, err
+	}
+
+	if err = s.store.Template().Update(ctx, tpl); err != nil {
+		switch {
+		case errors.Is(err, store.ErrNotFound):
+			return nil, service.ErrNotFound
+		default:
+			return nil, service.ErrInternal.With(zap.Error(err))
+		}
+	}
+	return tpl, nil
+}
+
+// DeleteTemplate deletes task template of group. Tasks which were created from template are kept.
+//
+// Template could be deleted by its creator or user who could change all tasks of group.
+func (s *Service) DeleteTemplate(ctx context.Context, user, group, template uuid.UUID) error {
+	tpl, err := s.getTemplate(ctx, group, template)
+	if err != nil {
+		return err
+	}
+	if err = s.checkChangeTemplate(ctx, user, tpl); err != nil {
+		return err
+	}
+
+	if err = s.store.Template().Delete(ctx, tpl.ID); err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return service.ErrNotFound
+		}
+		return service.ErrInternal.With(zap.Error(err))
+	}
+	return nil
+}
+
+// RunRecurringTasks creates tasks from recurring templates which runs are due at now.
+//
+// Every due template creates one task even if several runs were missed, after that template is moved
+// to first run after now. Runs which were already materialized by another call are skipped,
+// so it is safe to call it concurrently and to repeat it after restart.
+// Templates which authors could not create tasks in their groups anymore are stopped.
+// Count of created tasks is returned.
+func (s *Service) RunRecurringTasks(ctx context.Context, now time.Time) (int, error) {
+	templates, err := s.store.Template().Due(ctx, now, recurringBatchSize)
+	if err != nil {
+		return 0, service.ErrInternal.With(zap.Error(err))
+	}
+
+	var created int
+	for _, tpl := range templates {
+		if tpl.NextRunAt == nil {
+			continue
+		}
+
+		var next *time.Time
+		start := tpl.CreatedAt
+		if tpl.RecurrenceStart != nil {
+			start = *tpl.RecurrenceStart
+		}
+		rule, err := recurrence.Parse(tpl.Recurrence, start)
+		if err != nil {
+			// template with broken rule is stopped instead of being retried forever.
+			s.log.Warn("stop recurring template with bad rule", zap.Stringer("template", tpl.ID), zap.Error(err))
+		} else if n := rule.Next(now); !n.IsZero() {
+			next = &n
+		}
+
+		task := &model.Task{
+			ID:          uuid.New(),
+			Name:        tpl.TaskName(now),
+			Description: tpl.Description,
+			CreatedAt:   now,
+			CreatedBy:   tpl.CreatedBy,
+			Status:      model.StatusNew,
+		}
+		if err = s.store.Template().Materialize(ctx, tpl, task, next); err != nil {
+			if errors.Is(err, store.ErrNotFound) || errors.Is(err, store.ErrUniqueViolation) {
+				s.log.Debug("recurring task run was already materialized", zap.Stringer("template", tpl.ID))
+				continue
+			}
+			if errors.Is(err, store.ErrNotAuthorized) {
+				s.log.Warn("stop recurring template which author could not create tasks", zap.Stringer("template", tpl.ID))
+				continue
+			}
+			s.log.Error("materialize recurring task", zap.Stringer("template", tpl.ID), zap.Error(err))
+			continue
+		}
+		created++
+	}
+	return created, nil
+}
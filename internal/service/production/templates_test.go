@@ -0,0 +1,373 @@
+package production
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vlad-marlo/godo/internal/model"
+	"github.com/vlad-marlo/godo/internal/service"
+	"github.com/vlad-marlo/godo/internal/store"
+	"github.com/vlad-marlo/godo/internal/store/mocks"
+)
+
+func TestService_CreateTemplate_BadRequest(t *testing.T) {
+	s := testService(t, nil)
+
+	_, err := s.CreateTemplate(context.Background(), TestUser1.ID, uuid.New(), model.CreateTemplateRequest{Name: " "})
+	assert.ErrorIs(t, err, service.ErrBadTaskName)
+
+	_, err = s.CreateTemplate(context.Background(), TestUser1.ID, uuid.New(), model.CreateTemplateRequest{Name: "chores", Recurrence: "every monday"})
+	assert.ErrorIs(t, err, service.ErrBadRecurrence)
+
+	_, err = s.CreateTemplate(context.Background(), TestUser1.ID, uuid.New(), model.CreateTemplateRequest{Name: "chores", Checklist: []string{"wash", " "}})
+	assert.ErrorIs(t, err, service.ErrBadChecklistItem)
+}
+
+func TestService_CreateTemplate_Labels(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	group := uuid.New()
+	label := &model.Label{ID: uuid.New(), Group: group, Name: "release"}
+
+	groupRepo := mocks.NewMockGroupRepository(ctrl)
+	groupRepo.EXPECT().GetRoleOfMember(gomock.Any(), TestUser1.ID, group).Return(&model.Role{Permissions: model.PermCreateTasks}, nil).Times(2)
+	labelRepo := mocks.NewMockLabelRepository(ctrl)
+	labelRepo.EXPECT().AllByGroup(gomock.Any(), group).Return([]*model.Label{label}, nil).Times(2)
+	tplRepo := mocks.NewMockTemplateRepository(ctrl)
+	tplRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+	str := mocks.NewMockStore(ctrl)
+	str.EXPECT().Group().Return(groupRepo).AnyTimes()
+	str.EXPECT().Label().Return(labelRepo).AnyTimes()
+	str.EXPECT().Template().Return(tplRepo).AnyTimes()
+
+	s := testService(t, str)
+	tpl, err := s.CreateTemplate(context.Background(), TestUser1.ID, group, model.CreateTemplateRequest{
+		Name:      "release {date}",
+		Labels:    []uuid.UUID{label.ID},
+		Checklist: []string{"write changelog"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{label.ID}, tpl.Labels)
+	assert.Equal(t, []string{"write changelog"}, tpl.Checklist)
+	assert.Equal(t, []uuid.UUID{}, tpl.Assignees)
+
+	_, err = s.CreateTemplate(context.Background(), TestUser1.ID, group, model.CreateTemplateRequest{
+		Name:   "release",
+		Labels: []uuid.UUID{uuid.New()},
+	})
+	assert.ErrorIs(t, err, service.ErrBadTemplateLabels)
+}
+
+func TestService_GetTemplate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	group := uuid.New()
+	tpl := &model.TaskTemplate{ID: uuid.New(), Group: group}
+	other := &model.TaskTemplate{ID: uuid.New(), Group: uuid.New()}
+
+	groupRepo := mocks.NewMockGroupRepository(ctrl)
+	groupRepo.EXPECT().GetRoleOfMember(gomock.Any(), TestUser1.ID, group).Return(&model.Role{}, nil).Times(2)
+	groupRepo.EXPECT().GetRoleOfMember(gomock.Any(), uuid.Nil, group).Return(nil, store.ErrNotFound)
+	tplRepo := mocks.NewMockTemplateRepository(ctrl)
+	tplRepo.EXPECT().Get(gomock.Any(), tpl.ID).Return(tpl, nil)
+	tplRepo.EXPECT().Get(gomock.Any(), other.ID).Return(other, nil)
+	str := mocks.NewMockStore(ctrl)
+	str.EXPECT().Group().Return(groupRepo).AnyTimes()
+	str.EXPECT().Template().Return(tplRepo).AnyTimes()
+
+	s := testService(t, str)
+	got, err := s.GetTemplate(context.Background(), TestUser1.ID, group, tpl.ID)
+	require.NoError(t, err)
+	assert.Equal(t, tpl, got)
+
+	_, err = s.GetTemplate(context.Background(), TestUser1.ID, group, other.ID)
+	assert.ErrorIs(t, err, service.ErrNotFound)
+
+	_, err = s.GetTemplate(context.Background(), uuid.Nil, group, tpl.ID)
+	assert.ErrorIs(t, err, service.ErrForbidden)
+}
+
+func TestService_UpdateTemplate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	group := uuid.New()
+	planned := time.Now().Add(time.Hour)
+	tpl := &model.TaskTemplate{
+		ID:          uuid.New(),
+		Group:       group,
+		CreatedBy:   TestUser1.ID,
+		Name:        "release notes",
+		Description: "notes",
+		Assignees:   []uuid.UUID{},
+		Labels:      []uuid.UUID{},
+		Checklist:   []string{"write changelog"},
+		Recurrence:  "@daily",
+		NextRunAt:   &planned,
+	}
+	start := planned.Add(-24 * time.Hour)
+	tpl.RecurrenceStart = &start
+	foreign := &model.TaskTemplate{ID: uuid.New(), Group: group, CreatedBy: uuid.New(), Name: "foreign"}
+
+	groupRepo := mocks.NewMockGroupRepository(ctrl)
+	groupRepo.EXPECT().GetRoleOfMember(gomock.Any(), TestUser1.ID, group).Return(&model.Role{Permissions: model.PermCreateTasks}, nil).AnyTimes()
+	tplRepo := mocks.NewMockTemplateRepository(ctrl)
+	tplRepo.EXPECT().Get(gomock.Any(), tpl.ID).DoAndReturn(func(context.Context, uuid.UUID) (*model.TaskTemplate, error) {
+		cp := *tpl
+		return &cp, nil
+	}).Times(3)
+	tplRepo.EXPECT().Get(gomock.Any(), foreign.ID).Return(foreign, nil)
+	tplRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+	str := mocks.NewMockStore(ctrl)
+	str.EXPECT().Group().Return(groupRepo).AnyTimes()
+	str.EXPECT().Template().Return(tplRepo).AnyTimes()
+
+	s := testService(t, str)
+	name := "release notes {week}"
+	got, err := s.UpdateTemplate(context.Background(), TestUser1.ID, group, tpl.ID, model.UpdateTemplateRequest{
+		Name:      &name,
+		Checklist: []string{},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, name, got.Name)
+	assert.Equal(t, "notes", got.Description)
+	assert.Empty(t, got.Checklist)
+	// planned run is kept while rule is not changed.
+	assert.Equal(t, &planned, got.NextRunAt)
+	assert.Equal(t, &start, got.RecurrenceStart)
+
+	stop := ""
+	got, err = s.UpdateTemplate(context.Background(), TestUser1.ID, group, tpl.ID, model.UpdateTemplateRequest{Recurrence: &stop})
+	require.NoError(t, err)
+	assert.Nil(t, got.NextRunAt)
+	assert.Nil(t, got.RecurrenceStart)
+
+	empty := " "
+	_, err = s.UpdateTemplate(context.Background(), TestUser1.ID, group, tpl.ID, model.UpdateTemplateRequest{Name: &empty})
+	assert.ErrorIs(t, err, service.ErrBadTaskName)
+
+	_, err = s.UpdateTemplate(context.Background(), TestUser1.ID, group, foreign.ID, model.UpdateTemplateRequest{Name: &name})
+	assert.ErrorIs(t, err, service.ErrForbidden)
+}
+
+func TestService_CreateTask_FromTemplate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	group, member := uuid.New(), uuid.New()
+	tpl := &model.TaskTemplate{
+		ID:          uuid.New(),
+		Group:       group,
+		Name:        "report {date}",
+		Description: "## Summary",
+		Assignees:   []uuid.UUID{member},
+		Labels:      []uuid.UUID{uuid.New()},
+		Checklist:   []string{"collect numbers"},
+	}
+	broken := &model.TaskTemplate{ID: uuid.New(), Group: group, Name: "broken"}
+	foreign := &model.TaskTemplate{ID: uuid.New(), Group: uuid.New(), Name: "foreign"}
+	missing := uuid.New()
+
+	taskRepo := mocks.NewMockTaskRepository(ctrl)
+	taskRepo.EXPECT().CreateWithRelations(gomock.Any(), gomock.Any(), &group, tpl.Assignees, tpl).DoAndReturn(
+		func(_ context.Context, task *model.Task, _ *uuid.UUID, _ []uuid.UUID, _ *model.TaskTemplate) ([]uuid.UUID, error) {
+			assert.Equal(t, "report "+time.Now().UTC().Format("2006-01-02"), task.Name)
+			assert.Equal(t, tpl.Description, task.Description)
+			return []uuid.UUID{}, nil
+		},
+	)
+	taskRepo.EXPECT().CreateWithRelations(gomock.Any(), gomock.Any(), &group, nil, broken).Return(nil, errors.New(""))
+	groupRepo := mocks.NewMockGroupRepository(ctrl)
+	groupRepo.EXPECT().GetUserIDs(gomock.Any(), group).Return(nil, nil).AnyTimes()
+	groupRepo.EXPECT().GetRoleOfMember(gomock.Any(), TestUser1.ID, group).Return(&model.Role{Permissions: model.PermCreateTasks}, nil).AnyTimes()
+	tplRepo := mocks.NewMockTemplateRepository(ctrl)
+	tplRepo.EXPECT().Get(gomock.Any(), tpl.ID).Return(tpl, nil).Times(2)
+	tplRepo.EXPECT().Get(gomock.Any(), broken.ID).Return(broken, nil)
+	tplRepo.EXPECT().Get(gomock.Any(), missing).Return(nil, store.ErrNotFound)
+	tplRepo.EXPECT().Get(gomock.Any(), foreign.ID).Return(foreign, nil).Times(2)
+	groupRepo.EXPECT().GetRoleOfMember(gomock.Any(), TestUser1.ID, foreign.Group).Return(nil, store.ErrNotFound).Times(2)
+	str := mocks.NewMockStore(ctrl)
+	str.EXPECT().Task().Return(taskRepo).AnyTimes()
+	str.EXPECT().Group().Return(groupRepo).AnyTimes()
+	str.EXPECT().Template().Return(tplRepo).AnyTimes()
+
+	s := testService(t, str)
+	resp, err := s.CreateTask(context.Background(), TestUser1.ID, model.TaskCreateRequest{Template: &tpl.ID})
+	require.NoError(t, err)
+	assert.Equal(t, tpl.Description, resp.Task.Description)
+
+	other := uuid.New()
+	_, err = s.CreateTask(context.Background(), TestUser1.ID, model.TaskCreateRequest{Template: &tpl.ID, Group: &other})
+	assert.ErrorIs(t, err, service.ErrBadTemplate)
+
+	_, err = s.CreateTask(context.Background(), TestUser1.ID, model.TaskCreateRequest{Template: &missing})
+	assert.ErrorIs(t, err, service.ErrBadTemplate)
+
+	// template of group in which user is not member is reported like missing one, even with group of template.
+	_, err = s.CreateTask(context.Background(), TestUser1.ID, model.TaskCreateRequest{Template: &foreign.ID})
+	assert.ErrorIs(t, err, service.ErrBadTemplate)
+	_, err = s.CreateTask(context.Background(), TestUser1.ID, model.TaskCreateRequest{Template: &foreign.ID, Group: &other})
+	assert.ErrorIs(t, err, service.ErrBadTemplate)
+
+	// task is not stored if template could not be applied to it.
+	_, err = s.CreateTask(context.Background(), TestUser1.ID, model.TaskCreateRequest{Name: "task", Template: &broken.ID})
+	assert.ErrorIs(t, err, service.ErrInternal)
+}
+
+func TestService_CreateTemplate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	group, member, stranger := uuid.New(), uuid.New(), uuid.New()
+
+	groupRepo := mocks.NewMockGroupRepository(ctrl)
+	groupRepo.EXPECT().GetRoleOfMember(gomock.Any(), TestUser1.ID, group).Return(&model.Role{Permissions: model.PermCreateTasks}, nil).Times(2)
+	groupRepo.EXPECT().GetRoleOfMember(gomock.Any(), uuid.Nil, group).Return(&model.Role{Permissions: model.PermEditTasks}, nil)
+	groupRepo.EXPECT().GetUserIDs(gomock.Any(), group).Return([]uuid.UUID{TestUser1.ID, member}, nil).Times(2)
+	tplRepo := mocks.NewMockTemplateRepository(ctrl)
+	tplRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+	str := mocks.NewMockStore(ctrl)
+	str.EXPECT().Group().Return(groupRepo).AnyTimes()
+	str.EXPECT().Template().Return(tplRepo).AnyTimes()
+
+	s := testService(t, str)
+	before := time.Now()
+	tpl, err := s.CreateTemplate(context.Background(), TestUser1.ID, group, model.CreateTemplateRequest{
+		Name:       "release notes",
+		Assignees:  []uuid.UUID{member},
+		Recurrence: " @daily ",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "@daily", tpl.Recurrence)
+	require.NotNil(t, tpl.NextRunAt)
+	assert.True(t, tpl.NextRunAt.After(before))
+	assert.Equal(t, 0, tpl.NextRunAt.Hour())
+	require.NotNil(t, tpl.RecurrenceStart)
+	assert.False(t, tpl.RecurrenceStart.Before(before))
+
+	_, err = s.CreateTemplate(context.Background(), TestUser1.ID, group, model.CreateTemplateRequest{
+		Name:      "release notes",
+		Assignees: []uuid.UUID{member, stranger},
+	})
+	assert.ErrorIs(t, err, service.ErrBadTemplateAssignees)
+
+	_, err = s.CreateTemplate(context.Background(), uuid.Nil, group, model.CreateTemplateRequest{Name: "release notes"})
+	assert.ErrorIs(t, err, service.ErrForbidden)
+}
+
+func TestService_GetGroupTemplates(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	group := uuid.New()
+	templates := []*model.TaskTemplate{{ID: uuid.New(), Group: group}}
+
+	groupRepo := mocks.NewMockGroupRepository(ctrl)
+	groupRepo.EXPECT().GetRoleOfMember(gomock.Any(), TestUser1.ID, group).Return(&model.Role{}, nil)
+	tplRepo := mocks.NewMockTemplateRepository(ctrl)
+	tplRepo.EXPECT().AllByGroup(gomock.Any(), group).Return(templates, nil)
+	str := mocks.NewMockStore(ctrl)
+	str.EXPECT().Group().Return(groupRepo).AnyTimes()
+	str.EXPECT().Template().Return(tplRepo).AnyTimes()
+
+	s := testService(t, str)
+	resp, err := s.GetGroupTemplates(context.Background(), TestUser1.ID, group)
+	require.NoError(t, err)
+	assert.Equal(t, 1, resp.Count)
+	assert.Equal(t, templates, resp.Templates)
+}
+
+func TestService_DeleteTemplate(t *testing.T) {
+	group := uuid.New()
+	tt := []struct {
+		name      string
+		createdBy uuid.UUID
+		group     uuid.UUID
+		role      *model.Role
+		want      error
+	}{
+		{"creator", TestUser1.ID, group, &model.Role{Permissions: model.PermCreateTasks}, nil},
+		{"manager", uuid.New(), group, &model.Role{Permissions: model.PermManageTasks}, nil},
+		{"not enough permissions", uuid.New(), group, &model.Role{Permissions: model.PermCreateTasks}, service.ErrForbidden},
+		{"other group", TestUser1.ID, uuid.New(), &model.Role{Permissions: model.PermAll}, service.ErrNotFound},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			tpl := &model.TaskTemplate{ID: uuid.New(), Group: tc.group, CreatedBy: tc.createdBy}
+
+			groupRepo := mocks.NewMockGroupRepository(ctrl)
+			groupRepo.EXPECT().GetRoleOfMember(gomock.Any(), TestUser1.ID, group).Return(tc.role, nil).MaxTimes(1)
+			tplRepo := mocks.NewMockTemplateRepository(ctrl)
+			tplRepo.EXPECT().Get(gomock.Any(), tpl.ID).Return(tpl, nil)
+			tplRepo.EXPECT().Delete(gomock.Any(), tpl.ID).Return(nil).MaxTimes(1)
+			str := mocks.NewMockStore(ctrl)
+			str.EXPECT().Group().Return(groupRepo).AnyTimes()
+			str.EXPECT().Template().Return(tplRepo).AnyTimes()
+
+			s := testService(t, str)
+			err := s.DeleteTemplate(context.Background(), TestUser1.ID, group, tpl.ID)
+			if tc.want != nil {
+				assert.ErrorIs(t, err, tc.want)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestService_RunRecurringTasks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	now := time.Date(2023, time.May, 10, 12, 0, 0, 0, time.UTC)
+	created := time.Date(2023, time.May, 1, 9, 0, 0, 0, time.UTC)
+	missed := time.Date(2023, time.May, 8, 9, 0, 0, 0, time.UTC)
+	// rule was changed week after template was created, so runs are counted from the change.
+	weekly := &model.TaskTemplate{
+		ID:              uuid.New(),
+		Group:           uuid.New(),
+		CreatedBy:       TestUser1.ID,
+		Name:            "release notes {date}",
+		Recurrence:      "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO;BYHOUR=9;BYMINUTE=0",
+		RecurrenceStart: &created,
+		NextRunAt:       &missed,
+		CreatedAt:       created.AddDate(0, 0, -7),
+	}
+	done := &model.TaskTemplate{ID: uuid.New(), Recurrence: "@daily", NextRunAt: &missed, CreatedAt: created}
+	broken := &model.TaskTemplate{ID: uuid.New(), Recurrence: "bad", NextRunAt: &missed, CreatedAt: created}
+	failed := &model.TaskTemplate{ID: uuid.New(), Recurrence: "@daily", NextRunAt: &missed, CreatedAt: created}
+	orphan := &model.TaskTemplate{ID: uuid.New(), Recurrence: "@daily", NextRunAt: &missed, CreatedAt: created}
+
+	tplRepo := mocks.NewMockTemplateRepository(ctrl)
+	tplRepo.EXPECT().Due(gomock.Any(), now, recurringBatchSize).Return([]*model.TaskTemplate{weekly, done, broken, failed, orphan}, nil)
+	// missed runs are skipped, next run is first run of rule after now.
+	nextMonday := time.Date(2023, time.May, 15, 9, 0, 0, 0, time.UTC)
+	tplRepo.EXPECT().Materialize(gomock.Any(), weekly, gomock.Any(), &nextMonday).DoAndReturn(
+		func(_ context.Context, _ *model.TaskTemplate, task *model.Task, _ *time.Time) error {
+			assert.Equal(t, "release notes 2023-05-10", task.Name)
+			assert.Equal(t, weekly.CreatedBy, task.CreatedBy)
+			assert.Equal(t, model.StatusNew, task.Status)
+			return nil
+		},
+	)
+	tplRepo.EXPECT().Materialize(gomock.Any(), done, gomock.Any(), gomock.Any()).Return(store.ErrNotFound)
+	// broken rule stops template.
+	tplRepo.EXPECT().Materialize(gomock.Any(), broken, gomock.Any(), (*time.Time)(nil)).Return(nil)
+	tplRepo.EXPECT().Materialize(gomock.Any(), failed, gomock.Any(), gomock.Any()).Return(errors.New(""))
+	// author lost permission to create tasks.
+	tplRepo.EXPECT().Materialize(gomock.Any(), orphan, gomock.Any(), gomock.Any()).Return(store.ErrNotAuthorized)
+	str := mocks.NewMockStore(ctrl)
+	str.EXPECT().Template().Return(tplRepo).AnyTimes()
+
+	s := testService(t, str)
+	n, err := s.RunRecurringTasks(context.Background(), now)
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+}
+
+func TestService_RunRecurringTasks_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	tplRepo := mocks.NewMockTemplateRepository(ctrl)
+	tplRepo.EXPECT().Due(gomock.Any(), gomock.Any(), recurringBatchSize).Return(nil, errors.New(""))
+	str := mocks.NewMockStore(ctrl)
+	str.EXPECT().Template().Return(tplRepo)
+
+	s := testService(t, str)
+	_, err := s.RunRecurringTasks(context.Background(), time.Now())
+	assert.ErrorIs(t, err, service.ErrInternal)
+}
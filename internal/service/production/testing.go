@@ -29,25 +29,20 @@ var (
 		Description: uuid.NewString(),
 		CreatedAt:   time.Now(),
 		CreatedBy:   TestUser1.ID,
-		Status:      uuid.NewString(),
+		Status:      model.StatusNew,
 	}
 	ReadOnlyRole = &model.Role{
-		ID:       0,
-		Members:  model.PermReadRelated,
-		Tasks:    model.PermReadRelated,
-		Reviews:  model.PermReadRelated,
-		Comments: model.PermReadRelated,
+		ID:   1,
+		Name: "read only",
 	}
 	SudoRole = &model.Role{
-		ID:       0,
-		Members:  model.PermChangeAll,
-		Tasks:    model.PermChangeAll,
-		Reviews:  model.PermChangeAll,
-		Comments: model.PermChangeAll,
+		ID:          2,
+		Name:        "admin",
+		Permissions: model.PermAll,
 	}
 )
 
 func testService(t testing.TB, s store.Store) *Service {
 	t.Helper()
-	return New(s, config.New(), zap.L())
+	return New(s, nil, config.New(), zap.L())
 }
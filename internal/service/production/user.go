@@ -66,33 +66,31 @@ func (s *Service) RegisterUser(ctx context.Context, email, password string) (*mo
 	return u, nil
 }
 
-// CreateInvite ...
-func (s *Service) CreateInvite(ctx context.Context, user uuid.UUID, group uuid.UUID, role *model.Role, limit int) (*model.CreateInviteResponse, error) {
+// CreateInvite creates invite link to group which gives role of group to users who join by it.
+//
+// User must be able to create invites and could not give permissions which user does not have.
+func (s *Service) CreateInvite(ctx context.Context, user uuid.UUID, group uuid.UUID, role int32, limit int) (*model.CreateInviteResponse, error) {
 	if limit <= 0 {
 		return nil, service.ErrBadInviteLimit
 	}
-	if role == nil {
-		return nil, service.ErrBadData
+	userRole, err := s.authorizeRole(ctx, user, group, model.PermCreateInvites)
+	if err != nil {
+		return nil, err
 	}
-	userRole, err := s.store.Group().GetRoleOfMember(ctx, user, group)
+
+	r, err := s.store.Role().Get(ctx, group, role)
 	if err != nil {
 		if errors.Is(err, store.ErrNotFound) {
-			return nil, service.ErrForbidden
+			return nil, service.ErrBadMemberRole
 		}
 		return nil, service.ErrInternal.With(zap.Error(err))
 	}
-
-	if userRole.Members < 2 {
-		return nil, service.ErrForbidden
+	if err = authorizeGrant(userRole.Permissions, 0, r); err != nil {
+		return nil, err
 	}
 
 	invite := uuid.New()
-
-	if err = s.store.Role().Get(ctx, role); err != nil {
-		return nil, service.ErrInternal.With(zap.Error(err))
-	}
-
-	if err = s.store.Invite().Create(ctx, invite, role.ID, group, limit); err != nil {
+	if err = s.store.Invite().Create(ctx, invite, r.ID, group, limit, user); err != nil {
 		switch {
 		case errors.Is(err, store.ErrUniqueViolation):
 			return nil, service.ErrConflict
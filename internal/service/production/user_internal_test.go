@@ -26,10 +26,9 @@ var (
 		Email: "email@example.com",
 	}
 	TestRole1 = &model.Role{
-		Members:  model.PermChangeAll,
-		Tasks:    model.PermChangeAll,
-		Reviews:  model.PermChangeAll,
-		Comments: model.PermChangeAll,
+		ID:          3,
+		Name:        "test role",
+		Permissions: model.PermAll,
 	}
 )
 
@@ -154,16 +153,11 @@ func TestService_LoginUserJWT(t *testing.T) {
 
 func TestService_CreateInvite_Negative_BadData(t *testing.T) {
 	s := testService(t, nil)
-	resp, err := s.CreateInvite(context.Background(), uuid.Nil, uuid.Nil, nil, -1)
+	resp, err := s.CreateInvite(context.Background(), uuid.Nil, uuid.Nil, 0, -1)
 	assert.Nil(t, resp)
 	if assert.Error(t, err) {
 		assert.ErrorIs(t, err, service.ErrBadInviteLimit)
 	}
-	resp, err = s.CreateInvite(context.Background(), uuid.New(), uuid.New(), nil, 123)
-	assert.Nil(t, resp)
-	if assert.Error(t, err) {
-		assert.ErrorIs(t, err, service.ErrBadData)
-	}
 }
 
 func TestService_CreateInvite_Positive(t *testing.T) {
@@ -174,14 +168,14 @@ func TestService_CreateInvite_Positive(t *testing.T) {
 	inviteRepo := mocks.NewMockInviteRepository(ctrl)
 
 	groupRepo.EXPECT().GetRoleOfMember(gomock.Any(), TestUser1.ID, TestGroup1.ID).Return(TestRole1, nil)
-	roleRepo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil)
-	inviteRepo.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	roleRepo.EXPECT().Get(gomock.Any(), TestGroup1.ID, TestRole1.ID).Return(TestRole1, nil)
+	inviteRepo.EXPECT().Create(gomock.Any(), gomock.Any(), TestRole1.ID, TestGroup1.ID, 4, TestUser1.ID).Return(nil)
 	str.EXPECT().Group().Return(groupRepo)
 	str.EXPECT().Role().Return(roleRepo)
 	str.EXPECT().Invite().Return(inviteRepo)
 
 	s := testService(t, str)
-	resp, err := s.CreateInvite(context.Background(), TestUser1.ID, TestGroup1.ID, TestRole1, 4)
+	resp, err := s.CreateInvite(context.Background(), TestUser1.ID, TestGroup1.ID, TestRole1.ID, 4)
 	require.NoError(t, err)
 	if assert.NotNil(t, resp) {
 		assert.Equal(t, 4, resp.Limit)
@@ -200,7 +194,7 @@ func TestService_CreateInvite_ErrHasNoRights(t *testing.T) {
 	str.EXPECT().Group().Return(grp)
 
 	s := testService(t, str)
-	resp, err := s.CreateInvite(context.Background(), TestUser1.ID, TestGroup1.ID, ReadOnlyRole, 10)
+	resp, err := s.CreateInvite(context.Background(), TestUser1.ID, TestGroup1.ID, ReadOnlyRole.ID, 10)
 	assert.Nil(t, resp)
 	if assert.Error(t, err) {
 		assert.ErrorIs(t, err, service.ErrForbidden)
@@ -228,7 +222,7 @@ func TestService_CreateInvite_ErrWhileGettingRole(t *testing.T) {
 			str.EXPECT().Group().Return(grp)
 
 			s := testService(t, str)
-			resp, err := s.CreateInvite(context.Background(), TestUser1.ID, TestGroup1.ID, ReadOnlyRole, 10)
+			resp, err := s.CreateInvite(context.Background(), TestUser1.ID, TestGroup1.ID, ReadOnlyRole.ID, 10)
 			assert.Nil(t, resp)
 			if assert.Error(t, err) {
 				assert.ErrorIs(t, err, tc.want)
@@ -238,23 +232,39 @@ func TestService_CreateInvite_ErrWhileGettingRole(t *testing.T) {
 }
 
 func TestService_CreateInvite_ErrWhileGettingRoleForInvite(t *testing.T) {
-	ctrl := gomock.NewController(t)
+	inviter := &model.Role{Permissions: model.PermCreateInvites | model.PermViewMembers}
+	tt := []struct {
+		name   string
+		holder *model.Role
+		role   *model.Role
+		err    error
+		want   error
+	}{
+		{"unknown", SudoRole, nil, errors.New(""), service.ErrInternal},
+		{"role of other group", SudoRole, nil, store.ErrNotFound, service.ErrBadMemberRole},
+		{"escalation", inviter, TestRole1, nil, service.ErrRoleEscalation},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
 
-	str := mocks.NewMockStore(ctrl)
-	groupRepository := mocks.NewMockGroupRepository(ctrl)
-	roleRepository := mocks.NewMockRoleRepository(ctrl)
+			str := mocks.NewMockStore(ctrl)
+			groupRepository := mocks.NewMockGroupRepository(ctrl)
+			roleRepository := mocks.NewMockRoleRepository(ctrl)
 
-	roleRepository.EXPECT().Get(gomock.Any(), ReadOnlyRole).Return(errors.New(""))
-	groupRepository.EXPECT().GetRoleOfMember(gomock.Any(), TestUser1.ID, TestGroup1.ID).Return(SudoRole, nil)
+			roleRepository.EXPECT().Get(gomock.Any(), TestGroup1.ID, TestRole1.ID).Return(tc.role, tc.err)
+			groupRepository.EXPECT().GetRoleOfMember(gomock.Any(), TestUser1.ID, TestGroup1.ID).Return(tc.holder, nil)
 
-	str.EXPECT().Group().Return(groupRepository)
-	str.EXPECT().Role().Return(roleRepository)
+			str.EXPECT().Group().Return(groupRepository)
+			str.EXPECT().Role().Return(roleRepository)
 
-	s := testService(t, str)
-	resp, err := s.CreateInvite(context.Background(), TestUser1.ID, TestGroup1.ID, ReadOnlyRole, 10)
-	assert.Nil(t, resp)
-	if assert.Error(t, err) {
-		assert.ErrorIs(t, err, service.ErrInternal)
+			s := testService(t, str)
+			resp, err := s.CreateInvite(context.Background(), TestUser1.ID, TestGroup1.ID, TestRole1.ID, 10)
+			assert.Nil(t, resp)
+			if assert.Error(t, err) {
+				assert.ErrorIs(t, err, tc.want)
+			}
+		})
 	}
 }
 
@@ -277,16 +287,16 @@ func TestService_CreateInvite_ErrWhileStoringInvite(t *testing.T) {
 			roleRepository := mocks.NewMockRoleRepository(ctrl)
 			inviteRepository := mocks.NewMockInviteRepository(ctrl)
 
-			roleRepository.EXPECT().Get(gomock.Any(), ReadOnlyRole).Return(nil)
+			roleRepository.EXPECT().Get(gomock.Any(), TestGroup1.ID, ReadOnlyRole.ID).Return(ReadOnlyRole, nil)
 			groupRepository.EXPECT().GetRoleOfMember(gomock.Any(), TestUser1.ID, TestGroup1.ID).Return(SudoRole, nil)
-			inviteRepository.EXPECT().Create(gomock.Any(), gomock.Any(), ReadOnlyRole.ID, TestGroup1.ID, 10).Return(tc.err)
+			inviteRepository.EXPECT().Create(gomock.Any(), gomock.Any(), ReadOnlyRole.ID, TestGroup1.ID, 10, TestUser1.ID).Return(tc.err)
 
 			str.EXPECT().Group().Return(groupRepository)
 			str.EXPECT().Role().Return(roleRepository)
 			str.EXPECT().Invite().Return(inviteRepository)
 
 			s := testService(t, str)
-			resp, err := s.CreateInvite(context.Background(), TestUser1.ID, TestGroup1.ID, ReadOnlyRole, 10)
+			resp, err := s.CreateInvite(context.Background(), TestUser1.ID, TestGroup1.ID, ReadOnlyRole.ID, 10)
 			assert.Nil(t, resp)
 			if assert.Error(t, err) {
 				assert.ErrorIs(t, err, tc.want)
@@ -0,0 +1,107 @@
+package production
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/vlad-marlo/godo/internal/model"
+	"github.com/vlad-marlo/godo/internal/service"
+	"github.com/vlad-marlo/godo/internal/store"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultNotificationsLimit = 50
+	maxNotificationsLimit     = 200
+)
+
+// GetTaskWatchers return users who watch task. Permissions are equal to GetTask.
+func (s *Service) GetTaskWatchers(ctx context.Context, user, task uuid.UUID) (*model.GetWatchersResponse, error) {
+	t, err := s.GetTask(ctx, user, task)
+	if err != nil {
+		return nil, err
+	}
+
+	watchers, err := s.store.Watcher().AllByTask(ctx, t.ID)
+	if err != nil {
+		return nil, service.ErrInternal.With(zap.Error(err))
+	}
+	if watchers == nil {
+		watchers = []*model.Watcher{}
+	}
+
+	resp := &model.GetWatchersResponse{
+		Count:    len(watchers),
+		Watchers: watchers,
+	}
+	for _, w := range watchers {
+		if w.User == user {
+			resp.Watching = true
+			break
+		}
+	}
+	return resp, nil
+}
+
+// WatchTask subscribes user to changes of task. Every user who could see task could watch it.
+func (s *Service) WatchTask(ctx context.Context, user, task uuid.UUID) error {
+	t, err := s.GetTask(ctx, user, task)
+	if err != nil {
+		return err
+	}
+
+	if err = s.store.Watcher().Watch(ctx, t.ID, user); err != nil {
+		if errors.Is(err, store.ErrFKViolation) {
+			return service.ErrNotFound
+		}
+		return service.ErrInternal.With(zap.Error(err))
+	}
+	return nil
+}
+
+// UnwatchTask unsubscribes user from changes of task.
+func (s *Service) UnwatchTask(ctx context.Context, user, task uuid.UUID) error {
+	t, err := s.GetTask(ctx, user, task)
+	if err != nil {
+		return err
+	}
+
+	if err = s.store.Watcher().Unwatch(ctx, t.ID, user); err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return service.ErrNotFound
+		}
+		return service.ErrInternal.With(zap.Error(err))
+	}
+	return nil
+}
+
+// GetNotifications return changes of tasks watched by user which were made by other users after change with id after.
+//
+// Watchers of task are the only recipients of notifications about its changes.
+func (s *Service) GetNotifications(ctx context.Context, user uuid.UUID, after int64, limit int) (*model.GetNotificationsResponse, error) {
+	switch {
+	case limit < 0:
+		return nil, service.ErrBadPageLimit
+	case limit == 0:
+		limit = defaultNotificationsLimit
+	case limit > maxNotificationsLimit:
+		limit = maxNotificationsLimit
+	}
+	if after < 0 {
+		after = 0
+	}
+
+	changes, err := s.store.Watcher().Notifications(ctx, user, after, limit)
+	if err != nil {
+		return nil, service.ErrInternal.With(zap.Error(err))
+	}
+	if changes == nil {
+		changes = []*model.TaskChange{}
+	}
+
+	return &model.GetNotificationsResponse{
+		Count:   len(changes),
+		Changes: changes,
+	}, nil
+}
@@ -0,0 +1,96 @@
+package production
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vlad-marlo/godo/internal/model"
+	"github.com/vlad-marlo/godo/internal/service"
+	"github.com/vlad-marlo/godo/internal/store"
+	"github.com/vlad-marlo/godo/internal/store/mocks"
+)
+
+func TestService_GetTaskWatchers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	hidden, broken := uuid.New(), foreignTask()
+	watchers := []*model.Watcher{{User: uuid.New(), Email: "a@example.com"}, {User: TestUser1.ID, Email: TestUser1.Email}}
+
+	taskRepo := mocks.NewMockTaskRepository(ctrl)
+	taskRepo.EXPECT().GetByUserAndID(gomock.Any(), TestUser1.ID, TestTask1.ID).Return(TestTask1, nil)
+	taskRepo.EXPECT().GetByUserAndID(gomock.Any(), TestUser1.ID, broken.ID).Return(broken, nil)
+	taskRepo.EXPECT().GetByUserAndID(gomock.Any(), TestUser1.ID, hidden).Return(nil, store.ErrNotFound)
+	watcherRepo := mocks.NewMockWatcherRepository(ctrl)
+	watcherRepo.EXPECT().AllByTask(gomock.Any(), TestTask1.ID).Return(watchers, nil)
+	watcherRepo.EXPECT().AllByTask(gomock.Any(), broken.ID).Return(nil, errors.New(""))
+	str := mocks.NewMockStore(ctrl)
+	str.EXPECT().Task().Return(taskRepo).AnyTimes()
+	str.EXPECT().Watcher().Return(watcherRepo).AnyTimes()
+
+	s := testService(t, str)
+	resp, err := s.GetTaskWatchers(context.Background(), TestUser1.ID, TestTask1.ID)
+	require.NoError(t, err)
+	assert.Equal(t, &model.GetWatchersResponse{Count: 2, Watching: true, Watchers: watchers}, resp)
+
+	_, err = s.GetTaskWatchers(context.Background(), TestUser1.ID, hidden)
+	assert.ErrorIs(t, err, service.ErrNotFound)
+	_, err = s.GetTaskWatchers(context.Background(), TestUser1.ID, broken.ID)
+	assert.ErrorIs(t, err, service.ErrInternal)
+}
+
+func TestService_WatchTask(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	hidden := uuid.New()
+
+	taskRepo := mocks.NewMockTaskRepository(ctrl)
+	taskRepo.EXPECT().GetByUserAndID(gomock.Any(), TestUser1.ID, TestTask1.ID).Return(TestTask1, nil).Times(4)
+	taskRepo.EXPECT().GetByUserAndID(gomock.Any(), TestUser1.ID, hidden).Return(nil, store.ErrNotFound).Times(2)
+	watcherRepo := mocks.NewMockWatcherRepository(ctrl)
+	watcherRepo.EXPECT().Watch(gomock.Any(), TestTask1.ID, TestUser1.ID).Return(nil)
+	watcherRepo.EXPECT().Watch(gomock.Any(), TestTask1.ID, TestUser1.ID).Return(errors.New(""))
+	watcherRepo.EXPECT().Unwatch(gomock.Any(), TestTask1.ID, TestUser1.ID).Return(nil)
+	watcherRepo.EXPECT().Unwatch(gomock.Any(), TestTask1.ID, TestUser1.ID).Return(store.ErrNotFound)
+	str := mocks.NewMockStore(ctrl)
+	str.EXPECT().Task().Return(taskRepo).AnyTimes()
+	str.EXPECT().Watcher().Return(watcherRepo).AnyTimes()
+
+	s := testService(t, str)
+	assert.NoError(t, s.WatchTask(context.Background(), TestUser1.ID, TestTask1.ID))
+	assert.ErrorIs(t, s.WatchTask(context.Background(), TestUser1.ID, TestTask1.ID), service.ErrInternal)
+	assert.ErrorIs(t, s.WatchTask(context.Background(), TestUser1.ID, hidden), service.ErrNotFound)
+
+	assert.NoError(t, s.UnwatchTask(context.Background(), TestUser1.ID, TestTask1.ID))
+	assert.ErrorIs(t, s.UnwatchTask(context.Background(), TestUser1.ID, TestTask1.ID), service.ErrNotFound)
+	assert.ErrorIs(t, s.UnwatchTask(context.Background(), TestUser1.ID, hidden), service.ErrNotFound)
+}
+
+func TestService_GetNotifications(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	status := string(model.StatusChangesRequested)
+	changes := []*model.TaskChange{{ID: 7, Task: TestTask1.ID, Actor: uuid.New(), Field: model.TaskFieldStatus, NewValue: &status}}
+
+	watcherRepo := mocks.NewMockWatcherRepository(ctrl)
+	watcherRepo.EXPECT().Notifications(gomock.Any(), TestUser1.ID, int64(0), defaultNotificationsLimit).Return(changes, nil)
+	watcherRepo.EXPECT().Notifications(gomock.Any(), TestUser1.ID, int64(7), maxNotificationsLimit).Return(nil, nil)
+	watcherRepo.EXPECT().Notifications(gomock.Any(), TestUser1.ID, int64(0), 1).Return(nil, errors.New(""))
+	str := mocks.NewMockStore(ctrl)
+	str.EXPECT().Watcher().Return(watcherRepo).AnyTimes()
+
+	s := testService(t, str)
+	resp, err := s.GetNotifications(context.Background(), TestUser1.ID, -1, 0)
+	require.NoError(t, err)
+	assert.Equal(t, &model.GetNotificationsResponse{Count: 1, Changes: changes}, resp)
+
+	resp, err = s.GetNotifications(context.Background(), TestUser1.ID, 7, maxNotificationsLimit+1)
+	require.NoError(t, err)
+	assert.NotNil(t, resp.Changes)
+
+	_, err = s.GetNotifications(context.Background(), TestUser1.ID, 0, 1)
+	assert.ErrorIs(t, err, service.ErrInternal)
+	_, err = s.GetNotifications(context.Background(), TestUser1.ID, 0, -1)
+	assert.ErrorIs(t, err, service.ErrBadPageLimit)
+}
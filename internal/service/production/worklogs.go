@@ -0,0 +1,202 @@
+package production
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/vlad-marlo/godo/internal/model"
+	"github.com/vlad-marlo/godo/internal/service"
+	"github.com/vlad-marlo/godo/internal/store"
+	"go.uber.org/zap"
+)
+
+// checkLogTime checks that user could log time spent on task.
+//
+// Time could be logged by assignees of task and by users who could change progress of task.
+func (s *Service) checkLogTime(ctx context.Context, user uuid.UUID, task *model.Task) error {
+	if s.store.Task().IsAssignee(ctx, task.ID, user) {
+		return nil
+	}
+	return s.authorizeTask(ctx, user, task, model.PermEditRelatedTasks)
+}
+
+// createWorklog stores worklog of user.
+func (s *Service) createWorklog(ctx context.Context, w *model.Worklog) error {
+	if err := s.store.Worklog().Create(ctx, w); err != nil {
+		switch {
+		case errors.Is(err, store.ErrUniqueViolation):
+			return service.ErrTimerAlreadyRunning
+		case errors.Is(err, store.ErrFKViolation):
+			return service.ErrNotFound.With(zap.Error(err))
+		default:
+			return service.ErrInternal.With(zap.Error(err))
+		}
+	}
+	return nil
+}
+
+// StartTimer starts timer of user on task. User could have only one running timer.
+func (s *Service) StartTimer(ctx context.Context, user, task uuid.UUID, req model.StartTimerRequest) (*model.Worklog, error) {
+	t, err := s.GetTask(ctx, user, task)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.checkLogTime(ctx, user, t); err != nil {
+		return nil, err
+	}
+
+	w := &model.Worklog{
+		ID:          uuid.New(),
+		Task:        t.ID,
+		User:        user,
+		Description: req.Description,
+		StartedAt:   time.Now(),
+	}
+	if err = s.createWorklog(ctx, w); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+// StopTimer stops running timer of user and return stopped worklog.
+func (s *Service) StopTimer(ctx context.Context, user uuid.UUID) (*model.Worklog, error) {
+	w, err := s.store.Worklog().Stop(ctx, user, time.Now())
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return nil, service.ErrNoRunningTimer
+		}
+		return nil, service.ErrInternal.With(zap.Error(err))
+	}
+	return w, nil
+}
+
+// GetRunningTimer return running timer of user.
+func (s *Service) GetRunningTimer(ctx context.Context, user uuid.UUID) (*model.Worklog, error) {
+	w, err := s.store.Worklog().Running(ctx, user)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return nil, service.ErrNoRunningTimer
+		}
+		return nil, service.ErrInternal.With(zap.Error(err))
+	}
+	return w, nil
+}
+
+// CreateWorklog logs time which user spent on task manually. Permissions are equal to StartTimer.
+func (s *Service) CreateWorklog(ctx context.Context, user, task uuid.UUID, req model.CreateWorklogRequest) (*model.Worklog, error) {
+	if req.StartedAt.IsZero() || !req.StoppedAt.After(req.StartedAt) || req.StoppedAt.After(time.Now()) {
+		return nil, service.ErrBadWorklog
+	}
+
+	t, err := s.GetTask(ctx, user, task)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.checkLogTime(ctx, user, t); err != nil {
+		return nil, err
+	}
+
+	stopped := req.StoppedAt
+	w := &model.Worklog{
+		ID:          uuid.New(),
+		Task:        t.ID,
+		User:        user,
+		Description: req.Description,
+		StartedAt:   req.StartedAt,
+		StoppedAt:   &stopped,
+	}
+	if err = s.createWorklog(ctx, w); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+// GetTaskWorklogs return time which was logged on task ordered by start time.
+func (s *Service) GetTaskWorklogs(ctx context.Context, user, task uuid.UUID) (*model.GetWorklogsResponse, error) {
+	t, err := s.GetTask(ctx, user, task)
+	if err != nil {
+		return nil, err
+	}
+
+	worklogs, err := s.store.Worklog().AllByTask(ctx, t.ID)
+	if err != nil {
+		return nil, service.ErrInternal.With(zap.Error(err))
+	}
+
+	return &model.GetWorklogsResponse{
+		Count:    len(worklogs),
+		Worklogs: worklogs,
+	}, nil
+}
+
+// DeleteWorklog deletes worklog of task.
+//
+// Author could delete own worklog and users who could change task could delete any worklog of task.
+func (s *Service) DeleteWorklog(ctx context.Context, user, task, worklog uuid.UUID) error {
+	t, err := s.GetTask(ctx, user, task)
+	if err != nil {
+		return err
+	}
+
+	w, err := s.store.Worklog().Get(ctx, worklog)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return service.ErrNotFound
+		}
+		return service.ErrInternal.With(zap.Error(err))
+	}
+	if w.Task != t.ID {
+		return service.ErrNotFound
+	}
+
+	if w.User != user {
+		if err = s.authorizeTask(ctx, user, t, model.PermEditTasks); err != nil {
+			return err
+		}
+	}
+
+	if err = s.store.Worklog().Delete(ctx, w.ID); err != nil {
+		switch {
+		case errors.Is(err, store.ErrNotFound):
+			return service.ErrNotFound
+		default:
+			return service.ErrInternal.With(zap.Error(err))
+		}
+	}
+	return nil
+}
+
+// GetWorklogReport return logged time over period of filter aggregated by user, task or group.
+//
+// If group is provided then report contains time of all members on tasks of group and user must be admin
+// of group or could read all tasks of it. Otherwise, report contains only time which is logged by user.
+func (s *Service) GetWorklogReport(ctx context.Context, user uuid.UUID, filter model.WorklogReportFilter) (*model.WorklogReport, error) {
+	if filter.By == "" {
+		filter.By = model.ReportByUser
+	}
+	if !filter.By.Valid() {
+		return nil, service.ErrBadReportGrouping
+	}
+	if filter.From.IsZero() || filter.To.IsZero() || !filter.To.After(filter.From) {
+		return nil, service.ErrBadReportPeriod
+	}
+
+	if filter.Group != nil {
+		if err := s.authorize(ctx, user, *filter.Group, model.PermViewTasks); err != nil {
+			return nil, err
+		}
+		filter.User = nil
+	} else {
+		filter.User = &user
+	}
+
+	rows, err := s.store.Worklog().Report(ctx, filter)
+	if err != nil {
+		return nil, service.ErrInternal.With(zap.Error(err))
+	}
+	return model.NewWorklogReport(filter, rows), nil
+}
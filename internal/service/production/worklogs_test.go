@@ -0,0 +1,226 @@
+package production
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vlad-marlo/godo/internal/model"
+	"github.com/vlad-marlo/godo/internal/service"
+	"github.com/vlad-marlo/godo/internal/store"
+	"github.com/vlad-marlo/godo/internal/store/mocks"
+)
+
+func TestService_StartTimer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	assigned, foreign := foreignTask(), foreignTask()
+
+	taskRepo := mocks.NewMockTaskRepository(ctrl)
+	taskRepo.EXPECT().GetByUserAndID(gomock.Any(), TestUser1.ID, assigned.ID).Return(assigned, nil).Times(2)
+	taskRepo.EXPECT().GetByUserAndID(gomock.Any(), TestUser1.ID, foreign.ID).Return(foreign, nil)
+	taskRepo.EXPECT().IsAssignee(gomock.Any(), assigned.ID, TestUser1.ID).Return(true).Times(2)
+	taskRepo.EXPECT().IsAssignee(gomock.Any(), foreign.ID, TestUser1.ID).Return(false)
+	taskRepo.EXPECT().GetRoleOfMember(gomock.Any(), TestUser1.ID, foreign.ID).Return(ReadOnlyRole, nil)
+	worklogRepo := mocks.NewMockWorklogRepository(ctrl)
+	gomock.InOrder(
+		worklogRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, w *model.Worklog) error {
+			assert.Equal(t, assigned.ID, w.Task)
+			assert.Equal(t, TestUser1.ID, w.User)
+			assert.Nil(t, w.StoppedAt)
+			return nil
+		}),
+		worklogRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(store.ErrUniqueViolation),
+	)
+	str := mocks.NewMockStore(ctrl)
+	str.EXPECT().Task().Return(taskRepo).AnyTimes()
+	str.EXPECT().Worklog().Return(worklogRepo).AnyTimes()
+
+	s := testService(t, str)
+	w, err := s.StartTimer(context.Background(), TestUser1.ID, assigned.ID, model.StartTimerRequest{Description: "review"})
+	require.NoError(t, err)
+	assert.Equal(t, "review", w.Description)
+
+	_, err = s.StartTimer(context.Background(), TestUser1.ID, assigned.ID, model.StartTimerRequest{})
+	assert.ErrorIs(t, err, service.ErrTimerAlreadyRunning)
+
+	_, err = s.StartTimer(context.Background(), TestUser1.ID, foreign.ID, model.StartTimerRequest{})
+	assert.ErrorIs(t, err, service.ErrForbidden)
+}
+
+func TestService_StopTimer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	stopped := &model.Worklog{ID: uuid.New(), User: TestUser1.ID}
+
+	worklogRepo := mocks.NewMockWorklogRepository(ctrl)
+	gomock.InOrder(
+		worklogRepo.EXPECT().Stop(gomock.Any(), TestUser1.ID, gomock.Any()).Return(stopped, nil),
+		worklogRepo.EXPECT().Stop(gomock.Any(), TestUser1.ID, gomock.Any()).Return(nil, store.ErrNotFound),
+		worklogRepo.EXPECT().Stop(gomock.Any(), TestUser1.ID, gomock.Any()).Return(nil, errors.New("")),
+	)
+	str := mocks.NewMockStore(ctrl)
+	str.EXPECT().Worklog().Return(worklogRepo).AnyTimes()
+
+	s := testService(t, str)
+	w, err := s.StopTimer(context.Background(), TestUser1.ID)
+	require.NoError(t, err)
+	assert.Equal(t, stopped, w)
+
+	_, err = s.StopTimer(context.Background(), TestUser1.ID)
+	assert.ErrorIs(t, err, service.ErrNoRunningTimer)
+
+	_, err = s.StopTimer(context.Background(), TestUser1.ID)
+	assert.ErrorIs(t, err, service.ErrInternal)
+}
+
+func TestService_GetRunningTimer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	running := &model.Worklog{ID: uuid.New(), User: TestUser1.ID}
+
+	worklogRepo := mocks.NewMockWorklogRepository(ctrl)
+	gomock.InOrder(
+		worklogRepo.EXPECT().Running(gomock.Any(), TestUser1.ID).Return(running, nil),
+		worklogRepo.EXPECT().Running(gomock.Any(), TestUser1.ID).Return(nil, store.ErrNotFound),
+	)
+	str := mocks.NewMockStore(ctrl)
+	str.EXPECT().Worklog().Return(worklogRepo).AnyTimes()
+
+	s := testService(t, str)
+	w, err := s.GetRunningTimer(context.Background(), TestUser1.ID)
+	require.NoError(t, err)
+	assert.Equal(t, running, w)
+
+	_, err = s.GetRunningTimer(context.Background(), TestUser1.ID)
+	assert.ErrorIs(t, err, service.ErrNoRunningTimer)
+}
+
+func TestService_CreateWorklog(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	now := time.Now()
+
+	taskRepo := mocks.NewMockTaskRepository(ctrl)
+	taskRepo.EXPECT().GetByUserAndID(gomock.Any(), TestUser1.ID, TestTask1.ID).Return(TestTask1, nil)
+	taskRepo.EXPECT().IsAssignee(gomock.Any(), TestTask1.ID, TestUser1.ID).Return(false)
+	taskRepo.EXPECT().GetRoleOfMember(gomock.Any(), TestUser1.ID, TestTask1.ID).Return(nil, store.ErrNotFound)
+	worklogRepo := mocks.NewMockWorklogRepository(ctrl)
+	worklogRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+	str := mocks.NewMockStore(ctrl)
+	str.EXPECT().Task().Return(taskRepo).AnyTimes()
+	str.EXPECT().Worklog().Return(worklogRepo).AnyTimes()
+
+	s := testService(t, str)
+	for _, req := range []model.CreateWorklogRequest{
+		{StoppedAt: now.Add(-time.Hour)},
+		{StartedAt: now.Add(-time.Hour), StoppedAt: now.Add(-2 * time.Hour)},
+		{StartedAt: now.Add(-time.Hour), StoppedAt: now.Add(time.Hour)},
+	} {
+		_, err := s.CreateWorklog(context.Background(), TestUser1.ID, TestTask1.ID, req)
+		assert.ErrorIs(t, err, service.ErrBadWorklog)
+	}
+
+	req := model.CreateWorklogRequest{StartedAt: now.Add(-2 * time.Hour), StoppedAt: now.Add(-time.Hour)}
+	w, err := s.CreateWorklog(context.Background(), TestUser1.ID, TestTask1.ID, req)
+	require.NoError(t, err)
+	assert.Equal(t, time.Hour, w.Duration())
+}
+
+func TestService_GetTaskWorklogs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	worklogs := []*model.Worklog{{ID: uuid.New(), Task: TestTask1.ID}}
+
+	taskRepo := mocks.NewMockTaskRepository(ctrl)
+	taskRepo.EXPECT().GetByUserAndID(gomock.Any(), TestUser1.ID, TestTask1.ID).Return(TestTask1, nil)
+	worklogRepo := mocks.NewMockWorklogRepository(ctrl)
+	worklogRepo.EXPECT().AllByTask(gomock.Any(), TestTask1.ID).Return(worklogs, nil)
+	str := mocks.NewMockStore(ctrl)
+	str.EXPECT().Task().Return(taskRepo).AnyTimes()
+	str.EXPECT().Worklog().Return(worklogRepo).AnyTimes()
+
+	s := testService(t, str)
+	resp, err := s.GetTaskWorklogs(context.Background(), TestUser1.ID, TestTask1.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 1, resp.Count)
+	assert.Equal(t, worklogs, resp.Worklogs)
+}
+
+func TestService_DeleteWorklog(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	task := foreignTask()
+	own := &model.Worklog{ID: uuid.New(), Task: task.ID, User: TestUser1.ID}
+	other := &model.Worklog{ID: uuid.New(), Task: task.ID, User: uuid.New()}
+
+	taskRepo := mocks.NewMockTaskRepository(ctrl)
+	taskRepo.EXPECT().GetByUserAndID(gomock.Any(), TestUser1.ID, task.ID).Return(task, nil).Times(2)
+	taskRepo.EXPECT().GetRoleOfMember(gomock.Any(), TestUser1.ID, task.ID).Return(ReadOnlyRole, nil)
+	worklogRepo := mocks.NewMockWorklogRepository(ctrl)
+	worklogRepo.EXPECT().Get(gomock.Any(), own.ID).Return(own, nil)
+	worklogRepo.EXPECT().Get(gomock.Any(), other.ID).Return(other, nil)
+	worklogRepo.EXPECT().Delete(gomock.Any(), own.ID).Return(nil)
+	str := mocks.NewMockStore(ctrl)
+	str.EXPECT().Task().Return(taskRepo).AnyTimes()
+	str.EXPECT().Worklog().Return(worklogRepo).AnyTimes()
+
+	s := testService(t, str)
+	assert.NoError(t, s.DeleteWorklog(context.Background(), TestUser1.ID, task.ID, own.ID))
+	assert.ErrorIs(t, s.DeleteWorklog(context.Background(), TestUser1.ID, task.ID, other.ID), service.ErrForbidden)
+}
+
+func TestService_GetWorklogReport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	now := time.Now()
+	group, foreign := uuid.New(), uuid.New()
+	rows := []*model.WorklogReportRow{{ID: uuid.New(), Name: "task", Entries: 1, Seconds: 60}}
+
+	groupRepo := mocks.NewMockGroupRepository(ctrl)
+	groupRepo.EXPECT().GetRoleOfMember(gomock.Any(), TestUser1.ID, group).Return(SudoRole, nil)
+	groupRepo.EXPECT().GetRoleOfMember(gomock.Any(), TestUser1.ID, foreign).Return(ReadOnlyRole, nil)
+	worklogRepo := mocks.NewMockWorklogRepository(ctrl)
+	worklogRepo.EXPECT().Report(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, filter model.WorklogReportFilter) ([]*model.WorklogReportRow, error) {
+			assert.Equal(t, model.ReportByUser, filter.By)
+			assert.Equal(t, &TestUser1.ID, filter.User)
+			return rows, nil
+		},
+	)
+	worklogRepo.EXPECT().Report(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, filter model.WorklogReportFilter) ([]*model.WorklogReportRow, error) {
+			assert.Equal(t, &group, filter.Group)
+			assert.Nil(t, filter.User)
+			return nil, nil
+		},
+	)
+	str := mocks.NewMockStore(ctrl)
+	str.EXPECT().Group().Return(groupRepo).AnyTimes()
+	str.EXPECT().Worklog().Return(worklogRepo).AnyTimes()
+
+	s := testService(t, str)
+	_, err := s.GetWorklogReport(context.Background(), TestUser1.ID, model.WorklogReportFilter{From: now, To: now})
+	assert.ErrorIs(t, err, service.ErrBadReportPeriod)
+	_, err = s.GetWorklogReport(context.Background(), TestUser1.ID, model.WorklogReportFilter{From: now, To: now.Add(time.Hour), By: "day"})
+	assert.ErrorIs(t, err, service.ErrBadReportGrouping)
+
+	report, err := s.GetWorklogReport(context.Background(), TestUser1.ID, model.WorklogReportFilter{From: now.Add(-time.Hour), To: now})
+	require.NoError(t, err)
+	assert.Equal(t, int64(60), report.Seconds)
+	assert.Equal(t, rows, report.Rows)
+
+	report, err = s.GetWorklogReport(context.Background(), TestUser1.ID, model.WorklogReportFilter{
+		From:  now.Add(-time.Hour),
+		To:    now,
+		By:    model.ReportByTask,
+		Group: &group,
+	})
+	require.NoError(t, err)
+	assert.Empty(t, report.Rows)
+
+	_, err = s.GetWorklogReport(context.Background(), TestUser1.ID, model.WorklogReportFilter{
+		From:  now.Add(-time.Hour),
+		To:    now,
+		Group: &foreign,
+	})
+	assert.ErrorIs(t, err, service.ErrForbidden)
+}
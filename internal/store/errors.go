@@ -16,4 +16,6 @@ var (
 	ErrFKViolation         = errors.New("foreign key violation")
 	ErrNilReference        = errors.New("nil reference")
 	ErrNotAuthorized       = errors.New("has no permission")
+	ErrLimitExceeded       = errors.New("limit exceeded")
+	ErrLastAdmin           = errors.New("last admin of group")
 )
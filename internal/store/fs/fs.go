@@ -0,0 +1,119 @@
+// Package fs implements store.BlobStore on local file system.
+package fs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/vlad-marlo/godo/internal/config"
+	"github.com/vlad-marlo/godo/internal/store"
+)
+
+var _ store.BlobStore = (*BlobStore)(nil)
+
+// ErrBadKey is returned when key could not be used as name of file.
+var ErrBadKey = errors.New("bad blob key")
+
+// BlobStore keeps every blob in separate file of directory. Name of file is key of blob.
+type BlobStore struct {
+	dir string
+}
+
+// New return blob store which keeps blobs in directory from config. Directory is created if it does not exist.
+func New(cfg *config.Config) (*BlobStore, error) {
+	dir := cfg.Server.AttachmentsDir
+	if dir == "" {
+		return nil, errors.New("fs: attachments directory is not set")
+	}
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, fmt.Errorf("fs: create directory: %w", err)
+	}
+	return &BlobStore{dir: dir}, nil
+}
+
+// path return path to file of blob.
+func (s *BlobStore) path(key string) (string, error) {
+	if key == "" || key == "." || key == ".." || strings.ContainsAny(key, `/\`) || strings.HasPrefix(key, ".") {
+		return "", ErrBadKey
+	}
+	return filepath.Join(s.dir, key), nil
+}
+
+// Put writes content into temporary file and renames it to key, so partially written blobs are never visible.
+func (s *BlobStore) Put(ctx context.Context, key string, r io.Reader) (int64, error) {
+	p, err := s.path(key)
+	if err != nil {
+		return 0, err
+	}
+
+	f, err := os.CreateTemp(s.dir, ".upload-*")
+	if err != nil {
+		return 0, fmt.Errorf("fs: create temp file: %w", err)
+	}
+	tmp := f.Name()
+	defer func() {
+		_ = os.Remove(tmp)
+	}()
+
+	n, err := io.Copy(f, &ctxReader{ctx: ctx, r: r})
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return n, fmt.Errorf("fs: write blob: %w", err)
+	}
+
+	if err = os.Rename(tmp, p); err != nil {
+		return n, fmt.Errorf("fs: rename blob: %w", err)
+	}
+	return n, nil
+}
+
+// Get opens file of blob.
+func (s *BlobStore) Get(_ context.Context, key string) (io.ReadCloser, error) {
+	p, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(p)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, store.ErrNotFound
+		}
+		return nil, fmt.Errorf("fs: open blob: %w", err)
+	}
+	return f, nil
+}
+
+// Delete removes files of blobs.
+func (s *BlobStore) Delete(_ context.Context, keys ...string) error {
+	for _, key := range keys {
+		p, err := s.path(key)
+		if err != nil {
+			return err
+		}
+		if err = os.Remove(p); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("fs: remove blob: %w", err)
+		}
+	}
+	return nil
+}
+
+// ctxReader stops reading when context is done.
+type ctxReader struct {
+	ctx context.Context
+	r   io.Reader
+}
+
+// Read implements io.Reader.
+func (r *ctxReader) Read(p []byte) (int, error) {
+	if err := r.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return r.r.Read(p)
+}
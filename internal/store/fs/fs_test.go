@@ -0,0 +1,78 @@
+package fs
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vlad-marlo/godo/internal/config"
+	"github.com/vlad-marlo/godo/internal/store"
+)
+
+func testBlobStore(t *testing.T) *BlobStore {
+	t.Helper()
+	cfg := &config.Config{}
+	cfg.Server.AttachmentsDir = filepath.Join(t.TempDir(), "blobs")
+	s, err := New(cfg)
+	require.NoError(t, err)
+	return s
+}
+
+func TestNew_NoDir(t *testing.T) {
+	_, err := New(&config.Config{})
+	assert.Error(t, err)
+}
+
+func TestBlobStore(t *testing.T) {
+	s := testBlobStore(t)
+	ctx := context.Background()
+
+	n, err := s.Put(ctx, "key", strings.NewReader("content"))
+	require.NoError(t, err)
+	assert.EqualValues(t, 7, n)
+
+	r, err := s.Get(ctx, "key")
+	require.NoError(t, err)
+	data, err := io.ReadAll(r)
+	require.NoError(t, err)
+	require.NoError(t, r.Close())
+	assert.Equal(t, "content", string(data))
+
+	require.NoError(t, s.Delete(ctx, "key", "missing"))
+	_, err = s.Get(ctx, "key")
+	assert.ErrorIs(t, err, store.ErrNotFound)
+
+	// temporary files are not left.
+	entries, err := os.ReadDir(s.dir)
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func TestBlobStore_BadKey(t *testing.T) {
+	s := testBlobStore(t)
+	ctx := context.Background()
+
+	for _, key := range []string{"", ".", "..", "../key", "a/b", `a\b`, ".hidden"} {
+		_, err := s.Put(ctx, key, strings.NewReader(""))
+		assert.ErrorIs(t, err, ErrBadKey, key)
+		_, err = s.Get(ctx, key)
+		assert.ErrorIs(t, err, ErrBadKey, key)
+		assert.ErrorIs(t, s.Delete(ctx, key), ErrBadKey, key)
+	}
+}
+
+func TestBlobStore_CanceledPut(t *testing.T) {
+	s := testBlobStore(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := s.Put(ctx, "key", strings.NewReader("content"))
+	assert.ErrorIs(t, err, context.Canceled)
+	_, err = s.Get(context.Background(), "key")
+	assert.ErrorIs(t, err, store.ErrNotFound)
+}
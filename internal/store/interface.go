@@ -4,6 +4,9 @@ package store
 
 import (
 	"context"
+	"io"
+	"time"
+
 	"github.com/google/uuid"
 	"github.com/vlad-marlo/godo/internal/model"
 )
@@ -24,12 +27,40 @@ type UserRepository interface {
 type GroupRepository interface {
 	// Create creates record about group if it does not exist.
 	Create(ctx context.Context, group *model.Group) error
+	// CreateWithOwner creates group, its role and adds owner of group to it as admin with that role in one
+	// transaction.
+	CreateWithOwner(ctx context.Context, group *model.Group, role *model.Role) error
 	// GetByUser ...
 	GetByUser(ctx context.Context, user uuid.UUID) ([]*model.Group, error)
-	// GetRoleOfMember return role of member in group.
+	// Get return group with profile by id.
+	Get(ctx context.Context, id uuid.UUID) (*model.Group, error)
+	// Update changes name, description and profile of group.
+	Update(ctx context.Context, group *model.Group) error
+	// IsAdmin return true if user is admin of group.
+	IsAdmin(ctx context.Context, group, user uuid.UUID) (ok bool)
+	// Members return members of group ordered by email.
+	Members(ctx context.Context, group uuid.UUID) ([]*model.UserInGroup, error)
+	// GetMember return member of group.
+	GetMember(ctx context.Context, group, user uuid.UUID) (*model.UserInGroup, error)
+	// RemoveUser removes user from group, unassigns user from open tasks of group and records entry to audit log
+	// with role of user as old value in one transaction.
+	//
+	// Unassignment is recorded to history of tasks in the same transaction. Last admin of group could not be removed.
+	RemoveUser(ctx context.Context, group, user uuid.UUID, entry *model.AuditEntry) error
+	// SetMemberRole changes role and admin flag of member and records entry to audit log in one transaction.
+	//
+	// Admin flag could not be revoked from last admin of group.
+	SetMemberRole(ctx context.Context, group, user uuid.UUID, role int32, isAdmin bool, entry *model.AuditEntry) error
+	// AuditLog return at most limit entries of audit log of group which ids are greater than after, oldest first.
+	AuditLog(ctx context.Context, group uuid.UUID, after int64, limit int) ([]*model.AuditEntry, error)
+	// GetRoleOfMember return role of member in group. Admins of group have all permissions.
 	GetRoleOfMember(ctx context.Context, user, group uuid.UUID) (role *model.Role, err error)
 	GetUserIDs(ctx context.Context, group uuid.UUID) ([]uuid.UUID, error)
 	AddUser(ctx context.Context, roleID int32, groupID, userID uuid.UUID, isAdmin bool) error
+	// Stats return statistics of group tasks over period of filter.
+	//
+	// Weeks in which nothing happened are not returned.
+	Stats(ctx context.Context, filter model.GroupStatsFilter) (*model.GroupStats, error)
 }
 
 // TokenRepository is accessor to storing tokens.
@@ -42,8 +73,8 @@ type TokenRepository interface {
 
 // InviteRepository is accessor to storing invites.
 type InviteRepository interface {
-	// Create creates invite with provided data.
-	Create(ctx context.Context, invite uuid.UUID, role int32, group uuid.UUID, uses int) error
+	// Create creates invite with provided data. Users who join group by invite are remembered as invited by createdBy.
+	Create(ctx context.Context, invite uuid.UUID, role int32, group uuid.UUID, uses int, createdBy uuid.UUID) error
 	// Exists checks existence valid invite with provided data.
 	Exists(ctx context.Context, invite, group uuid.UUID) bool
 	// Use decrements left uses of invite and adds user to group in tx.
@@ -54,23 +85,259 @@ type InviteRepository interface {
 type TaskRepository interface {
 	// AllByGroupAndUser return all tasks that are related to group.
 	AllByGroupAndUser(ctx context.Context, group uuid.UUID, user uuid.UUID) ([]*model.Task, error)
-	// AllByUser ...
-	AllByUser(ctx context.Context, user uuid.UUID) ([]*model.Task, error)
+	// AllByUser return all tasks related to user which satisfy filter.
+	AllByUser(ctx context.Context, user uuid.UUID, filter model.TaskFilter) ([]*model.Task, error)
 	// GetByUserAndID return task that has id task and is related to user.
 	GetByUserAndID(ctx context.Context, user, task uuid.UUID) (*model.Task, error)
 	// Create creates record about task.
 	Create(ctx context.Context, task *model.Task) error
+	// CreateWithRelations creates task, relates it to group and assigns it to users in one transaction.
+	//
+	// Users to whom creator could not assign task are returned as rejected.
+	// If template is provided then its labels and checklist are added to task in the same transaction.
+	// Creation of task, its assignees and labels are recorded to history of task in the same transaction.
+	CreateWithRelations(ctx context.Context, task *model.Task, group *uuid.UUID, users []uuid.UUID, tpl *model.TaskTemplate) (rejected []uuid.UUID, err error)
 	// AddToUser add task to user with check that user has permission to do this.
 	AddToUser(ctx context.Context, from, task, to uuid.UUID) error
 	// ForceAddToUser add task to user without any checks.
 	ForceAddToUser(ctx context.Context, user, task uuid.UUID) error
 	// AddToGroup ...
 	AddToGroup(ctx context.Context, task, group uuid.UUID) error
+	// GetRoleOfMember return union of permissions of user in groups to which task is related.
+	GetRoleOfMember(ctx context.Context, user, task uuid.UUID) (*model.Role, error)
+	// UpdateStatus changes status of task if current status of task is equal to from.
+	//
+	// If task does not fit into column of board of any its group then store.ErrLimitExceeded will be returned.
+	UpdateStatus(ctx context.Context, task uuid.UUID, from, to model.TaskStatus, changes ...*model.TaskChange) error
+	// IsAssignee return true if task is assigned to user.
+	IsAssignee(ctx context.Context, task, user uuid.UUID) (ok bool)
+	// Update changes name, description and deadline of task and records changes to its history in one transaction.
+	Update(ctx context.Context, task *model.Task, changes ...*model.TaskChange) error
+	// Delete deletes task with all related objects.
+	Delete(ctx context.Context, task uuid.UUID) error
+	// ShareGroup return true if both tasks are related to one group.
+	ShareGroup(ctx context.Context, a, b uuid.UUID) (ok bool)
+	// InGroup return true if task is related to group.
+	InGroup(ctx context.Context, task, group uuid.UUID) (ok bool)
+	// Bulk applies operation of request to tasks in one transaction.
+	//
+	// Status of every task is changed only if it is still equal to status of provided task
+	// and work in progress limits of boards are not exceeded. Changes are recorded to history of tasks in the same
+	// transaction.
+	Bulk(ctx context.Context, req *model.BulkTaskRequest, tasks []*model.Task, changes ...*model.TaskChange) error
+	// Search return tasks visible to user which match full text query ordered by relevance.
+	Search(ctx context.Context, user uuid.UUID, query string, limit int) ([]*model.SearchResult, error)
+}
+
+// ReviewRepository is accessor to storage of review requests.
+type ReviewRepository interface {
+	// Create stores review and moves task from provided status to ON_REVIEW in one transaction.
+	//
+	// If task does not fit into ON_REVIEW column of board then store.ErrLimitExceeded will be returned.
+	Create(ctx context.Context, review *model.Review, from model.TaskStatus, changes ...*model.TaskChange) error
+	// Get return review with provided id.
+	Get(ctx context.Context, id uuid.UUID) (*model.Review, error)
+	// PendingByGroup return reviews of group tasks that are waiting for resolution.
+	PendingByGroup(ctx context.Context, group uuid.UUID) ([]*model.Review, error)
+	// Resolve stores resolution of pending review and moves reviewed task into status in one transaction.
+	//
+	// If task does not fit into column of board then store.ErrLimitExceeded will be returned.
+	Resolve(ctx context.Context, review *model.Review, status model.TaskStatus, changes ...*model.TaskChange) error
+}
+
+// CommentRepository is accessor to storage of task comments.
+type CommentRepository interface {
+	// Create creates record about comment.
+	Create(ctx context.Context, comment *model.Comment) error
+	// Get return comment with provided id.
+	Get(ctx context.Context, id uuid.UUID) (*model.Comment, error)
+	// AllByTask return all comments of task ordered by creation time.
+	AllByTask(ctx context.Context, task uuid.UUID) ([]*model.Comment, error)
+	// Update changes message of comment and stores previous version of it into edit history.
+	Update(ctx context.Context, comment *model.Comment, editor uuid.UUID) error
+	// Delete deletes comment with provided id.
+	Delete(ctx context.Context, id uuid.UUID) error
+	// History return previous versions of comment ordered by edit time.
+	History(ctx context.Context, id uuid.UUID) ([]*model.CommentEdit, error)
+}
+
+// DependencyRepository is accessor to storage of links between blocking and blocked tasks.
+type DependencyRepository interface {
+	// Create creates link between tasks.
+	//
+	// Links which are reachable from blocked task are passed to check inside of transaction which creates link. If
+	// check returns error then link is not created.
+	Create(ctx context.Context, dep *model.TaskDependency, check func(downstream []*model.TaskDependency) error) error
+	// Delete deletes link between tasks.
+	Delete(ctx context.Context, blocker, blocked uuid.UUID) error
+	// OpenBlockers return ids of tasks which block task and are not done yet.
+	OpenBlockers(ctx context.Context, task uuid.UUID) ([]uuid.UUID, error)
+	// GraphByGroup return tasks of group and links between them.
+	GraphByGroup(ctx context.Context, group uuid.UUID) (*model.DependencyGraph, error)
+}
+
+// LabelRepository is accessor to storage of group labels.
+type LabelRepository interface {
+	// Create creates record about label.
+	Create(ctx context.Context, label *model.Label) error
+	// Get return label with provided id.
+	Get(ctx context.Context, id uuid.UUID) (*model.Label, error)
+	// AllByGroup return labels of group ordered by name.
+	AllByGroup(ctx context.Context, group uuid.UUID) ([]*model.Label, error)
+	// Update changes name and color of label.
+	Update(ctx context.Context, label *model.Label) error
+	// Delete deletes label and records its detaching by actor to history of tasks in one transaction.
+	Delete(ctx context.Context, id, actor uuid.UUID) error
+	// Attach attaches label to task which is related to group of label and records changes to history of task.
+	Attach(ctx context.Context, task, label uuid.UUID, changes ...*model.TaskChange) error
+	// Detach detaches label from task and records changes to history of task.
+	Detach(ctx context.Context, task, label uuid.UUID, changes ...*model.TaskChange) error
+}
+
+// BoardRepository is accessor to storage of board positions and work-in-progress limits of groups.
+type BoardRepository interface {
+	// Limits return work-in-progress limits of board columns of group.
+	Limits(ctx context.Context, group uuid.UUID) (map[model.TaskStatus]int, error)
+	// SetLimits changes limits of board columns. Limit of column is removed if it is nil or zero.
+	SetLimits(ctx context.Context, group uuid.UUID, limits map[model.TaskStatus]*int) error
+	// Move changes status of task and places it at position in column of group board.
+	//
+	// If column has limit which will be exceeded after move then store.ErrLimitExceeded will be returned.
+	// If task is not related to group then store.ErrBadData will be returned.
+	// Changes are recorded to history of task in the same transaction.
+	Move(ctx context.Context, group, task uuid.UUID, from, to model.TaskStatus, position int, changes ...*model.TaskChange) error
+}
+
+// TemplateRepository is accessor to storage of task templates of groups.
+type TemplateRepository interface {
+	// Create creates record about template.
+	Create(ctx context.Context, tpl *model.TaskTemplate) error
+	// Get return template with provided id.
+	Get(ctx context.Context, id uuid.UUID) (*model.TaskTemplate, error)
+	// AllByGroup return templates of group.
+	AllByGroup(ctx context.Context, group uuid.UUID) ([]*model.TaskTemplate, error)
+	// Update changes name, description, assignees, labels, checklist and recurrence of template.
+	Update(ctx context.Context, tpl *model.TaskTemplate) error
+	// Apply attaches labels of template to task and adds checklist of template to it.
+	Apply(ctx context.Context, tpl *model.TaskTemplate, task uuid.UUID) error
+	// Delete deletes template. Tasks which were created from template are kept.
+	Delete(ctx context.Context, id uuid.UUID) error
+	// Due return at most limit recurring templates which next run is not after now.
+	Due(ctx context.Context, now time.Time, limit int) ([]*model.TaskTemplate, error)
+	// Materialize creates task from template for its current run and moves template to next run.
+	//
+	// If run was already materialized then store.ErrNotFound will be returned and nothing will be stored.
+	// If author of template could not create tasks in its group anymore then template is stopped
+	// and store.ErrNotAuthorized will be returned.
+	Materialize(ctx context.Context, tpl *model.TaskTemplate, task *model.Task, next *time.Time) error
+}
+
+// HistoryRepository is accessor to append-only storage of task changes.
+type HistoryRepository interface {
+	// Add stores changes of tasks. Either all changes are stored or none of them.
+	Add(ctx context.Context, changes ...*model.TaskChange) error
+	// AllByTask return changes of task in order in which they were made.
+	AllByTask(ctx context.Context, task uuid.UUID) ([]*model.TaskChange, error)
+}
+
+// AttachmentRepository is accessor to storage of attachments metadata.
+type AttachmentRepository interface {
+	// Create creates record about attachment.
+	Create(ctx context.Context, attachment *model.Attachment) error
+	// Get return attachment with provided id.
+	Get(ctx context.Context, id uuid.UUID) (*model.Attachment, error)
+	// AllByTask return attachments of task and of its comments ordered by upload time.
+	AllByTask(ctx context.Context, task uuid.UUID) ([]*model.Attachment, error)
+	// Delete deletes attachment. Key of its blob is marked as orphaned.
+	Delete(ctx context.Context, id uuid.UUID) error
+	// Orphaned return at most limit keys of blobs which attachments were deleted directly or with task or comment.
+	Orphaned(ctx context.Context, limit int) ([]string, error)
+	// ForgetOrphaned removes keys of deleted blobs from list of orphaned blobs.
+	ForgetOrphaned(ctx context.Context, keys []string) error
+}
+
+// ChecklistRepository is accessor to storage of checklist items of tasks.
+type ChecklistRepository interface {
+	// Create creates item at the end of checklist of task.
+	Create(ctx context.Context, item *model.ChecklistItem) error
+	// Get return checklist item with provided id.
+	Get(ctx context.Context, id uuid.UUID) (*model.ChecklistItem, error)
+	// AllByTask return items of task checklist ordered by position.
+	AllByTask(ctx context.Context, task uuid.UUID) ([]*model.ChecklistItem, error)
+	// SetDone marks item as done or not done.
+	SetDone(ctx context.Context, id uuid.UUID, done bool) error
+	// Move places item of task at position in checklist.
+	//
+	// If item is not in checklist of task then store.ErrNotFound will be returned.
+	Move(ctx context.Context, task, item uuid.UUID, position int) error
+	// Delete deletes checklist item.
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+// WorklogRepository is accessor to storage of time which users spent on tasks.
+type WorklogRepository interface {
+	// Create stores worklog. Worklog without stop time is running timer of user.
+	//
+	// If user already has running timer then store.ErrUniqueViolation will be returned.
+	Create(ctx context.Context, w *model.Worklog) error
+	// Get return worklog with provided id.
+	Get(ctx context.Context, id uuid.UUID) (*model.Worklog, error)
+	// Running return running timer of user.
+	Running(ctx context.Context, user uuid.UUID) (*model.Worklog, error)
+	// Stop stops running timer of user at provided time and return stopped worklog.
+	Stop(ctx context.Context, user uuid.UUID, at time.Time) (*model.Worklog, error)
+	// AllByTask return worklogs of task ordered by start time.
+	AllByTask(ctx context.Context, task uuid.UUID) ([]*model.Worklog, error)
+	// Delete deletes worklog.
+	Delete(ctx context.Context, id uuid.UUID) error
+	// Report return logged time which satisfies filter aggregated by grouping of filter.
+	Report(ctx context.Context, filter model.WorklogReportFilter) ([]*model.WorklogReportRow, error)
+}
+
+// WatcherRepository is accessor to storage of task watchers.
+//
+// Watchers of task are recipients of notifications about its changes. Creator, assignees, commenters and reviewers
+// of task are subscribed to it by storage automatically.
+type WatcherRepository interface {
+	// Watch subscribes user to task. Nothing is changed if user already watches task.
+	Watch(ctx context.Context, task, user uuid.UUID) error
+	// Unwatch unsubscribes user from task.
+	Unwatch(ctx context.Context, task, user uuid.UUID) error
+	// IsWatcher return true if user watches task.
+	IsWatcher(ctx context.Context, task, user uuid.UUID) (ok bool)
+	// AllByTask return watchers of task ordered by email.
+	AllByTask(ctx context.Context, task uuid.UUID) ([]*model.Watcher, error)
+	// Notifications return at most limit changes of tasks watched by user which were made by other users
+	// and which ids are greater than after, oldest first.
+	Notifications(ctx context.Context, user uuid.UUID, after int64, limit int) ([]*model.TaskChange, error)
+}
+
+// BlobStore is storage of file contents which are addressed by key.
+type BlobStore interface {
+	// Put stores content read from r with key and return count of stored bytes.
+	Put(ctx context.Context, key string, r io.Reader) (int64, error)
+	// Get return reader of content stored with key. Reader must be closed by caller.
+	//
+	// If there is no content with key then store.ErrNotFound will be returned.
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete deletes contents with keys. Keys which do not exist are ignored.
+	Delete(ctx context.Context, keys ...string) error
 }
 
+// RoleRepository is accessor to named roles of groups.
 type RoleRepository interface {
+	// Create creates role of group.
+	//
+	// If group already has role with same name or same permissions then store.ErrUniqueViolation will be returned.
 	Create(ctx context.Context, role *model.Role) error
-	Get(ctx context.Context, role *model.Role) error
+	// Get return role of group. If group has no such role then store.ErrNotFound will be returned.
+	Get(ctx context.Context, group uuid.UUID, id int32) (*model.Role, error)
+	// AllByGroup return roles of group ordered by name.
+	AllByGroup(ctx context.Context, group uuid.UUID) ([]*model.Role, error)
+	// Delete deletes role of group.
+	//
+	// If role is given to members or invites then store.ErrFKViolation will be returned.
+	Delete(ctx context.Context, group uuid.UUID, id int32) error
 }
 
 // Store is composite object that does not include any storage function.
@@ -85,7 +352,30 @@ type Store interface {
 	Token() TokenRepository
 	// Task is TaskRepository accessor.
 	Task() TaskRepository
+	// Role is RoleRepository accessor.
 	Role() RoleRepository
+	// Review is ReviewRepository accessor.
+	Review() ReviewRepository
+	// Comment is CommentRepository accessor.
+	Comment() CommentRepository
+	// Dependency is DependencyRepository accessor.
+	Dependency() DependencyRepository
+	// Label is LabelRepository accessor.
+	Label() LabelRepository
+	// Board is BoardRepository accessor.
+	Board() BoardRepository
+	// Template is TemplateRepository accessor.
+	Template() TemplateRepository
+	// History is HistoryRepository accessor.
+	History() HistoryRepository
+	// Attachment is AttachmentRepository accessor.
+	Attachment() AttachmentRepository
+	// Checklist is ChecklistRepository accessor.
+	Checklist() ChecklistRepository
+	// Worklog is WorklogRepository accessor.
+	Worklog() WorklogRepository
+	// Watcher is WatcherRepository accessor.
+	Watcher() WatcherRepository
 	// Invite is InviteRepository accessor.
 	Invite() InviteRepository
 	// Ping checks is Store working correctly.
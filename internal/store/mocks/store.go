@@ -6,7 +6,9 @@ package mocks
 
 import (
 	context "context"
+	io "io"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	uuid "github.com/google/uuid"
@@ -132,6 +134,21 @@ func (mr *MockGroupRepositoryMockRecorder) AddUser(ctx, roleID, groupID, userID,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUser", reflect.TypeOf((*MockGroupRepository)(nil).AddUser), ctx, roleID, groupID, userID, isAdmin)
 }
 
+// AuditLog mocks base method.
+func (m *MockGroupRepository) AuditLog(ctx context.Context, group uuid.UUID, after int64, limit int) ([]*model.AuditEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuditLog", ctx, group, after, limit)
+	ret0, _ := ret[0].([]*model.AuditEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuditLog indicates an expected call of AuditLog.
+func (mr *MockGroupRepositoryMockRecorder) AuditLog(ctx, group, after, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuditLog", reflect.TypeOf((*MockGroupRepository)(nil).AuditLog), ctx, group, after, limit)
+}
+
 // Create mocks base method.
 func (m *MockGroupRepository) Create(ctx context.Context, group *model.Group) error {
 	m.ctrl.T.Helper()
@@ -146,6 +163,35 @@ func (mr *MockGroupRepositoryMockRecorder) Create(ctx, group interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockGroupRepository)(nil).Create), ctx, group)
 }
 
+// CreateWithOwner mocks base method.
+func (m *MockGroupRepository) CreateWithOwner(ctx context.Context, group *model.Group, role *model.Role) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWithOwner", ctx, group, role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateWithOwner indicates an expected call of CreateWithOwner.
+func (mr *MockGroupRepositoryMockRecorder) CreateWithOwner(ctx, group, role interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWithOwner", reflect.TypeOf((*MockGroupRepository)(nil).CreateWithOwner), ctx, group, role)
+}
+
+// Get mocks base method.
+func (m *MockGroupRepository) Get(ctx context.Context, id uuid.UUID) (*model.Group, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(*model.Group)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockGroupRepositoryMockRecorder) Get(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockGroupRepository)(nil).Get), ctx, id)
+}
+
 // GetByUser mocks base method.
 func (m *MockGroupRepository) GetByUser(ctx context.Context, user uuid.UUID) ([]*model.Group, error) {
 	m.ctrl.T.Helper()
@@ -161,6 +207,21 @@ func (mr *MockGroupRepositoryMockRecorder) GetByUser(ctx, user interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUser", reflect.TypeOf((*MockGroupRepository)(nil).GetByUser), ctx, user)
 }
 
+// GetMember mocks base method.
+func (m *MockGroupRepository) GetMember(ctx context.Context, group, user uuid.UUID) (*model.UserInGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMember", ctx, group, user)
+	ret0, _ := ret[0].(*model.UserInGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMember indicates an expected call of GetMember.
+func (mr *MockGroupRepositoryMockRecorder) GetMember(ctx, group, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMember", reflect.TypeOf((*MockGroupRepository)(nil).GetMember), ctx, group, user)
+}
+
 // GetRoleOfMember mocks base method.
 func (m *MockGroupRepository) GetRoleOfMember(ctx context.Context, user, group uuid.UUID) (*model.Role, error) {
 	m.ctrl.T.Helper()
@@ -191,6 +252,92 @@ func (mr *MockGroupRepositoryMockRecorder) GetUserIDs(ctx, group interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserIDs", reflect.TypeOf((*MockGroupRepository)(nil).GetUserIDs), ctx, group)
 }
 
+// IsAdmin mocks base method.
+func (m *MockGroupRepository) IsAdmin(ctx context.Context, group, user uuid.UUID) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsAdmin", ctx, group, user)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsAdmin indicates an expected call of IsAdmin.
+func (mr *MockGroupRepositoryMockRecorder) IsAdmin(ctx, group, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsAdmin", reflect.TypeOf((*MockGroupRepository)(nil).IsAdmin), ctx, group, user)
+}
+
+// Members mocks base method.
+func (m *MockGroupRepository) Members(ctx context.Context, group uuid.UUID) ([]*model.UserInGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Members", ctx, group)
+	ret0, _ := ret[0].([]*model.UserInGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Members indicates an expected call of Members.
+func (mr *MockGroupRepositoryMockRecorder) Members(ctx, group interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Members", reflect.TypeOf((*MockGroupRepository)(nil).Members), ctx, group)
+}
+
+// RemoveUser mocks base method.
+func (m *MockGroupRepository) RemoveUser(ctx context.Context, group, user uuid.UUID, entry *model.AuditEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveUser", ctx, group, user, entry)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveUser indicates an expected call of RemoveUser.
+func (mr *MockGroupRepositoryMockRecorder) RemoveUser(ctx, group, user, entry interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveUser", reflect.TypeOf((*MockGroupRepository)(nil).RemoveUser), ctx, group, user, entry)
+}
+
+// SetMemberRole mocks base method.
+func (m *MockGroupRepository) SetMemberRole(ctx context.Context, group, user uuid.UUID, role int32, isAdmin bool, entry *model.AuditEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetMemberRole", ctx, group, user, role, isAdmin, entry)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetMemberRole indicates an expected call of SetMemberRole.
+func (mr *MockGroupRepositoryMockRecorder) SetMemberRole(ctx, group, user, role, isAdmin, entry interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMemberRole", reflect.TypeOf((*MockGroupRepository)(nil).SetMemberRole), ctx, group, user, role, isAdmin, entry)
+}
+
+// Stats mocks base method.
+func (m *MockGroupRepository) Stats(ctx context.Context, filter model.GroupStatsFilter) (*model.GroupStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stats", ctx, filter)
+	ret0, _ := ret[0].(*model.GroupStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Stats indicates an expected call of Stats.
+func (mr *MockGroupRepositoryMockRecorder) Stats(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stats", reflect.TypeOf((*MockGroupRepository)(nil).Stats), ctx, filter)
+}
+
+// Update mocks base method.
+func (m *MockGroupRepository) Update(ctx context.Context, group *model.Group) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, group)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockGroupRepositoryMockRecorder) Update(ctx, group interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockGroupRepository)(nil).Update), ctx, group)
+}
+
 // MockTokenRepository is a mock of TokenRepository interface.
 type MockTokenRepository struct {
 	ctrl     *gomock.Controller
@@ -267,17 +414,17 @@ func (m *MockInviteRepository) EXPECT() *MockInviteRepositoryMockRecorder {
 }
 
 // Create mocks base method.
-func (m *MockInviteRepository) Create(ctx context.Context, invite uuid.UUID, role int32, group uuid.UUID, uses int) error {
+func (m *MockInviteRepository) Create(ctx context.Context, invite uuid.UUID, role int32, group uuid.UUID, uses int, createdBy uuid.UUID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", ctx, invite, role, group, uses)
+	ret := m.ctrl.Call(m, "Create", ctx, invite, role, group, uses, createdBy)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockInviteRepositoryMockRecorder) Create(ctx, invite, role, group, uses interface{}) *gomock.Call {
+func (mr *MockInviteRepositoryMockRecorder) Create(ctx, invite, role, group, uses, createdBy interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockInviteRepository)(nil).Create), ctx, invite, role, group, uses)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockInviteRepository)(nil).Create), ctx, invite, role, group, uses, createdBy)
 }
 
 // Exists mocks base method.
@@ -375,18 +522,37 @@ func (mr *MockTaskRepositoryMockRecorder) AllByGroupAndUser(ctx, group, user int
 }
 
 // AllByUser mocks base method.
-func (m *MockTaskRepository) AllByUser(ctx context.Context, user uuid.UUID) ([]*model.Task, error) {
+func (m *MockTaskRepository) AllByUser(ctx context.Context, user uuid.UUID, filter model.TaskFilter) ([]*model.Task, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AllByUser", ctx, user)
+	ret := m.ctrl.Call(m, "AllByUser", ctx, user, filter)
 	ret0, _ := ret[0].([]*model.Task)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AllByUser indicates an expected call of AllByUser.
-func (mr *MockTaskRepositoryMockRecorder) AllByUser(ctx, user interface{}) *gomock.Call {
+func (mr *MockTaskRepositoryMockRecorder) AllByUser(ctx, user, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllByUser", reflect.TypeOf((*MockTaskRepository)(nil).AllByUser), ctx, user, filter)
+}
+
+// Bulk mocks base method.
+func (m *MockTaskRepository) Bulk(ctx context.Context, req *model.BulkTaskRequest, tasks []*model.Task, changes ...*model.TaskChange) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, req, tasks}
+	for _, a := range changes {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Bulk", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Bulk indicates an expected call of Bulk.
+func (mr *MockTaskRepositoryMockRecorder) Bulk(ctx, req, tasks interface{}, changes ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllByUser", reflect.TypeOf((*MockTaskRepository)(nil).AllByUser), ctx, user)
+	varargs := append([]interface{}{ctx, req, tasks}, changes...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Bulk", reflect.TypeOf((*MockTaskRepository)(nil).Bulk), varargs...)
 }
 
 // Create mocks base method.
@@ -403,6 +569,35 @@ func (mr *MockTaskRepositoryMockRecorder) Create(ctx, task interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTaskRepository)(nil).Create), ctx, task)
 }
 
+// CreateWithRelations mocks base method.
+func (m *MockTaskRepository) CreateWithRelations(ctx context.Context, task *model.Task, group *uuid.UUID, users []uuid.UUID, tpl *model.TaskTemplate) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWithRelations", ctx, task, group, users, tpl)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWithRelations indicates an expected call of CreateWithRelations.
+func (mr *MockTaskRepositoryMockRecorder) CreateWithRelations(ctx, task, group, users, tpl interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWithRelations", reflect.TypeOf((*MockTaskRepository)(nil).CreateWithRelations), ctx, task, group, users, tpl)
+}
+
+// Delete mocks base method.
+func (m *MockTaskRepository) Delete(ctx context.Context, task uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, task)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockTaskRepositoryMockRecorder) Delete(ctx, task interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTaskRepository)(nil).Delete), ctx, task)
+}
+
 // ForceAddToUser mocks base method.
 func (m *MockTaskRepository) ForceAddToUser(ctx context.Context, user, task uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -432,174 +627,1661 @@ func (mr *MockTaskRepositoryMockRecorder) GetByUserAndID(ctx, user, task interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUserAndID", reflect.TypeOf((*MockTaskRepository)(nil).GetByUserAndID), ctx, user, task)
 }
 
-// MockRoleRepository is a mock of RoleRepository interface.
-type MockRoleRepository struct {
-	ctrl     *gomock.Controller
-	recorder *MockRoleRepositoryMockRecorder
+// GetRoleOfMember mocks base method.
+func (m *MockTaskRepository) GetRoleOfMember(ctx context.Context, user, task uuid.UUID) (*model.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRoleOfMember", ctx, user, task)
+	ret0, _ := ret[0].(*model.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// MockRoleRepositoryMockRecorder is the mock recorder for MockRoleRepository.
-type MockRoleRepositoryMockRecorder struct {
-	mock *MockRoleRepository
+// GetRoleOfMember indicates an expected call of GetRoleOfMember.
+func (mr *MockTaskRepositoryMockRecorder) GetRoleOfMember(ctx, user, task interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoleOfMember", reflect.TypeOf((*MockTaskRepository)(nil).GetRoleOfMember), ctx, user, task)
 }
 
-// NewMockRoleRepository creates a new mock instance.
-func NewMockRoleRepository(ctrl *gomock.Controller) *MockRoleRepository {
-	mock := &MockRoleRepository{ctrl: ctrl}
-	mock.recorder = &MockRoleRepositoryMockRecorder{mock}
-	return mock
+// InGroup mocks base method.
+func (m *MockTaskRepository) InGroup(ctx context.Context, task, group uuid.UUID) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InGroup", ctx, task, group)
+	ret0, _ := ret[0].(bool)
+	return ret0
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockRoleRepository) EXPECT() *MockRoleRepositoryMockRecorder {
-	return m.recorder
+// InGroup indicates an expected call of InGroup.
+func (mr *MockTaskRepositoryMockRecorder) InGroup(ctx, task, group interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InGroup", reflect.TypeOf((*MockTaskRepository)(nil).InGroup), ctx, task, group)
 }
 
-// Create mocks base method.
-func (m *MockRoleRepository) Create(ctx context.Context, role *model.Role) error {
+// IsAssignee mocks base method.
+func (m *MockTaskRepository) IsAssignee(ctx context.Context, task, user uuid.UUID) bool {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", ctx, role)
+	ret := m.ctrl.Call(m, "IsAssignee", ctx, task, user)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsAssignee indicates an expected call of IsAssignee.
+func (mr *MockTaskRepositoryMockRecorder) IsAssignee(ctx, task, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsAssignee", reflect.TypeOf((*MockTaskRepository)(nil).IsAssignee), ctx, task, user)
+}
+
+// Search mocks base method.
+func (m *MockTaskRepository) Search(ctx context.Context, user uuid.UUID, query string, limit int) ([]*model.SearchResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, user, query, limit)
+	ret0, _ := ret[0].([]*model.SearchResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockTaskRepositoryMockRecorder) Search(ctx, user, query, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockTaskRepository)(nil).Search), ctx, user, query, limit)
+}
+
+// ShareGroup mocks base method.
+func (m *MockTaskRepository) ShareGroup(ctx context.Context, a, b uuid.UUID) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShareGroup", ctx, a, b)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// ShareGroup indicates an expected call of ShareGroup.
+func (mr *MockTaskRepositoryMockRecorder) ShareGroup(ctx, a, b interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShareGroup", reflect.TypeOf((*MockTaskRepository)(nil).ShareGroup), ctx, a, b)
+}
+
+// Update mocks base method.
+func (m *MockTaskRepository) Update(ctx context.Context, task *model.Task, changes ...*model.TaskChange) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, task}
+	for _, a := range changes {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Update", varargs...)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// Create indicates an expected call of Create.
-func (mr *MockRoleRepositoryMockRecorder) Create(ctx, role interface{}) *gomock.Call {
+// Update indicates an expected call of Update.
+func (mr *MockTaskRepositoryMockRecorder) Update(ctx, task interface{}, changes ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRoleRepository)(nil).Create), ctx, role)
+	varargs := append([]interface{}{ctx, task}, changes...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTaskRepository)(nil).Update), varargs...)
 }
 
-// Get mocks base method.
-func (m *MockRoleRepository) Get(ctx context.Context, role *model.Role) error {
+// UpdateStatus mocks base method.
+func (m *MockTaskRepository) UpdateStatus(ctx context.Context, task uuid.UUID, from, to model.TaskStatus, changes ...*model.TaskChange) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Get", ctx, role)
+	varargs := []interface{}{ctx, task, from, to}
+	for _, a := range changes {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateStatus", varargs...)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// Get indicates an expected call of Get.
-func (mr *MockRoleRepositoryMockRecorder) Get(ctx, role interface{}) *gomock.Call {
+// UpdateStatus indicates an expected call of UpdateStatus.
+func (mr *MockTaskRepositoryMockRecorder) UpdateStatus(ctx, task, from, to interface{}, changes ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockRoleRepository)(nil).Get), ctx, role)
+	varargs := append([]interface{}{ctx, task, from, to}, changes...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockTaskRepository)(nil).UpdateStatus), varargs...)
 }
 
-// MockStore is a mock of Store interface.
-type MockStore struct {
+// MockReviewRepository is a mock of ReviewRepository interface.
+type MockReviewRepository struct {
 	ctrl     *gomock.Controller
-	recorder *MockStoreMockRecorder
+	recorder *MockReviewRepositoryMockRecorder
 }
 
-// MockStoreMockRecorder is the mock recorder for MockStore.
-type MockStoreMockRecorder struct {
-	mock *MockStore
+// MockReviewRepositoryMockRecorder is the mock recorder for MockReviewRepository.
+type MockReviewRepositoryMockRecorder struct {
+	mock *MockReviewRepository
 }
 
-// NewMockStore creates a new mock instance.
-func NewMockStore(ctrl *gomock.Controller) *MockStore {
-	mock := &MockStore{ctrl: ctrl}
-	mock.recorder = &MockStoreMockRecorder{mock}
+// NewMockReviewRepository creates a new mock instance.
+func NewMockReviewRepository(ctrl *gomock.Controller) *MockReviewRepository {
+	mock := &MockReviewRepository{ctrl: ctrl}
+	mock.recorder = &MockReviewRepositoryMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockStore) EXPECT() *MockStoreMockRecorder {
+func (m *MockReviewRepository) EXPECT() *MockReviewRepositoryMockRecorder {
 	return m.recorder
 }
 
-// Group mocks base method.
-func (m *MockStore) Group() store.GroupRepository {
+// Create mocks base method.
+func (m *MockReviewRepository) Create(ctx context.Context, review *model.Review, from model.TaskStatus, changes ...*model.TaskChange) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Group")
-	ret0, _ := ret[0].(store.GroupRepository)
+	varargs := []interface{}{ctx, review, from}
+	for _, a := range changes {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Create", varargs...)
+	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// Group indicates an expected call of Group.
-func (mr *MockStoreMockRecorder) Group() *gomock.Call {
+// Create indicates an expected call of Create.
+func (mr *MockReviewRepositoryMockRecorder) Create(ctx, review, from interface{}, changes ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Group", reflect.TypeOf((*MockStore)(nil).Group))
+	varargs := append([]interface{}{ctx, review, from}, changes...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockReviewRepository)(nil).Create), varargs...)
 }
 
-// Invite mocks base method.
-func (m *MockStore) Invite() store.InviteRepository {
+// Get mocks base method.
+func (m *MockReviewRepository) Get(ctx context.Context, id uuid.UUID) (*model.Review, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Invite")
-	ret0, _ := ret[0].(store.InviteRepository)
-	return ret0
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(*model.Review)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// Invite indicates an expected call of Invite.
-func (mr *MockStoreMockRecorder) Invite() *gomock.Call {
+// Get indicates an expected call of Get.
+func (mr *MockReviewRepositoryMockRecorder) Get(ctx, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Invite", reflect.TypeOf((*MockStore)(nil).Invite))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockReviewRepository)(nil).Get), ctx, id)
 }
 
-// Ping mocks base method.
-func (m *MockStore) Ping(ctx context.Context) error {
+// PendingByGroup mocks base method.
+func (m *MockReviewRepository) PendingByGroup(ctx context.Context, group uuid.UUID) ([]*model.Review, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Ping", ctx)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "PendingByGroup", ctx, group)
+	ret0, _ := ret[0].([]*model.Review)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// Ping indicates an expected call of Ping.
-func (mr *MockStoreMockRecorder) Ping(ctx interface{}) *gomock.Call {
+// PendingByGroup indicates an expected call of PendingByGroup.
+func (mr *MockReviewRepositoryMockRecorder) PendingByGroup(ctx, group interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ping", reflect.TypeOf((*MockStore)(nil).Ping), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PendingByGroup", reflect.TypeOf((*MockReviewRepository)(nil).PendingByGroup), ctx, group)
 }
 
-// Role mocks base method.
-func (m *MockStore) Role() store.RoleRepository {
+// Resolve mocks base method.
+func (m *MockReviewRepository) Resolve(ctx context.Context, review *model.Review, status model.TaskStatus, changes ...*model.TaskChange) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Role")
-	ret0, _ := ret[0].(store.RoleRepository)
+	varargs := []interface{}{ctx, review, status}
+	for _, a := range changes {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Resolve", varargs...)
+	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// Role indicates an expected call of Role.
-func (mr *MockStoreMockRecorder) Role() *gomock.Call {
+// Resolve indicates an expected call of Resolve.
+func (mr *MockReviewRepositoryMockRecorder) Resolve(ctx, review, status interface{}, changes ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Role", reflect.TypeOf((*MockStore)(nil).Role))
+	varargs := append([]interface{}{ctx, review, status}, changes...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resolve", reflect.TypeOf((*MockReviewRepository)(nil).Resolve), varargs...)
 }
 
-// Task mocks base method.
-func (m *MockStore) Task() store.TaskRepository {
+// MockCommentRepository is a mock of CommentRepository interface.
+type MockCommentRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCommentRepositoryMockRecorder
+}
+
+// MockCommentRepositoryMockRecorder is the mock recorder for MockCommentRepository.
+type MockCommentRepositoryMockRecorder struct {
+	mock *MockCommentRepository
+}
+
+// NewMockCommentRepository creates a new mock instance.
+func NewMockCommentRepository(ctrl *gomock.Controller) *MockCommentRepository {
+	mock := &MockCommentRepository{ctrl: ctrl}
+	mock.recorder = &MockCommentRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCommentRepository) EXPECT() *MockCommentRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AllByTask mocks base method.
+func (m *MockCommentRepository) AllByTask(ctx context.Context, task uuid.UUID) ([]*model.Comment, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Task")
-	ret0, _ := ret[0].(store.TaskRepository)
-	return ret0
+	ret := m.ctrl.Call(m, "AllByTask", ctx, task)
+	ret0, _ := ret[0].([]*model.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// Task indicates an expected call of Task.
-func (mr *MockStoreMockRecorder) Task() *gomock.Call {
+// AllByTask indicates an expected call of AllByTask.
+func (mr *MockCommentRepositoryMockRecorder) AllByTask(ctx, task interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Task", reflect.TypeOf((*MockStore)(nil).Task))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllByTask", reflect.TypeOf((*MockCommentRepository)(nil).AllByTask), ctx, task)
 }
 
-// Token mocks base method.
-func (m *MockStore) Token() store.TokenRepository {
+// Create mocks base method.
+func (m *MockCommentRepository) Create(ctx context.Context, comment *model.Comment) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Token")
-	ret0, _ := ret[0].(store.TokenRepository)
+	ret := m.ctrl.Call(m, "Create", ctx, comment)
+	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// Token indicates an expected call of Token.
-func (mr *MockStoreMockRecorder) Token() *gomock.Call {
+// Create indicates an expected call of Create.
+func (mr *MockCommentRepositoryMockRecorder) Create(ctx, comment interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Token", reflect.TypeOf((*MockStore)(nil).Token))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCommentRepository)(nil).Create), ctx, comment)
 }
 
-// User mocks base method.
-func (m *MockStore) User() store.UserRepository {
+// Delete mocks base method.
+func (m *MockCommentRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "User")
-	ret0, _ := ret[0].(store.UserRepository)
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// User indicates an expected call of User.
+// Delete indicates an expected call of Delete.
+func (mr *MockCommentRepositoryMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockCommentRepository)(nil).Delete), ctx, id)
+}
+
+// Get mocks base method.
+func (m *MockCommentRepository) Get(ctx context.Context, id uuid.UUID) (*model.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(*model.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockCommentRepositoryMockRecorder) Get(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockCommentRepository)(nil).Get), ctx, id)
+}
+
+// History mocks base method.
+func (m *MockCommentRepository) History(ctx context.Context, id uuid.UUID) ([]*model.CommentEdit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "History", ctx, id)
+	ret0, _ := ret[0].([]*model.CommentEdit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// History indicates an expected call of History.
+func (mr *MockCommentRepositoryMockRecorder) History(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "History", reflect.TypeOf((*MockCommentRepository)(nil).History), ctx, id)
+}
+
+// Update mocks base method.
+func (m *MockCommentRepository) Update(ctx context.Context, comment *model.Comment, editor uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, comment, editor)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockCommentRepositoryMockRecorder) Update(ctx, comment, editor interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockCommentRepository)(nil).Update), ctx, comment, editor)
+}
+
+// MockDependencyRepository is a mock of DependencyRepository interface.
+type MockDependencyRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockDependencyRepositoryMockRecorder
+}
+
+// MockDependencyRepositoryMockRecorder is the mock recorder for MockDependencyRepository.
+type MockDependencyRepositoryMockRecorder struct {
+	mock *MockDependencyRepository
+}
+
+// NewMockDependencyRepository creates a new mock instance.
+func NewMockDependencyRepository(ctrl *gomock.Controller) *MockDependencyRepository {
+	mock := &MockDependencyRepository{ctrl: ctrl}
+	mock.recorder = &MockDependencyRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDependencyRepository) EXPECT() *MockDependencyRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockDependencyRepository) Create(ctx context.Context, dep *model.TaskDependency, check func([]*model.TaskDependency) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, dep, check)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockDependencyRepositoryMockRecorder) Create(ctx, dep, check interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockDependencyRepository)(nil).Create), ctx, dep, check)
+}
+
+// Delete mocks base method.
+func (m *MockDependencyRepository) Delete(ctx context.Context, blocker, blocked uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, blocker, blocked)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockDependencyRepositoryMockRecorder) Delete(ctx, blocker, blocked interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDependencyRepository)(nil).Delete), ctx, blocker, blocked)
+}
+
+// GraphByGroup mocks base method.
+func (m *MockDependencyRepository) GraphByGroup(ctx context.Context, group uuid.UUID) (*model.DependencyGraph, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GraphByGroup", ctx, group)
+	ret0, _ := ret[0].(*model.DependencyGraph)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GraphByGroup indicates an expected call of GraphByGroup.
+func (mr *MockDependencyRepositoryMockRecorder) GraphByGroup(ctx, group interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GraphByGroup", reflect.TypeOf((*MockDependencyRepository)(nil).GraphByGroup), ctx, group)
+}
+
+// OpenBlockers mocks base method.
+func (m *MockDependencyRepository) OpenBlockers(ctx context.Context, task uuid.UUID) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OpenBlockers", ctx, task)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OpenBlockers indicates an expected call of OpenBlockers.
+func (mr *MockDependencyRepositoryMockRecorder) OpenBlockers(ctx, task interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenBlockers", reflect.TypeOf((*MockDependencyRepository)(nil).OpenBlockers), ctx, task)
+}
+
+// MockLabelRepository is a mock of LabelRepository interface.
+type MockLabelRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLabelRepositoryMockRecorder
+}
+
+// MockLabelRepositoryMockRecorder is the mock recorder for MockLabelRepository.
+type MockLabelRepositoryMockRecorder struct {
+	mock *MockLabelRepository
+}
+
+// NewMockLabelRepository creates a new mock instance.
+func NewMockLabelRepository(ctrl *gomock.Controller) *MockLabelRepository {
+	mock := &MockLabelRepository{ctrl: ctrl}
+	mock.recorder = &MockLabelRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLabelRepository) EXPECT() *MockLabelRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AllByGroup mocks base method.
+func (m *MockLabelRepository) AllByGroup(ctx context.Context, group uuid.UUID) ([]*model.Label, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllByGroup", ctx, group)
+	ret0, _ := ret[0].([]*model.Label)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AllByGroup indicates an expected call of AllByGroup.
+func (mr *MockLabelRepositoryMockRecorder) AllByGroup(ctx, group interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllByGroup", reflect.TypeOf((*MockLabelRepository)(nil).AllByGroup), ctx, group)
+}
+
+// Attach mocks base method.
+func (m *MockLabelRepository) Attach(ctx context.Context, task, label uuid.UUID, changes ...*model.TaskChange) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, task, label}
+	for _, a := range changes {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Attach", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Attach indicates an expected call of Attach.
+func (mr *MockLabelRepositoryMockRecorder) Attach(ctx, task, label interface{}, changes ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, task, label}, changes...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Attach", reflect.TypeOf((*MockLabelRepository)(nil).Attach), varargs...)
+}
+
+// Create mocks base method.
+func (m *MockLabelRepository) Create(ctx context.Context, label *model.Label) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, label)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockLabelRepositoryMockRecorder) Create(ctx, label interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockLabelRepository)(nil).Create), ctx, label)
+}
+
+// Delete mocks base method.
+func (m *MockLabelRepository) Delete(ctx context.Context, id, actor uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id, actor)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockLabelRepositoryMockRecorder) Delete(ctx, id, actor interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockLabelRepository)(nil).Delete), ctx, id, actor)
+}
+
+// Detach mocks base method.
+func (m *MockLabelRepository) Detach(ctx context.Context, task, label uuid.UUID, changes ...*model.TaskChange) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, task, label}
+	for _, a := range changes {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Detach", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Detach indicates an expected call of Detach.
+func (mr *MockLabelRepositoryMockRecorder) Detach(ctx, task, label interface{}, changes ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, task, label}, changes...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Detach", reflect.TypeOf((*MockLabelRepository)(nil).Detach), varargs...)
+}
+
+// Get mocks base method.
+func (m *MockLabelRepository) Get(ctx context.Context, id uuid.UUID) (*model.Label, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(*model.Label)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockLabelRepositoryMockRecorder) Get(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockLabelRepository)(nil).Get), ctx, id)
+}
+
+// Update mocks base method.
+func (m *MockLabelRepository) Update(ctx context.Context, label *model.Label) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, label)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockLabelRepositoryMockRecorder) Update(ctx, label interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockLabelRepository)(nil).Update), ctx, label)
+}
+
+// MockBoardRepository is a mock of BoardRepository interface.
+type MockBoardRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockBoardRepositoryMockRecorder
+}
+
+// MockBoardRepositoryMockRecorder is the mock recorder for MockBoardRepository.
+type MockBoardRepositoryMockRecorder struct {
+	mock *MockBoardRepository
+}
+
+// NewMockBoardRepository creates a new mock instance.
+func NewMockBoardRepository(ctrl *gomock.Controller) *MockBoardRepository {
+	mock := &MockBoardRepository{ctrl: ctrl}
+	mock.recorder = &MockBoardRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBoardRepository) EXPECT() *MockBoardRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Limits mocks base method.
+func (m *MockBoardRepository) Limits(ctx context.Context, group uuid.UUID) (map[model.TaskStatus]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limits", ctx, group)
+	ret0, _ := ret[0].(map[model.TaskStatus]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Limits indicates an expected call of Limits.
+func (mr *MockBoardRepositoryMockRecorder) Limits(ctx, group interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limits", reflect.TypeOf((*MockBoardRepository)(nil).Limits), ctx, group)
+}
+
+// Move mocks base method.
+func (m *MockBoardRepository) Move(ctx context.Context, group, task uuid.UUID, from, to model.TaskStatus, position int, changes ...*model.TaskChange) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, group, task, from, to, position}
+	for _, a := range changes {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Move", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Move indicates an expected call of Move.
+func (mr *MockBoardRepositoryMockRecorder) Move(ctx, group, task, from, to, position interface{}, changes ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, group, task, from, to, position}, changes...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Move", reflect.TypeOf((*MockBoardRepository)(nil).Move), varargs...)
+}
+
+// SetLimits mocks base method.
+func (m *MockBoardRepository) SetLimits(ctx context.Context, group uuid.UUID, limits map[model.TaskStatus]*int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetLimits", ctx, group, limits)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetLimits indicates an expected call of SetLimits.
+func (mr *MockBoardRepositoryMockRecorder) SetLimits(ctx, group, limits interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLimits", reflect.TypeOf((*MockBoardRepository)(nil).SetLimits), ctx, group, limits)
+}
+
+// MockTemplateRepository is a mock of TemplateRepository interface.
+type MockTemplateRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTemplateRepositoryMockRecorder
+}
+
+// MockTemplateRepositoryMockRecorder is the mock recorder for MockTemplateRepository.
+type MockTemplateRepositoryMockRecorder struct {
+	mock *MockTemplateRepository
+}
+
+// NewMockTemplateRepository creates a new mock instance.
+func NewMockTemplateRepository(ctrl *gomock.Controller) *MockTemplateRepository {
+	mock := &MockTemplateRepository{ctrl: ctrl}
+	mock.recorder = &MockTemplateRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTemplateRepository) EXPECT() *MockTemplateRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AllByGroup mocks base method.
+func (m *MockTemplateRepository) AllByGroup(ctx context.Context, group uuid.UUID) ([]*model.TaskTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllByGroup", ctx, group)
+	ret0, _ := ret[0].([]*model.TaskTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AllByGroup indicates an expected call of AllByGroup.
+func (mr *MockTemplateRepositoryMockRecorder) AllByGroup(ctx, group interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllByGroup", reflect.TypeOf((*MockTemplateRepository)(nil).AllByGroup), ctx, group)
+}
+
+// Apply mocks base method.
+func (m *MockTemplateRepository) Apply(ctx context.Context, tpl *model.TaskTemplate, task uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Apply", ctx, tpl, task)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Apply indicates an expected call of Apply.
+func (mr *MockTemplateRepositoryMockRecorder) Apply(ctx, tpl, task interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Apply", reflect.TypeOf((*MockTemplateRepository)(nil).Apply), ctx, tpl, task)
+}
+
+// Create mocks base method.
+func (m *MockTemplateRepository) Create(ctx context.Context, tpl *model.TaskTemplate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, tpl)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockTemplateRepositoryMockRecorder) Create(ctx, tpl interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTemplateRepository)(nil).Create), ctx, tpl)
+}
+
+// Delete mocks base method.
+func (m *MockTemplateRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockTemplateRepositoryMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTemplateRepository)(nil).Delete), ctx, id)
+}
+
+// Due mocks base method.
+func (m *MockTemplateRepository) Due(ctx context.Context, now time.Time, limit int) ([]*model.TaskTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Due", ctx, now, limit)
+	ret0, _ := ret[0].([]*model.TaskTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Due indicates an expected call of Due.
+func (mr *MockTemplateRepositoryMockRecorder) Due(ctx, now, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Due", reflect.TypeOf((*MockTemplateRepository)(nil).Due), ctx, now, limit)
+}
+
+// Get mocks base method.
+func (m *MockTemplateRepository) Get(ctx context.Context, id uuid.UUID) (*model.TaskTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(*model.TaskTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockTemplateRepositoryMockRecorder) Get(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockTemplateRepository)(nil).Get), ctx, id)
+}
+
+// Materialize mocks base method.
+func (m *MockTemplateRepository) Materialize(ctx context.Context, tpl *model.TaskTemplate, task *model.Task, next *time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Materialize", ctx, tpl, task, next)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Materialize indicates an expected call of Materialize.
+func (mr *MockTemplateRepositoryMockRecorder) Materialize(ctx, tpl, task, next interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Materialize", reflect.TypeOf((*MockTemplateRepository)(nil).Materialize), ctx, tpl, task, next)
+}
+
+// Update mocks base method.
+func (m *MockTemplateRepository) Update(ctx context.Context, tpl *model.TaskTemplate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, tpl)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockTemplateRepositoryMockRecorder) Update(ctx, tpl interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTemplateRepository)(nil).Update), ctx, tpl)
+}
+
+// MockHistoryRepository is a mock of HistoryRepository interface.
+type MockHistoryRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockHistoryRepositoryMockRecorder
+}
+
+// MockHistoryRepositoryMockRecorder is the mock recorder for MockHistoryRepository.
+type MockHistoryRepositoryMockRecorder struct {
+	mock *MockHistoryRepository
+}
+
+// NewMockHistoryRepository creates a new mock instance.
+func NewMockHistoryRepository(ctrl *gomock.Controller) *MockHistoryRepository {
+	mock := &MockHistoryRepository{ctrl: ctrl}
+	mock.recorder = &MockHistoryRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHistoryRepository) EXPECT() *MockHistoryRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockHistoryRepository) Add(ctx context.Context, changes ...*model.TaskChange) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range changes {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Add", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockHistoryRepositoryMockRecorder) Add(ctx interface{}, changes ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, changes...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockHistoryRepository)(nil).Add), varargs...)
+}
+
+// AllByTask mocks base method.
+func (m *MockHistoryRepository) AllByTask(ctx context.Context, task uuid.UUID) ([]*model.TaskChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllByTask", ctx, task)
+	ret0, _ := ret[0].([]*model.TaskChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AllByTask indicates an expected call of AllByTask.
+func (mr *MockHistoryRepositoryMockRecorder) AllByTask(ctx, task interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllByTask", reflect.TypeOf((*MockHistoryRepository)(nil).AllByTask), ctx, task)
+}
+
+// MockAttachmentRepository is a mock of AttachmentRepository interface.
+type MockAttachmentRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAttachmentRepositoryMockRecorder
+}
+
+// MockAttachmentRepositoryMockRecorder is the mock recorder for MockAttachmentRepository.
+type MockAttachmentRepositoryMockRecorder struct {
+	mock *MockAttachmentRepository
+}
+
+// NewMockAttachmentRepository creates a new mock instance.
+func NewMockAttachmentRepository(ctrl *gomock.Controller) *MockAttachmentRepository {
+	mock := &MockAttachmentRepository{ctrl: ctrl}
+	mock.recorder = &MockAttachmentRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAttachmentRepository) EXPECT() *MockAttachmentRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AllByTask mocks base method.
+func (m *MockAttachmentRepository) AllByTask(ctx context.Context, task uuid.UUID) ([]*model.Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllByTask", ctx, task)
+	ret0, _ := ret[0].([]*model.Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AllByTask indicates an expected call of AllByTask.
+func (mr *MockAttachmentRepositoryMockRecorder) AllByTask(ctx, task interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllByTask", reflect.TypeOf((*MockAttachmentRepository)(nil).AllByTask), ctx, task)
+}
+
+// Create mocks base method.
+func (m *MockAttachmentRepository) Create(ctx context.Context, attachment *model.Attachment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, attachment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockAttachmentRepositoryMockRecorder) Create(ctx, attachment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAttachmentRepository)(nil).Create), ctx, attachment)
+}
+
+// Delete mocks base method.
+func (m *MockAttachmentRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockAttachmentRepositoryMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockAttachmentRepository)(nil).Delete), ctx, id)
+}
+
+// ForgetOrphaned mocks base method.
+func (m *MockAttachmentRepository) ForgetOrphaned(ctx context.Context, keys []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ForgetOrphaned", ctx, keys)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ForgetOrphaned indicates an expected call of ForgetOrphaned.
+func (mr *MockAttachmentRepositoryMockRecorder) ForgetOrphaned(ctx, keys interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForgetOrphaned", reflect.TypeOf((*MockAttachmentRepository)(nil).ForgetOrphaned), ctx, keys)
+}
+
+// Get mocks base method.
+func (m *MockAttachmentRepository) Get(ctx context.Context, id uuid.UUID) (*model.Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(*model.Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockAttachmentRepositoryMockRecorder) Get(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockAttachmentRepository)(nil).Get), ctx, id)
+}
+
+// Orphaned mocks base method.
+func (m *MockAttachmentRepository) Orphaned(ctx context.Context, limit int) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Orphaned", ctx, limit)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Orphaned indicates an expected call of Orphaned.
+func (mr *MockAttachmentRepositoryMockRecorder) Orphaned(ctx, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Orphaned", reflect.TypeOf((*MockAttachmentRepository)(nil).Orphaned), ctx, limit)
+}
+
+// MockChecklistRepository is a mock of ChecklistRepository interface.
+type MockChecklistRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockChecklistRepositoryMockRecorder
+}
+
+// MockChecklistRepositoryMockRecorder is the mock recorder for MockChecklistRepository.
+type MockChecklistRepositoryMockRecorder struct {
+	mock *MockChecklistRepository
+}
+
+// NewMockChecklistRepository creates a new mock instance.
+func NewMockChecklistRepository(ctrl *gomock.Controller) *MockChecklistRepository {
+	mock := &MockChecklistRepository{ctrl: ctrl}
+	mock.recorder = &MockChecklistRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChecklistRepository) EXPECT() *MockChecklistRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AllByTask mocks base method.
+func (m *MockChecklistRepository) AllByTask(ctx context.Context, task uuid.UUID) ([]*model.ChecklistItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllByTask", ctx, task)
+	ret0, _ := ret[0].([]*model.ChecklistItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AllByTask indicates an expected call of AllByTask.
+func (mr *MockChecklistRepositoryMockRecorder) AllByTask(ctx, task interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllByTask", reflect.TypeOf((*MockChecklistRepository)(nil).AllByTask), ctx, task)
+}
+
+// Create mocks base method.
+func (m *MockChecklistRepository) Create(ctx context.Context, item *model.ChecklistItem) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, item)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockChecklistRepositoryMockRecorder) Create(ctx, item interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockChecklistRepository)(nil).Create), ctx, item)
+}
+
+// Delete mocks base method.
+func (m *MockChecklistRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockChecklistRepositoryMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockChecklistRepository)(nil).Delete), ctx, id)
+}
+
+// Get mocks base method.
+func (m *MockChecklistRepository) Get(ctx context.Context, id uuid.UUID) (*model.ChecklistItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(*model.ChecklistItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockChecklistRepositoryMockRecorder) Get(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockChecklistRepository)(nil).Get), ctx, id)
+}
+
+// Move mocks base method.
+func (m *MockChecklistRepository) Move(ctx context.Context, task, item uuid.UUID, position int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Move", ctx, task, item, position)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Move indicates an expected call of Move.
+func (mr *MockChecklistRepositoryMockRecorder) Move(ctx, task, item, position interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Move", reflect.TypeOf((*MockChecklistRepository)(nil).Move), ctx, task, item, position)
+}
+
+// SetDone mocks base method.
+func (m *MockChecklistRepository) SetDone(ctx context.Context, id uuid.UUID, done bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDone", ctx, id, done)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetDone indicates an expected call of SetDone.
+func (mr *MockChecklistRepositoryMockRecorder) SetDone(ctx, id, done interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDone", reflect.TypeOf((*MockChecklistRepository)(nil).SetDone), ctx, id, done)
+}
+
+// MockWorklogRepository is a mock of WorklogRepository interface.
+type MockWorklogRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockWorklogRepositoryMockRecorder
+}
+
+// MockWorklogRepositoryMockRecorder is the mock recorder for MockWorklogRepository.
+type MockWorklogRepositoryMockRecorder struct {
+	mock *MockWorklogRepository
+}
+
+// NewMockWorklogRepository creates a new mock instance.
+func NewMockWorklogRepository(ctrl *gomock.Controller) *MockWorklogRepository {
+	mock := &MockWorklogRepository{ctrl: ctrl}
+	mock.recorder = &MockWorklogRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWorklogRepository) EXPECT() *MockWorklogRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AllByTask mocks base method.
+func (m *MockWorklogRepository) AllByTask(ctx context.Context, task uuid.UUID) ([]*model.Worklog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllByTask", ctx, task)
+	ret0, _ := ret[0].([]*model.Worklog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AllByTask indicates an expected call of AllByTask.
+func (mr *MockWorklogRepositoryMockRecorder) AllByTask(ctx, task interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllByTask", reflect.TypeOf((*MockWorklogRepository)(nil).AllByTask), ctx, task)
+}
+
+// Create mocks base method.
+func (m *MockWorklogRepository) Create(ctx context.Context, w *model.Worklog) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, w)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockWorklogRepositoryMockRecorder) Create(ctx, w interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockWorklogRepository)(nil).Create), ctx, w)
+}
+
+// Delete mocks base method.
+func (m *MockWorklogRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockWorklogRepositoryMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockWorklogRepository)(nil).Delete), ctx, id)
+}
+
+// Get mocks base method.
+func (m *MockWorklogRepository) Get(ctx context.Context, id uuid.UUID) (*model.Worklog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(*model.Worklog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockWorklogRepositoryMockRecorder) Get(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockWorklogRepository)(nil).Get), ctx, id)
+}
+
+// Report mocks base method.
+func (m *MockWorklogRepository) Report(ctx context.Context, filter model.WorklogReportFilter) ([]*model.WorklogReportRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Report", ctx, filter)
+	ret0, _ := ret[0].([]*model.WorklogReportRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Report indicates an expected call of Report.
+func (mr *MockWorklogRepositoryMockRecorder) Report(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Report", reflect.TypeOf((*MockWorklogRepository)(nil).Report), ctx, filter)
+}
+
+// Running mocks base method.
+func (m *MockWorklogRepository) Running(ctx context.Context, user uuid.UUID) (*model.Worklog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Running", ctx, user)
+	ret0, _ := ret[0].(*model.Worklog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Running indicates an expected call of Running.
+func (mr *MockWorklogRepositoryMockRecorder) Running(ctx, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Running", reflect.TypeOf((*MockWorklogRepository)(nil).Running), ctx, user)
+}
+
+// Stop mocks base method.
+func (m *MockWorklogRepository) Stop(ctx context.Context, user uuid.UUID, at time.Time) (*model.Worklog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stop", ctx, user, at)
+	ret0, _ := ret[0].(*model.Worklog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Stop indicates an expected call of Stop.
+func (mr *MockWorklogRepositoryMockRecorder) Stop(ctx, user, at interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockWorklogRepository)(nil).Stop), ctx, user, at)
+}
+
+// MockWatcherRepository is a mock of WatcherRepository interface.
+type MockWatcherRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockWatcherRepositoryMockRecorder
+}
+
+// MockWatcherRepositoryMockRecorder is the mock recorder for MockWatcherRepository.
+type MockWatcherRepositoryMockRecorder struct {
+	mock *MockWatcherRepository
+}
+
+// NewMockWatcherRepository creates a new mock instance.
+func NewMockWatcherRepository(ctrl *gomock.Controller) *MockWatcherRepository {
+	mock := &MockWatcherRepository{ctrl: ctrl}
+	mock.recorder = &MockWatcherRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWatcherRepository) EXPECT() *MockWatcherRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AllByTask mocks base method.
+func (m *MockWatcherRepository) AllByTask(ctx context.Context, task uuid.UUID) ([]*model.Watcher, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllByTask", ctx, task)
+	ret0, _ := ret[0].([]*model.Watcher)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AllByTask indicates an expected call of AllByTask.
+func (mr *MockWatcherRepositoryMockRecorder) AllByTask(ctx, task interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllByTask", reflect.TypeOf((*MockWatcherRepository)(nil).AllByTask), ctx, task)
+}
+
+// IsWatcher mocks base method.
+func (m *MockWatcherRepository) IsWatcher(ctx context.Context, task, user uuid.UUID) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsWatcher", ctx, task, user)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsWatcher indicates an expected call of IsWatcher.
+func (mr *MockWatcherRepositoryMockRecorder) IsWatcher(ctx, task, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsWatcher", reflect.TypeOf((*MockWatcherRepository)(nil).IsWatcher), ctx, task, user)
+}
+
+// Notifications mocks base method.
+func (m *MockWatcherRepository) Notifications(ctx context.Context, user uuid.UUID, after int64, limit int) ([]*model.TaskChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Notifications", ctx, user, after, limit)
+	ret0, _ := ret[0].([]*model.TaskChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Notifications indicates an expected call of Notifications.
+func (mr *MockWatcherRepositoryMockRecorder) Notifications(ctx, user, after, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Notifications", reflect.TypeOf((*MockWatcherRepository)(nil).Notifications), ctx, user, after, limit)
+}
+
+// Unwatch mocks base method.
+func (m *MockWatcherRepository) Unwatch(ctx context.Context, task, user uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unwatch", ctx, task, user)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unwatch indicates an expected call of Unwatch.
+func (mr *MockWatcherRepositoryMockRecorder) Unwatch(ctx, task, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unwatch", reflect.TypeOf((*MockWatcherRepository)(nil).Unwatch), ctx, task, user)
+}
+
+// Watch mocks base method.
+func (m *MockWatcherRepository) Watch(ctx context.Context, task, user uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Watch", ctx, task, user)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Watch indicates an expected call of Watch.
+func (mr *MockWatcherRepositoryMockRecorder) Watch(ctx, task, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Watch", reflect.TypeOf((*MockWatcherRepository)(nil).Watch), ctx, task, user)
+}
+
+// MockBlobStore is a mock of BlobStore interface.
+type MockBlobStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockBlobStoreMockRecorder
+}
+
+// MockBlobStoreMockRecorder is the mock recorder for MockBlobStore.
+type MockBlobStoreMockRecorder struct {
+	mock *MockBlobStore
+}
+
+// NewMockBlobStore creates a new mock instance.
+func NewMockBlobStore(ctrl *gomock.Controller) *MockBlobStore {
+	mock := &MockBlobStore{ctrl: ctrl}
+	mock.recorder = &MockBlobStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBlobStore) EXPECT() *MockBlobStoreMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockBlobStore) Delete(ctx context.Context, keys ...string) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range keys {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Delete", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockBlobStoreMockRecorder) Delete(ctx interface{}, keys ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, keys...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockBlobStore)(nil).Delete), varargs...)
+}
+
+// Get mocks base method.
+func (m *MockBlobStore) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, key)
+	ret0, _ := ret[0].(io.ReadCloser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockBlobStoreMockRecorder) Get(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockBlobStore)(nil).Get), ctx, key)
+}
+
+// Put mocks base method.
+func (m *MockBlobStore) Put(ctx context.Context, key string, r io.Reader) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Put", ctx, key, r)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Put indicates an expected call of Put.
+func (mr *MockBlobStoreMockRecorder) Put(ctx, key, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockBlobStore)(nil).Put), ctx, key, r)
+}
+
+// MockRoleRepository is a mock of RoleRepository interface.
+type MockRoleRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRoleRepositoryMockRecorder
+}
+
+// MockRoleRepositoryMockRecorder is the mock recorder for MockRoleRepository.
+type MockRoleRepositoryMockRecorder struct {
+	mock *MockRoleRepository
+}
+
+// NewMockRoleRepository creates a new mock instance.
+func NewMockRoleRepository(ctrl *gomock.Controller) *MockRoleRepository {
+	mock := &MockRoleRepository{ctrl: ctrl}
+	mock.recorder = &MockRoleRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRoleRepository) EXPECT() *MockRoleRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AllByGroup mocks base method.
+func (m *MockRoleRepository) AllByGroup(ctx context.Context, group uuid.UUID) ([]*model.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllByGroup", ctx, group)
+	ret0, _ := ret[0].([]*model.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AllByGroup indicates an expected call of AllByGroup.
+func (mr *MockRoleRepositoryMockRecorder) AllByGroup(ctx, group interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllByGroup", reflect.TypeOf((*MockRoleRepository)(nil).AllByGroup), ctx, group)
+}
+
+// Create mocks base method.
+func (m *MockRoleRepository) Create(ctx context.Context, role *model.Role) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockRoleRepositoryMockRecorder) Create(ctx, role interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRoleRepository)(nil).Create), ctx, role)
+}
+
+// Delete mocks base method.
+func (m *MockRoleRepository) Delete(ctx context.Context, group uuid.UUID, id int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, group, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockRoleRepositoryMockRecorder) Delete(ctx, group, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRoleRepository)(nil).Delete), ctx, group, id)
+}
+
+// Get mocks base method.
+func (m *MockRoleRepository) Get(ctx context.Context, group uuid.UUID, id int32) (*model.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, group, id)
+	ret0, _ := ret[0].(*model.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockRoleRepositoryMockRecorder) Get(ctx, group, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockRoleRepository)(nil).Get), ctx, group, id)
+}
+
+// MockStore is a mock of Store interface.
+type MockStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockStoreMockRecorder
+}
+
+// MockStoreMockRecorder is the mock recorder for MockStore.
+type MockStoreMockRecorder struct {
+	mock *MockStore
+}
+
+// NewMockStore creates a new mock instance.
+func NewMockStore(ctrl *gomock.Controller) *MockStore {
+	mock := &MockStore{ctrl: ctrl}
+	mock.recorder = &MockStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStore) EXPECT() *MockStoreMockRecorder {
+	return m.recorder
+}
+
+// Attachment mocks base method.
+func (m *MockStore) Attachment() store.AttachmentRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Attachment")
+	ret0, _ := ret[0].(store.AttachmentRepository)
+	return ret0
+}
+
+// Attachment indicates an expected call of Attachment.
+func (mr *MockStoreMockRecorder) Attachment() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Attachment", reflect.TypeOf((*MockStore)(nil).Attachment))
+}
+
+// Board mocks base method.
+func (m *MockStore) Board() store.BoardRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Board")
+	ret0, _ := ret[0].(store.BoardRepository)
+	return ret0
+}
+
+// Board indicates an expected call of Board.
+func (mr *MockStoreMockRecorder) Board() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Board", reflect.TypeOf((*MockStore)(nil).Board))
+}
+
+// Checklist mocks base method.
+func (m *MockStore) Checklist() store.ChecklistRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Checklist")
+	ret0, _ := ret[0].(store.ChecklistRepository)
+	return ret0
+}
+
+// Checklist indicates an expected call of Checklist.
+func (mr *MockStoreMockRecorder) Checklist() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Checklist", reflect.TypeOf((*MockStore)(nil).Checklist))
+}
+
+// Comment mocks base method.
+func (m *MockStore) Comment() store.CommentRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Comment")
+	ret0, _ := ret[0].(store.CommentRepository)
+	return ret0
+}
+
+// Comment indicates an expected call of Comment.
+func (mr *MockStoreMockRecorder) Comment() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Comment", reflect.TypeOf((*MockStore)(nil).Comment))
+}
+
+// Dependency mocks base method.
+func (m *MockStore) Dependency() store.DependencyRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Dependency")
+	ret0, _ := ret[0].(store.DependencyRepository)
+	return ret0
+}
+
+// Dependency indicates an expected call of Dependency.
+func (mr *MockStoreMockRecorder) Dependency() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Dependency", reflect.TypeOf((*MockStore)(nil).Dependency))
+}
+
+// Group mocks base method.
+func (m *MockStore) Group() store.GroupRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Group")
+	ret0, _ := ret[0].(store.GroupRepository)
+	return ret0
+}
+
+// Group indicates an expected call of Group.
+func (mr *MockStoreMockRecorder) Group() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Group", reflect.TypeOf((*MockStore)(nil).Group))
+}
+
+// History mocks base method.
+func (m *MockStore) History() store.HistoryRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "History")
+	ret0, _ := ret[0].(store.HistoryRepository)
+	return ret0
+}
+
+// History indicates an expected call of History.
+func (mr *MockStoreMockRecorder) History() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "History", reflect.TypeOf((*MockStore)(nil).History))
+}
+
+// Invite mocks base method.
+func (m *MockStore) Invite() store.InviteRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Invite")
+	ret0, _ := ret[0].(store.InviteRepository)
+	return ret0
+}
+
+// Invite indicates an expected call of Invite.
+func (mr *MockStoreMockRecorder) Invite() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Invite", reflect.TypeOf((*MockStore)(nil).Invite))
+}
+
+// Label mocks base method.
+func (m *MockStore) Label() store.LabelRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Label")
+	ret0, _ := ret[0].(store.LabelRepository)
+	return ret0
+}
+
+// Label indicates an expected call of Label.
+func (mr *MockStoreMockRecorder) Label() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Label", reflect.TypeOf((*MockStore)(nil).Label))
+}
+
+// Ping mocks base method.
+func (m *MockStore) Ping(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ping", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Ping indicates an expected call of Ping.
+func (mr *MockStoreMockRecorder) Ping(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ping", reflect.TypeOf((*MockStore)(nil).Ping), ctx)
+}
+
+// Review mocks base method.
+func (m *MockStore) Review() store.ReviewRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Review")
+	ret0, _ := ret[0].(store.ReviewRepository)
+	return ret0
+}
+
+// Review indicates an expected call of Review.
+func (mr *MockStoreMockRecorder) Review() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Review", reflect.TypeOf((*MockStore)(nil).Review))
+}
+
+// Role mocks base method.
+func (m *MockStore) Role() store.RoleRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Role")
+	ret0, _ := ret[0].(store.RoleRepository)
+	return ret0
+}
+
+// Role indicates an expected call of Role.
+func (mr *MockStoreMockRecorder) Role() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Role", reflect.TypeOf((*MockStore)(nil).Role))
+}
+
+// Task mocks base method.
+func (m *MockStore) Task() store.TaskRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Task")
+	ret0, _ := ret[0].(store.TaskRepository)
+	return ret0
+}
+
+// Task indicates an expected call of Task.
+func (mr *MockStoreMockRecorder) Task() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Task", reflect.TypeOf((*MockStore)(nil).Task))
+}
+
+// Template mocks base method.
+func (m *MockStore) Template() store.TemplateRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Template")
+	ret0, _ := ret[0].(store.TemplateRepository)
+	return ret0
+}
+
+// Template indicates an expected call of Template.
+func (mr *MockStoreMockRecorder) Template() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Template", reflect.TypeOf((*MockStore)(nil).Template))
+}
+
+// Token mocks base method.
+func (m *MockStore) Token() store.TokenRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Token")
+	ret0, _ := ret[0].(store.TokenRepository)
+	return ret0
+}
+
+// Token indicates an expected call of Token.
+func (mr *MockStoreMockRecorder) Token() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Token", reflect.TypeOf((*MockStore)(nil).Token))
+}
+
+// User mocks base method.
+func (m *MockStore) User() store.UserRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "User")
+	ret0, _ := ret[0].(store.UserRepository)
+	return ret0
+}
+
+// User indicates an expected call of User.
 func (mr *MockStoreMockRecorder) User() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "User", reflect.TypeOf((*MockStore)(nil).User))
 }
+
+// Watcher mocks base method.
+func (m *MockStore) Watcher() store.WatcherRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Watcher")
+	ret0, _ := ret[0].(store.WatcherRepository)
+	return ret0
+}
+
+// Watcher indicates an expected call of Watcher.
+func (mr *MockStoreMockRecorder) Watcher() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Watcher", reflect.TypeOf((*MockStore)(nil).Watcher))
+}
+
+// Worklog mocks base method.
+func (m *MockStore) Worklog() store.WorklogRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Worklog")
+	ret0, _ := ret[0].(store.WorklogRepository)
+	return ret0
+}
+
+// Worklog indicates an expected call of Worklog.
+func (mr *MockStoreMockRecorder) Worklog() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Worklog", reflect.TypeOf((*MockStore)(nil).Worklog))
+}
@@ -0,0 +1,181 @@
+package pgx
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/vlad-marlo/godo/internal/model"
+	"github.com/vlad-marlo/godo/internal/store"
+	"go.uber.org/zap"
+)
+
+var _ store.AttachmentRepository = (*AttachmentRepository)(nil)
+
+// _attachmentColumns is columns of attachment from table with alias a in order in which they are scanned by scanAttachment.
+const _attachmentColumns = `a.id,
+       a.task_id,
+       a.comment_id,
+       a.uploaded_by,
+       a.name,
+       a.content_type,
+       a.size,
+       a.blob_key,
+       a.created_at`
+
+// AttachmentRepository is storage of attachments metadata.
+type AttachmentRepository struct {
+	pool *pgxpool.Pool
+	log  *zap.Logger
+}
+
+// NewAttachmentRepository return new instance of AttachmentRepository.
+func NewAttachmentRepository(cli Client) *AttachmentRepository {
+	return &AttachmentRepository{
+		pool: cli.P(),
+		log:  cli.L(),
+	}
+}
+
+// scanAttachment scans attachment which columns are selected with _attachmentColumns.
+func scanAttachment(row pgx.Row) (*model.Attachment, error) {
+	a := new(model.Attachment)
+	if err := row.Scan(
+		&a.ID,
+		&a.Task,
+		&a.Comment,
+		&a.UploadedBy,
+		&a.Name,
+		&a.ContentType,
+		&a.Size,
+		&a.BlobKey,
+		&a.CreatedAt,
+	); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// Create stores attachment.
+func (repo *AttachmentRepository) Create(ctx context.Context, attachment *model.Attachment) error {
+	if attachment == nil {
+		return store.ErrNilReference
+	}
+	if _, err := repo.pool.Exec(
+		ctx,
+		`INSERT INTO attachments(id, task_id, comment_id, uploaded_by, "name", content_type, size, blob_key, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9);`,
+		attachment.ID,
+		attachment.Task,
+		attachment.Comment,
+		attachment.UploadedBy,
+		attachment.Name,
+		attachment.ContentType,
+		attachment.Size,
+		attachment.BlobKey,
+		attachment.CreatedAt,
+	); err != nil {
+		return pgError("store: attachment: create", err)
+	}
+	return nil
+}
+
+// Get return attachment by id.
+func (repo *AttachmentRepository) Get(ctx context.Context, id uuid.UUID) (*model.Attachment, error) {
+	a, err := scanAttachment(repo.pool.QueryRow(
+		ctx,
+		`SELECT `+_attachmentColumns+` FROM attachments a WHERE a.id = $1;`,
+		id,
+	))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, store.ErrNotFound
+		}
+		repo.log.Log(_unknownLevel, "get attachment by id", traceError(err)...)
+		return nil, unknown(err)
+	}
+	return a, nil
+}
+
+// AllByTask return attachments of task ordered by upload time.
+func (repo *AttachmentRepository) AllByTask(ctx context.Context, task uuid.UUID) ([]*model.Attachment, error) {
+	rows, err := repo.pool.Query(
+		ctx,
+		`SELECT `+_attachmentColumns+` FROM attachments a WHERE a.task_id = $1 ORDER BY a.created_at, a.id;`,
+		task,
+	)
+	if err != nil {
+		repo.log.Log(_unknownLevel, "get attachments of task", traceError(err)...)
+		return nil, unknown(err)
+	}
+	defer rows.Close()
+
+	var resp []*model.Attachment
+	for rows.Next() {
+		var a *model.Attachment
+		if a, err = scanAttachment(rows); err != nil {
+			repo.log.Log(_unknownLevel, "scan attachment", traceError(err)...)
+			return nil, unknown(err)
+		}
+		resp = append(resp, a)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, unknown(err)
+	}
+	return resp, nil
+}
+
+// Delete deletes attachment. Key of its blob is marked as orphaned by trigger.
+func (repo *AttachmentRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	tag, err := repo.pool.Exec(ctx, `DELETE FROM attachments WHERE id = $1;`, id)
+	if err != nil {
+		return pgError("store: attachment: delete", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return store.ErrNotFound
+	}
+	return nil
+}
+
+// Orphaned return keys of blobs which attachments were deleted, oldest first.
+func (repo *AttachmentRepository) Orphaned(ctx context.Context, limit int) ([]string, error) {
+	rows, err := repo.pool.Query(
+		ctx,
+		`SELECT o.blob_key FROM orphaned_blobs o ORDER BY o.deleted_at LIMIT $1;`,
+		limit,
+	)
+	if err != nil {
+		repo.log.Log(_unknownLevel, "get orphaned blobs", traceError(err)...)
+		return nil, unknown(err)
+	}
+	defer rows.Close()
+
+	var keys []string
+	for rows.Next() {
+		var key string
+		if err = rows.Scan(&key); err != nil {
+			repo.log.Log(_unknownLevel, "scan orphaned blob", traceError(err)...)
+			return nil, unknown(err)
+		}
+		keys = append(keys, key)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, unknown(err)
+	}
+	return keys, nil
+}
+
+// ForgetOrphaned removes keys from list of orphaned blobs.
+func (repo *AttachmentRepository) ForgetOrphaned(ctx context.Context, keys []string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	if _, err := repo.pool.Exec(ctx, `DELETE FROM orphaned_blobs WHERE blob_key = ANY ($1);`, keys); err != nil {
+		return pgError("store: attachment: forget orphaned", err)
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package pgx
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vlad-marlo/godo/internal/model"
+	"github.com/vlad-marlo/godo/internal/store"
+)
+
+func TestAttachmentRepository_NilAttachment(t *testing.T) {
+	s, td := testStore(t, nil)
+	defer td()
+
+	assert.ErrorIs(t, s.attach.Create(context.Background(), nil), store.ErrNilReference)
+}
+
+func TestAttachmentRepository(t *testing.T) {
+	s, td := testStore(t, nil)
+	defer td()
+
+	ctx := context.Background()
+
+	require.NoError(t, s.user.Create(ctx, TestUser1))
+	require.NoError(t, s.task.Create(ctx, TestTask1))
+	comment := &model.Comment{ID: uuid.New(), Task: TestTask1.ID, Message: "see file", CreatedBy: TestUser1.ID}
+	require.NoError(t, s.comment.Create(ctx, comment))
+
+	newAttachment := func(comment *uuid.UUID) *model.Attachment {
+		return &model.Attachment{
+			ID:          uuid.New(),
+			Task:        TestTask1.ID,
+			Comment:     comment,
+			UploadedBy:  TestUser1.ID,
+			Name:        "report.txt",
+			ContentType: "text/plain",
+			Size:        10,
+			BlobKey:     uuid.NewString(),
+			CreatedAt:   time.Now().UTC().Truncate(time.Second),
+		}
+	}
+	first, second, third := newAttachment(nil), newAttachment(&comment.ID), newAttachment(nil)
+	second.CreatedAt = first.CreatedAt.Add(time.Second)
+	third.CreatedAt = first.CreatedAt.Add(2 * time.Second)
+	for _, a := range []*model.Attachment{first, second, third} {
+		require.NoError(t, s.attach.Create(ctx, a))
+	}
+	assert.ErrorIs(t, s.attach.Create(ctx, first), store.ErrUniqueViolation)
+	bad := newAttachment(nil)
+	bad.Task = uuid.New()
+	assert.ErrorIs(t, s.attach.Create(ctx, bad), store.ErrFKViolation)
+
+	got, err := s.attach.Get(ctx, second.ID)
+	require.NoError(t, err)
+	assert.Equal(t, second, got)
+	_, err = s.attach.Get(ctx, uuid.New())
+	assert.ErrorIs(t, err, store.ErrNotFound)
+
+	all, err := s.attach.AllByTask(ctx, TestTask1.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []*model.Attachment{first, second, third}, all)
+
+	orphaned, err := s.attach.Orphaned(ctx, 10)
+	require.NoError(t, err)
+	assert.Empty(t, orphaned)
+
+	// blobs of deleted attachments are orphaned both on direct delete and on cascade delete.
+	require.NoError(t, s.attach.Delete(ctx, first.ID))
+	assert.ErrorIs(t, s.attach.Delete(ctx, first.ID), store.ErrNotFound)
+	require.NoError(t, s.comment.Delete(ctx, comment.ID))
+	require.NoError(t, s.task.Delete(ctx, TestTask1.ID))
+
+	orphaned, err = s.attach.Orphaned(ctx, 10)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{first.BlobKey, second.BlobKey, third.BlobKey}, orphaned)
+
+	require.NoError(t, s.attach.ForgetOrphaned(ctx, orphaned[:2]))
+	require.NoError(t, s.attach.ForgetOrphaned(ctx, nil))
+	orphaned, err = s.attach.Orphaned(ctx, 10)
+	require.NoError(t, err)
+	assert.Len(t, orphaned, 1)
+}
@@ -0,0 +1,245 @@
+package pgx
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/vlad-marlo/godo/internal/model"
+	"github.com/vlad-marlo/godo/internal/store"
+	"go.uber.org/zap"
+)
+
+var _ store.BoardRepository = (*BoardRepository)(nil)
+
+// BoardRepository is storage of board positions and work-in-progress limits of groups.
+type BoardRepository struct {
+	pool *pgxpool.Pool
+	log  *zap.Logger
+}
+
+// NewBoardRepository return new instance of BoardRepository.
+func NewBoardRepository(cli Client) *BoardRepository {
+	return &BoardRepository{
+		pool: cli.P(),
+		log:  cli.L(),
+	}
+}
+
+// Limits return work-in-progress limits of board columns of group.
+func (repo *BoardRepository) Limits(ctx context.Context, group uuid.UUID) (map[model.TaskStatus]int, error) {
+	rows, err := repo.pool.Query(ctx, `SELECT b.status, b.wip_limit FROM board_limits b WHERE b.group_id = $1;`, group)
+	if err != nil {
+		repo.log.Log(_unknownLevel, "get board limits of group", traceError(err)...)
+		return nil, unknown(err)
+	}
+	defer rows.Close()
+
+	limits := make(map[model.TaskStatus]int)
+	for rows.Next() {
+		var (
+			status model.TaskStatus
+			limit  int
+		)
+		if err = rows.Scan(&status, &limit); err != nil {
+			repo.log.Log(_unknownLevel, "scan board limit", traceError(err)...)
+			return nil, unknown(err)
+		}
+		limits[status] = limit
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, unknown(err)
+	}
+	return limits, nil
+}
+
+// SetLimits changes limits of board columns in one transaction. Limit of column is removed if it is nil or zero.
+func (repo *BoardRepository) SetLimits(ctx context.Context, group uuid.UUID, limits map[model.TaskStatus]*int) error {
+	tx, err := repo.pool.Begin(ctx)
+	if err != nil {
+		repo.log.Error("unexpected error received while starting new transaction: check drivers", traceError(err)...)
+		return unknown(err)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	for status, limit := range limits {
+		if limit == nil || *limit == 0 {
+			if _, err = tx.Exec(ctx, `DELETE FROM board_limits WHERE group_id = $1 AND status = $2;`, group, status); err != nil {
+				return pgError("store: board: remove limit", err)
+			}
+			continue
+		}
+
+		if _, err = tx.Exec(
+			ctx,
+			`INSERT INTO board_limits(group_id, status, wip_limit)
+VALUES ($1, $2, $3)
+ON CONFLICT (group_id, status) DO UPDATE SET wip_limit = excluded.wip_limit;`,
+			group,
+			status,
+			*limit,
+		); err != nil {
+			return pgError("store: board: set limit", err)
+		}
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		repo.log.Error("unexpected error while doing commit transaction: check pgx driver", traceError(err)...)
+		return unknown(err)
+	}
+	return nil
+}
+
+// Move changes status of task from one to another and places it at position in column of group board.
+//
+// Limits of target column are checked like in checkLimits.
+// If task is not related to group then store.ErrBadData will be returned.
+// If status of task was already changed then store.ErrNotFound will be returned.
+// Changes are recorded to history of task in the same transaction.
+func (repo *BoardRepository) Move(ctx context.Context, group, task uuid.UUID, from, to model.TaskStatus, position int, changes ...*model.TaskChange) error {
+	tx, err := repo.pool.Begin(ctx)
+	if err != nil {
+		repo.log.Error("unexpected error received while starting new transaction: check drivers", traceError(err)...)
+		return unknown(err)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	var ok bool
+	if err = tx.QueryRow(
+		ctx,
+		`SELECT EXISTS(SELECT * FROM task_group WHERE group_id = $1 AND task_id = $2);`,
+		group,
+		task,
+	).Scan(&ok); err != nil {
+		return pgError("store: board: move: check group", err)
+	}
+	if !ok {
+		return store.ErrBadData
+	}
+
+	if from != to {
+		if err = checkLimits(ctx, tx, task, to); err != nil {
+			return err
+		}
+	}
+
+	tag, err := tx.Exec(ctx, `UPDATE tasks SET status = $3 WHERE id = $1 AND status = $2;`, task, from, to)
+	if err != nil {
+		return pgError("store: board: move: update status", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return store.ErrNotFound
+	}
+
+	rows, err := tx.Query(
+		ctx,
+		`SELECT g.task_id
+FROM task_group g
+         JOIN tasks t on t.id = g.task_id
+WHERE g.group_id = $1
+  AND t.status = $2
+  AND t.id <> $3
+ORDER BY g.position, t.created_at, t.id;`,
+		group,
+		to,
+		task,
+	)
+	if err != nil {
+		return pgError("store: board: move: get column", err)
+	}
+	var column []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err = rows.Scan(&id); err != nil {
+			rows.Close()
+			return pgError("store: board: move: scan column", err)
+		}
+		column = append(column, id)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return pgError("store: board: move: get column", err)
+	}
+
+	if position < 0 || position > len(column) {
+		position = len(column)
+	}
+	column = append(column[:position], append([]uuid.UUID{task}, column[position:]...)...)
+
+	if _, err = tx.Exec(
+		ctx,
+		`UPDATE task_group g
+SET position = c.pos - 1
+FROM unnest($2::uuid[]) WITH ORDINALITY c(id, pos)
+WHERE g.group_id = $1
+  AND g.task_id = c.id;`,
+		group,
+		column,
+	); err != nil {
+		return pgError("store: board: move: update positions", err)
+	}
+
+	if err = addTaskChanges(ctx, tx, changes...); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		repo.log.Error("unexpected error while doing commit transaction: check pgx driver", traceError(err)...)
+		return unknown(err)
+	}
+	return nil
+}
+
+// checkLimits checks that task could be moved into column with status to on boards of all groups of task.
+//
+// Limits of column are locked until the end of transaction, so concurrent status changes could not exceed them.
+// Every path which changes status of task must call it in the same transaction.
+// If any limit would be exceeded then store.ErrLimitExceeded will be returned.
+func checkLimits(ctx context.Context, tx pgx.Tx, task uuid.UUID, to model.TaskStatus) error {
+	if _, err := tx.Exec(
+		ctx,
+		`SELECT b.wip_limit
+FROM board_limits b
+         JOIN task_group g on g.group_id = b.group_id
+WHERE g.task_id = $1
+  AND b.status = $2
+ORDER BY b.group_id
+FOR UPDATE OF b;`,
+		task,
+		to,
+	); err != nil {
+		return pgError("store: board: lock limits", err)
+	}
+
+	var exceeded bool
+	if err := tx.QueryRow(
+		ctx,
+		`SELECT EXISTS(
+               SELECT *
+               FROM board_limits b
+                        JOIN task_group g on g.group_id = b.group_id
+               WHERE g.task_id = $1
+                 AND b.status = $2
+                 AND b.wip_limit <= (SELECT COUNT(*)
+                                     FROM task_group og
+                                              JOIN tasks t on t.id = og.task_id
+                                     WHERE og.group_id = b.group_id
+                                       AND t.status = $2
+                                       AND t.id <> $1)
+           );`,
+		task,
+		to,
+	).Scan(&exceeded); err != nil {
+		return pgError("store: board: check limits", err)
+	}
+	if exceeded {
+		return store.ErrLimitExceeded
+	}
+	return nil
+}
@@ -0,0 +1,132 @@
+package pgx
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vlad-marlo/godo/internal/model"
+	"github.com/vlad-marlo/godo/internal/store"
+)
+
+func TestBoardRepository_Limits(t *testing.T) {
+	s, td := testStore(t, nil)
+	defer td()
+
+	ctx := context.Background()
+	require.NoError(t, s.user.Create(ctx, TestUser1))
+	require.NoError(t, s.group.Create(ctx, TestGroup1))
+
+	limits, err := s.board.Limits(ctx, TestGroup1.ID)
+	require.NoError(t, err)
+	assert.Empty(t, limits)
+
+	two, three := 2, 3
+	require.NoError(t, s.board.SetLimits(ctx, TestGroup1.ID, map[model.TaskStatus]*int{
+		model.StatusInProgress: &two,
+		model.StatusOnReview:   &three,
+	}))
+	require.NoError(t, s.board.SetLimits(ctx, TestGroup1.ID, map[model.TaskStatus]*int{
+		model.StatusInProgress: &three,
+		model.StatusOnReview:   nil,
+	}))
+	limits, err = s.board.Limits(ctx, TestGroup1.ID)
+	require.NoError(t, err)
+	assert.Equal(t, map[model.TaskStatus]int{model.StatusInProgress: 3}, limits)
+}
+
+func TestBoardRepository_Move(t *testing.T) {
+	s, td := testStore(t, nil)
+	defer td()
+
+	ctx := context.Background()
+	require.NoError(t, s.user.Create(ctx, TestUser1))
+	require.NoError(t, s.group.Create(ctx, TestGroup1))
+
+	tasks := make([]*model.Task, 3)
+	for i := range tasks {
+		tasks[i] = &model.Task{
+			ID:        uuid.New(),
+			Name:      "task",
+			CreatedAt: time.Now().Add(time.Duration(i) * time.Second),
+			CreatedBy: TestUser1.ID,
+			Status:    model.StatusNew,
+		}
+		require.NoError(t, s.task.Create(ctx, tasks[i]))
+		require.NoError(t, s.task.AddToGroup(ctx, tasks[i].ID, TestGroup1.ID))
+	}
+	ids := func() []uuid.UUID {
+		got, err := s.task.AllByGroupAndUser(ctx, TestGroup1.ID, TestUser1.ID)
+		require.NoError(t, err)
+		res := make([]uuid.UUID, 0, len(got))
+		for _, t := range got {
+			res = append(res, t.ID)
+		}
+		return res
+	}
+	assert.Equal(t, []uuid.UUID{tasks[0].ID, tasks[1].ID, tasks[2].ID}, ids())
+
+	// reorder inside of column.
+	require.NoError(t, s.board.Move(ctx, TestGroup1.ID, tasks[2].ID, model.StatusNew, model.StatusNew, 0))
+	assert.Equal(t, []uuid.UUID{tasks[2].ID, tasks[0].ID, tasks[1].ID}, ids())
+
+	one := 1
+	require.NoError(t, s.board.SetLimits(ctx, TestGroup1.ID, map[model.TaskStatus]*int{model.StatusInProgress: &one}))
+	require.NoError(t, s.board.Move(ctx, TestGroup1.ID, tasks[0].ID, model.StatusNew, model.StatusInProgress, 0))
+	assert.ErrorIs(t, s.board.Move(ctx, TestGroup1.ID, tasks[1].ID, model.StatusNew, model.StatusInProgress, 0), store.ErrLimitExceeded)
+	// status of task was already changed.
+	assert.ErrorIs(t, s.board.Move(ctx, TestGroup1.ID, tasks[0].ID, model.StatusNew, model.StatusOnReview, 0), store.ErrNotFound)
+	// task is not related to group.
+	assert.ErrorIs(t, s.board.Move(ctx, TestGroup2.ID, tasks[0].ID, model.StatusInProgress, model.StatusNew, 0), store.ErrBadData)
+
+	task, err := s.task.GetByUserAndID(ctx, TestUser1.ID, tasks[0].ID)
+	require.NoError(t, err)
+	assert.Equal(t, model.StatusInProgress, task.Status)
+}
+
+func TestBoardRepository_LimitsOfStatusChanges(t *testing.T) {
+	s, td := testStore(t, nil)
+	defer td()
+
+	ctx := context.Background()
+	require.NoError(t, s.user.Create(ctx, TestUser1))
+	require.NoError(t, s.group.Create(ctx, TestGroup1))
+
+	tasks := make([]*model.Task, 3)
+	for i := range tasks {
+		tasks[i] = &model.Task{ID: uuid.New(), Name: "task", CreatedAt: time.Now(), CreatedBy: TestUser1.ID, Status: model.StatusNew}
+		require.NoError(t, s.task.Create(ctx, tasks[i]))
+		require.NoError(t, s.task.AddToGroup(ctx, tasks[i].ID, TestGroup1.ID))
+	}
+	zero, one := 0, 1
+	require.NoError(t, s.board.SetLimits(ctx, TestGroup1.ID, map[model.TaskStatus]*int{
+		model.StatusInProgress:       &one,
+		model.StatusOnReview:         &one,
+		model.StatusChangesRequested: &zero,
+	}))
+
+	require.NoError(t, s.task.UpdateStatus(ctx, tasks[0].ID, model.StatusNew, model.StatusInProgress))
+	assert.ErrorIs(t, s.task.UpdateStatus(ctx, tasks[1].ID, model.StatusNew, model.StatusInProgress), store.ErrLimitExceeded)
+	bulk := &model.BulkTaskRequest{Operation: model.BulkSetStatus, Status: model.StatusInProgress}
+	assert.ErrorIs(t, s.task.Bulk(ctx, bulk, tasks[1:]), store.ErrLimitExceeded)
+
+	review := func(task uuid.UUID) *model.Review {
+		return &model.Review{ID: uuid.New(), Task: task, User: TestUser1.ID, Status: model.ReviewPending}
+	}
+	first := review(tasks[0].ID)
+	require.NoError(t, s.review.Create(ctx, first, model.StatusInProgress))
+	require.NoError(t, s.task.UpdateStatus(ctx, tasks[1].ID, model.StatusNew, model.StatusInProgress))
+	assert.ErrorIs(t, s.review.Create(ctx, review(tasks[1].ID), model.StatusInProgress), store.ErrLimitExceeded)
+
+	first.Status, first.ResolvedBy = model.ReviewChangesRequested, &TestUser1.ID
+	assert.ErrorIs(t, s.review.Resolve(ctx, first, model.StatusChangesRequested), store.ErrLimitExceeded)
+
+	for i, want := range []model.TaskStatus{model.StatusOnReview, model.StatusInProgress, model.StatusNew} {
+		task, err := s.task.GetByUserAndID(ctx, TestUser1.ID, tasks[i].ID)
+		require.NoError(t, err)
+		assert.Equal(t, want, task.Status)
+	}
+}
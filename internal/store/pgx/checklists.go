@@ -0,0 +1,198 @@
+package pgx
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/vlad-marlo/godo/internal/model"
+	"github.com/vlad-marlo/godo/internal/store"
+	"go.uber.org/zap"
+)
+
+var _ store.ChecklistRepository = (*ChecklistRepository)(nil)
+
+// ChecklistRepository is storage of checklist items of tasks.
+type ChecklistRepository struct {
+	pool *pgxpool.Pool
+	log  *zap.Logger
+}
+
+// NewChecklistRepository return new instance of ChecklistRepository.
+func NewChecklistRepository(cli Client) *ChecklistRepository {
+	return &ChecklistRepository{
+		pool: cli.P(),
+		log:  cli.L(),
+	}
+}
+
+// Create stores item at the end of checklist of task. Position of item is set to its position in checklist.
+func (repo *ChecklistRepository) Create(ctx context.Context, item *model.ChecklistItem) error {
+	if item == nil {
+		return store.ErrNilReference
+	}
+	if err := repo.pool.QueryRow(
+		ctx,
+		`INSERT INTO checklist_items(id, task_id, text, done, position)
+SELECT $1, $2, $3, $4, COALESCE(MAX(ci.position) + 1, 0)
+FROM checklist_items ci
+WHERE ci.task_id = $2
+RETURNING position;`,
+		item.ID,
+		item.Task,
+		item.Text,
+		item.Done,
+	).Scan(&item.Position); err != nil {
+		return pgError("store: checklist: create", err)
+	}
+	return nil
+}
+
+// Get return checklist item by id.
+func (repo *ChecklistRepository) Get(ctx context.Context, id uuid.UUID) (*model.ChecklistItem, error) {
+	item := new(model.ChecklistItem)
+	if err := repo.pool.QueryRow(
+		ctx,
+		`SELECT ci.id, ci.task_id, ci.text, ci.done, ci.position FROM checklist_items ci WHERE ci.id = $1;`,
+		id,
+	).Scan(&item.ID, &item.Task, &item.Text, &item.Done, &item.Position); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, store.ErrNotFound
+		}
+		repo.log.Log(_unknownLevel, "get checklist item by id", traceError(err)...)
+		return nil, unknown(err)
+	}
+	return item, nil
+}
+
+// AllByTask return items of task checklist ordered by position.
+func (repo *ChecklistRepository) AllByTask(ctx context.Context, task uuid.UUID) ([]*model.ChecklistItem, error) {
+	rows, err := repo.pool.Query(
+		ctx,
+		`SELECT ci.id, ci.task_id, ci.text, ci.done, ci.position
+FROM checklist_items ci
+WHERE ci.task_id = $1
+ORDER BY ci.position, ci.created_at, ci.id;`,
+		task,
+	)
+	if err != nil {
+		repo.log.Log(_unknownLevel, "get checklist of task", traceError(err)...)
+		return nil, unknown(err)
+	}
+	defer rows.Close()
+
+	var resp []*model.ChecklistItem
+	for rows.Next() {
+		item := new(model.ChecklistItem)
+		if err = rows.Scan(&item.ID, &item.Task, &item.Text, &item.Done, &item.Position); err != nil {
+			repo.log.Log(_unknownLevel, "scan checklist item", traceError(err)...)
+			return nil, unknown(err)
+		}
+		resp = append(resp, item)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, unknown(err)
+	}
+	return resp, nil
+}
+
+// SetDone marks item as done or not done.
+func (repo *ChecklistRepository) SetDone(ctx context.Context, id uuid.UUID, done bool) error {
+	tag, err := repo.pool.Exec(ctx, `UPDATE checklist_items SET done = $2 WHERE id = $1;`, id, done)
+	if err != nil {
+		return pgError("store: checklist: set done", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return store.ErrNotFound
+	}
+	return nil
+}
+
+// Move places item of task at position and renumbers other items of checklist in one transaction.
+//
+// Position which is out of checklist moves item to the end.
+func (repo *ChecklistRepository) Move(ctx context.Context, task, item uuid.UUID, position int) error {
+	tx, err := repo.pool.Begin(ctx)
+	if err != nil {
+		repo.log.Error("unexpected error received while starting new transaction: check drivers", traceError(err)...)
+		return unknown(err)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	rows, err := tx.Query(
+		ctx,
+		`SELECT ci.id
+FROM checklist_items ci
+WHERE ci.task_id = $1
+ORDER BY ci.position, ci.created_at, ci.id
+FOR UPDATE;`,
+		task,
+	)
+	if err != nil {
+		return pgError("store: checklist: move: get checklist", err)
+	}
+	var (
+		items []uuid.UUID
+		found bool
+	)
+	for rows.Next() {
+		var id uuid.UUID
+		if err = rows.Scan(&id); err != nil {
+			rows.Close()
+			return pgError("store: checklist: move: scan checklist", err)
+		}
+		if id == item {
+			found = true
+			continue
+		}
+		items = append(items, id)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return pgError("store: checklist: move: get checklist", err)
+	}
+	if !found {
+		return store.ErrNotFound
+	}
+
+	if position < 0 || position > len(items) {
+		position = len(items)
+	}
+	items = append(items[:position], append([]uuid.UUID{item}, items[position:]...)...)
+
+	if _, err = tx.Exec(
+		ctx,
+		`UPDATE checklist_items ci
+SET position = c.pos - 1
+FROM unnest($2::uuid[]) WITH ORDINALITY c(id, pos)
+WHERE ci.task_id = $1
+  AND ci.id = c.id;`,
+		task,
+		items,
+	); err != nil {
+		return pgError("store: checklist: move: update positions", err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		repo.log.Error("unexpected error while doing commit transaction: check pgx driver", traceError(err)...)
+		return unknown(err)
+	}
+	return nil
+}
+
+// Delete deletes checklist item.
+func (repo *ChecklistRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	tag, err := repo.pool.Exec(ctx, `DELETE FROM checklist_items WHERE id = $1;`, id)
+	if err != nil {
+		return pgError("store: checklist: delete", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return store.ErrNotFound
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package pgx
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vlad-marlo/godo/internal/model"
+	"github.com/vlad-marlo/godo/internal/store"
+)
+
+func TestChecklistRepository_NilItem(t *testing.T) {
+	s, td := testStore(t, nil)
+	defer td()
+
+	assert.ErrorIs(t, s.check.Create(context.Background(), nil), store.ErrNilReference)
+}
+
+func TestChecklistRepository(t *testing.T) {
+	s, td := testStore(t, nil)
+	defer td()
+
+	ctx := context.Background()
+
+	require.NoError(t, s.user.Create(ctx, TestUser1))
+	require.NoError(t, s.task.Create(ctx, TestTask1))
+
+	items := make([]*model.ChecklistItem, 3)
+	for i := range items {
+		items[i] = &model.ChecklistItem{ID: uuid.New(), Task: TestTask1.ID, Text: uuid.NewString()}
+		require.NoError(t, s.check.Create(ctx, items[i]))
+		assert.Equal(t, i, items[i].Position)
+	}
+
+	require.NoError(t, s.check.SetDone(ctx, items[1].ID, true))
+	got, err := s.check.Get(ctx, items[1].ID)
+	require.NoError(t, err)
+	assert.True(t, got.Done)
+
+	task, err := s.task.GetByUserAndID(ctx, TestUser1.ID, TestTask1.ID)
+	require.NoError(t, err)
+	assert.Equal(t, model.NewChecklistSummary(1, 3), task.Checklist)
+
+	require.NoError(t, s.check.Move(ctx, TestTask1.ID, items[2].ID, 0))
+	require.NoError(t, s.check.Move(ctx, TestTask1.ID, items[1].ID, 100))
+	all, err := s.check.AllByTask(ctx, TestTask1.ID)
+	require.NoError(t, err)
+	if assert.Len(t, all, 3) {
+		for i, id := range []uuid.UUID{items[2].ID, items[0].ID, items[1].ID} {
+			assert.Equal(t, id, all[i].ID)
+			assert.Equal(t, i, all[i].Position)
+		}
+	}
+	assert.ErrorIs(t, s.check.Move(ctx, uuid.New(), items[0].ID, 0), store.ErrNotFound)
+
+	require.NoError(t, s.check.Delete(ctx, items[0].ID))
+	assert.ErrorIs(t, s.check.Delete(ctx, items[0].ID), store.ErrNotFound)
+	assert.ErrorIs(t, s.check.SetDone(ctx, items[0].ID, true), store.ErrNotFound)
+	_, err = s.check.Get(ctx, items[0].ID)
+	assert.ErrorIs(t, err, store.ErrNotFound)
+}
@@ -0,0 +1,192 @@
+package pgx
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/vlad-marlo/godo/internal/model"
+	"github.com/vlad-marlo/godo/internal/store"
+	"go.uber.org/zap"
+)
+
+var _ store.CommentRepository = (*CommentRepository)(nil)
+
+// CommentRepository is storage of task comments.
+type CommentRepository struct {
+	pool *pgxpool.Pool
+	log  *zap.Logger
+}
+
+// NewCommentRepository return new instance of CommentRepository.
+func NewCommentRepository(cli Client) *CommentRepository {
+	return &CommentRepository{
+		pool: cli.P(),
+		log:  cli.L(),
+	}
+}
+
+// Create stores comment.
+func (repo *CommentRepository) Create(ctx context.Context, comment *model.Comment) error {
+	if comment == nil {
+		return store.ErrNilReference
+	}
+	if err := repo.pool.QueryRow(
+		ctx,
+		`INSERT INTO comments(id, task, reply_to, msg, created_by) VALUES ($1, $2, $3, $4, $5) RETURNING created_at;`,
+		comment.ID,
+		comment.Task,
+		comment.ReplyTo,
+		comment.Message,
+		comment.CreatedBy,
+	).Scan(&comment.CreatedAt); err != nil {
+		return pgError("store: comment: create", err)
+	}
+	return nil
+}
+
+// Get return comment by id.
+func (repo *CommentRepository) Get(ctx context.Context, id uuid.UUID) (*model.Comment, error) {
+	c := new(model.Comment)
+	if err := repo.pool.QueryRow(
+		ctx,
+		`SELECT c.id, c.task, c.reply_to, COALESCE(c.msg, ''), c.created_by, c.created_at, c.updated_at
+FROM comments c
+WHERE c.id = $1;`,
+		id,
+	).Scan(&c.ID, &c.Task, &c.ReplyTo, &c.Message, &c.CreatedBy, &c.CreatedAt, &c.UpdatedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, store.ErrNotFound
+		}
+		repo.log.Log(_unknownLevel, "get comment by id", traceError(err)...)
+		return nil, unknown(err)
+	}
+	return c, nil
+}
+
+// AllByTask return comments of task ordered by creation time.
+func (repo *CommentRepository) AllByTask(ctx context.Context, task uuid.UUID) ([]*model.Comment, error) {
+	rows, err := repo.pool.Query(
+		ctx,
+		`SELECT c.id, c.task, c.reply_to, COALESCE(c.msg, ''), c.created_by, c.created_at, c.updated_at
+FROM comments c
+WHERE c.task = $1
+ORDER BY c.created_at;`,
+		task,
+	)
+	if err != nil {
+		repo.log.Log(_unknownLevel, "get comments of task", traceError(err)...)
+		return nil, unknown(err)
+	}
+	defer rows.Close()
+
+	var resp []*model.Comment
+	for rows.Next() {
+		c := new(model.Comment)
+		if err = rows.Scan(&c.ID, &c.Task, &c.ReplyTo, &c.Message, &c.CreatedBy, &c.CreatedAt, &c.UpdatedAt); err != nil {
+			repo.log.Log(_unknownLevel, "scan comment while getting comments of task", traceError(err)...)
+			return nil, unknown(err)
+		}
+		resp = append(resp, c)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, unknown(err)
+	}
+	return resp, nil
+}
+
+// Update changes message of comment.
+//
+// Previous message of comment is stored into edit history in the same transaction.
+func (repo *CommentRepository) Update(ctx context.Context, comment *model.Comment, editor uuid.UUID) error {
+	if comment == nil {
+		return store.ErrNilReference
+	}
+
+	tx, err := repo.pool.Begin(ctx)
+	if err != nil {
+		repo.log.Error("unexpected error received while starting new transaction: check drivers", traceError(err)...)
+		return unknown(err)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	tag, err := tx.Exec(
+		ctx,
+		`INSERT INTO comment_edits("comment", msg, edited_by)
+SELECT c.id, c.msg, $2
+FROM comments c
+WHERE c.id = $1;`,
+		comment.ID,
+		editor,
+	)
+	if err != nil {
+		return pgError("store: comment: update: save history", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return store.ErrNotFound
+	}
+
+	if err = tx.QueryRow(
+		ctx,
+		`UPDATE comments SET msg = $2, updated_at = current_timestamp WHERE id = $1 RETURNING updated_at;`,
+		comment.ID,
+		comment.Message,
+	).Scan(&comment.UpdatedAt); err != nil {
+		return pgError("store: comment: update", err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		repo.log.Error("unexpected error while doing commit transaction: check pgx driver", traceError(err)...)
+		return unknown(err)
+	}
+	return nil
+}
+
+// Delete deletes comment. Replies to comment will not be deleted.
+func (repo *CommentRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	tag, err := repo.pool.Exec(ctx, `DELETE FROM comments WHERE id = $1;`, id)
+	if err != nil {
+		return pgError("store: comment: delete", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return store.ErrNotFound
+	}
+	return nil
+}
+
+// History return previous versions of comment.
+func (repo *CommentRepository) History(ctx context.Context, id uuid.UUID) ([]*model.CommentEdit, error) {
+	rows, err := repo.pool.Query(
+		ctx,
+		`SELECT COALESCE(e.msg, ''), e.edited_by, e.edited_at
+FROM comment_edits e
+WHERE e."comment" = $1
+ORDER BY e.edited_at;`,
+		id,
+	)
+	if err != nil {
+		repo.log.Log(_unknownLevel, "get comment history", traceError(err)...)
+		return nil, unknown(err)
+	}
+	defer rows.Close()
+
+	var resp []*model.CommentEdit
+	for rows.Next() {
+		e := new(model.CommentEdit)
+		if err = rows.Scan(&e.Message, &e.EditedBy, &e.EditedAt); err != nil {
+			repo.log.Log(_unknownLevel, "scan comment edit", traceError(err)...)
+			return nil, unknown(err)
+		}
+		resp = append(resp, e)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, unknown(err)
+	}
+	return resp, nil
+}
@@ -0,0 +1,82 @@
+package pgx
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vlad-marlo/godo/internal/model"
+	"github.com/vlad-marlo/godo/internal/store"
+)
+
+func TestCommentRepository_NilComment(t *testing.T) {
+	s, td := testStore(t, nil)
+	defer td()
+
+	assert.ErrorIs(t, s.comment.Create(context.Background(), nil), store.ErrNilReference)
+	assert.ErrorIs(t, s.comment.Update(context.Background(), nil, uuid.New()), store.ErrNilReference)
+}
+
+func TestCommentRepository(t *testing.T) {
+	s, td := testStore(t, nil)
+	defer td()
+
+	ctx := context.Background()
+	task := &model.Task{
+		ID:        uuid.New(),
+		Name:      uuid.NewString(),
+		CreatedBy: TestUser1.ID,
+		Status:    model.StatusNew,
+	}
+	require.NoError(t, s.user.Create(ctx, TestUser1))
+	require.NoError(t, s.task.Create(ctx, task))
+
+	comment := &model.Comment{
+		ID:        uuid.New(),
+		Task:      task.ID,
+		Message:   "first",
+		CreatedBy: TestUser1.ID,
+	}
+	require.NoError(t, s.comment.Create(ctx, comment))
+	reply := &model.Comment{
+		ID:        uuid.New(),
+		Task:      task.ID,
+		ReplyTo:   &comment.ID,
+		Message:   "reply",
+		CreatedBy: TestUser1.ID,
+	}
+	require.NoError(t, s.comment.Create(ctx, reply))
+
+	comment.Message = "edited"
+	require.NoError(t, s.comment.Update(ctx, comment, TestUser1.ID))
+	assert.NotNil(t, comment.UpdatedAt)
+
+	got, err := s.comment.Get(ctx, comment.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "edited", got.Message)
+
+	edits, err := s.comment.History(ctx, comment.ID)
+	require.NoError(t, err)
+	if assert.Len(t, edits, 1) {
+		assert.Equal(t, "first", edits[0].Message)
+		assert.Equal(t, TestUser1.ID, edits[0].EditedBy)
+	}
+
+	comments, err := s.comment.AllByTask(ctx, task.ID)
+	require.NoError(t, err)
+	if assert.Len(t, comments, 2) {
+		assert.Equal(t, &comment.ID, comments[1].ReplyTo)
+	}
+
+	require.NoError(t, s.comment.Delete(ctx, comment.ID))
+	assert.ErrorIs(t, s.comment.Delete(ctx, comment.ID), store.ErrNotFound)
+	_, err = s.comment.Get(ctx, comment.ID)
+	assert.ErrorIs(t, err, store.ErrNotFound)
+	assert.ErrorIs(t, s.comment.Update(ctx, comment, TestUser1.ID), store.ErrNotFound)
+
+	got, err = s.comment.Get(ctx, reply.ID)
+	require.NoError(t, err)
+	assert.Nil(t, got.ReplyTo)
+}
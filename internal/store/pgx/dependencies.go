@@ -0,0 +1,236 @@
+package pgx
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/vlad-marlo/godo/internal/model"
+	"github.com/vlad-marlo/godo/internal/store"
+	"go.uber.org/zap"
+)
+
+var _ store.DependencyRepository = (*DependencyRepository)(nil)
+
+// DependencyRepository is storage of links between blocking and blocked tasks.
+type DependencyRepository struct {
+	pool *pgxpool.Pool
+	log  *zap.Logger
+}
+
+// NewDependencyRepository return new instance of DependencyRepository.
+func NewDependencyRepository(cli Client) *DependencyRepository {
+	return &DependencyRepository{
+		pool: cli.P(),
+		log:  cli.L(),
+	}
+}
+
+// Create stores link between tasks.
+//
+// Creation of links is serialized by transaction-level advisory locks keyed on groups of blocked task. Links which are
+// reachable from blocked task are loaded under these locks and passed to check; if check returns error then link is
+// not created and error is returned as is.
+func (repo *DependencyRepository) Create(
+	ctx context.Context,
+	dep *model.TaskDependency,
+	check func(downstream []*model.TaskDependency) error,
+) error {
+	if dep == nil {
+		return store.ErrNilReference
+	}
+
+	tx, err := repo.pool.Begin(ctx)
+	if err != nil {
+		repo.log.Error("unexpected error received while starting new transaction: check drivers", traceError(err)...)
+		return unknown(err)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	if _, err = tx.Exec(
+		ctx,
+		`SELECT pg_advisory_xact_lock(hashtext(tg.group_id::text))
+FROM task_group tg
+WHERE tg.task_id = $1
+ORDER BY tg.group_id;`,
+		dep.Blocked,
+	); err != nil {
+		return pgError("store: dependency: create: lock", err)
+	}
+
+	if check != nil {
+		var downstream []*model.TaskDependency
+		if downstream, err = repo.downstream(ctx, tx, dep.Blocked); err != nil {
+			return err
+		}
+		if err = check(downstream); err != nil {
+			return err
+		}
+	}
+
+	if err = tx.QueryRow(
+		ctx,
+		`INSERT INTO task_dependencies(blocker, blocked, created_by) VALUES ($1, $2, $3) RETURNING created_at;`,
+		dep.Blocker,
+		dep.Blocked,
+		dep.CreatedBy,
+	).Scan(&dep.CreatedAt); err != nil {
+		return pgError("store: dependency: create", err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		repo.log.Error("unexpected error while doing commit transaction: check pgx driver", traceError(err)...)
+		return unknown(err)
+	}
+	return nil
+}
+
+// downstream return all links which are reachable from task by direction from blocker to blocked.
+func (repo *DependencyRepository) downstream(ctx context.Context, tx pgx.Tx, task uuid.UUID) ([]*model.TaskDependency, error) {
+	rows, err := tx.Query(
+		ctx,
+		`WITH RECURSIVE reach(blocker, blocked) AS (SELECT d.blocker, d.blocked
+                                           FROM task_dependencies d
+                                           WHERE d.blocker = $1
+                                           UNION
+                                           SELECT d.blocker, d.blocked
+                                           FROM task_dependencies d
+                                                    JOIN reach r on d.blocker = r.blocked)
+SELECT r.blocker, r.blocked
+FROM reach r;`,
+		task,
+	)
+	if err != nil {
+		repo.log.Log(_unknownLevel, "get downstream dependencies", traceError(err)...)
+		return nil, unknown(err)
+	}
+	defer rows.Close()
+
+	var resp []*model.TaskDependency
+	for rows.Next() {
+		d := new(model.TaskDependency)
+		if err = rows.Scan(&d.Blocker, &d.Blocked); err != nil {
+			repo.log.Log(_unknownLevel, "scan dependency", traceError(err)...)
+			return nil, unknown(err)
+		}
+		resp = append(resp, d)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, unknown(err)
+	}
+	return resp, nil
+}
+
+// Delete deletes link between tasks.
+func (repo *DependencyRepository) Delete(ctx context.Context, blocker, blocked uuid.UUID) error {
+	tag, err := repo.pool.Exec(ctx, `DELETE FROM task_dependencies WHERE blocker = $1 AND blocked = $2;`, blocker, blocked)
+	if err != nil {
+		return pgError("store: dependency: delete", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return store.ErrNotFound
+	}
+	return nil
+}
+
+// OpenBlockers return ids of tasks which block task and are not closed or accepted yet.
+func (repo *DependencyRepository) OpenBlockers(ctx context.Context, task uuid.UUID) ([]uuid.UUID, error) {
+	rows, err := repo.pool.Query(
+		ctx,
+		`SELECT d.blocker
+FROM task_dependencies d
+         JOIN tasks t on t.id = d.blocker
+WHERE d.blocked = $1
+  AND t.status NOT IN ($2, $3)
+ORDER BY d.created_at;`,
+		task,
+		model.StatusClosed,
+		model.StatusAccepted,
+	)
+	if err != nil {
+		repo.log.Log(_unknownLevel, "get open blockers of task", traceError(err)...)
+		return nil, unknown(err)
+	}
+	defer rows.Close()
+
+	var resp []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err = rows.Scan(&id); err != nil {
+			repo.log.Log(_unknownLevel, "scan blocker of task", traceError(err)...)
+			return nil, unknown(err)
+		}
+		resp = append(resp, id)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, unknown(err)
+	}
+	return resp, nil
+}
+
+// GraphByGroup return tasks of group and links between them.
+func (repo *DependencyRepository) GraphByGroup(ctx context.Context, group uuid.UUID) (*model.DependencyGraph, error) {
+	g := &model.DependencyGraph{
+		Nodes: []*model.DependencyNode{},
+		Edges: []*model.TaskDependency{},
+	}
+
+	rows, err := repo.pool.Query(
+		ctx,
+		`SELECT t.id, t.name, t.status
+FROM tasks t
+         JOIN task_group tg on t.id = tg.task_id
+WHERE tg.group_id = $1
+ORDER BY t.created_at, t.id;`,
+		group,
+	)
+	if err != nil {
+		repo.log.Log(_unknownLevel, "get tasks of dependency graph", traceError(err)...)
+		return nil, unknown(err)
+	}
+	for rows.Next() {
+		n := new(model.DependencyNode)
+		if err = rows.Scan(&n.ID, &n.Name, &n.Status); err != nil {
+			rows.Close()
+			repo.log.Log(_unknownLevel, "scan task of dependency graph", traceError(err)...)
+			return nil, unknown(err)
+		}
+		g.Nodes = append(g.Nodes, n)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return nil, unknown(err)
+	}
+
+	rows, err = repo.pool.Query(
+		ctx,
+		`SELECT d.blocker, d.blocked, d.created_by, d.created_at
+FROM task_dependencies d
+WHERE EXISTS(SELECT * FROM task_group tg WHERE tg.task_id = d.blocker AND tg.group_id = $1)
+  AND EXISTS(SELECT * FROM task_group tg WHERE tg.task_id = d.blocked AND tg.group_id = $1)
+ORDER BY d.created_at, d.id;`,
+		group,
+	)
+	if err != nil {
+		repo.log.Log(_unknownLevel, "get links of dependency graph", traceError(err)...)
+		return nil, unknown(err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		d := new(model.TaskDependency)
+		if err = rows.Scan(&d.Blocker, &d.Blocked, &d.CreatedBy, &d.CreatedAt); err != nil {
+			repo.log.Log(_unknownLevel, "scan link of dependency graph", traceError(err)...)
+			return nil, unknown(err)
+		}
+		g.Edges = append(g.Edges, d)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, unknown(err)
+	}
+	return g, nil
+}
@@ -0,0 +1,86 @@
+package pgx
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vlad-marlo/godo/internal/model"
+	"github.com/vlad-marlo/godo/internal/store"
+)
+
+func TestDependencyRepository_NilDependency(t *testing.T) {
+	s, td := testStore(t, nil)
+	defer td()
+
+	assert.ErrorIs(t, s.dep.Create(context.Background(), nil, nil), store.ErrNilReference)
+}
+
+func TestDependencyRepository(t *testing.T) {
+	s, td := testStore(t, nil)
+	defer td()
+
+	ctx := context.Background()
+
+	require.NoError(t, s.user.Create(ctx, TestUser1))
+	require.NoError(t, s.group.Create(ctx, TestGroup1))
+
+	tasks := make([]*model.Task, 3)
+	for i := range tasks {
+		tasks[i] = &model.Task{
+			ID:        uuid.New(),
+			Name:      uuid.NewString(),
+			CreatedAt: time.Now(),
+			CreatedBy: TestUser1.ID,
+			Status:    model.StatusNew,
+		}
+		require.NoError(t, s.task.Create(ctx, tasks[i]))
+	}
+	require.NoError(t, s.task.AddToGroup(ctx, tasks[0].ID, TestGroup1.ID))
+	require.NoError(t, s.task.AddToGroup(ctx, tasks[1].ID, TestGroup1.ID))
+	assert.True(t, s.task.ShareGroup(ctx, tasks[0].ID, tasks[1].ID))
+	assert.False(t, s.task.ShareGroup(ctx, tasks[0].ID, tasks[2].ID))
+
+	dep := &model.TaskDependency{Blocker: tasks[0].ID, Blocked: tasks[1].ID, CreatedBy: TestUser1.ID}
+	require.NoError(t, s.dep.Create(ctx, dep, nil))
+	assert.ErrorIs(t, s.dep.Create(ctx, dep, nil), store.ErrUniqueViolation)
+	require.NoError(t, s.dep.Create(ctx, &model.TaskDependency{Blocker: tasks[1].ID, Blocked: tasks[2].ID, CreatedBy: TestUser1.ID}, nil))
+
+	// check receives links reachable from blocked task and its error cancels creation of link.
+	errCheck := errors.New("check")
+	var downstream []*model.TaskDependency
+	assert.ErrorIs(t, s.dep.Create(
+		ctx,
+		&model.TaskDependency{Blocker: tasks[2].ID, Blocked: tasks[0].ID, CreatedBy: TestUser1.ID},
+		func(edges []*model.TaskDependency) error {
+			downstream = edges
+			return errCheck
+		},
+	), errCheck)
+	assert.Len(t, downstream, 2)
+	g, err := s.dep.GraphByGroup(ctx, TestGroup1.ID)
+	require.NoError(t, err)
+	assert.Len(t, g.Edges, 1)
+
+	blockers, err := s.dep.OpenBlockers(ctx, tasks[1].ID)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{tasks[0].ID}, blockers)
+	require.NoError(t, s.task.UpdateStatus(ctx, tasks[0].ID, model.StatusNew, model.StatusClosed))
+	blockers, err = s.dep.OpenBlockers(ctx, tasks[1].ID)
+	require.NoError(t, err)
+	assert.Empty(t, blockers)
+
+	g, err = s.dep.GraphByGroup(ctx, TestGroup1.ID)
+	require.NoError(t, err)
+	assert.Len(t, g.Nodes, 2)
+	if assert.Len(t, g.Edges, 1) {
+		assert.Equal(t, tasks[0].ID, g.Edges[0].Blocker)
+	}
+
+	require.NoError(t, s.dep.Delete(ctx, tasks[0].ID, tasks[1].ID))
+	assert.ErrorIs(t, s.dep.Delete(ctx, tasks[0].ID, tasks[1].ID), store.ErrNotFound)
+}
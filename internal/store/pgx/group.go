@@ -2,6 +2,7 @@ package pgx
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
@@ -51,6 +52,63 @@ func (repo *GroupRepository) Create(ctx context.Context, group *model.Group) err
 	return nil
 }
 
+// CreateWithOwner creates group, role of group and membership of owner of group in one transaction.
+//
+// Owner becomes admin of group with provided role, so group is never stored without role and admin. ID of role is set
+// to id of stored record.
+func (repo *GroupRepository) CreateWithOwner(ctx context.Context, group *model.Group, role *model.Role) error {
+	if group == nil || role == nil {
+		return store.ErrNilReference
+	}
+
+	tx, err := repo.pool.Begin(ctx)
+	if err != nil {
+		repo.log.Error("unexpected error received while starting new transaction: check drivers", traceError(err)...)
+		return unknown(err)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	if err = tx.QueryRow(
+		ctx,
+		`INSERT INTO groups(id, "name", description, "owner") VALUES ($1, $2, $3, $4) RETURNING created_at;`,
+		group.ID,
+		group.Name,
+		group.Description,
+		group.Owner,
+	).Scan(&group.CreatedAt); err != nil {
+		return pgError("store: group: create with owner", err)
+	}
+
+	role.Group = group.ID
+	if err = tx.QueryRow(
+		ctx,
+		`INSERT INTO group_roles(group_id, "name", permissions) VALUES ($1, $2, $3) RETURNING id;`,
+		role.Group,
+		role.Name,
+		int32(role.Permissions),
+	).Scan(&role.ID); err != nil {
+		return pgError("store: group: create with owner: create role", err)
+	}
+
+	if _, err = tx.Exec(
+		ctx,
+		`INSERT INTO user_in_group(user_id, group_id, role_id, is_admin) VALUES ($1, $2, $3, true);`,
+		group.Owner,
+		group.ID,
+		role.ID,
+	); err != nil {
+		return pgError("store: group: create with owner: add owner", err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		repo.log.Error("unexpected error while doing commit transaction: check pgx driver", traceError(err)...)
+		return unknown(err)
+	}
+	return nil
+}
+
 // UserExists ...
 func (repo *GroupRepository) UserExists(ctx context.Context, group, user uuid.UUID) (ok bool) {
 	if err := repo.pool.QueryRow(
@@ -64,23 +122,20 @@ func (repo *GroupRepository) UserExists(ctx context.Context, group, user uuid.UU
 	return
 }
 
-// GetRoleOfMember return role of user in provided group.
+// GetRoleOfMember return role of user in provided group. Admins of group have all permissions.
 func (repo *GroupRepository) GetRoleOfMember(ctx context.Context, user, group uuid.UUID) (role *model.Role, err error) {
 	role = new(model.Role)
 	if err = repo.pool.QueryRow(
 		ctx,
-		`SELECT r.id,
-       CASE WHEN uig.is_admin THEN 100 ELSE r.members END,
-       CASE WHEN uig.is_admin THEN 100 ELSE r.tasks END,
-       CASE WHEN uig.is_admin THEN 100 ELSE r.reviews END,
-       CASE WHEN uig.is_admin THEN 100 ELSE r.comments END
-FROM roles r
+		`SELECT r.id, r.group_id, r.name, CASE WHEN uig.is_admin THEN $3 ELSE r.permissions END
+FROM group_roles r
          JOIN user_in_group uig on r.id = uig.role_id
 WHERE uig.user_id = $1
   and uig.group_id = $2;`,
 		user,
 		group,
-	).Scan(&role.ID, &role.Members, &role.Tasks, &role.Reviews, &role.Comments); err != nil {
+		int32(model.PermAll),
+	).Scan(&role.ID, &role.Group, &role.Name, &role.Permissions); err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, store.ErrNotFound
 		}
@@ -134,7 +189,17 @@ func (repo *GroupRepository) Get(ctx context.Context, id uuid.UUID) (*model.Grou
 	g := new(model.Group)
 	if err := repo.pool.QueryRow(
 		ctx,
-		`SELECT g.id, g.name, g.description, g.created_at, g.owner FROM groups g WHERE g.id = $1`,
+		`SELECT g.id,
+       g.name,
+       g.description,
+       g.created_at,
+       g.owner,
+       g.telegram_chat,
+       g.company_name,
+       g.company_info,
+       g.custom_fields
+FROM groups g
+WHERE g.id = $1`,
 		id,
 	).Scan(
 		&g.ID,
@@ -142,6 +207,10 @@ func (repo *GroupRepository) Get(ctx context.Context, id uuid.UUID) (*model.Grou
 		&g.Description,
 		&g.CreatedAt,
 		&g.Owner,
+		&g.TelegramChat,
+		&g.CompanyName,
+		&g.CompanyInfo,
+		&g.Fields,
 	); err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, store.ErrNotFound
@@ -153,6 +222,60 @@ func (repo *GroupRepository) Get(ctx context.Context, id uuid.UUID) (*model.Grou
 
 }
 
+// Update changes name, description and profile of group.
+//
+// Errors:
+// store.ErrUniqueViolation group with provided name already exists;
+// store.ErrNotFound group does not exist;
+func (repo *GroupRepository) Update(ctx context.Context, group *model.Group) error {
+	if group == nil {
+		return store.ErrNilReference
+	}
+	fields := group.Fields
+	if fields == nil {
+		fields = map[string]string{}
+	}
+
+	tag, err := repo.pool.Exec(
+		ctx,
+		`UPDATE groups
+SET "name"        = $2,
+    description   = $3,
+    telegram_chat = $4,
+    company_name  = $5,
+    company_info  = $6,
+    custom_fields = $7
+WHERE id = $1;`,
+		group.ID,
+		group.Name,
+		group.Description,
+		group.TelegramChat,
+		group.CompanyName,
+		group.CompanyInfo,
+		fields,
+	)
+	if err != nil {
+		return pgError("store: group: update", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return store.ErrNotFound
+	}
+	return nil
+}
+
+// IsAdmin return true if user is admin of group.
+func (repo *GroupRepository) IsAdmin(ctx context.Context, group, user uuid.UUID) (ok bool) {
+	if err := repo.pool.QueryRow(
+		ctx,
+		`SELECT EXISTS(SELECT * FROM user_in_group WHERE group_id = $1 AND user_id = $2 AND is_admin);`,
+		group,
+		user,
+	).Scan(&ok); err != nil {
+		repo.log.Log(_unknownLevel, "get admin status of user in group", traceError(err)...)
+	}
+	return
+}
+
 // GetUserIDs ...
 func (repo *GroupRepository) GetUserIDs(ctx context.Context, group uuid.UUID) (ids []uuid.UUID, err error) {
 	var rows pgx.Rows
@@ -195,6 +318,303 @@ func (repo *GroupRepository) TaskExists(ctx context.Context, group, task uuid.UU
 	return
 }
 
+// _memberQuery selects members of group with their roles.
+const _memberQuery = `SELECT u.id, u.email, uig.is_admin, r.id, r.name, r.permissions, uig.invited_by
+FROM user_in_group uig
+         JOIN users u on u.id = uig.user_id
+         JOIN group_roles r on r.id = uig.role_id
+WHERE uig.group_id = $1`
+
+// Members return members of group ordered by email.
+func (repo *GroupRepository) Members(ctx context.Context, group uuid.UUID) ([]*model.UserInGroup, error) {
+	rows, err := repo.pool.Query(ctx, _memberQuery+`
+ORDER BY u.email;`, group)
+	if err != nil {
+		repo.log.Log(_unknownLevel, "get members of group", traceError(err)...)
+		return nil, unknown(err)
+	}
+	defer rows.Close()
+
+	var members []*model.UserInGroup
+	for rows.Next() {
+		m := new(model.UserInGroup)
+		if err = rows.Scan(&m.UserID, &m.Email, &m.IsAdmin, &m.Role, &m.RoleName, &m.Permissions, &m.InvitedBy); err != nil {
+			repo.log.Log(_unknownLevel, "scan member of group", traceError(err)...)
+			return nil, unknown(err)
+		}
+		members = append(members, m)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, unknown(err)
+	}
+	return members, nil
+}
+
+// GetMember return member of group. If user is not member of group then store.ErrNotFound will be returned.
+func (repo *GroupRepository) GetMember(ctx context.Context, group, user uuid.UUID) (*model.UserInGroup, error) {
+	m := new(model.UserInGroup)
+	if err := repo.pool.QueryRow(ctx, _memberQuery+`
+  AND uig.user_id = $2;`, group, user).Scan(
+		&m.UserID,
+		&m.Email,
+		&m.IsAdmin,
+		&m.Role,
+		&m.RoleName,
+		&m.Permissions,
+		&m.InvitedBy,
+	); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, store.ErrNotFound
+		}
+		repo.log.Log(_unknownLevel, "get member of group", traceError(err)...)
+		return nil, unknown(err)
+	}
+	return m, nil
+}
+
+// RemoveUser removes user from group in one transaction.
+//
+// User is unassigned from tasks of group which are not closed or accepted and stops watching tasks of group
+// which user could not see anymore. Entry is recorded to audit log with role of user before removal as old value.
+// Unassignment is recorded to history of every task with actor of entry as author of change.
+//
+// Errors:
+// store.ErrNotFound user is not member of group;
+// store.ErrLastAdmin user is the only admin of group;
+func (repo *GroupRepository) RemoveUser(ctx context.Context, group, user uuid.UUID, entry *model.AuditEntry) error {
+	if entry == nil {
+		return store.ErrNilReference
+	}
+
+	tx, err := repo.pool.Begin(ctx)
+	if err != nil {
+		repo.log.Error("unexpected error received while starting new transaction: check drivers", traceError(err)...)
+		return unknown(err)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	if err = repo.checkLastAdmin(ctx, tx, group, user); err != nil {
+		return err
+	}
+
+	var old model.MemberRole
+	if err = tx.QueryRow(
+		ctx,
+		`DELETE
+FROM user_in_group uig
+    USING group_roles r
+WHERE uig.group_id = $1
+  AND uig.user_id = $2
+  AND r.id = uig.role_id
+RETURNING uig.is_admin, r.id, r.name, r.permissions;`,
+		group,
+		user,
+	).Scan(&old.IsAdmin, &old.Role, &old.Name, &old.Permissions); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return store.ErrNotFound
+		}
+		return pgError("store: group: remove user", err)
+	}
+	// MemberRole has only plain fields, so marshaling could not fail.
+	entry.OldValue, _ = json.Marshal(old)
+	if err = addAuditEntry(ctx, tx, entry); err != nil {
+		return err
+	}
+
+	rows, err := tx.Query(
+		ctx,
+		`DELETE
+FROM task_user tu
+    USING task_group tg, tasks t
+WHERE tu.task_id = tg.task_id
+  AND t.id = tg.task_id
+  AND tg.group_id = $1
+  AND tu.user_id = $2
+  AND t.status NOT IN ('CLOSED', 'ACCEPTED')
+RETURNING tu.task_id;`,
+		group,
+		user,
+	)
+	if err != nil {
+		repo.log.Log(_unknownLevel, "unassign removed member from tasks of group", traceError(err)...)
+		return unknown(err)
+	}
+	id := user.String()
+	var changes []*model.TaskChange
+	for rows.Next() {
+		var task uuid.UUID
+		if err = rows.Scan(&task); err != nil {
+			rows.Close()
+			repo.log.Log(_unknownLevel, "scan unassigned task", traceError(err)...)
+			return unknown(err)
+		}
+		changes = append(changes, model.NewTaskChange(task, entry.Actor, model.TaskFieldAssignees, &id, nil))
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return unknown(err)
+	}
+	if err = addTaskChanges(ctx, tx, changes...); err != nil {
+		return err
+	}
+
+	if _, err = tx.Exec(
+		ctx,
+		`DELETE
+FROM task_watchers tw
+    USING task_group tg, tasks t
+WHERE tw.task_id = tg.task_id
+  AND t.id = tg.task_id
+  AND tg.group_id = $1
+  AND tw.user_id = $2
+  AND t.created_by <> $2
+  AND NOT EXISTS(SELECT * FROM task_user tu WHERE tu.task_id = t.id AND tu.user_id = $2);`,
+		group,
+		user,
+	); err != nil {
+		repo.log.Log(_unknownLevel, "unwatch tasks of group by removed member", traceError(err)...)
+		return unknown(err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		repo.log.Error("unexpected error while doing commit transaction: check pgx driver", traceError(err)...)
+		return unknown(err)
+	}
+	return nil
+}
+
+// checkLastAdmin locks admins of group in transaction and return store.ErrLastAdmin if user is the only admin of group.
+//
+// Admins are locked, so two last admins could not stop being admins concurrently.
+func (repo *GroupRepository) checkLastAdmin(ctx context.Context, tx pgx.Tx, group, user uuid.UUID) error {
+	var isAdmin bool
+	var admins int
+	if err := tx.QueryRow(
+		ctx,
+		`SELECT COALESCE(BOOL_OR(uig.user_id = $2), FALSE), COUNT(*)
+FROM (SELECT user_id FROM user_in_group WHERE group_id = $1 AND is_admin FOR UPDATE) uig;`,
+		group,
+		user,
+	).Scan(&isAdmin, &admins); err != nil {
+		repo.log.Log(_unknownLevel, "lock admins of group", traceError(err)...)
+		return unknown(err)
+	}
+	if isAdmin && admins == 1 {
+		return store.ErrLastAdmin
+	}
+	return nil
+}
+
+// SetMemberRole changes role and admin flag of member and records entry to audit log in one transaction.
+//
+// Errors:
+// store.ErrNotFound user is not member of group;
+// store.ErrLastAdmin admin flag is revoked from the only admin of group;
+func (repo *GroupRepository) SetMemberRole(ctx context.Context, group, user uuid.UUID, role int32, isAdmin bool, entry *model.AuditEntry) error {
+	if entry == nil {
+		return store.ErrNilReference
+	}
+
+	tx, err := repo.pool.Begin(ctx)
+	if err != nil {
+		repo.log.Error("unexpected error received while starting new transaction: check drivers", traceError(err)...)
+		return unknown(err)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	if !isAdmin {
+		if err = repo.checkLastAdmin(ctx, tx, group, user); err != nil {
+			return err
+		}
+	}
+
+	tag, err := tx.Exec(
+		ctx,
+		`UPDATE user_in_group SET role_id = $3, is_admin = $4 WHERE group_id = $1 AND user_id = $2;`,
+		group,
+		user,
+		role,
+		isAdmin,
+	)
+	if err != nil {
+		return pgError("store: group: set member role", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return store.ErrNotFound
+	}
+
+	if err = addAuditEntry(ctx, tx, entry); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		repo.log.Error("unexpected error while doing commit transaction: check pgx driver", traceError(err)...)
+		return unknown(err)
+	}
+	return nil
+}
+
+// addAuditEntry stores entry of audit log of group in transaction and sets id of it.
+func addAuditEntry(ctx context.Context, tx pgx.Tx, entry *model.AuditEntry) error {
+	if err := tx.QueryRow(
+		ctx,
+		`INSERT INTO group_audit_log(group_id, actor, member, action, old_value, new_value, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING id;`,
+		entry.Group,
+		entry.Actor,
+		entry.Member,
+		entry.Action,
+		entry.OldValue,
+		entry.NewValue,
+		entry.CreatedAt,
+	).Scan(&entry.ID); err != nil {
+		return pgError("store: group: add audit entry", err)
+	}
+	return nil
+}
+
+// AuditLog return at most limit entries of audit log of group which ids are greater than after, oldest first.
+func (repo *GroupRepository) AuditLog(ctx context.Context, group uuid.UUID, after int64, limit int) ([]*model.AuditEntry, error) {
+	rows, err := repo.pool.Query(
+		ctx,
+		`SELECT a.id, a.group_id, a.actor, a.member, a.action, a.old_value, a.new_value, a.created_at
+FROM group_audit_log a
+WHERE a.group_id = $1
+  AND a.id > $2
+ORDER BY a.id
+LIMIT $3;`,
+		group,
+		after,
+		limit,
+	)
+	if err != nil {
+		repo.log.Log(_unknownLevel, "get audit log of group", traceError(err)...)
+		return nil, unknown(err)
+	}
+	defer rows.Close()
+
+	var entries []*model.AuditEntry
+	for rows.Next() {
+		e := new(model.AuditEntry)
+		if err = rows.Scan(&e.ID, &e.Group, &e.Actor, &e.Member, &e.Action, &e.OldValue, &e.NewValue, &e.CreatedAt); err != nil {
+			repo.log.Log(_unknownLevel, "scan audit entry", traceError(err)...)
+			return nil, unknown(err)
+		}
+		entries = append(entries, e)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, unknown(err)
+	}
+	return entries, nil
+}
+
 // AddUser adds user to group.
 func (repo *GroupRepository) AddUser(ctx context.Context, roleID int32, groupID, userID uuid.UUID, isAdmin bool) error {
 	if _, err := repo.pool.Exec(
@@ -209,3 +629,140 @@ func (repo *GroupRepository) AddUser(ctx context.Context, roleID int32, groupID,
 	}
 	return nil
 }
+
+// Stats return statistics of group tasks over period of filter.
+//
+// Task is closed during week if it was moved into CLOSED status during it. Cycle time is counted for every
+// acceptance of task during period from the first move of task into IN_PROGRESS. Weeks in which nothing happened
+// are not returned.
+func (repo *GroupRepository) Stats(ctx context.Context, filter model.GroupStatsFilter) (*model.GroupStats, error) {
+	stats := &model.GroupStats{
+		Group:    filter.Group,
+		From:     filter.From,
+		To:       filter.To,
+		Statuses: make(map[model.TaskStatus]int),
+	}
+
+	rows, err := repo.pool.Query(
+		ctx,
+		`SELECT t.status, COUNT(*)
+FROM tasks t
+         JOIN task_group tg on tg.task_id = t.id
+WHERE tg.group_id = $1
+GROUP BY t.status;`,
+		filter.Group,
+	)
+	if err != nil {
+		repo.log.Log(_unknownLevel, "groups: stats: get statuses", traceError(err)...)
+		return nil, unknown(err)
+	}
+	for rows.Next() {
+		var (
+			status model.TaskStatus
+			count  int
+		)
+		if err = rows.Scan(&status, &count); err != nil {
+			rows.Close()
+			repo.log.Log(_unknownLevel, "groups: stats: scan status", traceError(err)...)
+			return nil, unknown(err)
+		}
+		stats.Statuses[status] = count
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return nil, unknown(err)
+	}
+
+	rows, err = repo.pool.Query(
+		ctx,
+		`SELECT w.week, COUNT(*) FILTER (WHERE w.kind = 'created'), COUNT(*) FILTER (WHERE w.kind = 'closed')
+FROM (SELECT date_trunc('week', t.created_at) AS week, 'created' AS kind
+      FROM tasks t
+               JOIN task_group tg on tg.task_id = t.id
+      WHERE tg.group_id = $1
+        AND t.created_at >= $2
+        AND t.created_at < $3
+      UNION ALL
+      SELECT date_trunc('week', h.created_at), 'closed'
+      FROM task_history h
+               JOIN task_group tg on tg.task_id = h.task_id
+      WHERE tg.group_id = $1
+        AND h.field = $4
+        AND h.new_value = $5
+        AND h.created_at >= $2
+        AND h.created_at < $3) w
+GROUP BY w.week
+ORDER BY w.week;`,
+		filter.Group,
+		filter.From,
+		filter.To,
+		model.TaskFieldStatus,
+		model.StatusClosed,
+	)
+	if err != nil {
+		repo.log.Log(_unknownLevel, "groups: stats: get weeks", traceError(err)...)
+		return nil, unknown(err)
+	}
+	for rows.Next() {
+		w := new(model.WeeklyFlow)
+		if err = rows.Scan(&w.Week, &w.Created, &w.Closed); err != nil {
+			rows.Close()
+			repo.log.Log(_unknownLevel, "groups: stats: scan week", traceError(err)...)
+			return nil, unknown(err)
+		}
+		stats.Weeks = append(stats.Weeks, w)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return nil, unknown(err)
+	}
+
+	var median float64
+	if err = repo.pool.QueryRow(
+		ctx,
+		`SELECT COUNT(*), COALESCE(percentile_cont(0.5) WITHIN GROUP (ORDER BY c.seconds), 0)
+FROM (SELECT EXTRACT(EPOCH FROM a.created_at - (SELECT MIN(s.created_at)
+                                               FROM task_history s
+                                               WHERE s.task_id = a.task_id
+                                                 AND s.field = $4
+                                                 AND s.new_value = $5
+                                                 AND s.created_at <= a.created_at)) AS seconds
+      FROM task_history a
+               JOIN task_group tg on tg.task_id = a.task_id
+      WHERE tg.group_id = $1
+        AND a.field = $4
+        AND a.new_value = $6
+        AND a.created_at >= $2
+        AND a.created_at < $3) c
+WHERE c.seconds IS NOT NULL;`,
+		filter.Group,
+		filter.From,
+		filter.To,
+		model.TaskFieldStatus,
+		model.StatusInProgress,
+		model.StatusAccepted,
+	).Scan(&stats.CycleTime.Tasks, &median); err != nil {
+		repo.log.Log(_unknownLevel, "groups: stats: get cycle time", traceError(err)...)
+		return nil, unknown(err)
+	}
+	stats.CycleTime.MedianSeconds = int64(median)
+
+	if err = repo.pool.QueryRow(
+		ctx,
+		`SELECT COUNT(*), COUNT(*) FILTER (WHERE r.status = $4)
+FROM reviews r
+         JOIN task_group tg on tg.task_id = r.task
+WHERE tg.group_id = $1
+  AND r.resolved_at >= $2
+  AND r.resolved_at < $3;`,
+		filter.Group,
+		filter.From,
+		filter.To,
+		model.ReviewChangesRequested,
+	).Scan(&stats.Reviews.Resolved, &stats.Reviews.Rejected); err != nil {
+		repo.log.Log(_unknownLevel, "groups: stats: get reviews", traceError(err)...)
+		return nil, unknown(err)
+	}
+
+	return stats, nil
+}
@@ -2,6 +2,7 @@ package pgx
 
 import (
 	"context"
+	"encoding/json"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -41,6 +42,31 @@ func TestGroupRepository_Create_Positive(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestGroupRepository_CreateWithOwner(t *testing.T) {
+	s, td := testStore(t, nil)
+	defer td()
+
+	ctx := context.Background()
+
+	require.NoError(t, s.user.Create(ctx, TestUser1))
+	assert.ErrorIs(t, s.group.CreateWithOwner(ctx, TestGroup1, nil), store.ErrNilReference)
+
+	role := &model.Role{Name: "admin", Permissions: model.PermAll}
+	require.NoError(t, s.group.CreateWithOwner(ctx, TestGroup1, role))
+	assert.Equal(t, TestGroup1.ID, role.Group)
+	assert.NotZero(t, role.ID)
+	assert.True(t, s.group.IsAdmin(ctx, TestGroup1.ID, TestUser1.ID))
+	member, err := s.group.GetMember(ctx, TestGroup1.ID, TestUser1.ID)
+	require.NoError(t, err)
+	assert.Equal(t, role.ID, member.Role)
+
+	// group is not stored if its role could not be created.
+	bad := &model.Role{Name: "admin", Permissions: -1}
+	assert.Error(t, s.group.CreateWithOwner(ctx, TestGroup2, bad))
+	_, err = s.group.Get(ctx, TestGroup2.ID)
+	assert.ErrorIs(t, err, store.ErrNotFound)
+}
+
 func TestGroupRepository_Create_NilReference(t *testing.T) {
 	s, td := testStore(t, nil)
 	defer td()
@@ -94,3 +120,249 @@ func TestGroupRepository_Get(t *testing.T) {
 	assert.ErrorIs(t, err, store.ErrNotFound)
 	assert.Error(t, err)
 }
+
+func TestGroupRepository_Update(t *testing.T) {
+	s, td := testStore(t, nil)
+	defer td()
+	ctx := context.Background()
+	require.NoError(t, s.user.Create(ctx, TestUser1))
+	require.NoError(t, s.group.Create(ctx, TestGroup1))
+	require.NoError(t, s.group.Create(ctx, TestGroup2))
+
+	g := *TestGroup1
+	g.Name = "renamed group"
+	g.TelegramChat = "@godo_team"
+	g.CompanyName = "company"
+	g.CompanyInfo = "info"
+	g.Fields = map[string]string{"site": "example.com"}
+	require.NoError(t, s.group.Update(ctx, &g))
+
+	got, err := s.group.Get(ctx, g.ID)
+	require.NoError(t, err)
+	assert.Equal(t, g.Name, got.Name)
+	assert.Equal(t, g.TelegramChat, got.TelegramChat)
+	assert.Equal(t, g.CompanyName, got.CompanyName)
+	assert.Equal(t, g.CompanyInfo, got.CompanyInfo)
+	assert.Equal(t, g.Fields, got.Fields)
+
+	g.Name = TestGroup2.Name
+	assert.ErrorIs(t, s.group.Update(ctx, &g), store.ErrUniqueViolation)
+	g.ID = uuid.New()
+	g.Name = "missing group"
+	assert.ErrorIs(t, s.group.Update(ctx, &g), store.ErrNotFound)
+	assert.ErrorIs(t, s.group.Update(ctx, nil), store.ErrNilReference)
+}
+
+func TestGroupRepository_IsAdmin(t *testing.T) {
+	s, td := testStore(t, nil)
+	defer td()
+	ctx := context.Background()
+	require.NoError(t, s.user.Create(ctx, TestUser1))
+	require.NoError(t, s.user.Create(ctx, TestUser2))
+	require.NoError(t, s.group.Create(ctx, TestGroup1))
+	role := &model.Role{Group: TestGroup1.ID, Name: "read only"}
+	require.NoError(t, s.role.Create(ctx, role))
+	require.NoError(t, s.group.AddUser(ctx, role.ID, TestGroup1.ID, TestUser1.ID, true))
+	require.NoError(t, s.group.AddUser(ctx, role.ID, TestGroup1.ID, TestUser2.ID, false))
+
+	assert.True(t, s.group.IsAdmin(ctx, TestGroup1.ID, TestUser1.ID))
+	assert.False(t, s.group.IsAdmin(ctx, TestGroup1.ID, TestUser2.ID))
+	assert.False(t, s.group.IsAdmin(ctx, uuid.New(), TestUser1.ID))
+}
+
+func TestGroupRepository_Members(t *testing.T) {
+	s, td := testStore(t, nil)
+	defer td()
+	ctx := context.Background()
+	require.NoError(t, s.user.Create(ctx, TestUser1))
+	require.NoError(t, s.user.Create(ctx, TestUser2))
+	require.NoError(t, s.group.Create(ctx, TestGroup1))
+	require.NoError(t, s.role.Create(ctx, TestRole1))
+	require.NoError(t, s.group.AddUser(ctx, TestRole1.ID, TestGroup1.ID, TestUser1.ID, true))
+	require.NoError(t, s.invite.Create(ctx, TestInvite1, TestRole1.ID, TestGroup1.ID, 1, TestUser1.ID))
+	require.NoError(t, s.invite.Use(ctx, TestInvite1, TestUser2.ID))
+
+	members, err := s.group.Members(ctx, TestGroup1.ID)
+	require.NoError(t, err)
+	require.Len(t, members, 2)
+
+	m, err := s.group.GetMember(ctx, TestGroup1.ID, TestUser2.ID)
+	require.NoError(t, err)
+	assert.Equal(t, TestUser2.Email, m.Email)
+	assert.False(t, m.IsAdmin)
+	assert.Equal(t, TestRole1.ID, m.Role)
+	assert.Equal(t, TestRole1.Name, m.RoleName)
+	assert.Equal(t, TestRole1.Permissions, m.Permissions)
+	if assert.NotNil(t, m.InvitedBy) {
+		assert.Equal(t, TestUser1.ID, *m.InvitedBy)
+	}
+
+	_, err = s.group.GetMember(ctx, TestGroup2.ID, TestUser2.ID)
+	assert.ErrorIs(t, err, store.ErrNotFound)
+}
+
+func TestGroupRepository_RemoveUser(t *testing.T) {
+	s, td := testStore(t, nil)
+	defer td()
+	ctx := context.Background()
+	require.NoError(t, s.user.Create(ctx, TestUser1))
+	require.NoError(t, s.user.Create(ctx, TestUser2))
+	require.NoError(t, s.group.Create(ctx, TestGroup1))
+	require.NoError(t, s.role.Create(ctx, TestRole1))
+	require.NoError(t, s.group.AddUser(ctx, TestRole1.ID, TestGroup1.ID, TestUser1.ID, true))
+	require.NoError(t, s.group.AddUser(ctx, TestRole1.ID, TestGroup1.ID, TestUser2.ID, false))
+
+	open := &model.Task{ID: uuid.New(), Name: "open", CreatedAt: time.Now(), CreatedBy: TestUser1.ID, Status: model.StatusInProgress}
+	closed := &model.Task{ID: uuid.New(), Name: "closed", CreatedAt: time.Now(), CreatedBy: TestUser1.ID, Status: model.StatusClosed}
+	for _, task := range []*model.Task{open, closed} {
+		require.NoError(t, s.task.Create(ctx, task))
+		require.NoError(t, s.task.AddToGroup(ctx, task.ID, TestGroup1.ID))
+		require.NoError(t, s.task.ForceAddToUser(ctx, TestUser2.ID, task.ID))
+	}
+
+	err := s.group.RemoveUser(ctx, TestGroup1.ID, TestUser1.ID, model.NewMemberRemovalEntry(TestGroup1.ID, TestUser1.ID, TestUser1.ID))
+	assert.ErrorIs(t, err, store.ErrLastAdmin)
+	err = s.group.RemoveUser(ctx, TestGroup1.ID, TestUser2.ID, nil)
+	assert.ErrorIs(t, err, store.ErrNilReference)
+
+	removal := model.NewMemberRemovalEntry(TestGroup1.ID, TestUser1.ID, TestUser2.ID)
+	require.NoError(t, s.group.RemoveUser(ctx, TestGroup1.ID, TestUser2.ID, removal))
+	// unassignment is recorded only for open task.
+	for task, want := range map[uuid.UUID]int{open.ID: 1, closed.ID: 0} {
+		changes, err := s.history.AllByTask(ctx, task)
+		require.NoError(t, err)
+		require.Len(t, changes, want)
+		if want > 0 {
+			assert.Equal(t, model.TaskFieldAssignees, changes[0].Field)
+			assert.Equal(t, TestUser1.ID, changes[0].Actor)
+			assert.Equal(t, TestUser2.ID.String(), *changes[0].OldValue)
+		}
+	}
+	assert.False(t, s.task.IsAssignee(ctx, open.ID, TestUser2.ID))
+	assert.True(t, s.task.IsAssignee(ctx, closed.ID, TestUser2.ID))
+	assert.False(t, s.watcher.IsWatcher(ctx, open.ID, TestUser2.ID))
+	assert.True(t, s.watcher.IsWatcher(ctx, closed.ID, TestUser2.ID))
+
+	err = s.group.RemoveUser(ctx, TestGroup1.ID, TestUser2.ID, model.NewMemberRemovalEntry(TestGroup1.ID, TestUser1.ID, TestUser2.ID))
+	assert.ErrorIs(t, err, store.ErrNotFound)
+
+	// only successful removal is recorded with role of member before it.
+	entries, err := s.group.AuditLog(ctx, TestGroup1.ID, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, removal.ID, entries[0].ID)
+	assert.Equal(t, model.AuditMemberRemoved, entries[0].Action)
+	var old model.MemberRole
+	require.NoError(t, json.Unmarshal(entries[0].OldValue, &old))
+	assert.Equal(t, TestRole1.ID, old.Role)
+	assert.False(t, old.IsAdmin)
+}
+
+func TestGroupRepository_SetMemberRole(t *testing.T) {
+	s, td := testStore(t, nil)
+	defer td()
+	ctx := context.Background()
+	require.NoError(t, s.user.Create(ctx, TestUser1))
+	require.NoError(t, s.user.Create(ctx, TestUser2))
+	require.NoError(t, s.group.Create(ctx, TestGroup1))
+	require.NoError(t, s.role.Create(ctx, TestRole1))
+	require.NoError(t, s.group.AddUser(ctx, TestRole1.ID, TestGroup1.ID, TestUser1.ID, true))
+	require.NoError(t, s.group.AddUser(ctx, TestRole1.ID, TestGroup1.ID, TestUser2.ID, false))
+
+	readOnly := &model.Role{Group: TestGroup1.ID, Name: "read only"}
+	require.NoError(t, s.role.Create(ctx, readOnly))
+
+	entry := func(member uuid.UUID, old, new model.MemberRole) *model.AuditEntry {
+		return model.NewMemberRoleEntry(TestGroup1.ID, TestUser1.ID, member, old, new)
+	}
+
+	promote := entry(TestUser2.ID, model.MemberRole{}, model.MemberRole{IsAdmin: true})
+	require.NoError(t, s.group.SetMemberRole(ctx, TestGroup1.ID, TestUser2.ID, readOnly.ID, true, promote))
+	m, err := s.group.GetMember(ctx, TestGroup1.ID, TestUser2.ID)
+	require.NoError(t, err)
+	assert.True(t, m.IsAdmin)
+	assert.Equal(t, readOnly.ID, m.Role)
+	assert.Equal(t, model.Permission(0), m.Permissions)
+
+	demote := entry(TestUser1.ID, model.MemberRole{IsAdmin: true}, model.MemberRole{})
+	require.NoError(t, s.group.SetMemberRole(ctx, TestGroup1.ID, TestUser1.ID, readOnly.ID, false, demote))
+	err = s.group.SetMemberRole(ctx, TestGroup1.ID, TestUser2.ID, readOnly.ID, false, entry(TestUser2.ID, model.MemberRole{}, model.MemberRole{}))
+	assert.ErrorIs(t, err, store.ErrLastAdmin)
+	err = s.group.SetMemberRole(ctx, TestGroup2.ID, TestUser2.ID, readOnly.ID, true, entry(TestUser2.ID, model.MemberRole{}, model.MemberRole{}))
+	assert.ErrorIs(t, err, store.ErrNotFound)
+	assert.ErrorIs(t, s.group.SetMemberRole(ctx, TestGroup1.ID, TestUser2.ID, readOnly.ID, true, nil), store.ErrNilReference)
+
+	entries, err := s.group.AuditLog(ctx, TestGroup1.ID, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, promote.ID, entries[0].ID)
+	assert.Equal(t, model.AuditMemberRole, entries[0].Action)
+	assert.JSONEq(t, string(promote.NewValue), string(entries[0].NewValue))
+	assert.Equal(t, demote.ID, entries[1].ID)
+
+	entries, err = s.group.AuditLog(ctx, TestGroup1.ID, promote.ID, 10)
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+}
+
+func TestGroupRepository_Stats(t *testing.T) {
+	s, td := testStore(t, nil)
+	defer td()
+
+	ctx := context.Background()
+	require.NoError(t, s.user.Create(ctx, TestUser1))
+	require.NoError(t, s.group.Create(ctx, TestGroup1))
+
+	now := time.Now()
+	done := &model.Task{ID: uuid.New(), Name: "done", CreatedAt: now, CreatedBy: TestUser1.ID, Status: model.StatusOnReview}
+	open := &model.Task{ID: uuid.New(), Name: "open", CreatedAt: now, CreatedBy: TestUser1.ID, Status: model.StatusNew}
+	old := &model.Task{ID: uuid.New(), Name: "old", CreatedAt: now.AddDate(0, -6, 0), CreatedBy: TestUser1.ID, Status: model.StatusClosed}
+	for _, task := range []*model.Task{done, open, old} {
+		require.NoError(t, s.task.Create(ctx, task))
+		require.NoError(t, s.task.AddToGroup(ctx, task.ID, TestGroup1.ID))
+	}
+
+	status := func(task uuid.UUID, to model.TaskStatus, at time.Time) *model.TaskChange {
+		c := model.NewTaskChange(task, TestUser1.ID, model.TaskFieldStatus, nil, (*string)(&to))
+		c.CreatedAt = at
+		return c
+	}
+	require.NoError(t, s.history.Add(
+		ctx,
+		status(done.ID, model.StatusInProgress, now.Add(-4*time.Hour)),
+		status(done.ID, model.StatusOnReview, now.Add(-3*time.Hour)),
+		status(done.ID, model.StatusInProgress, now.Add(-2*time.Hour)),
+		status(done.ID, model.StatusAccepted, now.Add(-time.Hour)),
+		status(old.ID, model.StatusClosed, now),
+	))
+
+	rejected := &model.Review{ID: uuid.New(), Task: done.ID, User: TestUser1.ID, Message: "check", Status: model.ReviewPending}
+	require.NoError(t, s.review.Create(ctx, rejected, model.StatusOnReview))
+	rejected.Status, rejected.ResolvedBy = model.ReviewChangesRequested, &TestUser1.ID
+	require.NoError(t, s.review.Resolve(ctx, rejected, model.StatusChangesRequested))
+
+	from, to := now.Add(-24*time.Hour), now.Add(24*time.Hour)
+	stats, err := s.group.Stats(ctx, model.GroupStatsFilter{Group: TestGroup1.ID, From: from, To: to})
+	require.NoError(t, err)
+	assert.Equal(t, 1, stats.Statuses[model.StatusNew])
+	assert.Equal(t, 1, stats.Statuses[model.StatusChangesRequested])
+	assert.Equal(t, 1, stats.Statuses[model.StatusClosed])
+
+	var created, closed int
+	for _, w := range stats.Weeks {
+		created += w.Created
+		closed += w.Closed
+	}
+	assert.Equal(t, 2, created)
+	assert.Equal(t, 1, closed)
+
+	assert.Equal(t, 1, stats.CycleTime.Tasks)
+	assert.Equal(t, int64(3*60*60), stats.CycleTime.MedianSeconds)
+	assert.Equal(t, 1, stats.Reviews.Resolved)
+	assert.Equal(t, 1, stats.Reviews.Rejected)
+
+	stats, err = s.group.Stats(ctx, model.GroupStatsFilter{Group: uuid.New(), From: from, To: to})
+	require.NoError(t, err)
+	assert.Empty(t, stats.Statuses)
+	assert.Empty(t, stats.Weeks)
+}
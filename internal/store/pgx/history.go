@@ -0,0 +1,157 @@
+package pgx
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/vlad-marlo/godo/internal/model"
+	"github.com/vlad-marlo/godo/internal/store"
+	"go.uber.org/zap"
+)
+
+var _ store.HistoryRepository = (*HistoryRepository)(nil)
+
+// HistoryRepository is append-only storage of task changes.
+type HistoryRepository struct {
+	pool *pgxpool.Pool
+	log  *zap.Logger
+}
+
+// NewHistoryRepository return new instance of HistoryRepository.
+func NewHistoryRepository(cli Client) *HistoryRepository {
+	return &HistoryRepository{
+		pool: cli.P(),
+		log:  cli.L(),
+	}
+}
+
+// Add stores changes in one transaction. ID of every change is set to id of stored record.
+func (repo *HistoryRepository) Add(ctx context.Context, changes ...*model.TaskChange) error {
+	if len(changes) == 0 {
+		return nil
+	}
+
+	tx, err := repo.pool.Begin(ctx)
+	if err != nil {
+		repo.log.Error("unexpected error received while starting new transaction: check drivers", traceError(err)...)
+		return unknown(err)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	if err = addTaskChanges(ctx, tx, changes...); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		repo.log.Error("unexpected error while doing commit transaction: check pgx driver", traceError(err)...)
+		return unknown(err)
+	}
+	return nil
+}
+
+// addTaskChanges stores changes of tasks in transaction which applies them. ID of every change is set to id of stored
+// record.
+func addTaskChanges(ctx context.Context, tx pgx.Tx, changes ...*model.TaskChange) error {
+	for _, c := range changes {
+		if c == nil {
+			return store.ErrNilReference
+		}
+	}
+
+	for _, c := range changes {
+		if err := tx.QueryRow(
+			ctx,
+			`INSERT INTO task_history(task_id, actor, field, old_value, new_value, created_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id;`,
+			c.Task,
+			c.Actor,
+			c.Field,
+			c.OldValue,
+			c.NewValue,
+			c.CreatedAt,
+		).Scan(&c.ID); err != nil {
+			return pgError("store: history: add", err)
+		}
+	}
+	return nil
+}
+
+// addCreationHistory stores creation of task, its assignees and labels which were added to task in transaction
+// which creates it. Creator of task is actor of every change.
+func addCreationHistory(ctx context.Context, tx pgx.Tx, task *model.Task) error {
+	changes := []*model.TaskChange{
+		model.NewTaskChange(task.ID, task.CreatedBy, model.TaskFieldCreated, nil, &task.Name),
+	}
+
+	rows, err := tx.Query(
+		ctx,
+		`SELECT $2::text, tu.user_id::text
+FROM task_user tu
+WHERE tu.task_id = $1
+UNION ALL
+SELECT $3::text, l.name
+FROM task_labels tl
+         JOIN labels l on l.id = tl.label_id
+WHERE tl.task_id = $1;`,
+		task.ID,
+		model.TaskFieldAssignees,
+		model.TaskFieldLabels,
+	)
+	if err != nil {
+		return pgError("store: history: get relations of created task", err)
+	}
+	for rows.Next() {
+		var (
+			field model.TaskField
+			value string
+		)
+		if err = rows.Scan(&field, &value); err != nil {
+			rows.Close()
+			return pgError("store: history: scan relation of created task", err)
+		}
+		changes = append(changes, model.NewTaskChange(task.ID, task.CreatedBy, field, nil, &value))
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return unknown(err)
+	}
+
+	return addTaskChanges(ctx, tx, changes...)
+}
+
+// AllByTask return changes of task ordered by time of change.
+func (repo *HistoryRepository) AllByTask(ctx context.Context, task uuid.UUID) ([]*model.TaskChange, error) {
+	rows, err := repo.pool.Query(
+		ctx,
+		`SELECT h.id, h.task_id, h.actor, h.field, h.old_value, h.new_value, h.created_at
+FROM task_history h
+WHERE h.task_id = $1
+ORDER BY h.created_at, h.id;`,
+		task,
+	)
+	if err != nil {
+		repo.log.Log(_unknownLevel, "get history of task", traceError(err)...)
+		return nil, unknown(err)
+	}
+	defer rows.Close()
+
+	var resp []*model.TaskChange
+	for rows.Next() {
+		c := new(model.TaskChange)
+		if err = rows.Scan(&c.ID, &c.Task, &c.Actor, &c.Field, &c.OldValue, &c.NewValue, &c.CreatedAt); err != nil {
+			repo.log.Log(_unknownLevel, "scan task change", traceError(err)...)
+			return nil, unknown(err)
+		}
+		resp = append(resp, c)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, unknown(err)
+	}
+	return resp, nil
+}
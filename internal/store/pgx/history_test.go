@@ -0,0 +1,120 @@
+package pgx
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vlad-marlo/godo/internal/model"
+	"github.com/vlad-marlo/godo/internal/store"
+)
+
+func TestHistoryRepository(t *testing.T) {
+	s, td := testStore(t, nil)
+	defer td()
+
+	ctx := context.Background()
+
+	require.NoError(t, s.user.Create(ctx, TestUser1))
+	require.NoError(t, s.task.Create(ctx, TestTask1))
+
+	assert.NoError(t, s.history.Add(ctx))
+	assert.ErrorIs(t, s.history.Add(ctx, nil), store.ErrNilReference)
+	// task does not exist, so nothing must be stored.
+	assert.ErrorIs(t, s.history.Add(
+		ctx,
+		model.NewTaskChange(TestTask1.ID, TestUser1.ID, model.TaskFieldName, nil, &TestTask1.Name),
+		model.NewTaskChange(uuid.New(), TestUser1.ID, model.TaskFieldName, nil, &TestTask1.Name),
+	), store.ErrFKViolation)
+	changes, err := s.history.AllByTask(ctx, TestTask1.ID)
+	require.NoError(t, err)
+	assert.Empty(t, changes)
+
+	oldStatus, newStatus := string(model.StatusNew), string(model.StatusInProgress)
+	created := model.NewTaskChange(TestTask1.ID, TestUser1.ID, model.TaskFieldCreated, nil, &TestTask1.Name)
+	status := model.NewTaskChange(TestTask1.ID, TestUser1.ID, model.TaskFieldStatus, &oldStatus, &newStatus)
+	require.NoError(t, s.history.Add(ctx, created, status))
+	assert.NotZero(t, created.ID)
+	assert.Greater(t, status.ID, created.ID)
+
+	changes, err = s.history.AllByTask(ctx, TestTask1.ID)
+	require.NoError(t, err)
+	require.Len(t, changes, 2)
+	for i, want := range []*model.TaskChange{created, status} {
+		assert.Equal(t, want.ID, changes[i].ID)
+		assert.Equal(t, want.Task, changes[i].Task)
+		assert.Equal(t, want.Actor, changes[i].Actor)
+		assert.Equal(t, want.Field, changes[i].Field)
+		assert.Equal(t, want.OldValue, changes[i].OldValue)
+		assert.Equal(t, want.NewValue, changes[i].NewValue)
+	}
+
+	// history is append-only.
+	_, err = s.pool.Exec(ctx, `UPDATE task_history SET new_value = 'x' WHERE id = $1;`, status.ID)
+	require.NoError(t, err)
+	changes, err = s.history.AllByTask(ctx, TestTask1.ID)
+	require.NoError(t, err)
+	assert.Equal(t, newStatus, *changes[1].NewValue)
+	_, err = s.pool.Exec(ctx, `DELETE FROM task_history WHERE id = $1;`, status.ID)
+	require.NoError(t, err)
+	changes, err = s.history.AllByTask(ctx, TestTask1.ID)
+	require.NoError(t, err)
+	assert.Len(t, changes, 2)
+
+	// history is deleted together with task.
+	require.NoError(t, s.task.Delete(ctx, TestTask1.ID))
+	var count int
+	require.NoError(t, s.pool.QueryRow(ctx, `SELECT count(*) FROM task_history WHERE task_id = $1;`, TestTask1.ID).Scan(&count))
+	assert.Zero(t, count)
+}
+
+func TestHistoryRepository_RecordedWithChanges(t *testing.T) {
+	s, td := testStore(t, nil)
+	defer td()
+
+	ctx := context.Background()
+
+	require.NoError(t, s.user.Create(ctx, TestUser1))
+	require.NoError(t, s.user.Create(ctx, TestUser2))
+	require.NoError(t, s.group.Create(ctx, TestGroup1))
+	require.NoError(t, s.role.Create(ctx, TestRole1))
+	require.NoError(t, s.group.AddUser(ctx, TestRole1.ID, TestGroup1.ID, TestUser1.ID, true))
+	require.NoError(t, s.group.AddUser(ctx, TestRole1.ID, TestGroup1.ID, TestUser2.ID, false))
+	label := &model.Label{ID: uuid.New(), Group: TestGroup1.ID, Name: "bug", Color: "#ff0000"}
+	require.NoError(t, s.label.Create(ctx, label))
+
+	// creation of task, its assignees and labels of template are recorded in transaction which creates task.
+	task := &model.Task{ID: uuid.New(), Name: "task", CreatedAt: time.Now(), CreatedBy: TestUser1.ID, Status: model.StatusNew}
+	tpl := &model.TaskTemplate{Group: TestGroup1.ID, Labels: []uuid.UUID{label.ID}}
+	_, err := s.task.CreateWithRelations(ctx, task, &TestGroup1.ID, nil, tpl)
+	require.NoError(t, err)
+	changes, err := s.history.AllByTask(ctx, task.ID)
+	require.NoError(t, err)
+	require.Len(t, changes, 4)
+	assert.Equal(t, model.TaskFieldCreated, changes[0].Field)
+	assigned := []string{*changes[1].NewValue, *changes[2].NewValue}
+	assert.ElementsMatch(t, []string{TestUser1.ID.String(), TestUser2.ID.String()}, assigned)
+	assert.Equal(t, model.TaskFieldLabels, changes[3].Field)
+	assert.Equal(t, label.Name, *changes[3].NewValue)
+
+	// failed change is not recorded.
+	oldStatus, newStatus := string(model.StatusInProgress), string(model.StatusClosed)
+	status := model.NewTaskChange(task.ID, TestUser1.ID, model.TaskFieldStatus, &oldStatus, &newStatus)
+	assert.ErrorIs(t, s.task.UpdateStatus(ctx, task.ID, model.StatusInProgress, model.StatusClosed, status), store.ErrNotFound)
+	changes, err = s.history.AllByTask(ctx, task.ID)
+	require.NoError(t, err)
+	assert.Len(t, changes, 4)
+
+	// deleting of label is recorded as detaching of label from task.
+	require.NoError(t, s.label.Delete(ctx, label.ID, TestUser2.ID))
+	changes, err = s.history.AllByTask(ctx, task.ID)
+	require.NoError(t, err)
+	require.Len(t, changes, 5)
+	assert.Equal(t, model.TaskFieldLabels, changes[4].Field)
+	assert.Equal(t, TestUser2.ID, changes[4].Actor)
+	assert.Equal(t, label.Name, *changes[4].OldValue)
+	assert.Nil(t, changes[4].NewValue)
+}
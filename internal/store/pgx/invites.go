@@ -26,16 +26,17 @@ func NewInviteRepository(cli Client) *InviteRepository {
 }
 
 // Create stores invite with provided data.
-func (repo *InviteRepository) Create(ctx context.Context, invite uuid.UUID, role int32, group uuid.UUID, uses int) error {
+func (repo *InviteRepository) Create(ctx context.Context, invite uuid.UUID, role int32, group uuid.UUID, uses int, createdBy uuid.UUID) error {
 
 	if _, err := repo.pool.Exec(
 		ctx,
-		`INSERT INTO invites(id, group_id, role_id, use_count)
-VALUES ($1, $2, $3, $4);`,
+		`INSERT INTO invites(id, group_id, role_id, use_count, created_by)
+VALUES ($1, $2, $3, $4, $5);`,
 		invite,
 		group,
 		role,
 		uses,
+		createdBy,
 	); err != nil {
 
 		if pgErr, ok := err.(*pgconn.PgError); ok {
@@ -82,14 +83,16 @@ func (repo *InviteRepository) Use(ctx context.Context, invite uuid.UUID, user uu
 
 	var group uuid.UUID
 	var role int
+	var invitedBy *uuid.UUID
 
 	if err = tx.QueryRow(
 		ctx,
-		`UPDATE invites SET use_count = use_count - 1 WHERE id = $1 RETURNING group_id, role_id;`,
+		`UPDATE invites SET use_count = use_count - 1 WHERE id = $1 RETURNING group_id, role_id, created_by;`,
 		invite,
 	).Scan(
 		&group,
 		&role,
+		&invitedBy,
 	); err != nil {
 		if pgErr, ok := err.(*pgconn.PgError); ok {
 			if pgErr.Code == pgerrcode.CheckViolation {
@@ -100,10 +103,11 @@ func (repo *InviteRepository) Use(ctx context.Context, invite uuid.UUID, user uu
 
 	if _, err = tx.Exec(
 		ctx,
-		`INSERT INTO user_in_group(user_id, group_id, role_id) VALUES ($1, $2, $3);`,
+		`INSERT INTO user_in_group(user_id, group_id, role_id, invited_by) VALUES ($1, $2, $3, $4);`,
 		user,
 		group,
 		role,
+		invitedBy,
 	); err != nil {
 
 		if pgErr, ok := err.(*pgconn.PgError); ok {
@@ -0,0 +1,247 @@
+package pgx
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/vlad-marlo/godo/internal/model"
+	"github.com/vlad-marlo/godo/internal/store"
+	"go.uber.org/zap"
+)
+
+var _ store.LabelRepository = (*LabelRepository)(nil)
+
+// LabelRepository is storage of group labels.
+type LabelRepository struct {
+	pool *pgxpool.Pool
+	log  *zap.Logger
+}
+
+// NewLabelRepository return new instance of LabelRepository.
+func NewLabelRepository(cli Client) *LabelRepository {
+	return &LabelRepository{
+		pool: cli.P(),
+		log:  cli.L(),
+	}
+}
+
+// Create stores label.
+func (repo *LabelRepository) Create(ctx context.Context, label *model.Label) error {
+	if label == nil {
+		return store.ErrNilReference
+	}
+	if _, err := repo.pool.Exec(
+		ctx,
+		`INSERT INTO labels(id, group_id, "name", color) VALUES ($1, $2, $3, $4);`,
+		label.ID,
+		label.Group,
+		label.Name,
+		label.Color,
+	); err != nil {
+		return pgError("store: label: create", err)
+	}
+	return nil
+}
+
+// Get return label by id.
+func (repo *LabelRepository) Get(ctx context.Context, id uuid.UUID) (*model.Label, error) {
+	l := new(model.Label)
+	if err := repo.pool.QueryRow(
+		ctx,
+		`SELECT l.id, l.group_id, l.name, l.color FROM labels l WHERE l.id = $1;`,
+		id,
+	).Scan(&l.ID, &l.Group, &l.Name, &l.Color); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, store.ErrNotFound
+		}
+		repo.log.Log(_unknownLevel, "get label by id", traceError(err)...)
+		return nil, unknown(err)
+	}
+	return l, nil
+}
+
+// AllByGroup return labels of group ordered by name.
+func (repo *LabelRepository) AllByGroup(ctx context.Context, group uuid.UUID) ([]*model.Label, error) {
+	rows, err := repo.pool.Query(
+		ctx,
+		`SELECT l.id, l.group_id, l.name, l.color FROM labels l WHERE l.group_id = $1 ORDER BY l.name;`,
+		group,
+	)
+	if err != nil {
+		repo.log.Log(_unknownLevel, "get labels of group", traceError(err)...)
+		return nil, unknown(err)
+	}
+	defer rows.Close()
+
+	var resp []*model.Label
+	for rows.Next() {
+		l := new(model.Label)
+		if err = rows.Scan(&l.ID, &l.Group, &l.Name, &l.Color); err != nil {
+			repo.log.Log(_unknownLevel, "scan label while getting labels of group", traceError(err)...)
+			return nil, unknown(err)
+		}
+		resp = append(resp, l)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, unknown(err)
+	}
+	return resp, nil
+}
+
+// Update changes name and color of label.
+func (repo *LabelRepository) Update(ctx context.Context, label *model.Label) error {
+	if label == nil {
+		return store.ErrNilReference
+	}
+	tag, err := repo.pool.Exec(
+		ctx,
+		`UPDATE labels SET "name" = $2, color = $3 WHERE id = $1;`,
+		label.ID,
+		label.Name,
+		label.Color,
+	)
+	if err != nil {
+		return pgError("store: label: update", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return store.ErrNotFound
+	}
+	return nil
+}
+
+// Delete deletes label and detaches it from all tasks.
+//
+// Detaching of label is recorded to history of every task to which it was attached with actor as author of change.
+func (repo *LabelRepository) Delete(ctx context.Context, id, actor uuid.UUID) error {
+	tx, err := repo.pool.Begin(ctx)
+	if err != nil {
+		repo.log.Error("unexpected error received while starting new transaction: check drivers", traceError(err)...)
+		return unknown(err)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	rows, err := tx.Query(
+		ctx,
+		`DELETE
+FROM task_labels tl
+    USING labels l
+WHERE tl.label_id = l.id
+  AND l.id = $1
+RETURNING tl.task_id, l.name;`,
+		id,
+	)
+	if err != nil {
+		return pgError("store: label: delete: detach from tasks", err)
+	}
+	var changes []*model.TaskChange
+	for rows.Next() {
+		var (
+			task uuid.UUID
+			name string
+		)
+		if err = rows.Scan(&task, &name); err != nil {
+			rows.Close()
+			return pgError("store: label: delete: scan detached task", err)
+		}
+		changes = append(changes, model.NewTaskChange(task, actor, model.TaskFieldLabels, &name, nil))
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return pgError("store: label: delete: detach from tasks", err)
+	}
+	if err = addTaskChanges(ctx, tx, changes...); err != nil {
+		return err
+	}
+
+	tag, err := tx.Exec(ctx, `DELETE FROM labels WHERE id = $1;`, id)
+	if err != nil {
+		return pgError("store: label: delete", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return store.ErrNotFound
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		repo.log.Error("unexpected error while doing commit transaction: check pgx driver", traceError(err)...)
+		return unknown(err)
+	}
+	return nil
+}
+
+// Attach attaches label to task. Changes are recorded to history of task in the same transaction.
+//
+// If task is not related to group of label then store.ErrNotFound will be returned.
+func (repo *LabelRepository) Attach(ctx context.Context, task, label uuid.UUID, changes ...*model.TaskChange) error {
+	tx, err := repo.pool.Begin(ctx)
+	if err != nil {
+		repo.log.Error("unexpected error received while starting new transaction: check drivers", traceError(err)...)
+		return unknown(err)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	tag, err := tx.Exec(
+		ctx,
+		`INSERT INTO task_labels(task_id, label_id)
+SELECT tg.task_id, l.id
+FROM labels l
+         JOIN task_group tg on tg.group_id = l.group_id
+WHERE tg.task_id = $1
+  AND l.id = $2;`,
+		task,
+		label,
+	)
+	if err != nil {
+		return pgError("store: label: attach", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return store.ErrNotFound
+	}
+
+	if err = addTaskChanges(ctx, tx, changes...); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		repo.log.Error("unexpected error while doing commit transaction: check pgx driver", traceError(err)...)
+		return unknown(err)
+	}
+	return nil
+}
+
+// Detach detaches label from task. Changes are recorded to history of task in the same transaction.
+func (repo *LabelRepository) Detach(ctx context.Context, task, label uuid.UUID, changes ...*model.TaskChange) error {
+	tx, err := repo.pool.Begin(ctx)
+	if err != nil {
+		repo.log.Error("unexpected error received while starting new transaction: check drivers", traceError(err)...)
+		return unknown(err)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	tag, err := tx.Exec(ctx, `DELETE FROM task_labels WHERE task_id = $1 AND label_id = $2;`, task, label)
+	if err != nil {
+		return pgError("store: label: detach", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return store.ErrNotFound
+	}
+
+	if err = addTaskChanges(ctx, tx, changes...); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		repo.log.Error("unexpected error while doing commit transaction: check pgx driver", traceError(err)...)
+		return unknown(err)
+	}
+	return nil
+}
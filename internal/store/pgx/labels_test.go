@@ -0,0 +1,68 @@
+package pgx
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vlad-marlo/godo/internal/model"
+	"github.com/vlad-marlo/godo/internal/store"
+)
+
+func TestLabelRepository_NilLabel(t *testing.T) {
+	s, td := testStore(t, nil)
+	defer td()
+
+	assert.ErrorIs(t, s.label.Create(context.Background(), nil), store.ErrNilReference)
+	assert.ErrorIs(t, s.label.Update(context.Background(), nil), store.ErrNilReference)
+}
+
+func TestLabelRepository(t *testing.T) {
+	s, td := testStore(t, nil)
+	defer td()
+
+	ctx := context.Background()
+
+	require.NoError(t, s.user.Create(ctx, TestUser1))
+	require.NoError(t, s.group.Create(ctx, TestGroup1))
+	require.NoError(t, s.task.Create(ctx, TestTask1))
+
+	label := &model.Label{ID: uuid.New(), Group: TestGroup1.ID, Name: "bug", Color: "#d73a4a"}
+	require.NoError(t, s.label.Create(ctx, label))
+	assert.ErrorIs(t, s.label.Create(ctx, &model.Label{ID: uuid.New(), Group: TestGroup1.ID, Name: "bug", Color: "#ffffff"}), store.ErrUniqueViolation)
+
+	got, err := s.label.Get(ctx, label.ID)
+	require.NoError(t, err)
+	assert.Equal(t, label, got)
+
+	label.Name = "defect"
+	require.NoError(t, s.label.Update(ctx, label))
+	labels, err := s.label.AllByGroup(ctx, TestGroup1.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []*model.Label{label}, labels)
+
+	// task is not related to group of label.
+	assert.ErrorIs(t, s.label.Attach(ctx, TestTask1.ID, label.ID), store.ErrNotFound)
+	require.NoError(t, s.task.AddToGroup(ctx, TestTask1.ID, TestGroup1.ID))
+	require.NoError(t, s.label.Attach(ctx, TestTask1.ID, label.ID))
+	assert.ErrorIs(t, s.label.Attach(ctx, TestTask1.ID, label.ID), store.ErrUniqueViolation)
+
+	task, err := s.task.GetByUserAndID(ctx, TestUser1.ID, TestTask1.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []*model.Label{label}, task.Labels)
+
+	tasks, err := s.task.AllByUser(ctx, TestUser1.ID, model.TaskFilter{Labels: []uuid.UUID{label.ID}})
+	require.NoError(t, err)
+	assert.Len(t, tasks, 1)
+	tasks, err = s.task.AllByUser(ctx, TestUser1.ID, model.TaskFilter{Labels: []uuid.UUID{label.ID, uuid.New()}})
+	require.NoError(t, err)
+	assert.Empty(t, tasks)
+
+	require.NoError(t, s.label.Detach(ctx, TestTask1.ID, label.ID))
+	assert.ErrorIs(t, s.label.Detach(ctx, TestTask1.ID, label.ID), store.ErrNotFound)
+	require.NoError(t, s.label.Delete(ctx, label.ID, TestUser1.ID))
+	_, err = s.label.Get(ctx, label.ID)
+	assert.ErrorIs(t, err, store.ErrNotFound)
+}
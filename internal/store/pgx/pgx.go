@@ -17,14 +17,25 @@ const _unknownLevel = zapcore.WarnLevel
 
 // Store is implementation of storage Interface.
 type Store struct {
-	pool   *pgxpool.Pool
-	log    *zap.Logger
-	user   *UserRepository
-	group  *GroupRepository
-	token  *TokenRepository
-	task   *TaskRepository
-	invite *InviteRepository
-	role   *RoleRepository
+	pool    *pgxpool.Pool
+	log     *zap.Logger
+	user    *UserRepository
+	group   *GroupRepository
+	token   *TokenRepository
+	task    *TaskRepository
+	invite  *InviteRepository
+	role    *RoleRepository
+	review  *ReviewRepository
+	comment *CommentRepository
+	dep     *DependencyRepository
+	label   *LabelRepository
+	board   *BoardRepository
+	tpl     *TemplateRepository
+	history *HistoryRepository
+	attach  *AttachmentRepository
+	check   *ChecklistRepository
+	worklog *WorklogRepository
+	watcher *WatcherRepository
 }
 
 type Client interface {
@@ -43,16 +54,38 @@ func New(
 	task *TaskRepository,
 	invite *InviteRepository,
 	role *RoleRepository,
+	review *ReviewRepository,
+	comment *CommentRepository,
+	dep *DependencyRepository,
+	label *LabelRepository,
+	board *BoardRepository,
+	tpl *TemplateRepository,
+	history *HistoryRepository,
+	attach *AttachmentRepository,
+	check *ChecklistRepository,
+	worklog *WorklogRepository,
+	watcher *WatcherRepository,
 ) *Store {
 	return &Store{
-		pool:   client.P(),
-		log:    client.L(),
-		user:   user,
-		group:  group,
-		token:  token,
-		task:   task,
-		invite: invite,
-		role:   role,
+		pool:    client.P(),
+		log:     client.L(),
+		user:    user,
+		group:   group,
+		token:   token,
+		task:    task,
+		invite:  invite,
+		role:    role,
+		review:  review,
+		comment: comment,
+		dep:     dep,
+		label:   label,
+		board:   board,
+		tpl:     tpl,
+		history: history,
+		attach:  attach,
+		check:   check,
+		worklog: worklog,
+		watcher: watcher,
 	}
 }
 
@@ -90,6 +123,61 @@ func (store *Store) Role() store.RoleRepository {
 	return store.role
 }
 
+// Review return review repository.
+func (store *Store) Review() store.ReviewRepository {
+	return store.review
+}
+
+// Comment return comment repository.
+func (store *Store) Comment() store.CommentRepository {
+	return store.comment
+}
+
+// Dependency return dependency repository.
+func (store *Store) Dependency() store.DependencyRepository {
+	return store.dep
+}
+
+// Label return label repository.
+func (store *Store) Label() store.LabelRepository {
+	return store.label
+}
+
+// Board return board repository.
+func (store *Store) Board() store.BoardRepository {
+	return store.board
+}
+
+// Template return template repository.
+func (store *Store) Template() store.TemplateRepository {
+	return store.tpl
+}
+
+// History return history repository.
+func (store *Store) History() store.HistoryRepository {
+	return store.history
+}
+
+// Attachment return attachment repository.
+func (store *Store) Attachment() store.AttachmentRepository {
+	return store.attach
+}
+
+// Checklist return checklist repository.
+func (store *Store) Checklist() store.ChecklistRepository {
+	return store.check
+}
+
+// Worklog return worklog repository.
+func (store *Store) Worklog() store.WorklogRepository {
+	return store.worklog
+}
+
+// Watcher return watcher repository.
+func (store *Store) Watcher() store.WatcherRepository {
+	return store.watcher
+}
+
 // Close is helper function to close connection.
 func (store *Store) Close() {
 	if store != nil {
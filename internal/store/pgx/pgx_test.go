@@ -40,6 +40,17 @@ func TestStore_User(t *testing.T) {
 	tskRepo := NewTaskRepository(cli)
 	invRepo := NewInviteRepository(cli)
 	roleRepo := NewRoleRepository(cli)
+	revRepo := NewReviewRepository(cli)
+	comRepo := NewCommentRepository(cli)
+	depRepo := NewDependencyRepository(cli)
+	labelRepo := NewLabelRepository(cli)
+	boardRepo := NewBoardRepository(cli)
+	tplRepo := NewTemplateRepository(cli)
+	historyRepo := NewHistoryRepository(cli)
+	attachRepo := NewAttachmentRepository(cli)
+	checkRepo := NewChecklistRepository(cli)
+	worklogRepo := NewWorklogRepository(cli)
+	watcherRepo := NewWatcherRepository(cli)
 	s := New(
 		cli,
 		usrRepo,
@@ -48,6 +59,17 @@ func TestStore_User(t *testing.T) {
 		tskRepo,
 		invRepo,
 		roleRepo,
+		revRepo,
+		comRepo,
+		depRepo,
+		labelRepo,
+		boardRepo,
+		tplRepo,
+		historyRepo,
+		attachRepo,
+		checkRepo,
+		worklogRepo,
+		watcherRepo,
 	)
 	assert.Equal(t, usrRepo, s.User())
 	assert.Equal(t, s.user, s.User())
@@ -66,6 +88,38 @@ func TestStore_User(t *testing.T) {
 
 	assert.Equal(t, s.role, s.Role())
 	assert.Equal(t, s.role, roleRepo)
+
+	assert.Equal(t, s.review, s.Review())
+	assert.Equal(t, s.review, revRepo)
+
+	assert.Equal(t, s.comment, s.Comment())
+	assert.Equal(t, s.comment, comRepo)
+
+	assert.Equal(t, s.dep, s.Dependency())
+	assert.Equal(t, s.dep, depRepo)
+
+	assert.Equal(t, s.label, s.Label())
+	assert.Equal(t, s.label, labelRepo)
+
+	assert.Equal(t, s.board, s.Board())
+	assert.Equal(t, s.board, boardRepo)
+
+	assert.Equal(t, s.tpl, s.Template())
+	assert.Equal(t, s.tpl, tplRepo)
+
+	assert.Equal(t, s.history, s.History())
+	assert.Equal(t, s.history, historyRepo)
+
+	assert.Equal(t, s.attach, s.Attachment())
+	assert.Equal(t, s.attach, attachRepo)
+
+	assert.Equal(t, s.check, s.Checklist())
+	assert.Equal(t, s.check, checkRepo)
+
+	assert.Equal(t, s.worklog, s.Worklog())
+	assert.Equal(t, s.worklog, worklogRepo)
+	assert.Equal(t, s.watcher, s.Watcher())
+	assert.Equal(t, s.watcher, watcherRepo)
 	s.Close()
 }
 
@@ -101,7 +155,7 @@ func TestBadCli(t *testing.T) {
 		assert.ErrorIs(t, err, store.ErrUnknown)
 	}
 
-	err = st.invite.Create(context.Background(), TestInvite1, TestRole1.ID, TestGroup1.ID, 1)
+	err = st.invite.Create(context.Background(), TestInvite1, TestRole1.ID, TestGroup1.ID, 1, TestUser1.ID)
 	if assert.Error(t, err) {
 		assert.ErrorIs(t, err, store.ErrUnknown)
 	}
@@ -150,7 +204,7 @@ func TestBadCli(t *testing.T) {
 		assert.ErrorIs(t, err, store.ErrUnknown)
 	}
 
-	err = st.role.Get(ctx, TestRole1)
+	_, err = st.role.Get(ctx, TestGroup1.ID, TestRole1.ID)
 	if assert.Error(t, err) {
 		assert.ErrorIs(t, err, store.ErrUnknown)
 	}
@@ -159,4 +213,18 @@ func TestBadCli(t *testing.T) {
 	if assert.Error(t, err) {
 		assert.ErrorIs(t, err, store.ErrUnknown)
 	}
+
+	var review *model.Review
+	review, err = st.review.Get(ctx, uuid.New())
+	assert.Nil(t, review)
+	if assert.Error(t, err) {
+		assert.ErrorIs(t, err, store.ErrUnknown)
+	}
+
+	var comment *model.Comment
+	comment, err = st.comment.Get(ctx, uuid.New())
+	assert.Nil(t, comment)
+	if assert.Error(t, err) {
+		assert.ErrorIs(t, err, store.ErrUnknown)
+	}
 }
@@ -0,0 +1,157 @@
+package pgx
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/vlad-marlo/godo/internal/model"
+)
+
+// taskSortColumns is sort expressions of tasks. Expressions are formatted with alias of tasks table.
+//
+// Tasks without deadline are placed after tasks with deadline.
+var taskSortColumns = map[model.TaskSort]string{
+	model.SortCreatedAt: "%s.created_at",
+	model.SortDeadline:  "COALESCE(%s.deadline, 'infinity'::timestamptz)",
+	model.SortName:      `%s."name"`,
+}
+
+// queryBuilder is helper to build queries with dynamic conditions and positional arguments.
+type queryBuilder struct {
+	conds []string
+	args  []any
+}
+
+// arg adds argument to query and return its placeholder.
+func (b *queryBuilder) arg(v any) string {
+	b.args = append(b.args, v)
+	return "$" + strconv.Itoa(len(b.args))
+}
+
+// where adds condition to query. All conditions are joined with AND.
+func (b *queryBuilder) where(cond string) {
+	b.conds = append(b.conds, cond)
+}
+
+// whereClause return WHERE clause with all added conditions.
+func (b *queryBuilder) whereClause() string {
+	if len(b.conds) == 0 {
+		return ""
+	}
+	return "WHERE " + strings.Join(b.conds, "\n  AND ")
+}
+
+// visibleTaskCond return condition which is true if task from table with alias t is visible to user.
+//
+// Task is visible to its creator, its assignees and members of its groups who have model.PermViewTasks.
+func visibleTaskCond(user string) string {
+	return fmt.Sprintf(`(t.created_by = %[1]s
+    OR EXISTS(SELECT * FROM task_user tu WHERE tu.task_id = t.id AND tu.user_id = %[1]s)
+    OR EXISTS(SELECT *
+              FROM task_group tg
+                       JOIN user_in_group uig on tg.group_id = uig.group_id
+                       JOIN group_roles r on r.id = uig.role_id
+              WHERE tg.task_id = t.id
+                AND uig.user_id = %[1]s
+                AND (uig.is_admin OR r.permissions & %[2]d <> 0)))`, user, model.PermViewTasks)
+}
+
+// visibleCommentCond return condition which is true if comment from table with alias cm of task with alias t
+// could be read by user.
+//
+// Creator and assignees of task read all comments of it as well as members of task groups who could view issues.
+// Other users read only own comments and replies to them.
+func visibleCommentCond(user string) string {
+	return fmt.Sprintf(`(t.created_by = %[1]s
+    OR cm.created_by = %[1]s
+    OR EXISTS(SELECT * FROM comments own WHERE own.id = cm.reply_to AND own.created_by = %[1]s)
+    OR EXISTS(SELECT * FROM task_user tu WHERE tu.task_id = t.id AND tu.user_id = %[1]s)
+    OR EXISTS(SELECT *
+              FROM task_group tg
+                       JOIN user_in_group uig on tg.group_id = uig.group_id
+                       JOIN group_roles r on r.id = uig.role_id
+              WHERE tg.task_id = t.id
+                AND uig.user_id = %[1]s
+                AND (uig.is_admin OR r.permissions & %[2]d <> 0)))`, user, model.PermViewIssues)
+}
+
+// taskListQuery return query which selects tasks from table with alias t with applied filter.
+//
+// Visibility conditions must be added to builder before call.
+func taskListQuery(b *queryBuilder, filter model.TaskFilter) string {
+	if filter.Overdue {
+		b.where(fmt.Sprintf(
+			"(t.deadline < current_timestamp AND t.status NOT IN (%s, %s))",
+			b.arg(model.StatusClosed),
+			b.arg(model.StatusAccepted),
+		))
+	}
+	if filter.DueBefore != nil {
+		b.where("t.deadline < " + b.arg(*filter.DueBefore))
+	}
+	if filter.DueAfter != nil {
+		b.where("t.deadline > " + b.arg(*filter.DueAfter))
+	}
+	if len(filter.Status) > 0 {
+		statuses := make([]string, 0, len(filter.Status))
+		for _, s := range filter.Status {
+			statuses = append(statuses, string(s))
+		}
+		b.where("t.status = ANY(" + b.arg(statuses) + ")")
+	}
+	if filter.Group != nil {
+		b.where("EXISTS(SELECT * FROM task_group ftg WHERE ftg.task_id = t.id AND ftg.group_id = " + b.arg(*filter.Group) + ")")
+	}
+	if filter.CreatedBy != nil {
+		b.where("t.created_by = " + b.arg(*filter.CreatedBy))
+	}
+	if filter.Assignee != nil {
+		b.where("EXISTS(SELECT * FROM task_user ftu WHERE ftu.task_id = t.id AND ftu.user_id = " + b.arg(*filter.Assignee) + ")")
+	}
+
+	if filter.Parent != nil {
+		b.where("t.parent_id = " + b.arg(*filter.Parent))
+	}
+	if len(filter.Labels) > 0 {
+		b.where(fmt.Sprintf(`NOT EXISTS(SELECT *
+               FROM unnest(%s::uuid[]) fl(id)
+               WHERE NOT EXISTS(SELECT * FROM task_labels ftl WHERE ftl.task_id = t.id AND ftl.label_id = fl.id))`,
+			b.arg(filter.Labels),
+		))
+	}
+
+	col, ok := taskSortColumns[filter.Sort]
+	if !ok {
+		col = taskSortColumns[model.SortCreatedAt]
+	}
+	cmp, order := ">", "ASC"
+	if filter.Desc {
+		cmp, order = "<", "DESC"
+	}
+	if filter.Cursor != nil {
+		b.where(fmt.Sprintf(
+			"(%s, t.id) %s (SELECT %s, c.id FROM tasks c WHERE c.id = %s)",
+			fmt.Sprintf(col, "t"),
+			cmp,
+			fmt.Sprintf(col, "c"),
+			b.arg(*filter.Cursor),
+		))
+	}
+
+	q := fmt.Sprintf(
+		`SELECT %s
+FROM tasks t
+%s
+ORDER BY %s %s, t.id %s`,
+		_taskColumns,
+		b.whereClause(),
+		fmt.Sprintf(col, "t"),
+		order,
+		order,
+	)
+	if filter.Limit > 0 {
+		q += "\nLIMIT " + b.arg(filter.Limit)
+	}
+	return q + ";"
+}
@@ -0,0 +1,88 @@
+package pgx
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/vlad-marlo/godo/internal/model"
+)
+
+func TestQueryBuilder(t *testing.T) {
+	b := new(queryBuilder)
+	assert.Equal(t, "", b.whereClause())
+
+	assert.Equal(t, "$1", b.arg(1))
+	assert.Equal(t, "$2", b.arg("2"))
+	b.where("a = $1")
+	b.where("b = $2")
+	assert.Equal(t, "WHERE a = $1\n  AND b = $2", b.whereClause())
+	assert.Equal(t, []any{1, "2"}, b.args)
+}
+
+func TestTaskListQuery(t *testing.T) {
+	b := new(queryBuilder)
+	q := taskListQuery(b, model.TaskFilter{})
+	assert.Contains(t, q, "ORDER BY t.created_at ASC, t.id ASC;")
+	assert.NotContains(t, q, "FROM tasks t\nWHERE")
+	assert.NotContains(t, q, "LIMIT")
+	assert.Empty(t, b.args)
+
+	now := time.Now()
+	group, creator, assignee, parent, cursor := uuid.New(), uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	b = new(queryBuilder)
+	q = taskListQuery(b, model.TaskFilter{
+		Overdue:   true,
+		DueBefore: &now,
+		DueAfter:  &now,
+		Status:    []model.TaskStatus{model.StatusNew, model.StatusInProgress},
+		Group:     &group,
+		CreatedBy: &creator,
+		Assignee:  &assignee,
+		Parent:    &parent,
+		Sort:      model.SortDeadline,
+		Desc:      true,
+		Cursor:    &cursor,
+		Limit:     10,
+	})
+	assert.Contains(t, q, "t.status NOT IN ($1, $2)")
+	assert.Contains(t, q, "t.deadline < $3")
+	assert.Contains(t, q, "t.deadline > $4")
+	assert.Contains(t, q, "t.status = ANY($5)")
+	assert.Contains(t, q, "ftg.group_id = $6")
+	assert.Contains(t, q, "t.created_by = $7")
+	assert.Contains(t, q, "ftu.user_id = $8")
+	assert.Contains(t, q, "t.parent_id = $9")
+	assert.Contains(t, q, "(COALESCE(t.deadline, 'infinity'::timestamptz), t.id) < (SELECT COALESCE(c.deadline, 'infinity'::timestamptz), c.id FROM tasks c WHERE c.id = $10)")
+	assert.Contains(t, q, "ORDER BY COALESCE(t.deadline, 'infinity'::timestamptz) DESC, t.id DESC\nLIMIT $11;")
+	assert.Equal(t, []any{
+		model.StatusClosed,
+		model.StatusAccepted,
+		now,
+		now,
+		[]string{"NEW", "IN_PROGRESS"},
+		group,
+		creator,
+		assignee,
+		parent,
+		cursor,
+		10,
+	}, b.args)
+}
+
+func TestVisibleTaskCond(t *testing.T) {
+	cond := visibleTaskCond("$3")
+	assert.Contains(t, cond, "t.created_by = $3")
+	assert.Contains(t, cond, "tu.user_id = $3")
+	assert.Contains(t, cond, "uig.user_id = $3")
+	assert.NotContains(t, cond, "%")
+}
+
+func TestTaskListQuery_Labels(t *testing.T) {
+	b := new(queryBuilder)
+	labels := []uuid.UUID{uuid.New(), uuid.New()}
+	q := taskListQuery(b, model.TaskFilter{Labels: labels})
+	assert.Contains(t, q, "FROM unnest($1::uuid[]) fl(id)")
+	assert.Equal(t, []any{labels}, b.args)
+}
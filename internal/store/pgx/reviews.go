@@ -0,0 +1,217 @@
+package pgx
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/vlad-marlo/godo/internal/model"
+	"github.com/vlad-marlo/godo/internal/store"
+	"go.uber.org/zap"
+)
+
+var _ store.ReviewRepository = (*ReviewRepository)(nil)
+
+// ReviewRepository is storage of review requests.
+type ReviewRepository struct {
+	pool *pgxpool.Pool
+	log  *zap.Logger
+}
+
+// NewReviewRepository return new instance of ReviewRepository.
+func NewReviewRepository(cli Client) *ReviewRepository {
+	return &ReviewRepository{
+		pool: cli.P(),
+		log:  cli.L(),
+	}
+}
+
+// Create stores review and moves task on review.
+//
+// If task status is not equal to from then store.ErrNotFound will be returned and nothing will be stored.
+// If task does not fit into column of board then store.ErrLimitExceeded will be returned.
+// Changes are recorded to history of task in the same transaction.
+func (repo *ReviewRepository) Create(ctx context.Context, review *model.Review, from model.TaskStatus, changes ...*model.TaskChange) error {
+	if review == nil {
+		return store.ErrNilReference
+	}
+
+	tx, err := repo.pool.Begin(ctx)
+	if err != nil {
+		repo.log.Error("unexpected error received while starting new transaction: check drivers", traceError(err)...)
+		return unknown(err)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	if err = checkLimits(ctx, tx, review.Task, model.StatusOnReview); err != nil {
+		return err
+	}
+
+	tag, err := tx.Exec(
+		ctx,
+		`UPDATE tasks SET status = $3 WHERE id = $1 AND status = $2;`,
+		review.Task,
+		from,
+		model.StatusOnReview,
+	)
+	if err != nil {
+		return pgError("store: review: create: update task status", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return store.ErrNotFound
+	}
+
+	if err = tx.QueryRow(
+		ctx,
+		`INSERT INTO reviews(id, task, user_id, msg, status) VALUES ($1, $2, $3, $4, $5) RETURNING created_at;`,
+		review.ID,
+		review.Task,
+		review.User,
+		review.Message,
+		review.Status,
+	).Scan(&review.CreatedAt); err != nil {
+		return pgError("store: review: create", err)
+	}
+
+	if err = addTaskChanges(ctx, tx, changes...); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		repo.log.Error("unexpected error while doing commit transaction: check pgx driver", traceError(err)...)
+		return unknown(err)
+	}
+	return nil
+}
+
+// Get return review by id.
+func (repo *ReviewRepository) Get(ctx context.Context, id uuid.UUID) (*model.Review, error) {
+	r := new(model.Review)
+	var answer *string
+	if err := repo.pool.QueryRow(
+		ctx,
+		`SELECT r.id, r.task, r.user_id, r.msg, r.status, r.created_at, r.resolved_by, r.answer
+FROM reviews r
+WHERE r.id = $1;`,
+		id,
+	).Scan(&r.ID, &r.Task, &r.User, &r.Message, &r.Status, &r.CreatedAt, &r.ResolvedBy, &answer); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, store.ErrNotFound
+		}
+		repo.log.Log(_unknownLevel, "get review by id", traceError(err)...)
+		return nil, unknown(err)
+	}
+	if answer != nil {
+		r.Answer = *answer
+	}
+	return r, nil
+}
+
+// PendingByGroup return reviews of group tasks that are waiting for resolution ordered by submission time.
+func (repo *ReviewRepository) PendingByGroup(ctx context.Context, group uuid.UUID) ([]*model.Review, error) {
+	rows, err := repo.pool.Query(
+		ctx,
+		`SELECT r.id, r.task, r.user_id, r.msg, r.status, r.created_at
+FROM reviews r
+         JOIN task_group tg on r.task = tg.task_id
+WHERE tg.group_id = $1
+  AND r.status = $2
+ORDER BY r.created_at;`,
+		group,
+		model.ReviewPending,
+	)
+	if err != nil {
+		repo.log.Log(_unknownLevel, "get pending reviews of group", traceError(err)...)
+		return nil, unknown(err)
+	}
+	defer rows.Close()
+
+	var resp []*model.Review
+	for rows.Next() {
+		r := new(model.Review)
+		if err = rows.Scan(&r.ID, &r.Task, &r.User, &r.Message, &r.Status, &r.CreatedAt); err != nil {
+			repo.log.Log(_unknownLevel, "scan review while getting pending reviews", traceError(err)...)
+			return nil, unknown(err)
+		}
+		resp = append(resp, r)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, unknown(err)
+	}
+	return resp, nil
+}
+
+// Resolve stores resolution of review and moves reviewed task from ON_REVIEW into provided status.
+//
+// If review was already resolved or task is not on review then store.ErrNotFound will be returned.
+// If task does not fit into column of board then store.ErrLimitExceeded will be returned.
+// Changes are recorded to history of task in the same transaction.
+func (repo *ReviewRepository) Resolve(ctx context.Context, review *model.Review, status model.TaskStatus, changes ...*model.TaskChange) error {
+	if review == nil || review.ResolvedBy == nil {
+		return store.ErrNilReference
+	}
+
+	tx, err := repo.pool.Begin(ctx)
+	if err != nil {
+		repo.log.Error("unexpected error received while starting new transaction: check drivers", traceError(err)...)
+		return unknown(err)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	tag, err := tx.Exec(
+		ctx,
+		`UPDATE reviews
+SET status      = $3,
+    resolved_by = $4,
+    answer      = $5,
+    resolved_at = current_timestamp
+WHERE id = $1
+  AND status = $2;`,
+		review.ID,
+		model.ReviewPending,
+		review.Status,
+		*review.ResolvedBy,
+		review.Answer,
+	)
+	if err != nil {
+		return pgError("store: review: resolve", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return store.ErrNotFound
+	}
+
+	if err = checkLimits(ctx, tx, review.Task, status); err != nil {
+		return err
+	}
+
+	tag, err = tx.Exec(
+		ctx,
+		`UPDATE tasks SET status = $3 WHERE id = $1 AND status = $2;`,
+		review.Task,
+		model.StatusOnReview,
+		status,
+	)
+	if err != nil {
+		return pgError("store: review: resolve: update task status", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return store.ErrNotFound
+	}
+
+	if err = addTaskChanges(ctx, tx, changes...); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		repo.log.Error("unexpected error while doing commit transaction: check pgx driver", traceError(err)...)
+		return unknown(err)
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package pgx
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vlad-marlo/godo/internal/model"
+	"github.com/vlad-marlo/godo/internal/store"
+)
+
+func TestReviewRepository_Create_NilReview(t *testing.T) {
+	s, td := testStore(t, nil)
+	defer td()
+
+	err := s.review.Create(context.Background(), nil, model.StatusInProgress)
+	assert.ErrorIs(t, err, store.ErrNilReference)
+}
+
+func TestReviewRepository(t *testing.T) {
+	s, td := testStore(t, nil)
+	defer td()
+
+	ctx := context.Background()
+	task := &model.Task{
+		ID:        uuid.New(),
+		Name:      uuid.NewString(),
+		CreatedBy: TestUser1.ID,
+		Status:    model.StatusInProgress,
+	}
+	require.NoError(t, s.user.Create(ctx, TestUser1))
+	require.NoError(t, s.group.Create(ctx, TestGroup1))
+	require.NoError(t, s.task.Create(ctx, task))
+	require.NoError(t, s.task.AddToGroup(ctx, task.ID, TestGroup1.ID))
+
+	review := &model.Review{
+		ID:      uuid.New(),
+		Task:    task.ID,
+		User:    TestUser1.ID,
+		Message: "check",
+		Status:  model.ReviewPending,
+	}
+	err := s.review.Create(ctx, review, model.StatusNew)
+	assert.ErrorIs(t, err, store.ErrNotFound)
+	require.NoError(t, s.review.Create(ctx, review, model.StatusInProgress))
+
+	reviews, err := s.review.PendingByGroup(ctx, TestGroup1.ID)
+	require.NoError(t, err)
+	if assert.Len(t, reviews, 1) {
+		assert.Equal(t, review.ID, reviews[0].ID)
+	}
+
+	review.Status = model.ReviewAccepted
+	review.ResolvedBy = &TestUser1.ID
+	review.Answer = "good"
+	require.NoError(t, s.review.Resolve(ctx, review, model.StatusAccepted))
+	err = s.review.Resolve(ctx, review, model.StatusAccepted)
+	assert.ErrorIs(t, err, store.ErrNotFound)
+
+	got, err := s.review.Get(ctx, review.ID)
+	require.NoError(t, err)
+	assert.Equal(t, model.ReviewAccepted, got.Status)
+	assert.Equal(t, "good", got.Answer)
+
+	reviews, err = s.review.PendingByGroup(ctx, TestGroup1.ID)
+	require.NoError(t, err)
+	assert.Empty(t, reviews)
+
+	_, err = s.review.Get(ctx, uuid.New())
+	assert.ErrorIs(t, err, store.ErrNotFound)
+}
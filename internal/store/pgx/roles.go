@@ -3,20 +3,24 @@ package pgx
 import (
 	"context"
 	"errors"
-	"github.com/jackc/pgerrcode"
+
+	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
-	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/vlad-marlo/godo/internal/model"
 	"github.com/vlad-marlo/godo/internal/store"
 	"go.uber.org/zap"
 )
 
+var _ store.RoleRepository = (*RoleRepository)(nil)
+
+// RoleRepository is storage of named roles of groups.
 type RoleRepository struct {
 	pool *pgxpool.Pool
 	log  *zap.Logger
 }
 
+// NewRoleRepository return new instance of RoleRepository.
 func NewRoleRepository(cli Client) *RoleRepository {
 	return &RoleRepository{
 		pool: cli.P(),
@@ -24,50 +28,78 @@ func NewRoleRepository(cli Client) *RoleRepository {
 	}
 }
 
+// Create creates role of group and sets its id.
 func (repo *RoleRepository) Create(ctx context.Context, role *model.Role) error {
 	if role == nil {
 		return store.ErrNilReference
 	}
 	if err := repo.pool.QueryRow(
 		ctx,
-		`INSERT INTO roles(members, tasks, reviews, comments) VALUES ($1, $2, $3, $4) RETURNING id;`,
-		role.Members,
-		role.Tasks,
-		role.Reviews,
-		role.Comments,
+		`INSERT INTO group_roles(group_id, "name", permissions) VALUES ($1, $2, $3) RETURNING id;`,
+		role.Group,
+		role.Name,
+		int32(role.Permissions),
 	).Scan(&role.ID); err != nil {
-		var pgErr *pgconn.PgError
-		if errors.As(err, &pgErr) {
-			switch pgErr.Code {
-			case pgerrcode.UniqueViolation:
-				return store.ErrUniqueViolation
-			default:
-
-			}
-		}
-		zap.L().Log(_unknownLevel, "store: role: create", traceError(err)...)
-
-		return unknown(err)
+		return pgError("store: role: create", err)
 	}
 	return nil
 }
 
-func (repo *RoleRepository) Get(ctx context.Context, role *model.Role) error {
-	if role == nil {
-		return store.ErrNilReference
-	}
+// Get return role of group by id.
+func (repo *RoleRepository) Get(ctx context.Context, group uuid.UUID, id int32) (*model.Role, error) {
+	r := new(model.Role)
 	if err := repo.pool.QueryRow(
 		ctx,
-		`SELECT id FROM roles WHERE members = $1 AND tasks = $2 AND reviews = $3 AND comments = $4`,
-		role.Members,
-		role.Tasks,
-		role.Reviews,
-		role.Comments,
-	).Scan(&role.ID); err != nil {
+		`SELECT r.id, r.group_id, r.name, r.permissions FROM group_roles r WHERE r.group_id = $1 AND r.id = $2;`,
+		group,
+		id,
+	).Scan(&r.ID, &r.Group, &r.Name, &r.Permissions); err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return repo.Create(ctx, role)
+			return nil, store.ErrNotFound
+		}
+		repo.log.Log(_unknownLevel, "get role of group", traceError(err)...)
+		return nil, unknown(err)
+	}
+	return r, nil
+}
+
+// AllByGroup return roles of group ordered by name.
+func (repo *RoleRepository) AllByGroup(ctx context.Context, group uuid.UUID) ([]*model.Role, error) {
+	rows, err := repo.pool.Query(
+		ctx,
+		`SELECT r.id, r.group_id, r.name, r.permissions FROM group_roles r WHERE r.group_id = $1 ORDER BY r.name;`,
+		group,
+	)
+	if err != nil {
+		repo.log.Log(_unknownLevel, "get roles of group", traceError(err)...)
+		return nil, unknown(err)
+	}
+	defer rows.Close()
+
+	var resp []*model.Role
+	for rows.Next() {
+		r := new(model.Role)
+		if err = rows.Scan(&r.ID, &r.Group, &r.Name, &r.Permissions); err != nil {
+			repo.log.Log(_unknownLevel, "scan role while getting roles of group", traceError(err)...)
+			return nil, unknown(err)
 		}
-		return unknown(err)
+		resp = append(resp, r)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, unknown(err)
+	}
+	return resp, nil
+}
+
+// Delete deletes role of group. Roles which are given to members or invites could not be deleted.
+func (repo *RoleRepository) Delete(ctx context.Context, group uuid.UUID, id int32) error {
+	tag, err := repo.pool.Exec(ctx, `DELETE FROM group_roles WHERE group_id = $1 AND id = $2;`, group, id)
+	if err != nil {
+		return pgError("store: role: delete", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return store.ErrNotFound
 	}
 	return nil
 }
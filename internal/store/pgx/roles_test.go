@@ -2,10 +2,14 @@ package pgx
 
 import (
 	"context"
+	"testing"
+
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/vlad-marlo/godo/internal/model"
+	"github.com/vlad-marlo/godo/internal/pkg/client/postgres"
 	"github.com/vlad-marlo/godo/internal/store"
-	"testing"
 )
 
 func TestRoleRepository_Create(t *testing.T) {
@@ -17,13 +21,16 @@ func TestRoleRepository_Create(t *testing.T) {
 	if assert.Error(t, err) {
 		assert.ErrorIs(t, err, store.ErrNilReference)
 	}
+	assert.ErrorIs(t, str.role.Create(ctx, TestRole1), store.ErrFKViolation)
 
-	err = str.role.Create(ctx, TestRole1)
-	require.NoError(t, err)
-	err = str.role.Create(ctx, TestRole1)
-	if assert.Error(t, err) {
-		assert.ErrorIs(t, err, store.ErrUniqueViolation)
-	}
+	require.NoError(t, str.user.Create(ctx, TestUser1))
+	require.NoError(t, str.group.Create(ctx, TestGroup1))
+	require.NoError(t, str.role.Create(ctx, TestRole1))
+
+	sameName := &model.Role{Group: TestGroup1.ID, Name: TestRole1.Name, Permissions: model.PermViewMembers}
+	assert.ErrorIs(t, str.role.Create(ctx, sameName), store.ErrUniqueViolation)
+	samePermissions := &model.Role{Group: TestGroup1.ID, Name: "copy", Permissions: TestRole1.Permissions}
+	assert.ErrorIs(t, str.role.Create(ctx, samePermissions), store.ErrUniqueViolation)
 }
 
 func TestRoleRepository_Get(t *testing.T) {
@@ -31,12 +38,106 @@ func TestRoleRepository_Get(t *testing.T) {
 	defer td()
 
 	ctx := context.Background()
-	err := st.role.Get(ctx, nil)
-	if assert.Error(t, err) {
-		assert.ErrorIs(t, err, store.ErrNilReference)
+	require.NoError(t, st.user.Create(ctx, TestUser1))
+	require.NoError(t, st.group.Create(ctx, TestGroup1))
+	require.NoError(t, st.group.Create(ctx, TestGroup2))
+	require.NoError(t, st.role.Create(ctx, TestRole1))
+	reader := &model.Role{Group: TestGroup1.ID, Name: "reader", Permissions: model.PermViewMembers}
+	require.NoError(t, st.role.Create(ctx, reader))
+
+	r, err := st.role.Get(ctx, TestGroup1.ID, TestRole1.ID)
+	require.NoError(t, err)
+	assert.Equal(t, TestRole1, r)
+
+	_, err = st.role.Get(ctx, TestGroup2.ID, TestRole1.ID)
+	assert.ErrorIs(t, err, store.ErrNotFound)
+
+	roles, err := st.role.AllByGroup(ctx, TestGroup1.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []*model.Role{reader, TestRole1}, roles)
+
+	roles, err = st.role.AllByGroup(ctx, uuid.New())
+	require.NoError(t, err)
+	assert.Empty(t, roles)
+}
+
+func TestRoleRepository_Delete(t *testing.T) {
+	st, td := testStore(t, nil)
+	defer td()
+
+	ctx := context.Background()
+	require.NoError(t, st.user.Create(ctx, TestUser1))
+	require.NoError(t, st.group.Create(ctx, TestGroup1))
+	require.NoError(t, st.role.Create(ctx, TestRole1))
+	require.NoError(t, st.group.AddUser(ctx, TestRole1.ID, TestGroup1.ID, TestUser1.ID, true))
+	unused := &model.Role{Group: TestGroup1.ID, Name: "unused"}
+	require.NoError(t, st.role.Create(ctx, unused))
+
+	assert.ErrorIs(t, st.role.Delete(ctx, TestGroup1.ID, TestRole1.ID), store.ErrFKViolation)
+	assert.ErrorIs(t, st.role.Delete(ctx, TestGroup2.ID, unused.ID), store.ErrNotFound)
+	require.NoError(t, st.role.Delete(ctx, TestGroup1.ID, unused.ID))
+	assert.ErrorIs(t, st.role.Delete(ctx, TestGroup1.ID, unused.ID), store.ErrNotFound)
+}
+
+// legacyCheck is check of legacy role level which was done before roles were converted into permissions.
+type legacyCheck struct {
+	// level return level of legacy role which is checked.
+	level func(members, tasks, reviews, comments int) int
+	// min is minimal level which passed check.
+	min int
+	// perm is permission which does the same check now.
+	perm model.Permission
+}
+
+func TestLegacyRolePermissions(t *testing.T) {
+	cli := postgres.TestClient(t)
+	defer cli.Close()
+
+	members := func(m, _, _, _ int) int { return m }
+	tasks := func(_, t, _, _ int) int { return t }
+	reviews := func(_, _, r, _ int) int { return r }
+	comments := func(_, _, _, c int) int { return c }
+	checks := []legacyCheck{
+		{tasks, 1, model.PermViewTasks},
+		{tasks, 2, model.PermCreateTasks},
+		{tasks, 3, model.PermEditRelatedTasks},
+		{tasks, 4, model.PermEditTasks},
+		{tasks, 4, model.PermDeleteTasks},
+		{reviews, 1, model.PermViewReviews},
+		{reviews, 4, model.PermReviewTasks},
+		{comments, 1, model.PermViewIssues},
+		{comments, 2, model.PermCreateIssues},
+		{comments, 3, model.PermEditRelatedIssues},
+		{comments, 4, model.PermModerateIssues},
+		{members, 0, model.PermViewMembers},
+		{members, 2, model.PermCreateInvites},
+		{members, 3, model.PermRemoveRelatedMembers},
+		{members, 4, model.PermRemoveMembers},
+	}
+
+	rows, err := cli.P().Query(
+		context.Background(),
+		`SELECT m, t, r, c, legacy_role_permissions(m, t, r, c)
+FROM generate_series(0, 5) m, generate_series(0, 5) t, generate_series(0, 5) r, generate_series(0, 5) c;`,
+	)
+	require.NoError(t, err)
+	defer rows.Close()
+
+	var n int
+	for rows.Next() {
+		var m, tl, r, c int
+		var perms model.Permission
+		require.NoError(t, rows.Scan(&m, &tl, &r, &c, &perms))
+
+		var want model.Permission
+		for _, check := range checks {
+			if check.level(m, tl, r, c) >= check.min {
+				want |= check.perm
+			}
+		}
+		assert.Equal(t, want, perms, "members %d, tasks %d, reviews %d, comments %d", m, tl, r, c)
+		n++
 	}
-	err = st.role.Get(ctx, TestRole1)
-	assert.NoError(t, err)
-	err = st.role.Get(ctx, TestRole1)
-	assert.NoError(t, err)
+	require.NoError(t, rows.Err())
+	assert.Equal(t, 6*6*6*6, n)
 }
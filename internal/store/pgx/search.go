@@ -0,0 +1,76 @@
+package pgx
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/vlad-marlo/godo/internal/model"
+)
+
+// _searchHeadlineOptions is options of ts_headline which are used to build snippets.
+const _searchHeadlineOptions = "MaxFragments=2, MaxWords=20, MinWords=5, FragmentDelimiter=\" ... \""
+
+// Search return tasks visible to user which name, description or comments match query ordered by relevance.
+//
+// Query is parsed as web search query with both english and russian stemming. Comments which user could not
+// read are not used for matching and snippets.
+func (repo *TaskRepository) Search(ctx context.Context, user uuid.UUID, query string, limit int) ([]*model.SearchResult, error) {
+	b := new(queryBuilder)
+	q := b.arg(query)
+	u := b.arg(user)
+	opts := b.arg(_searchHeadlineOptions)
+	b.where(visibleTaskCond(u))
+	b.where("(t.search @@ sq.query OR c.rank IS NOT NULL)")
+
+	sql := fmt.Sprintf(`WITH sq AS (SELECT websearch_to_tsquery('english', %[1]s) || websearch_to_tsquery('russian', %[1]s) AS query)
+SELECT %[5]s,
+       ts_rank(t.search, sq.query) + COALESCE(c.rank, 0) AS rank,
+       CASE
+           WHEN t.search @@ sq.query
+               THEN ts_headline('russian', t.name || ' ' || COALESCE(t.description, ''), sq.query, %[3]s)
+           ELSE '' END,
+       COALESCE(c.snippet, '')
+FROM tasks t
+         CROSS JOIN sq
+         LEFT JOIN LATERAL (SELECT ts_rank(cm.search, sq.query)                                 AS rank,
+                                   ts_headline('russian', COALESCE(cm.msg, ''), sq.query, %[3]s) AS snippet
+                            FROM comments cm
+                            WHERE cm.task = t.id
+                              AND cm.search @@ sq.query
+                              AND %[2]s
+                            ORDER BY rank DESC
+                            LIMIT 1) c ON TRUE
+%[4]s
+ORDER BY rank DESC, t.id`,
+		q,
+		visibleCommentCond(u),
+		opts,
+		b.whereClause(),
+		_taskColumns,
+	)
+	if limit > 0 {
+		sql += "\nLIMIT " + b.arg(limit)
+	}
+
+	rows, err := repo.pool.Query(ctx, sql+";", b.args...)
+	if err != nil {
+		repo.log.Log(_unknownLevel, "search tasks", traceError(err)...)
+		return nil, unknown(err)
+	}
+	defer rows.Close()
+
+	res := make([]*model.SearchResult, 0, limit)
+	for rows.Next() {
+		r := new(model.SearchResult)
+		if r.Task, err = scanTask(rows, &r.Rank, &r.Snippet, &r.CommentSnippet); err != nil {
+			repo.log.Log(_unknownLevel, "scan search result", traceError(err)...)
+			return nil, unknown(err)
+		}
+		res = append(res, r)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, unknown(err)
+	}
+	return res, nil
+}
@@ -0,0 +1,57 @@
+package pgx
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vlad-marlo/godo/internal/model"
+)
+
+func TestTaskRepository_Search(t *testing.T) {
+	s, td := testStore(t, nil)
+	defer td()
+
+	ctx := context.Background()
+
+	require.NoError(t, s.user.Create(ctx, TestUser1))
+	require.NoError(t, s.user.Create(ctx, TestUser2))
+
+	task := &model.Task{
+		ID:          uuid.New(),
+		Name:        "Починить авторизацию",
+		Description: "login fails for new users",
+		CreatedAt:   time.Now(),
+		CreatedBy:   TestUser1.ID,
+		Status:      model.StatusNew,
+	}
+	require.NoError(t, s.task.Create(ctx, task))
+	require.NoError(t, s.comment.Create(ctx, &model.Comment{
+		ID:        uuid.New(),
+		Task:      task.ID,
+		Message:   "bug is in refreshing of tokens",
+		CreatedBy: TestUser1.ID,
+	}))
+
+	res, err := s.task.Search(ctx, TestUser1.ID, "авторизация", 10)
+	require.NoError(t, err)
+	if assert.Len(t, res, 1) {
+		assert.Equal(t, task.ID, res[0].Task.ID)
+		assert.Contains(t, res[0].Snippet, "<b>")
+		assert.Empty(t, res[0].CommentSnippet)
+	}
+
+	res, err = s.task.Search(ctx, TestUser1.ID, "token refresh", 10)
+	require.NoError(t, err)
+	if assert.Len(t, res, 1) {
+		assert.Empty(t, res[0].Snippet)
+		assert.Contains(t, res[0].CommentSnippet, "<b>")
+	}
+
+	res, err = s.task.Search(ctx, TestUser2.ID, "авторизация", 10)
+	require.NoError(t, err)
+	assert.Empty(t, res)
+}
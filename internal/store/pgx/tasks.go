@@ -5,6 +5,7 @@ import (
 	"errors"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/vlad-marlo/godo/internal/model"
 	"github.com/vlad-marlo/godo/internal/store"
@@ -13,6 +14,61 @@ import (
 
 var _ store.TaskRepository = (*TaskRepository)(nil)
 
+const (
+	// _taskColumns is columns of task from table with alias t in order in which they are scanned by scanTask.
+	//
+	// Progress of task is computed from statuses of its subtasks. Labels of task are aggregated into json array.
+	// Checklist summary is computed from items of task checklist.
+	_taskColumns = `t.id,
+       t.name,
+       t.description,
+       t.created_at,
+       t.created_by,
+       t.status,
+       t.deadline,
+       t.parent_id,
+       (SELECT COUNT(*) FROM tasks st WHERE st.parent_id = t.id),
+       (SELECT COUNT(*) FROM tasks st WHERE st.parent_id = t.id AND st.status IN ('CLOSED', 'ACCEPTED')),
+       COALESCE((SELECT json_agg(json_build_object('id', l.id, 'group', l.group_id, 'name', l.name, 'color', l.color)
+                                 ORDER BY l.name)
+                 FROM task_labels tl
+                          JOIN labels l on l.id = tl.label_id
+                 WHERE tl.task_id = t.id), '[]'::json),
+       (SELECT COUNT(*) FROM checklist_items ci WHERE ci.task_id = t.id),
+       (SELECT COUNT(*) FROM checklist_items ci WHERE ci.task_id = t.id AND ci.done)`
+	// _canCreateTaskInGroupQuery checks that user is admin of group or could create tasks in it.
+	//
+	// Arguments: group, user, required permissions.
+	_canCreateTaskInGroupQuery = `SELECT EXISTS(
+               SELECT *
+               FROM user_in_group uig
+                        JOIN group_roles r on r.id = uig.role_id
+               WHERE uig.group_id = $1
+                 AND uig.user_id = $2
+                 AND (uig.is_admin OR r.permissions & $3 = $3)
+           );`
+	// _addTaskToGroupQuery relates task to group and places it at the end of board of group.
+	//
+	// Arguments: task, group.
+	_addTaskToGroupQuery = `INSERT INTO task_group(task_id, group_id, position)
+SELECT $1, $2, COALESCE(MAX(g.position) + 1, 0)
+FROM task_group g
+WHERE g.group_id = $2;`
+	// _canAssignTaskQuery checks existence of group where both of users are members
+	// and user who assigns task is admin or has permission.
+	//
+	// Arguments: user who assigns task, assignee, required permissions.
+	_canAssignTaskQuery = `SELECT EXISTS(
+               SELECT *
+               FROM user_in_group f
+                        JOIN group_roles r on r.id = f.role_id
+                        JOIN user_in_group a on a.group_id = f.group_id
+               WHERE f.user_id = $1
+                 AND a.user_id = $2
+                 AND (f.is_admin OR r.permissions & $3 = $3)
+           );`
+)
+
 type TaskRepository struct {
 	pool *pgxpool.Pool
 	log  *zap.Logger
@@ -26,22 +82,47 @@ func NewTaskRepository(cli Client) *TaskRepository {
 	}
 }
 
-// AllByUser return all tasks related to user.
+// scanTask scans task which columns are selected with _taskColumns. Extra destinations are scanned after task.
+func scanTask(row pgx.Row, extra ...any) (*model.Task, error) {
+	t := new(model.Task)
+	var total, done, checklistTotal, checklistDone int
+	dest := append([]any{
+		&t.ID,
+		&t.Name,
+		&t.Description,
+		&t.CreatedAt,
+		&t.CreatedBy,
+		&t.Status,
+		&t.Deadline,
+		&t.Parent,
+		&total,
+		&done,
+		&t.Labels,
+		&checklistTotal,
+		&checklistDone,
+	}, extra...)
+	if err := row.Scan(dest...); err != nil {
+		return nil, err
+	}
+	t.Progress = model.NewTaskProgress(done, total)
+	t.Checklist = model.NewChecklistSummary(checklistDone, checklistTotal)
+	return t, nil
+}
+
+// AllByUser return tasks related to user which satisfy filter.
 //
 // Task will be returned to user if this cases:
 // * User is admin of group to which task is related;
 // * user is related to group;
-// * user has permission to read tasks in group where task is created.
-func (repo *TaskRepository) AllByUser(ctx context.Context, user uuid.UUID) ([]*model.Task, error) {
-	q := `SELECT t.id, t.name, t.description, t.created_at, t.created_by, t.status
-FROM tasks t
-         LEFT JOIN task_user tu on t.id = tu.task_id
-         LEFT JOIN task_group tg on t.id = tg.task_id
-         LEFT JOIN user_in_group uig on tg.group_id = uig.group_id
-         LEFT JOIN roles r on r.id = uig.role_id
-WHERE tu.user_id = $1 OR t.created_by = $1 OR (uig.user_id = $1 AND (uig.is_admin OR r.tasks >= 2));`
+// * user has model.PermViewTasks in group where task is created.
+//
+// Tasks are sorted and paginated as it is defined in filter.
+func (repo *TaskRepository) AllByUser(ctx context.Context, user uuid.UUID, filter model.TaskFilter) ([]*model.Task, error) {
+	b := new(queryBuilder)
+	b.where(visibleTaskCond(b.arg(user)))
+	q := taskListQuery(b, filter)
 
-	rows, err := repo.pool.Query(ctx, q, user)
+	rows, err := repo.pool.Query(ctx, q, b.args...)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, store.ErrNotFound
@@ -56,9 +137,8 @@ WHERE tu.user_id = $1 OR t.created_by = $1 OR (uig.user_id = $1 AND (uig.is_admi
 
 	var resp []*model.Task
 	for rows.Next() {
-		t := new(model.Task)
-
-		if err = rows.Scan(&t.ID, &t.Name, &t.Description, &t.CreatedAt, &t.CreatedBy, &t.Status); err != nil {
+		var t *model.Task
+		if t, err = scanTask(rows); err != nil {
 			repo.log.Log(_unknownLevel, "scan task while getting group tasks", traceError(err)...)
 			return nil, unknown(err)
 		}
@@ -76,17 +156,16 @@ WHERE tu.user_id = $1 OR t.created_by = $1 OR (uig.user_id = $1 AND (uig.is_admi
 	return resp, nil
 }
 
-// AllByGroupAndUser return all related to user tasks.
+// AllByGroupAndUser return tasks of group which are visible to user.
+//
+// Tasks are ordered by their position on board of group.
 func (repo *TaskRepository) AllByGroupAndUser(ctx context.Context, group uuid.UUID, user uuid.UUID) ([]*model.Task, error) {
-	// данный вопрос возвращает все задачи, к которым относится пользователь - он администратор группы, имеет право на чтение, или указан как получатель задачи.
-	q := `SELECT t.id, t.name, t.description, t.created_at, t.created_by, t.status
+	q := `SELECT ` + _taskColumns + `
 FROM tasks t
-         JOIN task_group tg on t.id = tg.task_id
-         LEFT JOIN task_user tu on t.id = tu.task_id
-         JOIN user_in_group uig on tu.user_id = uig.user_id
-         JOIN roles r on uig.role_id = r.id
-WHERE tg.group_id = $1
-  AND (tu.user_id = $2 OR (uig.user_id = $2 AND (uig.is_admin OR r.tasks >= 1)));`
+         JOIN task_group g on t.id = g.task_id
+WHERE g.group_id = $1
+  AND ` + visibleTaskCond("$2") + `
+ORDER BY g.position, t.created_at, t.id;`
 
 	rows, err := repo.pool.Query(ctx, q, group, user)
 	if err != nil {
@@ -103,15 +182,17 @@ WHERE tg.group_id = $1
 
 	var resp []*model.Task
 	for rows.Next() {
-		t := new(model.Task)
-
-		if err = rows.Scan(&t.ID, &t.Name, &t.Description, &t.CreatedAt, &t.CreatedBy, &t.Status); err != nil {
+		var t *model.Task
+		if t, err = scanTask(rows); err != nil {
 			repo.log.Log(_unknownLevel, "scan task while getting group tasks", traceError(err)...)
 			return nil, unknown(err)
 		}
 
 		resp = append(resp, t)
 	}
+	if err = rows.Err(); err != nil {
+		return nil, unknown(err)
+	}
 
 	return resp, nil
 }
@@ -123,16 +204,15 @@ WHERE tg.group_id = $1
 // * user is related to group;
 // * user has permission to read tasks in group where task is created.
 func (repo *TaskRepository) GetByUserAndID(ctx context.Context, user, task uuid.UUID) (*model.Task, error) {
-	q := `SELECT t.id, t.name, t.description, t.created_at, t.created_by, t.status
+	q := `SELECT ` + _taskColumns + `
 FROM tasks t
          LEFT JOIN task_user tu on t.id = tu.task_id
          LEFT JOIN task_group tg on t.id = tg.task_id
          LEFT JOIN user_in_group uig on tg.group_id = uig.group_id
-         LEFT JOIN roles r on r.id = uig.role_id
-WHERE t.id = $2 AND (tu.user_id = $1 OR t.created_by = $1 OR (uig.user_id = $1 AND (uig.is_admin OR r.tasks >= 2)));`
-	t := new(model.Task)
-
-	if err := repo.pool.QueryRow(ctx, q, user, task).Scan(&t.ID, &t.Name, &t.Description, &t.CreatedAt, &t.CreatedBy, &t.Status); err != nil {
+         LEFT JOIN group_roles r on r.id = uig.role_id
+WHERE t.id = $2 AND (tu.user_id = $1 OR t.created_by = $1 OR (uig.user_id = $1 AND (uig.is_admin OR r.permissions & $3 <> 0)));`
+	t, err := scanTask(repo.pool.QueryRow(ctx, q, user, task, int32(model.PermViewTasks)))
+	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, store.ErrNotFound
 		}
@@ -151,14 +231,16 @@ func (repo *TaskRepository) Create(ctx context.Context, task *model.Task) error
 	}
 	if _, err := repo.pool.Exec(
 		ctx,
-		`INSERT INTO tasks(id, "name", description, created_at, created_by, status)
-VALUES ($1, $2, $3, $4, $5, $6);`,
+		`INSERT INTO tasks(id, "name", description, created_at, created_by, status, deadline, parent_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8);`,
 		task.ID,
 		task.Name,
 		task.Description,
 		task.CreatedAt,
 		task.CreatedBy,
 		task.Status,
+		task.Deadline,
+		task.Parent,
 	); err != nil {
 		return pgError("tasks: create", err)
 	}
@@ -168,7 +250,7 @@ VALUES ($1, $2, $3, $4, $5, $6);`,
 
 // AddToGroup add relation task-group.
 func (repo *TaskRepository) AddToGroup(ctx context.Context, task, group uuid.UUID) error {
-	if _, err := repo.pool.Exec(ctx, `INSERT INTO task_group(task_id, group_id) VALUES ($1, $2);`, task, group); err != nil {
+	if _, err := repo.pool.Exec(ctx, _addTaskToGroupQuery, task, group); err != nil {
 		return pgError("add task to group", err)
 	}
 	return nil
@@ -183,21 +265,7 @@ func (repo *TaskRepository) AddToGroup(ctx context.Context, task, group uuid.UUI
 func (repo *TaskRepository) AddToUser(ctx context.Context, from, task, to uuid.UUID) error {
 	var ok bool
 	// check existence of group where exists both of users and user, who want to add task has permission.
-	_ = repo.pool.QueryRow(
-		ctx,
-		`SELECT EXISTS(
-               SELECT g.id
-               FROM groups g
-                        LEFT JOIN user_in_group uig on g.id = uig.group_id
-                        LEFT JOIN roles r on r.id = uig.role_id
-               WHERE EXISTS(SELECT * FROM user_in_group WHERE user_id = $1)
-                 AND EXISTS(SELECT * FROM user_in_group WHERE user_id = $2)
-                 AND (uig.user_id = $2 AND (uig.is_admin OR r.tasks >= $3))
-           );`,
-		to,
-		from,
-		model.PermCreate,
-	).Scan(&ok)
+	_ = repo.pool.QueryRow(ctx, _canAssignTaskQuery, from, to, int32(model.PermCreateTasks)).Scan(&ok)
 	if !ok {
 		return store.ErrNotAuthorized
 	}
@@ -214,6 +282,114 @@ VALUES ($1, $2);`,
 	return nil
 }
 
+// CreateWithRelations creates task, relates it to group and assigns it to users in one transaction.
+//
+// Task is related to group only if creator is admin of group or could create tasks in it, otherwise
+// store.ErrNotAuthorized will be returned and nothing will be stored.
+// If users is nil and group is provided then task is assigned to all members of group.
+// Users who have no common group with creator in which creator could create tasks are not assigned to task
+// and are returned as rejected. If template is provided then its labels and checklist are added to task like in
+// TemplateRepository.Apply, so task is never stored without them. Creation of task, its assignees and labels are
+// recorded to history of task in the same transaction.
+func (repo *TaskRepository) CreateWithRelations(ctx context.Context, task *model.Task, group *uuid.UUID, users []uuid.UUID, tpl *model.TaskTemplate) (rejected []uuid.UUID, err error) {
+	if task == nil {
+		return nil, store.ErrNilReference
+	}
+
+	tx, err := repo.pool.Begin(ctx)
+	if err != nil {
+		repo.log.Error("unexpected error received while starting new transaction: check drivers", traceError(err)...)
+		return nil, unknown(err)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	if _, err = tx.Exec(
+		ctx,
+		`INSERT INTO tasks(id, "name", description, created_at, created_by, status, deadline, parent_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8);`,
+		task.ID,
+		task.Name,
+		task.Description,
+		task.CreatedAt,
+		task.CreatedBy,
+		task.Status,
+		task.Deadline,
+		task.Parent,
+	); err != nil {
+		return nil, pgError("store: task: create with relations", err)
+	}
+
+	if group != nil {
+		var ok bool
+		if err = tx.QueryRow(ctx, _canCreateTaskInGroupQuery, *group, task.CreatedBy, int32(model.PermCreateTasks)).Scan(&ok); err != nil {
+			return nil, pgError("store: task: create with relations: check group permission", err)
+		}
+		if !ok {
+			return nil, store.ErrNotAuthorized
+		}
+
+		if _, err = tx.Exec(ctx, _addTaskToGroupQuery, task.ID, *group); err != nil {
+			return nil, pgError("store: task: create with relations: add to group", err)
+		}
+
+		if users == nil {
+			if _, err = tx.Exec(
+				ctx,
+				`INSERT INTO task_user(user_id, task_id)
+SELECT uig.user_id, $2
+FROM user_in_group uig
+WHERE uig.group_id = $1;`,
+				*group,
+				task.ID,
+			); err != nil {
+				return nil, pgError("store: task: create with relations: add to group users", err)
+			}
+		}
+	}
+
+	rejected = make([]uuid.UUID, 0)
+	assigned := make(map[uuid.UUID]struct{}, len(users))
+	for _, u := range users {
+		if _, ok := assigned[u]; ok {
+			continue
+		}
+
+		ok := u == task.CreatedBy
+		if !ok {
+			if err = tx.QueryRow(ctx, _canAssignTaskQuery, task.CreatedBy, u, int32(model.PermCreateTasks)).Scan(&ok); err != nil {
+				return nil, pgError("store: task: create with relations: check assignee", err)
+			}
+		}
+		if !ok {
+			rejected = append(rejected, u)
+			continue
+		}
+
+		if _, err = tx.Exec(ctx, `INSERT INTO task_user(user_id, task_id) VALUES ($1, $2);`, u, task.ID); err != nil {
+			return nil, pgError("store: task: create with relations: add to user", err)
+		}
+		assigned[u] = struct{}{}
+	}
+
+	if tpl != nil {
+		if err = applyTemplate(ctx, tx, tpl, task.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = addCreationHistory(ctx, tx, task); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		repo.log.Error("unexpected error while doing commit transaction: check pgx driver", traceError(err)...)
+		return nil, unknown(err)
+	}
+	return rejected, nil
+}
+
 // ForceAddToUser add task to user without any permission checks in it.
 func (repo *TaskRepository) ForceAddToUser(ctx context.Context, userID, taskID uuid.UUID) error {
 	if _, err := repo.pool.Exec(ctx, `INSERT INTO task_user(user_id, task_id) VALUES ($1, $2)`, userID, taskID); err != nil {
@@ -222,6 +398,288 @@ func (repo *TaskRepository) ForceAddToUser(ctx context.Context, userID, taskID u
 	return nil
 }
 
+// GetRoleOfMember return role of user in groups to which task is related.
+//
+// If task is related to many groups in which user is member, then union of permissions will be returned.
+// Admins of group have all permissions.
+func (repo *TaskRepository) GetRoleOfMember(ctx context.Context, user, task uuid.UUID) (*model.Role, error) {
+	role := new(model.Role)
+	if err := repo.pool.QueryRow(
+		ctx,
+		`SELECT BIT_OR(CASE WHEN uig.is_admin THEN $3 ELSE r.permissions END)
+FROM task_group tg
+         JOIN user_in_group uig on tg.group_id = uig.group_id
+         JOIN group_roles r on r.id = uig.role_id
+WHERE tg.task_id = $2
+  AND uig.user_id = $1
+HAVING COUNT(*) > 0;`,
+		user,
+		task,
+		int32(model.PermAll),
+	).Scan(&role.Permissions); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, store.ErrNotFound
+		}
+		repo.log.Log(_unknownLevel, "get role of user in task groups", traceError(err)...)
+		return nil, unknown(err)
+	}
+	return role, nil
+}
+
+// UpdateStatus changes status of task from one to another.
+//
+// If task does not exist or its status was already changed then store.ErrNotFound will be returned.
+// If task does not fit into column of board of any its group then store.ErrLimitExceeded will be returned.
+// Changes are recorded to history of task in the same transaction.
+func (repo *TaskRepository) UpdateStatus(ctx context.Context, task uuid.UUID, from, to model.TaskStatus, changes ...*model.TaskChange) error {
+	tx, err := repo.pool.Begin(ctx)
+	if err != nil {
+		repo.log.Error("unexpected error received while starting new transaction: check drivers", traceError(err)...)
+		return unknown(err)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	if from != to {
+		if err = checkLimits(ctx, tx, task, to); err != nil {
+			return err
+		}
+	}
+
+	tag, err := tx.Exec(
+		ctx,
+		`UPDATE tasks SET status = $3 WHERE id = $1 AND status = $2;`,
+		task,
+		from,
+		to,
+	)
+	if err != nil {
+		return pgError("store: task: update status", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return store.ErrNotFound
+	}
+
+	if err = addTaskChanges(ctx, tx, changes...); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		repo.log.Error("unexpected error while doing commit transaction: check pgx driver", traceError(err)...)
+		return unknown(err)
+	}
+	return nil
+}
+
+// IsAssignee return existence of relation between task and user.
+func (repo *TaskRepository) IsAssignee(ctx context.Context, task, user uuid.UUID) (ok bool) {
+	_ = repo.pool.QueryRow(
+		ctx,
+		`SELECT EXISTS(SELECT * FROM task_user tu WHERE tu.task_id = $1 AND tu.user_id = $2);`,
+		task,
+		user,
+	).Scan(&ok)
+	return
+}
+
+// Update changes name, description and deadline of task.
+//
+// If task does not exist then store.ErrNotFound will be returned.
+// Changes are recorded to history of task in the same transaction.
+func (repo *TaskRepository) Update(ctx context.Context, task *model.Task, changes ...*model.TaskChange) error {
+	if task == nil {
+		return store.ErrNilReference
+	}
+
+	tx, err := repo.pool.Begin(ctx)
+	if err != nil {
+		repo.log.Error("unexpected error received while starting new transaction: check drivers", traceError(err)...)
+		return unknown(err)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	tag, err := tx.Exec(
+		ctx,
+		`UPDATE tasks SET "name" = $2, description = $3, deadline = $4 WHERE id = $1;`,
+		task.ID,
+		task.Name,
+		task.Description,
+		task.Deadline,
+	)
+	if err != nil {
+		return pgError("store: task: update", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return store.ErrNotFound
+	}
+
+	if err = addTaskChanges(ctx, tx, changes...); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		repo.log.Error("unexpected error while doing commit transaction: check pgx driver", traceError(err)...)
+		return unknown(err)
+	}
+	return nil
+}
+
+// Delete deletes task. Relations of task, comments and reviews are deleted by cascade.
+func (repo *TaskRepository) Delete(ctx context.Context, task uuid.UUID) error {
+	tag, err := repo.pool.Exec(ctx, `DELETE FROM tasks WHERE id = $1;`, task)
+	if err != nil {
+		return pgError("store: task: delete", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return store.ErrNotFound
+	}
+	return nil
+}
+
+// ShareGroup return true if both tasks are related to one group.
+func (repo *TaskRepository) ShareGroup(ctx context.Context, a, b uuid.UUID) (ok bool) {
+	_ = repo.pool.QueryRow(
+		ctx,
+		`SELECT EXISTS(SELECT *
+              FROM task_group ta
+                       JOIN task_group tb on ta.group_id = tb.group_id
+              WHERE ta.task_id = $1
+                AND tb.task_id = $2);`,
+		a,
+		b,
+	).Scan(&ok)
+	return
+}
+
+// InGroup return true if task is related to group.
+func (repo *TaskRepository) InGroup(ctx context.Context, task, group uuid.UUID) (ok bool) {
+	_ = repo.pool.QueryRow(
+		ctx,
+		`SELECT EXISTS(SELECT * FROM task_group tg WHERE tg.task_id = $1 AND tg.group_id = $2);`,
+		task,
+		group,
+	).Scan(&ok)
+	return
+}
+
+// Bulk applies operation of request to tasks in one transaction.
+//
+// If status of any task was already changed or any task does not exist then store.ErrNotFound will be returned
+// and no task will be changed. If tasks do not fit into column of board then store.ErrLimitExceeded will be
+// returned.
+//
+// Assignee is added to task only once and labels are attached only if task is related to group of label.
+// Changes are recorded to history of tasks in the same transaction.
+func (repo *TaskRepository) Bulk(ctx context.Context, req *model.BulkTaskRequest, tasks []*model.Task, changes ...*model.TaskChange) error {
+	if req == nil {
+		return store.ErrNilReference
+	}
+	if !req.Operation.Valid() {
+		return store.ErrBadData
+	}
+
+	tx, err := repo.pool.Begin(ctx)
+	if err != nil {
+		repo.log.Error("unexpected error received while starting new transaction: check drivers", traceError(err)...)
+		return unknown(err)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	for _, task := range tasks {
+		if task == nil {
+			return store.ErrNilReference
+		}
+		if err = bulkTask(ctx, tx, req, task); err != nil {
+			return err
+		}
+	}
+
+	if err = addTaskChanges(ctx, tx, changes...); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		repo.log.Error("unexpected error while doing commit transaction: check pgx driver", traceError(err)...)
+		return unknown(err)
+	}
+	return nil
+}
+
+// bulkTask applies operation of request to one task in transaction.
+func bulkTask(ctx context.Context, tx pgx.Tx, req *model.BulkTaskRequest, task *model.Task) error {
+	var (
+		tag pgconn.CommandTag
+		err error
+	)
+	switch req.Operation {
+	case model.BulkSetStatus:
+		if task.Status != req.Status {
+			if err = checkLimits(ctx, tx, task.ID, req.Status); err != nil {
+				return err
+			}
+		}
+		tag, err = tx.Exec(ctx, `UPDATE tasks SET status = $3 WHERE id = $1 AND status = $2;`, task.ID, task.Status, req.Status)
+	case model.BulkAssign:
+		if req.User == nil {
+			return store.ErrNilReference
+		}
+		if _, err = tx.Exec(
+			ctx,
+			`INSERT INTO task_user(user_id, task_id)
+SELECT $1, $2
+WHERE NOT EXISTS(SELECT * FROM task_user tu WHERE tu.user_id = $1 AND tu.task_id = $2);`,
+			*req.User,
+			task.ID,
+		); err != nil {
+			return pgError("store: task: bulk: assign", err)
+		}
+		return nil
+	case model.BulkUnassign:
+		if req.User == nil {
+			return store.ErrNilReference
+		}
+		if _, err = tx.Exec(ctx, `DELETE FROM task_user WHERE user_id = $1 AND task_id = $2;`, *req.User, task.ID); err != nil {
+			return pgError("store: task: bulk: unassign", err)
+		}
+		return nil
+	case model.BulkAddLabel:
+		if req.Label == nil {
+			return store.ErrNilReference
+		}
+		if _, err = tx.Exec(
+			ctx,
+			`INSERT INTO task_labels(task_id, label_id)
+SELECT tg.task_id, l.id
+FROM labels l
+         JOIN task_group tg on tg.group_id = l.group_id
+WHERE tg.task_id = $1
+  AND l.id = $2
+ON CONFLICT DO NOTHING;`,
+			task.ID,
+			*req.Label,
+		); err != nil {
+			return pgError("store: task: bulk: add label", err)
+		}
+		return nil
+	case model.BulkSetDeadline:
+		tag, err = tx.Exec(ctx, `UPDATE tasks SET deadline = $2 WHERE id = $1;`, task.ID, req.Deadline)
+	case model.BulkDelete:
+		tag, err = tx.Exec(ctx, `DELETE FROM tasks WHERE id = $1;`, task.ID)
+	}
+	if err != nil {
+		return pgError("store: task: bulk: "+string(req.Operation), err)
+	}
+	if tag.RowsAffected() == 0 {
+		return store.ErrNotFound
+	}
+	return nil
+}
+
 // Exists return existence of task with provided id.
 func (repo *TaskRepository) Exists(ctx context.Context, id uuid.UUID) (ok bool) {
 	_ = repo.pool.QueryRow(ctx, `SELECT EXISTS(SELECT * FROM tasks WHERE id = $1);`, id).Scan(&ok)
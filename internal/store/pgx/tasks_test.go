@@ -106,3 +106,336 @@ func TestTaskRepository_ForceAddToUser_Positive(t *testing.T) {
 	err = s.task.ForceAddToUser(ctx, TestUser1.ID, TestTask1.ID)
 	assert.NoError(t, err)
 }
+
+func TestTaskRepository_UpdateStatus(t *testing.T) {
+	s, td := testStore(t, nil)
+	defer td()
+
+	ctx := context.Background()
+
+	err := s.task.UpdateStatus(ctx, TestTask1.ID, model.StatusNew, model.StatusInProgress)
+	assert.ErrorIs(t, err, store.ErrNotFound)
+
+	require.NoError(t, s.user.Create(ctx, TestUser1))
+	require.NoError(t, s.task.Create(ctx, TestTask1))
+
+	require.NoError(t, s.task.UpdateStatus(ctx, TestTask1.ID, model.StatusNew, model.StatusInProgress))
+	err = s.task.UpdateStatus(ctx, TestTask1.ID, model.StatusNew, model.StatusClosed)
+	assert.ErrorIs(t, err, store.ErrNotFound)
+
+	task, err := s.task.GetByUserAndID(ctx, TestUser1.ID, TestTask1.ID)
+	require.NoError(t, err)
+	assert.Equal(t, model.StatusInProgress, task.Status)
+}
+
+func TestTaskRepository_GetRoleOfMember(t *testing.T) {
+	s, td := testStore(t, nil)
+	defer td()
+
+	ctx := context.Background()
+
+	require.NoError(t, s.user.Create(ctx, TestUser1))
+	require.NoError(t, s.group.Create(ctx, TestGroup1))
+	require.NoError(t, s.task.Create(ctx, TestTask1))
+
+	_, err := s.task.GetRoleOfMember(ctx, TestUser1.ID, TestTask1.ID)
+	assert.ErrorIs(t, err, store.ErrNotFound)
+
+	require.NoError(t, s.role.Create(ctx, TestRole1))
+	require.NoError(t, s.group.AddUser(ctx, TestRole1.ID, TestGroup1.ID, TestUser1.ID, false))
+	require.NoError(t, s.task.AddToGroup(ctx, TestTask1.ID, TestGroup1.ID))
+
+	role, err := s.task.GetRoleOfMember(ctx, TestUser1.ID, TestTask1.ID)
+	require.NoError(t, err)
+	assert.Equal(t, TestRole1.Permissions, role.Permissions)
+}
+
+func TestTaskRepository_Update(t *testing.T) {
+	s, td := testStore(t, nil)
+	defer td()
+
+	ctx := context.Background()
+
+	assert.ErrorIs(t, s.task.Update(ctx, nil), store.ErrNilReference)
+	assert.ErrorIs(t, s.task.Update(ctx, TestTask1), store.ErrNotFound)
+
+	require.NoError(t, s.user.Create(ctx, TestUser1))
+	require.NoError(t, s.task.Create(ctx, TestTask1))
+
+	task := *TestTask1
+	task.Name = uuid.NewString()
+	task.Description = uuid.NewString()
+	require.NoError(t, s.task.Update(ctx, &task))
+
+	got, err := s.task.GetByUserAndID(ctx, TestUser1.ID, TestTask1.ID)
+	require.NoError(t, err)
+	assert.Equal(t, task.Name, got.Name)
+	assert.Equal(t, task.Description, got.Description)
+}
+
+func TestTaskRepository_Delete(t *testing.T) {
+	s, td := testStore(t, nil)
+	defer td()
+
+	ctx := context.Background()
+
+	assert.ErrorIs(t, s.task.Delete(ctx, TestTask1.ID), store.ErrNotFound)
+
+	require.NoError(t, s.user.Create(ctx, TestUser1))
+	require.NoError(t, s.task.Create(ctx, TestTask1))
+	require.NoError(t, s.task.ForceAddToUser(ctx, TestUser1.ID, TestTask1.ID))
+
+	require.NoError(t, s.task.Delete(ctx, TestTask1.ID))
+	assert.False(t, s.task.Exists(ctx, TestTask1.ID))
+	assert.False(t, s.task.IsAssignee(ctx, TestTask1.ID, TestUser1.ID))
+}
+
+func TestTaskRepository_AllByUser_Deadline(t *testing.T) {
+	s, td := testStore(t, nil)
+	defer td()
+
+	ctx := context.Background()
+	require.NoError(t, s.user.Create(ctx, TestUser1))
+
+	past, future := time.Now().Add(-time.Hour), time.Now().Add(time.Hour)
+	overdue := &model.Task{ID: uuid.New(), Name: uuid.NewString(), CreatedBy: TestUser1.ID, Status: model.StatusNew, Deadline: &past}
+	closed := &model.Task{ID: uuid.New(), Name: uuid.NewString(), CreatedBy: TestUser1.ID, Status: model.StatusClosed, Deadline: &past}
+	upcoming := &model.Task{ID: uuid.New(), Name: uuid.NewString(), CreatedBy: TestUser1.ID, Status: model.StatusNew, Deadline: &future}
+	for _, task := range []*model.Task{overdue, closed, upcoming, TestTask1} {
+		require.NoError(t, s.task.Create(ctx, task))
+	}
+
+	tasks, err := s.task.AllByUser(ctx, TestUser1.ID, model.TaskFilter{})
+	require.NoError(t, err)
+	assert.Len(t, tasks, 4)
+
+	tasks, err = s.task.AllByUser(ctx, TestUser1.ID, model.TaskFilter{Overdue: true})
+	require.NoError(t, err)
+	if assert.Len(t, tasks, 1) {
+		assert.Equal(t, overdue.ID, tasks[0].ID)
+	}
+
+	now := time.Now()
+	tasks, err = s.task.AllByUser(ctx, TestUser1.ID, model.TaskFilter{DueAfter: &now})
+	require.NoError(t, err)
+	if assert.Len(t, tasks, 1) {
+		assert.Equal(t, upcoming.ID, tasks[0].ID)
+	}
+
+	tasks, err = s.task.AllByUser(ctx, TestUser1.ID, model.TaskFilter{DueBefore: &now})
+	require.NoError(t, err)
+	assert.Len(t, tasks, 2)
+}
+
+func TestTaskRepository_AllByUser_Pagination(t *testing.T) {
+	s, td := testStore(t, nil)
+	defer td()
+
+	ctx := context.Background()
+	require.NoError(t, s.user.Create(ctx, TestUser1))
+	require.NoError(t, s.user.Create(ctx, TestUser2))
+
+	var ids []uuid.UUID
+	for i := 0; i < 5; i++ {
+		task := &model.Task{
+			ID:        uuid.New(),
+			Name:      string(rune('a' + i)),
+			CreatedAt: time.Now(),
+			CreatedBy: TestUser1.ID,
+			Status:    model.StatusNew,
+		}
+		require.NoError(t, s.task.Create(ctx, task))
+		ids = append(ids, task.ID)
+	}
+	require.NoError(t, s.task.ForceAddToUser(ctx, TestUser2.ID, ids[1]))
+
+	filter := model.TaskFilter{Sort: model.SortName, Limit: 2}
+	var got []uuid.UUID
+	for {
+		tasks, err := s.task.AllByUser(ctx, TestUser1.ID, filter)
+		require.NoError(t, err)
+		if len(tasks) == 0 {
+			break
+		}
+		for _, task := range tasks {
+			got = append(got, task.ID)
+		}
+		filter.Cursor = &tasks[len(tasks)-1].ID
+	}
+	assert.Equal(t, ids, got)
+
+	tasks, err := s.task.AllByUser(ctx, TestUser1.ID, model.TaskFilter{Sort: model.SortName, Desc: true, Limit: 1})
+	require.NoError(t, err)
+	if assert.Len(t, tasks, 1) {
+		assert.Equal(t, ids[4], tasks[0].ID)
+	}
+
+	tasks, err = s.task.AllByUser(ctx, TestUser1.ID, model.TaskFilter{Assignee: &TestUser2.ID})
+	require.NoError(t, err)
+	if assert.Len(t, tasks, 1) {
+		assert.Equal(t, ids[1], tasks[0].ID)
+	}
+
+	tasks, err = s.task.AllByUser(ctx, TestUser2.ID, model.TaskFilter{CreatedBy: &TestUser1.ID, Status: []model.TaskStatus{model.StatusNew}})
+	require.NoError(t, err)
+	assert.Len(t, tasks, 1)
+}
+
+func TestTaskRepository_CreateWithRelations(t *testing.T) {
+	s, td := testStore(t, nil)
+	defer td()
+
+	ctx := context.Background()
+
+	require.NoError(t, s.user.Create(ctx, TestUser1))
+	require.NoError(t, s.user.Create(ctx, TestUser2))
+	require.NoError(t, s.group.Create(ctx, TestGroup1))
+	require.NoError(t, s.role.Create(ctx, TestRole1))
+	require.NoError(t, s.group.AddUser(ctx, TestRole1.ID, TestGroup1.ID, TestUser1.ID, true))
+	require.NoError(t, s.group.AddUser(ctx, TestRole1.ID, TestGroup1.ID, TestUser2.ID, false))
+
+	_, err := s.task.CreateWithRelations(ctx, nil, nil, nil, nil)
+	assert.ErrorIs(t, err, store.ErrNilReference)
+
+	task := &model.Task{
+		ID:          uuid.New(),
+		Name:        uuid.NewString(),
+		Description: uuid.NewString(),
+		CreatedAt:   time.Now(),
+		CreatedBy:   TestUser1.ID,
+		Status:      model.StatusNew,
+	}
+	stranger := uuid.New()
+
+	rejected, err := s.task.CreateWithRelations(ctx, task, &TestGroup1.ID, []uuid.UUID{TestUser2.ID, stranger, TestUser2.ID, TestUser1.ID}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{stranger}, rejected)
+	assert.True(t, s.task.Exists(ctx, task.ID))
+	assert.True(t, s.group.TaskExists(ctx, TestGroup1.ID, task.ID))
+	assert.True(t, s.task.IsAssignee(ctx, task.ID, TestUser1.ID))
+	assert.True(t, s.task.IsAssignee(ctx, task.ID, TestUser2.ID))
+
+	fromTemplate := &model.Task{
+		ID:        uuid.New(),
+		Name:      uuid.NewString(),
+		CreatedAt: time.Now(),
+		CreatedBy: TestUser1.ID,
+		Status:    model.StatusNew,
+	}
+	tpl := &model.TaskTemplate{Group: TestGroup1.ID, Checklist: []string{"collect numbers"}}
+	_, err = s.task.CreateWithRelations(ctx, fromTemplate, &TestGroup1.ID, nil, tpl)
+	require.NoError(t, err)
+	items, err := s.check.AllByTask(ctx, fromTemplate.ID)
+	require.NoError(t, err)
+	assert.Len(t, items, 1)
+
+	forbidden := &model.Task{
+		ID:          uuid.New(),
+		Name:        uuid.NewString(),
+		Description: uuid.NewString(),
+		CreatedAt:   time.Now(),
+		CreatedBy:   TestUser1.ID,
+		Status:      model.StatusNew,
+	}
+	group := uuid.New()
+	_, err = s.task.CreateWithRelations(ctx, forbidden, &group, nil, nil)
+	assert.ErrorIs(t, err, store.ErrNotAuthorized)
+	assert.False(t, s.task.Exists(ctx, forbidden.ID))
+}
+
+func TestTaskRepository_Subtasks(t *testing.T) {
+	s, td := testStore(t, nil)
+	defer td()
+
+	ctx := context.Background()
+
+	require.NoError(t, s.user.Create(ctx, TestUser1))
+	parent := &model.Task{
+		ID:        uuid.New(),
+		Name:      "parent",
+		CreatedAt: time.Now(),
+		CreatedBy: TestUser1.ID,
+		Status:    model.StatusNew,
+	}
+	require.NoError(t, s.task.Create(ctx, parent))
+	for _, status := range []model.TaskStatus{model.StatusClosed, model.StatusNew, model.StatusAccepted} {
+		require.NoError(t, s.task.Create(ctx, &model.Task{
+			ID:        uuid.New(),
+			Name:      string(status),
+			CreatedAt: time.Now(),
+			CreatedBy: TestUser1.ID,
+			Status:    status,
+			Parent:    &parent.ID,
+		}))
+	}
+
+	got, err := s.task.GetByUserAndID(ctx, TestUser1.ID, parent.ID)
+	require.NoError(t, err)
+	assert.Equal(t, &model.TaskProgress{Done: 2, Total: 3, Percent: 66}, got.Progress)
+
+	subtasks, err := s.task.AllByUser(ctx, TestUser1.ID, model.TaskFilter{Parent: &parent.ID})
+	require.NoError(t, err)
+	if assert.Len(t, subtasks, 3) {
+		assert.Equal(t, &parent.ID, subtasks[0].Parent)
+		assert.Nil(t, subtasks[0].Progress)
+	}
+}
+
+func TestTaskRepository_Bulk(t *testing.T) {
+	s, td := testStore(t, nil)
+	defer td()
+
+	ctx := context.Background()
+
+	assert.ErrorIs(t, s.task.Bulk(ctx, nil, nil), store.ErrNilReference)
+	assert.ErrorIs(t, s.task.Bulk(ctx, &model.BulkTaskRequest{Operation: "rename"}, nil), store.ErrBadData)
+
+	require.NoError(t, s.user.Create(ctx, TestUser1))
+	require.NoError(t, s.user.Create(ctx, TestUser2))
+	require.NoError(t, s.group.Create(ctx, TestGroup1))
+	a := &model.Task{ID: uuid.New(), Name: "a", CreatedAt: time.Now(), CreatedBy: TestUser1.ID, Status: model.StatusNew}
+	b := &model.Task{ID: uuid.New(), Name: "b", CreatedAt: time.Now(), CreatedBy: TestUser1.ID, Status: model.StatusNew}
+	for _, task := range []*model.Task{a, b} {
+		_, err := s.task.CreateWithRelations(ctx, task, &TestGroup1.ID, []uuid.UUID{TestUser1.ID}, nil)
+		require.NoError(t, err)
+	}
+	assert.True(t, s.task.InGroup(ctx, a.ID, TestGroup1.ID))
+	assert.False(t, s.task.InGroup(ctx, a.ID, uuid.New()))
+
+	tasks := []*model.Task{a, b}
+	require.NoError(t, s.task.Bulk(ctx, &model.BulkTaskRequest{Operation: model.BulkSetStatus, Status: model.StatusInProgress}, tasks))
+	// status of tasks was already changed, so nothing is changed.
+	assert.ErrorIs(t, s.task.Bulk(ctx, &model.BulkTaskRequest{Operation: model.BulkSetStatus, Status: model.StatusClosed}, tasks), store.ErrNotFound)
+	got, err := s.task.GetByUserAndID(ctx, TestUser1.ID, b.ID)
+	require.NoError(t, err)
+	assert.Equal(t, model.StatusInProgress, got.Status)
+
+	assign := &model.BulkTaskRequest{Operation: model.BulkAssign, User: &TestUser2.ID}
+	require.NoError(t, s.task.Bulk(ctx, assign, tasks))
+	require.NoError(t, s.task.Bulk(ctx, assign, tasks))
+	assert.True(t, s.task.IsAssignee(ctx, a.ID, TestUser2.ID))
+	require.NoError(t, s.task.Bulk(ctx, &model.BulkTaskRequest{Operation: model.BulkUnassign, User: &TestUser2.ID}, tasks[:1]))
+	assert.False(t, s.task.IsAssignee(ctx, a.ID, TestUser2.ID))
+	assert.True(t, s.task.IsAssignee(ctx, b.ID, TestUser2.ID))
+
+	label := &model.Label{ID: uuid.New(), Group: TestGroup1.ID, Name: "sprint", Color: "#1f883d"}
+	require.NoError(t, s.label.Create(ctx, label))
+	addLabel := &model.BulkTaskRequest{Operation: model.BulkAddLabel, Label: &label.ID}
+	require.NoError(t, s.task.Bulk(ctx, addLabel, tasks))
+	require.NoError(t, s.task.Bulk(ctx, addLabel, tasks))
+	got, err = s.task.GetByUserAndID(ctx, TestUser1.ID, a.ID)
+	require.NoError(t, err)
+	assert.Len(t, got.Labels, 1)
+
+	deadline := time.Now().Add(time.Hour).Truncate(time.Second)
+	require.NoError(t, s.task.Bulk(ctx, &model.BulkTaskRequest{Operation: model.BulkSetDeadline, Deadline: &deadline}, tasks))
+	got, err = s.task.GetByUserAndID(ctx, TestUser1.ID, a.ID)
+	require.NoError(t, err)
+	require.NotNil(t, got.Deadline)
+	assert.True(t, deadline.Equal(*got.Deadline))
+
+	require.NoError(t, s.task.Bulk(ctx, &model.BulkTaskRequest{Operation: model.BulkDelete}, tasks))
+	assert.False(t, s.task.Exists(ctx, a.ID))
+	assert.False(t, s.task.Exists(ctx, b.ID))
+	assert.ErrorIs(t, s.task.Bulk(ctx, &model.BulkTaskRequest{Operation: model.BulkDelete}, tasks), store.ErrNotFound)
+}
@@ -0,0 +1,393 @@
+package pgx
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/vlad-marlo/godo/internal/model"
+	"github.com/vlad-marlo/godo/internal/store"
+	"go.uber.org/zap"
+)
+
+var _ store.TemplateRepository = (*TemplateRepository)(nil)
+
+// _templateColumns is columns of template from table with alias tt in order in which they are scanned by scanTemplate.
+const _templateColumns = `tt.id,
+       tt.group_id,
+       tt.created_by,
+       tt.name,
+       tt.description,
+       tt.assignees,
+       tt.labels,
+       tt.checklist,
+       COALESCE(tt.recurrence, ''),
+       tt.recurrence_start,
+       tt.next_run_at,
+       tt.created_at`
+
+// TemplateRepository is storage of task templates.
+type TemplateRepository struct {
+	pool *pgxpool.Pool
+	log  *zap.Logger
+}
+
+// NewTemplateRepository return new instance of TemplateRepository.
+func NewTemplateRepository(cli Client) *TemplateRepository {
+	return &TemplateRepository{
+		pool: cli.P(),
+		log:  cli.L(),
+	}
+}
+
+// scanTemplate scans template which columns are selected with _templateColumns.
+func scanTemplate(row pgx.Row) (*model.TaskTemplate, error) {
+	tpl := new(model.TaskTemplate)
+	if err := row.Scan(
+		&tpl.ID,
+		&tpl.Group,
+		&tpl.CreatedBy,
+		&tpl.Name,
+		&tpl.Description,
+		&tpl.Assignees,
+		&tpl.Labels,
+		&tpl.Checklist,
+		&tpl.Recurrence,
+		&tpl.RecurrenceStart,
+		&tpl.NextRunAt,
+		&tpl.CreatedAt,
+	); err != nil {
+		return nil, err
+	}
+	return tpl, nil
+}
+
+// Create stores template.
+func (repo *TemplateRepository) Create(ctx context.Context, tpl *model.TaskTemplate) error {
+	if tpl == nil {
+		return store.ErrNilReference
+	}
+	if _, err := repo.pool.Exec(
+		ctx,
+		`INSERT INTO task_templates(id, group_id, created_by, "name", description, assignees, labels, checklist, recurrence,
+                           recurrence_start, next_run_at, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NULLIF($9, ''), $10, $11, $12);`,
+		tpl.ID,
+		tpl.Group,
+		tpl.CreatedBy,
+		tpl.Name,
+		tpl.Description,
+		nonNilIDs(tpl.Assignees),
+		nonNilIDs(tpl.Labels),
+		nonNilStrings(tpl.Checklist),
+		tpl.Recurrence,
+		tpl.RecurrenceStart,
+		tpl.NextRunAt,
+		tpl.CreatedAt,
+	); err != nil {
+		return pgError("store: template: create", err)
+	}
+	return nil
+}
+
+// Get return template by id.
+func (repo *TemplateRepository) Get(ctx context.Context, id uuid.UUID) (*model.TaskTemplate, error) {
+	tpl, err := scanTemplate(repo.pool.QueryRow(
+		ctx,
+		`SELECT `+_templateColumns+` FROM task_templates tt WHERE tt.id = $1;`,
+		id,
+	))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, store.ErrNotFound
+		}
+		repo.log.Log(_unknownLevel, "get template by id", traceError(err)...)
+		return nil, unknown(err)
+	}
+	return tpl, nil
+}
+
+// AllByGroup return templates of group ordered by name.
+func (repo *TemplateRepository) AllByGroup(ctx context.Context, group uuid.UUID) ([]*model.TaskTemplate, error) {
+	return repo.query(
+		ctx,
+		`SELECT `+_templateColumns+` FROM task_templates tt WHERE tt.group_id = $1 ORDER BY tt.name, tt.id;`,
+		group,
+	)
+}
+
+// Due return at most limit recurring templates which next run is not after now, earliest first.
+func (repo *TemplateRepository) Due(ctx context.Context, now time.Time, limit int) ([]*model.TaskTemplate, error) {
+	return repo.query(
+		ctx,
+		`SELECT `+_templateColumns+`
+FROM task_templates tt
+WHERE tt.next_run_at <= $1
+ORDER BY tt.next_run_at
+LIMIT $2;`,
+		now,
+		limit,
+	)
+}
+
+// query return templates selected by query with _templateColumns.
+func (repo *TemplateRepository) query(ctx context.Context, q string, args ...any) ([]*model.TaskTemplate, error) {
+	rows, err := repo.pool.Query(ctx, q, args...)
+	if err != nil {
+		repo.log.Log(_unknownLevel, "get templates", traceError(err)...)
+		return nil, unknown(err)
+	}
+	defer rows.Close()
+
+	var resp []*model.TaskTemplate
+	for rows.Next() {
+		var tpl *model.TaskTemplate
+		if tpl, err = scanTemplate(rows); err != nil {
+			repo.log.Log(_unknownLevel, "scan template", traceError(err)...)
+			return nil, unknown(err)
+		}
+		resp = append(resp, tpl)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, unknown(err)
+	}
+	return resp, nil
+}
+
+// Update changes name, description, assignees, labels, checklist and recurrence of template.
+func (repo *TemplateRepository) Update(ctx context.Context, tpl *model.TaskTemplate) error {
+	if tpl == nil {
+		return store.ErrNilReference
+	}
+	tag, err := repo.pool.Exec(
+		ctx,
+		`UPDATE task_templates
+SET "name"           = $2,
+    description      = $3,
+    assignees        = $4,
+    labels           = $5,
+    checklist        = $6,
+    recurrence       = NULLIF($7, ''),
+    recurrence_start = $8,
+    next_run_at      = $9
+WHERE id = $1;`,
+		tpl.ID,
+		tpl.Name,
+		tpl.Description,
+		nonNilIDs(tpl.Assignees),
+		nonNilIDs(tpl.Labels),
+		nonNilStrings(tpl.Checklist),
+		tpl.Recurrence,
+		tpl.RecurrenceStart,
+		tpl.NextRunAt,
+	)
+	if err != nil {
+		return pgError("store: template: update", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return store.ErrNotFound
+	}
+	return nil
+}
+
+// Apply attaches labels of template to task and adds checklist of template to it in one transaction.
+//
+// Labels which were deleted from group of template are skipped.
+func (repo *TemplateRepository) Apply(ctx context.Context, tpl *model.TaskTemplate, task uuid.UUID) error {
+	if tpl == nil {
+		return store.ErrNilReference
+	}
+
+	tx, err := repo.pool.Begin(ctx)
+	if err != nil {
+		repo.log.Error("unexpected error received while starting new transaction: check drivers", traceError(err)...)
+		return unknown(err)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	if err = applyTemplate(ctx, tx, tpl, task); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		repo.log.Error("unexpected error while doing commit transaction: check pgx driver", traceError(err)...)
+		return unknown(err)
+	}
+	return nil
+}
+
+// applyTemplate attaches labels of template to task and adds checklist of template to it in transaction.
+func applyTemplate(ctx context.Context, tx pgx.Tx, tpl *model.TaskTemplate, task uuid.UUID) error {
+	if len(tpl.Labels) > 0 {
+		if _, err := tx.Exec(
+			ctx,
+			`INSERT INTO task_labels(task_id, label_id)
+SELECT $1, l.id
+FROM labels l
+WHERE l.group_id = $2
+  AND l.id = ANY ($3)
+ON CONFLICT DO NOTHING;`,
+			task,
+			tpl.Group,
+			tpl.Labels,
+		); err != nil {
+			return pgError("store: template: apply: attach labels", err)
+		}
+	}
+
+	if len(tpl.Checklist) > 0 {
+		ids := make([]uuid.UUID, len(tpl.Checklist))
+		for i := range ids {
+			ids[i] = uuid.New()
+		}
+		if _, err := tx.Exec(
+			ctx,
+			`INSERT INTO checklist_items(id, task_id, text, position)
+SELECT c.id, $1, c.text, c.pos - 1
+FROM unnest($2::uuid[], $3::text[]) WITH ORDINALITY c(id, text, pos);`,
+			task,
+			ids,
+			tpl.Checklist,
+		); err != nil {
+			return pgError("store: template: apply: add checklist", err)
+		}
+	}
+	return nil
+}
+
+// nonNilIDs return ids or empty slice if ids is nil, so it is stored as empty array instead of NULL.
+func nonNilIDs(ids []uuid.UUID) []uuid.UUID {
+	if ids == nil {
+		return []uuid.UUID{}
+	}
+	return ids
+}
+
+// nonNilStrings return values or empty slice if values is nil, so it is stored as empty array instead of NULL.
+func nonNilStrings(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
+
+// Delete deletes template.
+func (repo *TemplateRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	tag, err := repo.pool.Exec(ctx, `DELETE FROM task_templates WHERE id = $1;`, id)
+	if err != nil {
+		return pgError("store: template: delete", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return store.ErrNotFound
+	}
+	return nil
+}
+
+// Materialize creates task from template for its current run and moves template to next run in one transaction.
+//
+// Run is identified by template and NextRunAt of tpl. Template is moved to next run only if it is still at tpl run,
+// so concurrent or repeated calls for the same run create only one task.
+// Task is related to group of template and assigned to assignees of template who are still members of group.
+// If template has no assignees then task is assigned to all members of group. Labels and checklist of template
+// are added to task like in Apply. Creation of task, its assignees and labels are recorded to history of task.
+//
+// If author of template is no longer admin of group and could not create tasks in it then template is stopped,
+// no task is created and store.ErrNotAuthorized is returned.
+func (repo *TemplateRepository) Materialize(ctx context.Context, tpl *model.TaskTemplate, task *model.Task, next *time.Time) error {
+	if tpl == nil || task == nil || tpl.NextRunAt == nil {
+		return store.ErrNilReference
+	}
+
+	tx, err := repo.pool.Begin(ctx)
+	if err != nil {
+		repo.log.Error("unexpected error received while starting new transaction: check drivers", traceError(err)...)
+		return unknown(err)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	tag, err := tx.Exec(
+		ctx,
+		`UPDATE task_templates SET next_run_at = $3 WHERE id = $1 AND next_run_at = $2;`,
+		tpl.ID,
+		*tpl.NextRunAt,
+		next,
+	)
+	if err != nil {
+		return pgError("store: template: materialize: move to next run", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return store.ErrNotFound
+	}
+
+	var ok bool
+	if err = tx.QueryRow(ctx, _canCreateTaskInGroupQuery, tpl.Group, task.CreatedBy, int32(model.PermCreateTasks)).Scan(&ok); err != nil {
+		return pgError("store: template: materialize: check group permission", err)
+	}
+	if !ok {
+		if _, err = tx.Exec(ctx, `UPDATE task_templates SET next_run_at = NULL WHERE id = $1;`, tpl.ID); err != nil {
+			return pgError("store: template: materialize: stop template", err)
+		}
+		if err = tx.Commit(ctx); err != nil {
+			repo.log.Error("unexpected error while doing commit transaction: check pgx driver", traceError(err)...)
+			return unknown(err)
+		}
+		return store.ErrNotAuthorized
+	}
+
+	if _, err = tx.Exec(
+		ctx,
+		`INSERT INTO tasks(id, "name", description, created_at, created_by, status, deadline, template_id, scheduled_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9);`,
+		task.ID,
+		task.Name,
+		task.Description,
+		task.CreatedAt,
+		task.CreatedBy,
+		task.Status,
+		task.Deadline,
+		tpl.ID,
+		*tpl.NextRunAt,
+	); err != nil {
+		return pgError("store: template: materialize: create task", err)
+	}
+
+	if _, err = tx.Exec(ctx, _addTaskToGroupQuery, task.ID, tpl.Group); err != nil {
+		return pgError("store: template: materialize: add to group", err)
+	}
+
+	if _, err = tx.Exec(
+		ctx,
+		`INSERT INTO task_user(user_id, task_id)
+SELECT uig.user_id, $2
+FROM user_in_group uig
+WHERE uig.group_id = $1
+  AND (cardinality($3::uuid[]) = 0 OR uig.user_id = ANY ($3));`,
+		tpl.Group,
+		task.ID,
+		nonNilIDs(tpl.Assignees),
+	); err != nil {
+		return pgError("store: template: materialize: add to users", err)
+	}
+
+	if err = applyTemplate(ctx, tx, tpl, task.ID); err != nil {
+		return err
+	}
+
+	if err = addCreationHistory(ctx, tx, task); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		repo.log.Error("unexpected error while doing commit transaction: check pgx driver", traceError(err)...)
+		return unknown(err)
+	}
+	return nil
+}
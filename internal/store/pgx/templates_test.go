@@ -0,0 +1,155 @@
+package pgx
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vlad-marlo/godo/internal/model"
+	"github.com/vlad-marlo/godo/internal/store"
+)
+
+func TestTemplateRepository_NilReference(t *testing.T) {
+	s, td := testStore(t, nil)
+	defer td()
+
+	assert.ErrorIs(t, s.tpl.Create(context.Background(), nil), store.ErrNilReference)
+	assert.ErrorIs(t, s.tpl.Update(context.Background(), nil), store.ErrNilReference)
+	assert.ErrorIs(t, s.tpl.Apply(context.Background(), nil, uuid.New()), store.ErrNilReference)
+	assert.ErrorIs(t, s.tpl.Materialize(context.Background(), &model.TaskTemplate{}, &model.Task{}, nil), store.ErrNilReference)
+}
+
+func TestTemplateRepository(t *testing.T) {
+	s, td := testStore(t, nil)
+	defer td()
+
+	ctx := context.Background()
+	require.NoError(t, s.user.Create(ctx, TestUser1))
+	require.NoError(t, s.user.Create(ctx, TestUser2))
+	require.NoError(t, s.group.Create(ctx, TestGroup1))
+	require.NoError(t, s.role.Create(ctx, TestRole1))
+	require.NoError(t, s.group.AddUser(ctx, TestRole1.ID, TestGroup1.ID, TestUser1.ID, true))
+	require.NoError(t, s.group.AddUser(ctx, TestRole1.ID, TestGroup1.ID, TestUser2.ID, false))
+
+	label := &model.Label{ID: uuid.New(), Group: TestGroup1.ID, Name: "release", Color: "#1f883d"}
+	require.NoError(t, s.label.Create(ctx, label))
+
+	run := time.Now().UTC().Truncate(time.Minute)
+	tpl := &model.TaskTemplate{
+		ID:              uuid.New(),
+		Group:           TestGroup1.ID,
+		CreatedBy:       TestUser1.ID,
+		Name:            "release notes",
+		Assignees:       []uuid.UUID{TestUser2.ID},
+		Labels:          []uuid.UUID{label.ID},
+		Checklist:       []string{"write changelog", "notify users"},
+		Recurrence:      "@daily",
+		RecurrenceStart: &run,
+		NextRunAt:       &run,
+		CreatedAt:       run,
+	}
+	require.NoError(t, s.tpl.Create(ctx, tpl))
+	once := &model.TaskTemplate{ID: uuid.New(), Group: TestGroup1.ID, CreatedBy: TestUser1.ID, Name: "once", CreatedAt: run}
+	require.NoError(t, s.tpl.Create(ctx, once))
+
+	got, err := s.tpl.Get(ctx, tpl.ID)
+	require.NoError(t, err)
+	assert.Equal(t, tpl.Assignees, got.Assignees)
+	assert.Equal(t, tpl.Labels, got.Labels)
+	assert.Equal(t, tpl.Checklist, got.Checklist)
+	assert.Equal(t, tpl.Recurrence, got.Recurrence)
+	require.NotNil(t, got.RecurrenceStart)
+	assert.True(t, run.Equal(*got.RecurrenceStart))
+	require.NotNil(t, got.NextRunAt)
+	assert.True(t, run.Equal(*got.NextRunAt))
+
+	templates, err := s.tpl.AllByGroup(ctx, TestGroup1.ID)
+	require.NoError(t, err)
+	assert.Len(t, templates, 2)
+
+	due, err := s.tpl.Due(ctx, run, 10)
+	require.NoError(t, err)
+	require.Len(t, due, 1)
+	assert.Equal(t, tpl.ID, due[0].ID)
+
+	next := run.Add(24 * time.Hour)
+	task := &model.Task{ID: uuid.New(), Name: tpl.Name, CreatedAt: run, CreatedBy: TestUser1.ID, Status: model.StatusNew}
+	require.NoError(t, s.tpl.Materialize(ctx, due[0], task, &next))
+	// second run for the same time must not create one more task.
+	assert.ErrorIs(t, s.tpl.Materialize(ctx, due[0], &model.Task{ID: uuid.New(), Name: tpl.Name, CreatedAt: run, CreatedBy: TestUser1.ID, Status: model.StatusNew}, &next), store.ErrNotFound)
+
+	due, err = s.tpl.Due(ctx, run, 10)
+	require.NoError(t, err)
+	assert.Empty(t, due)
+
+	assert.True(t, s.task.IsAssignee(ctx, task.ID, TestUser2.ID))
+	assert.False(t, s.task.IsAssignee(ctx, task.ID, TestUser1.ID))
+	tasks, err := s.task.AllByGroupAndUser(ctx, TestGroup1.ID, TestUser1.ID)
+	require.NoError(t, err)
+	assert.Len(t, tasks, 1)
+	items, err := s.check.AllByTask(ctx, task.ID)
+	require.NoError(t, err)
+	if assert.Len(t, items, 2) {
+		assert.Equal(t, "write changelog", items[0].Text)
+		assert.Equal(t, 1, items[1].Position)
+	}
+	// labels are not attached twice.
+	require.NoError(t, s.tpl.Apply(ctx, &model.TaskTemplate{Group: TestGroup1.ID, Labels: tpl.Labels}, task.ID))
+
+	once.Name, once.Labels, once.Checklist = "once {date}", []uuid.UUID{}, []string{"check"}
+	require.NoError(t, s.tpl.Update(ctx, once))
+	got, err = s.tpl.Get(ctx, once.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "once {date}", got.Name)
+	assert.Equal(t, []string{"check"}, got.Checklist)
+	assert.ErrorIs(t, s.tpl.Update(ctx, &model.TaskTemplate{ID: uuid.New()}), store.ErrNotFound)
+
+	require.NoError(t, s.tpl.Delete(ctx, tpl.ID))
+	assert.ErrorIs(t, s.tpl.Delete(ctx, tpl.ID), store.ErrNotFound)
+	_, err = s.tpl.Get(ctx, tpl.ID)
+	assert.ErrorIs(t, err, store.ErrNotFound)
+	// tasks created from template are kept.
+	_, err = s.task.GetByUserAndID(ctx, TestUser1.ID, task.ID)
+	assert.NoError(t, err)
+}
+
+func TestTemplateRepository_Materialize_NotAuthorized(t *testing.T) {
+	s, td := testStore(t, nil)
+	defer td()
+
+	ctx := context.Background()
+	require.NoError(t, s.user.Create(ctx, TestUser1))
+	require.NoError(t, s.user.Create(ctx, TestUser2))
+	require.NoError(t, s.group.Create(ctx, TestGroup1))
+	require.NoError(t, s.role.Create(ctx, TestRole1))
+	require.NoError(t, s.group.AddUser(ctx, TestRole1.ID, TestGroup1.ID, TestUser1.ID, true))
+	// author of template lost permission to create tasks.
+	require.NoError(t, s.group.AddUser(ctx, TestRole1.ID, TestGroup1.ID, TestUser2.ID, false))
+
+	run := time.Now().UTC().Truncate(time.Minute)
+	tpl := &model.TaskTemplate{
+		ID:         uuid.New(),
+		Group:      TestGroup1.ID,
+		CreatedBy:  TestUser2.ID,
+		Name:       "standup",
+		Recurrence: "@daily",
+		NextRunAt:  &run,
+		CreatedAt:  run,
+	}
+	require.NoError(t, s.tpl.Create(ctx, tpl))
+
+	next := run.Add(24 * time.Hour)
+	task := &model.Task{ID: uuid.New(), Name: tpl.Name, CreatedAt: run, CreatedBy: TestUser2.ID, Status: model.StatusNew}
+	assert.ErrorIs(t, s.tpl.Materialize(ctx, tpl, task, &next), store.ErrNotAuthorized)
+
+	// template is stopped and no task is created.
+	got, err := s.tpl.Get(ctx, tpl.ID)
+	require.NoError(t, err)
+	assert.Nil(t, got.NextRunAt)
+	tasks, err := s.task.AllByGroupAndUser(ctx, TestGroup1.ID, TestUser1.ID)
+	require.NoError(t, err)
+	assert.Empty(t, tasks)
+}
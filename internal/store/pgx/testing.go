@@ -24,8 +24,21 @@ var _dbTables = []string{
 	"groups",
 	"auth_tokens",
 	"comments",
+	"comment_edits",
+	"task_dependencies",
+	"task_labels",
+	"labels",
+	"board_limits",
+	"task_templates",
+	"task_history",
+	"attachments",
+	"orphaned_blobs",
+	"checklist_items",
+	"worklogs",
+	"task_watchers",
+	"group_audit_log",
 	"reviews",
-	"roles",
+	"group_roles",
 	"task_group",
 	"task_user",
 	"tasks",
@@ -61,6 +74,7 @@ var (
 		Owner:       TestUser1.ID,
 		Description: "test description",
 		CreatedAt:   time.Now(),
+		Fields:      map[string]string{},
 	}
 	TestGroup2 = &model.Group{
 		ID:          uuid.New(),
@@ -68,6 +82,7 @@ var (
 		Owner:       TestUser1.ID,
 		Description: "another description",
 		CreatedAt:   time.Now(),
+		Fields:      map[string]string{},
 	}
 
 	// Test TOKENS //
@@ -99,10 +114,9 @@ var (
 	// TEST ROLES //
 
 	TestRole1 = &model.Role{
-		Members:  3,
-		Tasks:    4,
-		Reviews:  2,
-		Comments: 1,
+		Group:       TestGroup1.ID,
+		Name:        "test role",
+		Permissions: model.PermViewTasks | model.PermViewReviews | model.PermViewIssues | model.PermViewMembers,
 	}
 
 	// TEST TASKS //
@@ -130,6 +144,17 @@ func testStore(t testing.TB, cli Client) (*Store, func()) {
 		NewTaskRepository(cli),
 		NewInviteRepository(cli),
 		NewRoleRepository(cli),
+		NewReviewRepository(cli),
+		NewCommentRepository(cli),
+		NewDependencyRepository(cli),
+		NewLabelRepository(cli),
+		NewBoardRepository(cli),
+		NewTemplateRepository(cli),
+		NewHistoryRepository(cli),
+		NewAttachmentRepository(cli),
+		NewChecklistRepository(cli),
+		NewWorklogRepository(cli),
+		NewWatcherRepository(cli),
 	)
 	return s, func() { teardown(t, cli)(_dbTables...) }
 }
@@ -115,20 +115,11 @@ func (repo *UserRepository) Get(ctx context.Context, id uuid.UUID) (u *model.Use
 func (repo *UserRepository) AddToGroup(ctx context.Context, user, group uuid.UUID, r *model.Role, isAdmin bool) error {
 	if _, err := repo.pool.Exec(
 		ctx,
-		`INSERT INTO user_in_group(user_id, group_id, is_admin, role_id)
-SELECT $1, $2, $3, r.id
-FROM roles r
-WHERE r.members = $4
-  AND r.tasks = $5
-  AND r.reviews = $6
-  AND r.comments = $7;`,
+		`INSERT INTO user_in_group(user_id, group_id, is_admin, role_id) VALUES ($1, $2, $3, $4);`,
 		user,
 		group,
 		isAdmin,
-		r.Members,
-		r.Tasks,
-		r.Reviews,
-		r.Comments,
+		r.ID,
 	); err != nil {
 
 		if pgErr, ok := err.(*pgconn.PgError); ok {
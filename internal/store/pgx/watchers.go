@@ -0,0 +1,141 @@
+package pgx
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/vlad-marlo/godo/internal/model"
+	"github.com/vlad-marlo/godo/internal/store"
+	"go.uber.org/zap"
+)
+
+var _ store.WatcherRepository = (*WatcherRepository)(nil)
+
+// WatcherRepository is storage of users who watch tasks.
+//
+// Creator, assignees, commenters and reviewers of task are subscribed to it by database triggers.
+type WatcherRepository struct {
+	pool *pgxpool.Pool
+	log  *zap.Logger
+}
+
+// NewWatcherRepository return new instance of WatcherRepository.
+func NewWatcherRepository(cli Client) *WatcherRepository {
+	return &WatcherRepository{
+		pool: cli.P(),
+		log:  cli.L(),
+	}
+}
+
+// Watch subscribes user to task. Nothing is changed if user already watches task.
+func (repo *WatcherRepository) Watch(ctx context.Context, task, user uuid.UUID) error {
+	if _, err := repo.pool.Exec(
+		ctx,
+		`INSERT INTO task_watchers(task_id, user_id) VALUES ($1, $2) ON CONFLICT DO NOTHING;`,
+		task,
+		user,
+	); err != nil {
+		return pgError("store: watcher: watch", err)
+	}
+	return nil
+}
+
+// Unwatch unsubscribes user from task. If user does not watch task then store.ErrNotFound will be returned.
+func (repo *WatcherRepository) Unwatch(ctx context.Context, task, user uuid.UUID) error {
+	tag, err := repo.pool.Exec(ctx, `DELETE FROM task_watchers WHERE task_id = $1 AND user_id = $2;`, task, user)
+	if err != nil {
+		return pgError("store: watcher: unwatch", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return store.ErrNotFound
+	}
+	return nil
+}
+
+// IsWatcher return true if user watches task.
+func (repo *WatcherRepository) IsWatcher(ctx context.Context, task, user uuid.UUID) (ok bool) {
+	_ = repo.pool.QueryRow(
+		ctx,
+		`SELECT EXISTS(SELECT * FROM task_watchers tw WHERE tw.task_id = $1 AND tw.user_id = $2);`,
+		task,
+		user,
+	).Scan(&ok)
+	return
+}
+
+// AllByTask return watchers of task ordered by email.
+func (repo *WatcherRepository) AllByTask(ctx context.Context, task uuid.UUID) ([]*model.Watcher, error) {
+	rows, err := repo.pool.Query(
+		ctx,
+		`SELECT u.id, u.email
+FROM task_watchers tw
+         JOIN users u on u.id = tw.user_id
+WHERE tw.task_id = $1
+ORDER BY u.email;`,
+		task,
+	)
+	if err != nil {
+		repo.log.Log(_unknownLevel, "get watchers of task", traceError(err)...)
+		return nil, unknown(err)
+	}
+	defer rows.Close()
+
+	var resp []*model.Watcher
+	for rows.Next() {
+		w := new(model.Watcher)
+		if err = rows.Scan(&w.User, &w.Email); err != nil {
+			repo.log.Log(_unknownLevel, "scan watcher", traceError(err)...)
+			return nil, unknown(err)
+		}
+		resp = append(resp, w)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, unknown(err)
+	}
+	return resp, nil
+}
+
+// Notifications return at most limit changes of tasks watched by user which were made by other users
+// and which ids are greater than after, oldest first.
+//
+// Changes of tasks which are no longer visible to user are skipped even if user still watches them.
+func (repo *WatcherRepository) Notifications(ctx context.Context, user uuid.UUID, after int64, limit int) ([]*model.TaskChange, error) {
+	rows, err := repo.pool.Query(
+		ctx,
+		`SELECT h.id, h.task_id, h.actor, h.field, h.old_value, h.new_value, h.created_at
+FROM task_history h
+         JOIN task_watchers tw on tw.task_id = h.task_id
+         JOIN tasks t on t.id = h.task_id
+WHERE tw.user_id = $1
+  AND h.actor <> $1
+  AND h.id > $2
+  AND `+visibleTaskCond("$1")+`
+ORDER BY h.id
+LIMIT $3;`,
+		user,
+		after,
+		limit,
+	)
+	if err != nil {
+		repo.log.Log(_unknownLevel, "get notifications of user", traceError(err)...)
+		return nil, unknown(err)
+	}
+	defer rows.Close()
+
+	var resp []*model.TaskChange
+	for rows.Next() {
+		c := new(model.TaskChange)
+		if err = rows.Scan(&c.ID, &c.Task, &c.Actor, &c.Field, &c.OldValue, &c.NewValue, &c.CreatedAt); err != nil {
+			repo.log.Log(_unknownLevel, "scan task change", traceError(err)...)
+			return nil, unknown(err)
+		}
+		resp = append(resp, c)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, unknown(err)
+	}
+	return resp, nil
+}
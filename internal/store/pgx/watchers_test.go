@@ -0,0 +1,100 @@
+package pgx
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vlad-marlo/godo/internal/model"
+	"github.com/vlad-marlo/godo/internal/store"
+)
+
+func TestWatcherRepository(t *testing.T) {
+	s, td := testStore(t, nil)
+	defer td()
+
+	ctx := context.Background()
+
+	require.NoError(t, s.user.Create(ctx, TestUser1))
+	require.NoError(t, s.user.Create(ctx, TestUser2))
+	require.NoError(t, s.task.Create(ctx, TestTask1))
+
+	// creator watches task automatically.
+	assert.True(t, s.watcher.IsWatcher(ctx, TestTask1.ID, TestUser1.ID))
+	assert.False(t, s.watcher.IsWatcher(ctx, TestTask1.ID, TestUser2.ID))
+
+	require.NoError(t, s.watcher.Watch(ctx, TestTask1.ID, TestUser2.ID))
+	require.NoError(t, s.watcher.Watch(ctx, TestTask1.ID, TestUser2.ID))
+	assert.ErrorIs(t, s.watcher.Watch(ctx, uuid.New(), TestUser2.ID), store.ErrFKViolation)
+	watchers, err := s.watcher.AllByTask(ctx, TestTask1.ID)
+	require.NoError(t, err)
+	assert.Len(t, watchers, 2)
+
+	require.NoError(t, s.watcher.Unwatch(ctx, TestTask1.ID, TestUser2.ID))
+	assert.ErrorIs(t, s.watcher.Unwatch(ctx, TestTask1.ID, TestUser2.ID), store.ErrNotFound)
+
+	// assignees and commenters watch task automatically.
+	require.NoError(t, s.task.ForceAddToUser(ctx, TestUser2.ID, TestTask1.ID))
+	assert.True(t, s.watcher.IsWatcher(ctx, TestTask1.ID, TestUser2.ID))
+	require.NoError(t, s.watcher.Unwatch(ctx, TestTask1.ID, TestUser2.ID))
+	require.NoError(t, s.comment.Create(ctx, &model.Comment{ID: uuid.New(), Task: TestTask1.ID, Message: "ok", CreatedBy: TestUser2.ID}))
+	assert.True(t, s.watcher.IsWatcher(ctx, TestTask1.ID, TestUser2.ID))
+
+	oldStatus, newStatus := string(model.StatusNew), string(model.StatusInProgress)
+	own := model.NewTaskChange(TestTask1.ID, TestUser1.ID, model.TaskFieldStatus, &oldStatus, &newStatus)
+	other := model.NewTaskChange(TestTask1.ID, TestUser2.ID, model.TaskFieldStatus, &newStatus, &oldStatus)
+	require.NoError(t, s.history.Add(ctx, own, other))
+
+	// changes made by user are not sent to the same user.
+	changes, err := s.watcher.Notifications(ctx, TestUser1.ID, 0, 10)
+	require.NoError(t, err)
+	if assert.Len(t, changes, 1) {
+		assert.Equal(t, other.ID, changes[0].ID)
+	}
+	changes, err = s.watcher.Notifications(ctx, TestUser1.ID, other.ID, 10)
+	require.NoError(t, err)
+	assert.Empty(t, changes)
+
+	require.NoError(t, s.watcher.Unwatch(ctx, TestTask1.ID, TestUser2.ID))
+	changes, err = s.watcher.Notifications(ctx, TestUser2.ID, 0, 10)
+	require.NoError(t, err)
+	assert.Empty(t, changes)
+}
+
+func TestWatcherRepository_Notifications_LostAccess(t *testing.T) {
+	s, td := testStore(t, nil)
+	defer td()
+
+	ctx := context.Background()
+
+	require.NoError(t, s.user.Create(ctx, TestUser1))
+	require.NoError(t, s.user.Create(ctx, TestUser2))
+	require.NoError(t, s.group.Create(ctx, TestGroup1))
+	require.NoError(t, s.role.Create(ctx, TestRole1))
+	require.NoError(t, s.group.AddUser(ctx, TestRole1.ID, TestGroup1.ID, TestUser1.ID, true))
+	require.NoError(t, s.group.AddUser(ctx, TestRole1.ID, TestGroup1.ID, TestUser2.ID, false))
+	require.NoError(t, s.task.Create(ctx, TestTask1))
+	require.NoError(t, s.task.AddToGroup(ctx, TestTask1.ID, TestGroup1.ID))
+	require.NoError(t, s.watcher.Watch(ctx, TestTask1.ID, TestUser2.ID))
+
+	oldStatus, newStatus := string(model.StatusNew), string(model.StatusInProgress)
+	change := model.NewTaskChange(TestTask1.ID, TestUser1.ID, model.TaskFieldStatus, &oldStatus, &newStatus)
+	require.NoError(t, s.history.Add(ctx, change))
+
+	changes, err := s.watcher.Notifications(ctx, TestUser2.ID, 0, 10)
+	require.NoError(t, err)
+	assert.Len(t, changes, 1)
+
+	// demoted user still watches task but could not see it anymore.
+	readOnly := &model.Role{Group: TestGroup1.ID, Name: "read only"}
+	require.NoError(t, s.role.Create(ctx, readOnly))
+	demote := model.NewMemberRoleEntry(TestGroup1.ID, TestUser1.ID, TestUser2.ID, model.MemberRole{}, model.MemberRole{})
+	require.NoError(t, s.group.SetMemberRole(ctx, TestGroup1.ID, TestUser2.ID, readOnly.ID, false, demote))
+	assert.True(t, s.watcher.IsWatcher(ctx, TestTask1.ID, TestUser2.ID))
+
+	changes, err = s.watcher.Notifications(ctx, TestUser2.ID, 0, 10)
+	require.NoError(t, err)
+	assert.Empty(t, changes)
+}
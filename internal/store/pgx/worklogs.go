@@ -0,0 +1,215 @@
+package pgx
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/vlad-marlo/godo/internal/model"
+	"github.com/vlad-marlo/godo/internal/store"
+	"go.uber.org/zap"
+)
+
+var _ store.WorklogRepository = (*WorklogRepository)(nil)
+
+// _worklogColumns is columns of worklog which are scanned by scanWorklog.
+const _worklogColumns = `w.id, w.task_id, w.user_id, w.description, w.started_at, w.stopped_at`
+
+// _reportColumns maps report grouping to columns and joins by which worklogs are aggregated.
+var _reportColumns = map[model.ReportGrouping]struct {
+	key, name, join string
+}{
+	model.ReportByUser:  {"u.id", "u.email", "JOIN users u ON u.id = w.user_id"},
+	model.ReportByTask:  {"t.id", "t.name", "JOIN tasks t ON t.id = w.task_id"},
+	model.ReportByGroup: {"g.id", "g.name", "JOIN task_group tg ON tg.task_id = w.task_id JOIN groups g ON g.id = tg.group_id AND ($4::uuid IS NULL OR g.id = $4)"},
+}
+
+// WorklogRepository is storage of time which users spent on tasks.
+type WorklogRepository struct {
+	pool *pgxpool.Pool
+	log  *zap.Logger
+}
+
+// NewWorklogRepository return new instance of WorklogRepository.
+func NewWorklogRepository(cli Client) *WorklogRepository {
+	return &WorklogRepository{
+		pool: cli.P(),
+		log:  cli.L(),
+	}
+}
+
+// scanWorklog scans worklog from row.
+func scanWorklog(row pgx.Row) (*model.Worklog, error) {
+	w := new(model.Worklog)
+	if err := row.Scan(&w.ID, &w.Task, &w.User, &w.Description, &w.StartedAt, &w.StoppedAt); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+// Create stores worklog. Worklog without stop time is stored as running timer.
+//
+// If user already has running timer then store.ErrUniqueViolation will be returned.
+func (repo *WorklogRepository) Create(ctx context.Context, w *model.Worklog) error {
+	if w == nil {
+		return store.ErrNilReference
+	}
+	if _, err := repo.pool.Exec(
+		ctx,
+		`INSERT INTO worklogs(id, task_id, user_id, description, started_at, stopped_at) VALUES ($1, $2, $3, $4, $5, $6);`,
+		w.ID,
+		w.Task,
+		w.User,
+		w.Description,
+		w.StartedAt,
+		w.StoppedAt,
+	); err != nil {
+		return pgError("store: worklog: create", err)
+	}
+	return nil
+}
+
+// Get return worklog by id.
+func (repo *WorklogRepository) Get(ctx context.Context, id uuid.UUID) (*model.Worklog, error) {
+	w, err := scanWorklog(repo.pool.QueryRow(ctx, `SELECT `+_worklogColumns+` FROM worklogs w WHERE w.id = $1;`, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, store.ErrNotFound
+		}
+		repo.log.Log(_unknownLevel, "get worklog by id", traceError(err)...)
+		return nil, unknown(err)
+	}
+	return w, nil
+}
+
+// Running return running timer of user.
+func (repo *WorklogRepository) Running(ctx context.Context, user uuid.UUID) (*model.Worklog, error) {
+	w, err := scanWorklog(repo.pool.QueryRow(
+		ctx,
+		`SELECT `+_worklogColumns+` FROM worklogs w WHERE w.user_id = $1 AND w.stopped_at IS NULL;`,
+		user,
+	))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, store.ErrNotFound
+		}
+		repo.log.Log(_unknownLevel, "get running timer", traceError(err)...)
+		return nil, unknown(err)
+	}
+	return w, nil
+}
+
+// Stop stops running timer of user at provided time and return stopped worklog.
+//
+// Timer which is started after provided time is stopped at its start.
+func (repo *WorklogRepository) Stop(ctx context.Context, user uuid.UUID, at time.Time) (*model.Worklog, error) {
+	w, err := scanWorklog(repo.pool.QueryRow(
+		ctx,
+		`UPDATE worklogs w
+SET stopped_at = GREATEST($2, w.started_at)
+WHERE w.user_id = $1
+  AND w.stopped_at IS NULL
+RETURNING `+_worklogColumns+`;`,
+		user,
+		at,
+	))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, store.ErrNotFound
+		}
+		repo.log.Log(_unknownLevel, "stop timer", traceError(err)...)
+		return nil, unknown(err)
+	}
+	return w, nil
+}
+
+// AllByTask return worklogs of task ordered by start time.
+func (repo *WorklogRepository) AllByTask(ctx context.Context, task uuid.UUID) ([]*model.Worklog, error) {
+	rows, err := repo.pool.Query(
+		ctx,
+		`SELECT `+_worklogColumns+` FROM worklogs w WHERE w.task_id = $1 ORDER BY w.started_at, w.id;`,
+		task,
+	)
+	if err != nil {
+		repo.log.Log(_unknownLevel, "get worklogs of task", traceError(err)...)
+		return nil, unknown(err)
+	}
+	defer rows.Close()
+
+	var resp []*model.Worklog
+	for rows.Next() {
+		var w *model.Worklog
+		if w, err = scanWorklog(rows); err != nil {
+			repo.log.Log(_unknownLevel, "scan worklog", traceError(err)...)
+			return nil, unknown(err)
+		}
+		resp = append(resp, w)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, unknown(err)
+	}
+	return resp, nil
+}
+
+// Delete deletes worklog.
+func (repo *WorklogRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	tag, err := repo.pool.Exec(ctx, `DELETE FROM worklogs WHERE id = $1;`, id)
+	if err != nil {
+		return pgError("store: worklog: delete", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return store.ErrNotFound
+	}
+	return nil
+}
+
+// Report return stopped worklogs which are started in period of filter aggregated by grouping of filter.
+//
+// Rows are ordered by logged time descending. Worklogs of tasks without groups are not counted in report by groups.
+func (repo *WorklogRepository) Report(ctx context.Context, filter model.WorklogReportFilter) ([]*model.WorklogReportRow, error) {
+	cols, ok := _reportColumns[filter.By]
+	if !ok {
+		return nil, store.ErrBadData
+	}
+	rows, err := repo.pool.Query(
+		ctx,
+		`SELECT `+cols.key+`, `+cols.name+`, COUNT(*), SUM(EXTRACT(EPOCH FROM w.stopped_at - w.started_at))::bigint
+FROM worklogs w
+         `+cols.join+`
+WHERE w.stopped_at IS NOT NULL
+  AND w.started_at >= $1
+  AND w.started_at < $2
+  AND ($3::uuid IS NULL OR w.user_id = $3)
+  AND ($4::uuid IS NULL OR EXISTS(SELECT 1 FROM task_group tgf WHERE tgf.task_id = w.task_id AND tgf.group_id = $4))
+GROUP BY `+cols.key+`, `+cols.name+`
+ORDER BY 4 DESC, 2;`,
+		filter.From,
+		filter.To,
+		filter.User,
+		filter.Group,
+	)
+	if err != nil {
+		repo.log.Log(_unknownLevel, "get worklog report", traceError(err)...)
+		return nil, unknown(err)
+	}
+	defer rows.Close()
+
+	var resp []*model.WorklogReportRow
+	for rows.Next() {
+		row := new(model.WorklogReportRow)
+		if err = rows.Scan(&row.ID, &row.Name, &row.Entries, &row.Seconds); err != nil {
+			repo.log.Log(_unknownLevel, "scan worklog report row", traceError(err)...)
+			return nil, unknown(err)
+		}
+		resp = append(resp, row)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, unknown(err)
+	}
+	return resp, nil
+}
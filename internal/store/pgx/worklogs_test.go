@@ -0,0 +1,115 @@
+package pgx
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vlad-marlo/godo/internal/model"
+	"github.com/vlad-marlo/godo/internal/store"
+)
+
+func TestWorklogRepository_NilWorklog(t *testing.T) {
+	s, td := testStore(t, nil)
+	defer td()
+
+	assert.ErrorIs(t, s.worklog.Create(context.Background(), nil), store.ErrNilReference)
+}
+
+func TestWorklogRepository(t *testing.T) {
+	s, td := testStore(t, nil)
+	defer td()
+
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Second)
+
+	require.NoError(t, s.user.Create(ctx, TestUser1))
+	require.NoError(t, s.task.Create(ctx, TestTask1))
+
+	timer := &model.Worklog{ID: uuid.New(), Task: TestTask1.ID, User: TestUser1.ID, StartedAt: now.Add(-time.Hour)}
+	require.NoError(t, s.worklog.Create(ctx, timer))
+	assert.ErrorIs(t, s.worklog.Create(ctx, &model.Worklog{
+		ID:        uuid.New(),
+		Task:      TestTask1.ID,
+		User:      TestUser1.ID,
+		StartedAt: now,
+	}), store.ErrUniqueViolation)
+
+	running, err := s.worklog.Running(ctx, TestUser1.ID)
+	require.NoError(t, err)
+	assert.Equal(t, timer.ID, running.ID)
+	assert.Nil(t, running.StoppedAt)
+
+	stopped, err := s.worklog.Stop(ctx, TestUser1.ID, now)
+	require.NoError(t, err)
+	if assert.NotNil(t, stopped.StoppedAt) {
+		assert.True(t, now.Equal(*stopped.StoppedAt))
+	}
+	_, err = s.worklog.Stop(ctx, TestUser1.ID, now)
+	assert.ErrorIs(t, err, store.ErrNotFound)
+	_, err = s.worklog.Running(ctx, TestUser1.ID)
+	assert.ErrorIs(t, err, store.ErrNotFound)
+
+	manualStop := now.Add(-2 * time.Hour)
+	manual := &model.Worklog{
+		ID:        uuid.New(),
+		Task:      TestTask1.ID,
+		User:      TestUser1.ID,
+		StartedAt: now.Add(-3 * time.Hour),
+		StoppedAt: &manualStop,
+	}
+	require.NoError(t, s.worklog.Create(ctx, manual))
+
+	worklogs, err := s.worklog.AllByTask(ctx, TestTask1.ID)
+	require.NoError(t, err)
+	if assert.Len(t, worklogs, 2) {
+		assert.Equal(t, manual.ID, worklogs[0].ID)
+		assert.Equal(t, timer.ID, worklogs[1].ID)
+	}
+
+	filter := model.WorklogReportFilter{From: now.Add(-24 * time.Hour), To: now.Add(time.Minute), By: model.ReportByTask}
+	rows, err := s.worklog.Report(ctx, filter)
+	require.NoError(t, err)
+	if assert.Len(t, rows, 1) {
+		assert.Equal(t, TestTask1.ID, rows[0].ID)
+		assert.Equal(t, 2, rows[0].Entries)
+		assert.Equal(t, int64(2*60*60), rows[0].Seconds)
+	}
+
+	filter.By = model.ReportByUser
+	filter.From = now.Add(-90 * time.Minute)
+	rows, err = s.worklog.Report(ctx, filter)
+	require.NoError(t, err)
+	if assert.Len(t, rows, 1) {
+		assert.Equal(t, TestUser1.Email, rows[0].Name)
+		assert.Equal(t, int64(60*60), rows[0].Seconds)
+	}
+
+	filter.By = model.ReportByGroup
+	rows, err = s.worklog.Report(ctx, filter)
+	require.NoError(t, err)
+	assert.Empty(t, rows)
+
+	require.NoError(t, s.group.Create(ctx, TestGroup1))
+	require.NoError(t, s.task.AddToGroup(ctx, TestTask1.ID, TestGroup1.ID))
+	filter.Group = &TestGroup1.ID
+	rows, err = s.worklog.Report(ctx, filter)
+	require.NoError(t, err)
+	if assert.Len(t, rows, 1) {
+		assert.Equal(t, TestGroup1.Name, rows[0].Name)
+	}
+
+	_, err = s.worklog.Report(ctx, model.WorklogReportFilter{By: "day"})
+	assert.ErrorIs(t, err, store.ErrBadData)
+
+	require.NoError(t, s.worklog.Delete(ctx, manual.ID))
+	assert.ErrorIs(t, s.worklog.Delete(ctx, manual.ID), store.ErrNotFound)
+	_, err = s.worklog.Get(ctx, manual.ID)
+	assert.ErrorIs(t, err, store.ErrNotFound)
+	got, err := s.worklog.Get(ctx, timer.ID)
+	require.NoError(t, err)
+	assert.Equal(t, timer.Task, got.Task)
+}
@@ -0,0 +1,13 @@
+alter table reviews
+    add column resolved_by uuid,
+    add column resolved_at timestamp,
+    add column answer      text,
+    add constraint resolved_by_fk foreign key (resolved_by) references users (id) on delete set null;
+create index reviews_task_status_idx on reviews (task, status);
+---- create above / drop below ----
+drop index reviews_task_status_idx;
+alter table reviews
+    drop constraint resolved_by_fk,
+    drop column answer,
+    drop column resolved_at,
+    drop column resolved_by;
@@ -0,0 +1,24 @@
+alter table comments
+    add column reply_to   uuid,
+    add column updated_at timestamp,
+    add constraint reply_to_fk foreign key (reply_to) references comments (id) on delete set null;
+create index comments_task_idx on comments (task, created_at);
+create table comment_edits
+(
+    id        bigserial primary key not null unique,
+    "comment" uuid                  not null,
+    msg       text,
+    edited_by uuid                  not null,
+    edited_at timestamp default current_timestamp,
+    constraint comment_fk foreign key ("comment") references comments (id) match full on delete cascade,
+    constraint edited_by_fk foreign key (edited_by) references users (id) match full on delete cascade
+);
+create index comment_edits_comment_idx on comment_edits ("comment", edited_at);
+---- create above / drop below ----
+drop index comment_edits_comment_idx;
+drop table comment_edits;
+drop index comments_task_idx;
+alter table comments
+    drop constraint reply_to_fk,
+    drop column updated_at,
+    drop column reply_to;
@@ -0,0 +1,7 @@
+alter table tasks
+    add column deadline timestamptz;
+create index tasks_deadline_idx on tasks (deadline) where deadline is not null;
+---- create above / drop below ----
+drop index tasks_deadline_idx;
+alter table tasks
+    drop column deadline;
@@ -0,0 +1,17 @@
+create index tasks_created_at_id_idx on tasks (created_at, id);
+create index tasks_name_id_idx on tasks ("name", id);
+create index tasks_deadline_id_idx on tasks ((coalesce(deadline, 'infinity'::timestamptz)), id);
+create index tasks_created_by_idx on tasks (created_by);
+create index tasks_status_idx on tasks (status);
+create index task_user_task_user_idx on task_user (task_id, user_id);
+create index task_user_user_idx on task_user (user_id);
+create index task_group_group_idx on task_group (group_id);
+---- create above / drop below ----
+drop index task_group_group_idx;
+drop index task_user_user_idx;
+drop index task_user_task_user_idx;
+drop index tasks_status_idx;
+drop index tasks_created_by_idx;
+drop index tasks_deadline_id_idx;
+drop index tasks_name_id_idx;
+drop index tasks_created_at_id_idx;
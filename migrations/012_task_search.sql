@@ -0,0 +1,21 @@
+alter table tasks
+    add column search tsvector generated always as (
+                setweight(to_tsvector('english'::regconfig, coalesce("name", '')), 'A') ||
+                setweight(to_tsvector('russian'::regconfig, coalesce("name", '')), 'A') ||
+                setweight(to_tsvector('english'::regconfig, coalesce(description, '')), 'B') ||
+                setweight(to_tsvector('russian'::regconfig, coalesce(description, '')), 'B')
+        ) stored;
+create index tasks_search_idx on tasks using gin (search);
+alter table comments
+    add column search tsvector generated always as (
+                to_tsvector('english'::regconfig, coalesce(msg, '')) ||
+                to_tsvector('russian'::regconfig, coalesce(msg, ''))
+        ) stored;
+create index comments_search_idx on comments using gin (search);
+---- create above / drop below ----
+drop index comments_search_idx;
+alter table comments
+    drop column search;
+drop index tasks_search_idx;
+alter table tasks
+    drop column search;
@@ -0,0 +1,9 @@
+alter table tasks
+    add column parent_id uuid,
+    add constraint parent_id_fk foreign key (parent_id) references tasks (id) on delete set null;
+create index tasks_parent_idx on tasks (parent_id, status);
+---- create above / drop below ----
+drop index tasks_parent_idx;
+alter table tasks
+    drop constraint parent_id_fk,
+    drop column parent_id;
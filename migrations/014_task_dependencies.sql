@@ -0,0 +1,17 @@
+create table task_dependencies
+(
+    id         bigserial primary key not null unique,
+    blocker    uuid                  not null,
+    blocked    uuid                  not null,
+    created_by uuid                  not null,
+    created_at timestamp default current_timestamp,
+    constraint blocker_fk foreign key (blocker) references tasks (id) match full on delete cascade,
+    constraint blocked_fk foreign key (blocked) references tasks (id) match full on delete cascade,
+    constraint created_by_fk foreign key (created_by) references users (id) match full on delete cascade,
+    constraint task_dependencies_unique unique (blocker, blocked),
+    constraint task_dependencies_self check (blocker <> blocked)
+);
+create index task_dependencies_blocked_idx on task_dependencies (blocked);
+---- create above / drop below ----
+drop index task_dependencies_blocked_idx;
+drop table task_dependencies;
@@ -0,0 +1,23 @@
+create table labels
+(
+    id         uuid not null unique primary key,
+    group_id   uuid not null,
+    "name"     text not null,
+    color      text not null,
+    created_at timestamp default current_timestamp,
+    constraint group_id_fk foreign key (group_id) references groups (id) match full on delete cascade,
+    constraint labels_group_name_unique unique (group_id, "name")
+);
+create table task_labels
+(
+    task_id  uuid not null,
+    label_id uuid not null,
+    constraint task_id_fk foreign key (task_id) references tasks (id) match full on delete cascade,
+    constraint label_id_fk foreign key (label_id) references labels (id) match full on delete cascade,
+    constraint task_labels_pk primary key (task_id, label_id)
+);
+create index task_labels_label_idx on task_labels (label_id);
+---- create above / drop below ----
+drop index task_labels_label_idx;
+drop table task_labels;
+drop table labels;
@@ -0,0 +1,18 @@
+alter table task_group
+    add column position integer not null default 0;
+create index task_group_board_idx on task_group (group_id, position);
+
+create table board_limits
+(
+    group_id  uuid    not null,
+    status    text    not null,
+    wip_limit integer not null,
+    constraint group_id_fk foreign key (group_id) references groups (id) match full on delete cascade,
+    constraint board_limits_pk primary key (group_id, status),
+    constraint board_limits_positive check (wip_limit > 0)
+);
+---- create above / drop below ----
+drop table board_limits;
+drop index task_group_board_idx;
+alter table task_group
+    drop column position;
@@ -0,0 +1,29 @@
+create table task_templates
+(
+    id          uuid   not null unique primary key,
+    group_id    uuid   not null,
+    created_by  uuid   not null,
+    "name"      text   not null,
+    description text   not null default '',
+    assignees   uuid[] not null default '{}',
+    recurrence  text,
+    next_run_at timestamptz,
+    created_at  timestamp default current_timestamp,
+    constraint group_id_fk foreign key (group_id) references groups (id) match full on delete cascade,
+    constraint created_by_fk foreign key (created_by) references users (id) match full on delete cascade
+);
+create index task_templates_next_run_idx on task_templates (next_run_at) where next_run_at is not null;
+
+alter table tasks
+    add column template_id  uuid,
+    add column scheduled_at timestamptz,
+    add constraint template_id_fk foreign key (template_id) references task_templates (id) on delete set null;
+create unique index tasks_template_run_idx on tasks (template_id, scheduled_at);
+---- create above / drop below ----
+drop index tasks_template_run_idx;
+alter table tasks
+    drop constraint template_id_fk,
+    drop column scheduled_at,
+    drop column template_id;
+drop index task_templates_next_run_idx;
+drop table task_templates;
@@ -0,0 +1,17 @@
+create table task_history
+(
+    id         bigserial primary key not null unique,
+    task_id    uuid                  not null,
+    actor      uuid                  not null,
+    field      text                  not null,
+    old_value  text,
+    new_value  text,
+    created_at timestamp default current_timestamp,
+    constraint task_id_fk foreign key (task_id) references tasks (id) match full on delete cascade
+);
+create index task_history_task_idx on task_history (task_id, id);
+create rule task_history_append_only as on update to task_history do instead nothing;
+---- create above / drop below ----
+drop rule task_history_append_only on task_history;
+drop index task_history_task_idx;
+drop table task_history;
@@ -0,0 +1,39 @@
+create table attachments
+(
+    id           uuid primary key not null unique,
+    task_id      uuid             not null,
+    comment_id   uuid,
+    uploaded_by  uuid             not null,
+    "name"       text             not null,
+    content_type text             not null,
+    size         bigint           not null check (size >= 0),
+    blob_key     text             not null unique,
+    created_at   timestamp default current_timestamp,
+    constraint task_id_fk foreign key (task_id) references tasks (id) match full on delete cascade,
+    constraint comment_id_fk foreign key (comment_id) references comments (id) on delete cascade,
+    constraint uploaded_by_fk foreign key (uploaded_by) references users (id) match full on delete cascade
+);
+create index attachments_task_idx on attachments (task_id, created_at);
+create table orphaned_blobs
+(
+    blob_key   text primary key not null unique,
+    deleted_at timestamp default current_timestamp
+);
+create function record_orphaned_blob() returns trigger as
+$$
+begin
+    insert into orphaned_blobs(blob_key) values (old.blob_key) on conflict do nothing;
+    return old;
+end;
+$$ language plpgsql;
+create trigger attachments_orphaned_blob
+    after delete
+    on attachments
+    for each row
+execute function record_orphaned_blob();
+---- create above / drop below ----
+drop trigger attachments_orphaned_blob on attachments;
+drop function record_orphaned_blob;
+drop table orphaned_blobs;
+drop index attachments_task_idx;
+drop table attachments;
@@ -0,0 +1,14 @@
+create table checklist_items
+(
+    id         uuid primary key not null unique,
+    task_id    uuid             not null,
+    text       text             not null,
+    done       boolean          not null default false,
+    position   integer          not null,
+    created_at timestamp default current_timestamp,
+    constraint task_id_fk foreign key (task_id) references tasks (id) match full on delete cascade
+);
+create index checklist_items_task_idx on checklist_items (task_id, position);
+---- create above / drop below ----
+drop index checklist_items_task_idx;
+drop table checklist_items;
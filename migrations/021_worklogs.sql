@@ -0,0 +1,22 @@
+create table worklogs
+(
+    id          uuid primary key not null unique,
+    task_id     uuid             not null,
+    user_id     uuid             not null,
+    description text             not null default '',
+    started_at  timestamptz      not null,
+    stopped_at  timestamptz,
+    created_at  timestamp default current_timestamp,
+    constraint task_id_fk foreign key (task_id) references tasks (id) match full on delete cascade,
+    constraint user_id_fk foreign key (user_id) references users (id) match full on delete cascade,
+    constraint worklogs_interval_check check (stopped_at is null or stopped_at >= started_at)
+);
+-- worklog which is not stopped is running timer and user could have only one running timer.
+create unique index worklogs_running_timer_idx on worklogs (user_id) where stopped_at is null;
+create index worklogs_task_idx on worklogs (task_id, started_at);
+create index worklogs_user_idx on worklogs (user_id, started_at);
+---- create above / drop below ----
+drop index worklogs_user_idx;
+drop index worklogs_task_idx;
+drop index worklogs_running_timer_idx;
+drop table worklogs;
@@ -0,0 +1,7 @@
+alter table task_templates
+    add column labels    uuid[] not null default '{}',
+    add column checklist text[] not null default '{}';
+---- create above / drop below ----
+alter table task_templates
+    drop column checklist,
+    drop column labels;
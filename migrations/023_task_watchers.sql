@@ -0,0 +1,66 @@
+create table task_watchers
+(
+    task_id    uuid not null,
+    user_id    uuid not null,
+    created_at timestamp default current_timestamp,
+    constraint task_watchers_pk primary key (task_id, user_id),
+    constraint task_id_fk foreign key (task_id) references tasks (id) match full on delete cascade,
+    constraint user_id_fk foreign key (user_id) references users (id) match full on delete cascade
+);
+create index task_watchers_user_idx on task_watchers (user_id);
+-- auto_watch_task subscribes user to task. Names of task and user columns of new row are passed as arguments.
+create function auto_watch_task() returns trigger as
+$$
+declare
+    rec jsonb := to_jsonb(new);
+begin
+    if rec ->> tg_argv[1] is not null then
+        insert into task_watchers(task_id, user_id)
+        values ((rec ->> tg_argv[0])::uuid, (rec ->> tg_argv[1])::uuid)
+        on conflict do nothing;
+    end if;
+    return new;
+end;
+$$ language plpgsql;
+create trigger tasks_watch_creator
+    after insert
+    on tasks
+    for each row
+execute function auto_watch_task('id', 'created_by');
+create trigger task_user_watch_assignee
+    after insert
+    on task_user
+    for each row
+execute function auto_watch_task('task_id', 'user_id');
+create trigger comments_watch_author
+    after insert
+    on comments
+    for each row
+execute function auto_watch_task('task', 'created_by');
+create trigger reviews_watch_reviewer
+    after update of resolved_by
+    on reviews
+    for each row
+execute function auto_watch_task('task', 'resolved_by');
+insert into task_watchers(task_id, user_id)
+select id, created_by
+from tasks
+union
+select task_id, user_id
+from task_user
+union
+select task, created_by
+from comments
+union
+select task, resolved_by
+from reviews
+where resolved_by is not null
+on conflict do nothing;
+---- create above / drop below ----
+drop trigger reviews_watch_reviewer on reviews;
+drop trigger comments_watch_author on comments;
+drop trigger task_user_watch_assignee on task_user;
+drop trigger tasks_watch_creator on tasks;
+drop function auto_watch_task;
+drop index task_watchers_user_idx;
+drop table task_watchers;
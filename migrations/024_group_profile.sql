@@ -0,0 +1,18 @@
+update groups
+set description = ''
+where description is null;
+alter table groups
+    alter column description set default '',
+    alter column description set not null,
+    add column telegram_chat text  not null default '',
+    add column company_name  text  not null default '',
+    add column company_info  text  not null default '',
+    add column custom_fields jsonb not null default '{}';
+---- create above / drop below ----
+alter table groups
+    drop column custom_fields,
+    drop column company_info,
+    drop column company_name,
+    drop column telegram_chat,
+    alter column description drop not null,
+    alter column description drop default;
@@ -0,0 +1,13 @@
+alter table invites
+    add column created_by uuid,
+    add constraint created_by_fk foreign key (created_by) references users (id) on delete set null;
+alter table user_in_group
+    add column invited_by uuid,
+    add constraint invited_by_fk foreign key (invited_by) references users (id) on delete set null;
+---- create above / drop below ----
+alter table user_in_group
+    drop constraint invited_by_fk,
+    drop column invited_by;
+alter table invites
+    drop constraint created_by_fk,
+    drop column created_by;
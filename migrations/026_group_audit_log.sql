@@ -0,0 +1,18 @@
+create table group_audit_log
+(
+    id         bigserial primary key not null unique,
+    group_id   uuid                  not null,
+    actor      uuid                  not null,
+    member     uuid                  not null,
+    action     text                  not null,
+    old_value  jsonb,
+    new_value  jsonb,
+    created_at timestamp default current_timestamp,
+    constraint group_id_fk foreign key (group_id) references groups (id) match full on delete cascade
+);
+create index group_audit_log_group_idx on group_audit_log (group_id, id);
+create rule group_audit_log_append_only as on update to group_audit_log do instead nothing;
+---- create above / drop below ----
+drop rule group_audit_log_append_only on group_audit_log;
+drop index group_audit_log_group_idx;
+drop table group_audit_log;
@@ -0,0 +1,185 @@
+-- Named roles of group built from set of permissions. Bits of permissions are:
+-- 1 tasks.create, 2 tasks.edit, 4 tasks.delete, 8 tasks.review, 16 reviews.view,
+-- 32 issues.create, 64 issues.view, 128 members.view, 256 invites.create, 512 members.remove,
+-- 1024 tasks.view, 2048 tasks.edit-related, 4096 issues.edit-related, 8192 issues.moderate,
+-- 16384 members.remove-related.
+create table group_roles
+(
+    id          bigserial primary key not null unique,
+    group_id    uuid                  not null,
+    "name"      text                  not null,
+    permissions int                   not null,
+    check ( permissions >= 0 and permissions < 32768 ),
+    constraint group_id_fk foreign key (group_id) references groups (id) match full on delete cascade,
+    constraint group_role_uq unique (group_id, id),
+    constraint group_role_name_uq unique (group_id, "name"),
+    constraint group_role_permissions_uq unique (group_id, permissions)
+);
+
+-- legacy_role_permissions converts legacy levels of role into permissions which give the same access:
+-- tasks 1 could see all tasks of group, 2 could create tasks, 3 could change status, board position, attachments
+-- and logged time of tasks and 4 could change content of tasks, delete them and make review decisions;
+-- reviews 1 could view review queue and 4 could resolve reviews;
+-- comments 1 could read all comments, 2 could write them, 3 could edit own comments and 4 could edit any comment;
+-- members 0 could view members, 2 could invite users, 3 could remove users invited by them and 4 could remove anyone.
+create function legacy_role_permissions(members int, tasks int, reviews int, "comments" int) returns int
+    language sql
+    immutable
+as
+$$
+select 128
+           | (case when tasks >= 1 then 1024 else 0 end)
+           | (case when tasks >= 2 then 1 else 0 end)
+           | (case when tasks >= 3 then 2048 else 0 end)
+           | (case when tasks >= 4 then 2 | 4 else 0 end)
+           | (case when reviews >= 1 then 16 else 0 end)
+           | (case when reviews >= 4 then 8 else 0 end)
+           | (case when "comments" >= 1 then 64 else 0 end)
+           | (case when "comments" >= 2 then 32 else 0 end)
+           | (case when "comments" >= 3 then 4096 else 0 end)
+           | (case when "comments" >= 4 then 8192 else 0 end)
+           | (case when members >= 2 then 256 else 0 end)
+           | (case when members >= 3 then 16384 else 0 end)
+           | (case when members >= 4 then 512 else 0 end)
+$$;
+
+alter table roles
+    add column permissions int;
+update roles
+set permissions = legacy_role_permissions(members, tasks, reviews, "comments");
+
+-- Every group gets own copy of roles which are used by its members and invites. Roles are named by their
+-- permissions.
+insert into group_roles(group_id, "name", permissions)
+select distinct x.group_id,
+                case
+                    when r.permissions = 32767 then 'admin'
+                    else array_to_string(array(select p.name
+                                               from (values (1, 1024, 'tasks.view'),
+                                                            (2, 1, 'tasks.create'),
+                                                            (3, 2048, 'tasks.edit-related'),
+                                                            (4, 2, 'tasks.edit'),
+                                                            (5, 4, 'tasks.delete'),
+                                                            (6, 8, 'tasks.review'),
+                                                            (7, 16, 'reviews.view'),
+                                                            (8, 64, 'issues.view'),
+                                                            (9, 32, 'issues.create'),
+                                                            (10, 4096, 'issues.edit-related'),
+                                                            (11, 8192, 'issues.moderate'),
+                                                            (12, 128, 'members.view'),
+                                                            (13, 256, 'invites.create'),
+                                                            (14, 16384, 'members.remove-related'),
+                                                            (15, 512, 'members.remove')) p(pos, bit, name)
+                                               where r.permissions & p.bit <> 0
+                                               order by p.pos), ', ')
+                    end,
+                r.permissions
+from (select group_id, role_id
+      from user_in_group
+      union
+      select group_id, role_id
+      from invites) x
+         join roles r on r.id = x.role_id;
+
+alter table user_in_group
+    add column group_role_id bigint;
+update user_in_group uig
+set group_role_id = gr.id
+from roles r,
+     group_roles gr
+where r.id = uig.role_id
+  and gr.group_id = uig.group_id
+  and gr.permissions = r.permissions;
+alter table user_in_group
+    drop column role_id;
+alter table user_in_group
+    rename column group_role_id to role_id;
+alter table user_in_group
+    alter column role_id set not null;
+alter table user_in_group
+    add constraint role_id_fk foreign key (group_id, role_id) references group_roles (group_id, id);
+
+alter table invites
+    add column group_role_id bigint;
+update invites i
+set group_role_id = gr.id
+from roles r,
+     group_roles gr
+where r.id = i.role_id
+  and gr.group_id = i.group_id
+  and gr.permissions = r.permissions;
+alter table invites
+    drop column role_id;
+alter table invites
+    rename column group_role_id to role_id;
+alter table invites
+    add constraint role_id_fk foreign key (group_id, role_id) references group_roles (group_id, id);
+
+drop table roles;
+---- create above / drop below ----
+create table roles
+(
+    id         bigserial primary key not null unique,
+    members    int,
+    tasks      int,
+    reviews    int,
+    "comments" int,
+    constraint unique_role unique (members, tasks, reviews, "comments"),
+    check ( members >= 0 and tasks >= 0 and reviews >= 0 and "comments" >= 0 )
+);
+
+alter table group_roles
+    add column members    int,
+    add column tasks      int,
+    add column reviews    int,
+    add column "comments" int;
+update group_roles
+set members    = case
+                     when permissions & 512 <> 0 then 4
+                     when permissions & 16384 <> 0 then 3
+                     when permissions & 256 <> 0 then 2
+                     else 0 end,
+    tasks      = case
+                     when permissions & (2 | 4) <> 0 then 4
+                     when permissions & 2048 <> 0 then 3
+                     when permissions & 1 <> 0 then 2
+                     when permissions & 1024 <> 0 then 1
+                     else 0 end,
+    reviews    = case
+                     when permissions & 8 <> 0 then 4
+                     when permissions & 16 <> 0 then 1
+                     else 0 end,
+    "comments" = case
+                     when permissions & 8192 <> 0 then 4
+                     when permissions & 4096 <> 0 then 3
+                     when permissions & 32 <> 0 then 2
+                     when permissions & 64 <> 0 then 1
+                     else 0 end;
+insert into roles(members, tasks, reviews, "comments")
+select distinct members, tasks, reviews, "comments"
+from group_roles;
+
+alter table user_in_group
+    drop constraint role_id_fk;
+update user_in_group uig
+set role_id = r.id
+from group_roles gr
+         join roles r using (members, tasks, reviews, "comments")
+where gr.id = uig.role_id;
+alter table user_in_group
+    add constraint role_id_fk foreign key (role_id) references roles (id) match full on delete cascade;
+
+alter table invites
+    drop constraint role_id_fk;
+update invites i
+set role_id = r.id
+from group_roles gr
+         join roles r using (members, tasks, reviews, "comments")
+where gr.id = i.role_id;
+alter table invites
+    alter column role_id type int;
+alter table invites
+    add constraint role_id_fk foreign key (role_id) references roles (id) match full on delete cascade;
+
+drop table group_roles;
+drop function legacy_role_permissions(int, int, int, int);
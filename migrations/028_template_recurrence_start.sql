@@ -0,0 +1,8 @@
+alter table task_templates
+    add column recurrence_start timestamptz;
+update task_templates
+set recurrence_start = created_at
+where recurrence is not null;
+---- create above / drop below ----
+alter table task_templates
+    drop column recurrence_start;
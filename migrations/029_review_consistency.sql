@@ -0,0 +1,17 @@
+-- tasks which were sent to review without review get pending review from their creator.
+insert into reviews(id, task, user_id, msg, status)
+select gen_random_uuid(), t.id, t.created_by, '', 'PENDING'
+from tasks t
+where t.status = 'ON_REVIEW'
+  and not exists(select * from reviews r where r.task = t.id and r.status = 'PENDING');
+-- reviews which were left pending after task was moved out of review are resolved by status of task.
+update reviews r
+set status      = case when t.status = 'ACCEPTED' then 'ACCEPTED' else 'CHANGES_REQUESTED' end,
+    resolved_at = current_timestamp
+from tasks t
+where t.id = r.task
+  and r.status = 'PENDING'
+  and t.status <> 'ON_REVIEW';
+---- create above / drop below ----
+-- migration only repairs data to match review transitions and is deliberately irreversible: rolling it back would
+-- bring back tasks on review without pending review and pending reviews of tasks which are not on review.
@@ -0,0 +1,6 @@
+-- history of existing task could not be deleted; it is deleted only by cascade together with its task.
+create rule task_history_no_delete as on delete to task_history
+    where exists(select * from tasks t where t.id = old.task_id)
+    do instead nothing;
+---- create above / drop below ----
+drop rule task_history_no_delete on task_history;